@@ -33,6 +33,7 @@ func NewAuth(ctx http.Context, config config.Config, log log.Log) (*Auth, error)
 
 	auth.Extend("jwt", NewJwtGuard)
 	auth.Extend("session", NewSessionGuard)
+	auth.Extend("token", NewTokenGuard)
 	auth.Provider("orm", NewOrmUserProvider)
 
 	defaultGuardName := config.GetString("auth.defaults.guard")
@@ -58,10 +59,53 @@ func (r *Auth) Guard(name string) contractsauth.GuardDriver {
 	return r.guard(name)
 }
 
+// Password returns the password broker configured in auth.passwords, the default
+// broker is auth.defaults.passwords.
+func (r *Auth) Password(name ...string) contractsauth.PasswordBroker {
+	broker := r.config.GetString("auth.defaults.passwords", "users")
+	if len(name) > 0 && name[0] != "" {
+		broker = name[0]
+	}
+
+	return NewPasswordBroker(r.config, dbFacade, hashFacade, notificationFacade, urlFacade, broker)
+}
+
 func (r *Auth) Provider(name string, fn contractsauth.UserProviderFunc) {
 	providersFuncs.Store(name, fn)
 }
 
+// Tokens returns the personal access tokens of the user, the guard must use the token driver.
+func (r *Auth) Tokens(user any, guard ...string) contractsauth.UserTokens {
+	name := r.defaultGuardName
+	if len(guard) > 0 && guard[0] != "" {
+		name = guard[0]
+	}
+
+	if driver := r.config.GetString(fmt.Sprintf("auth.guards.%s.driver", name)); driver != "token" {
+		return &UserTokens{err: errors.AuthGuardNotTokenDriver.Args(name)}
+	}
+
+	userProvider, err := r.createUserProvider(r.config.GetString(fmt.Sprintf("auth.guards.%s.provider", name)))
+	if err != nil {
+		return &UserTokens{err: err}
+	}
+
+	tokenGuard, err := newTokenGuard(r.ctx, name, userProvider)
+	if err != nil {
+		return &UserTokens{err: err}
+	}
+
+	return &UserTokens{guard: tokenGuard, user: user}
+}
+
+func (r *Auth) TwoFactor() contractsauth.TwoFactor {
+	return NewTwoFactor(r.config, cryptFacade, cacheFacade)
+}
+
+func (r *Auth) Verification() contractsauth.EmailVerifier {
+	return NewEmailVerifier(r.config, notificationFacade, urlFacade)
+}
+
 func (r *Auth) createUserProvider(name string) (contractsauth.UserProvider, error) {
 	driverName := r.config.GetString(fmt.Sprintf("auth.providers.%s.driver", name))
 
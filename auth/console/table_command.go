@@ -0,0 +1,238 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/packages/modify"
+	"github.com/goravel/framework/support/env"
+	"github.com/goravel/framework/support/str"
+)
+
+// Usage:
+//
+//	./artisan auth:token-table
+//	./artisan auth:password-reset-table
+//	./artisan auth:remember-table
+//	./artisan migrate
+type TableCommand struct {
+	signature   string
+	description string
+	table       string
+	stub        func(timestamp string) string
+}
+
+func NewTokenTableCommand() *TableCommand {
+	return &TableCommand{
+		signature:   "auth:token-table",
+		description: "Create a migration for the personal access tokens database table",
+		table:       "personal_access_tokens",
+		stub:        tokenMigrationStub,
+	}
+}
+
+func NewPasswordResetTableCommand() *TableCommand {
+	return &TableCommand{
+		signature:   "auth:password-reset-table",
+		description: "Create a migration for the password reset tokens database table",
+		table:       "password_reset_tokens",
+		stub:        passwordResetMigrationStub,
+	}
+}
+
+func NewRememberTableCommand() *TableCommand {
+	return &TableCommand{
+		signature:   "auth:remember-table",
+		description: "Create a migration for the remember tokens database table",
+		table:       "remember_tokens",
+		stub:        rememberMigrationStub,
+	}
+}
+
+func (c *TableCommand) Signature() string {
+	return c.signature
+}
+
+func (c *TableCommand) Description() string {
+	return c.description
+}
+
+func (c *TableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "auth",
+	}
+}
+
+func (c *TableCommand) Handle(ctx console.Context) error {
+	timestamp := time.Now().Format("20060102150405")
+	filename := timestamp + "_create_" + c.table + "_table.go"
+	dest := filepath.Join("database", "migrations", filename)
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dest); err == nil {
+		ctx.Warning("Migration already exists: " + dest)
+		return nil
+	}
+
+	if err := os.WriteFile(dest, []byte(c.stub(timestamp)), 0o644); err != nil {
+		return err
+	}
+
+	ctx.Info("Migration created successfully: " + dest)
+
+	structName := "M" + timestamp + "Create" + str.Of(c.table).Studly().String() + "Table"
+	if err := c.registerMigration(structName); err != nil {
+		ctx.Warning("Could not auto-register migration: " + err.Error())
+		ctx.Warning("Add manually to your migrations registration:")
+		ctx.Info("  &migrations." + structName + "{},")
+	} else {
+		ctx.Info("Migration registered successfully")
+	}
+
+	ctx.Info("Run `./artisan migrate` to apply it.")
+	return nil
+}
+
+func (c *TableCommand) registerMigration(structName string) error {
+	if !env.IsBootstrapSetup() {
+		return errors.AuthTableRequiresBootstrapSetup.Args(c.signature)
+	}
+
+	modulePath := "goravel"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		modulePath = info.Main.Path
+	}
+	pkgImportPath := modulePath + "/database/migrations"
+	entry := fmt.Sprintf("&migrations.%s{}", structName)
+
+	return modify.AddMigration(pkgImportPath, entry)
+}
+
+// Column shape here MUST stay in sync with PersonalAccessToken in
+// auth/token_guard.go.
+func tokenMigrationStub(timestamp string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreatePersonalAccessTokensTable struct{}
+
+func (r *M` + timestamp + `CreatePersonalAccessTokensTable) Signature() string {
+	return "` + timestamp + `_create_personal_access_tokens_table"
+}
+
+func (r *M` + timestamp + `CreatePersonalAccessTokensTable) Up() error {
+	if facades.Schema().HasTable("personal_access_tokens") {
+		return nil
+	}
+
+	return facades.Schema().Create("personal_access_tokens", func(table schema.Blueprint) {
+		table.String("id", 20)
+		table.Primary("id")
+		table.String("guard")
+		table.String("user_id")
+		table.String("name")
+		table.String("token")
+		table.Text("abilities")
+		table.DateTime("last_used_at").Nullable()
+		table.DateTime("expires_at").Nullable()
+		table.DateTime("created_at").Nullable()
+		table.DateTime("updated_at").Nullable()
+		table.Index("guard", "user_id")
+	})
+}
+
+func (r *M` + timestamp + `CreatePersonalAccessTokensTable) Down() error {
+	return facades.Schema().DropIfExists("personal_access_tokens")
+}
+`
+}
+
+// Column shape here MUST stay in sync with PasswordResetToken in
+// auth/password_broker.go.
+func passwordResetMigrationStub(timestamp string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreatePasswordResetTokensTable struct{}
+
+func (r *M` + timestamp + `CreatePasswordResetTokensTable) Signature() string {
+	return "` + timestamp + `_create_password_reset_tokens_table"
+}
+
+func (r *M` + timestamp + `CreatePasswordResetTokensTable) Up() error {
+	if facades.Schema().HasTable("password_reset_tokens") {
+		return nil
+	}
+
+	return facades.Schema().Create("password_reset_tokens", func(table schema.Blueprint) {
+		table.String("email")
+		table.Primary("email")
+		table.String("token")
+		table.DateTime("created_at").Nullable()
+	})
+}
+
+func (r *M` + timestamp + `CreatePasswordResetTokensTable) Down() error {
+	return facades.Schema().DropIfExists("password_reset_tokens")
+}
+`
+}
+
+// Column shape here MUST stay in sync with RememberToken in
+// auth/session_guard.go.
+func rememberMigrationStub(timestamp string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreateRememberTokensTable struct{}
+
+func (r *M` + timestamp + `CreateRememberTokensTable) Signature() string {
+	return "` + timestamp + `_create_remember_tokens_table"
+}
+
+func (r *M` + timestamp + `CreateRememberTokensTable) Up() error {
+	if facades.Schema().HasTable("remember_tokens") {
+		return nil
+	}
+
+	return facades.Schema().Create("remember_tokens", func(table schema.Blueprint) {
+		table.String("id", 20)
+		table.Primary("id")
+		table.String("guard")
+		table.String("user_id")
+		table.String("token")
+		table.DateTime("expires_at").Nullable()
+		table.DateTime("created_at").Nullable()
+		table.Index("guard", "user_id")
+	})
+}
+
+func (r *M` + timestamp + `CreateRememberTokensTable) Down() error {
+	return facades.Schema().DropIfExists("remember_tokens")
+}
+`
+}
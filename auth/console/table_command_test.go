@@ -0,0 +1,72 @@
+package console
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/str"
+)
+
+func TestTableCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   *TableCommand
+		signature string
+		table     string
+	}{
+		{
+			name:      "personal access tokens",
+			command:   NewTokenTableCommand(),
+			signature: "auth:token-table",
+			table:     "personal_access_tokens",
+		},
+		{
+			name:      "password reset tokens",
+			command:   NewPasswordResetTableCommand(),
+			signature: "auth:password-reset-table",
+			table:     "password_reset_tokens",
+		},
+		{
+			name:      "remember tokens",
+			command:   NewRememberTableCommand(),
+			signature: "auth:remember-table",
+			table:     "remember_tokens",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+
+			ctx := mocksconsole.NewContext(t)
+			ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+				return strings.HasPrefix(s, "Migration created successfully:")
+			})).Once()
+			ctx.EXPECT().Warning(mock.MatchedBy(func(s string) bool {
+				return strings.HasPrefix(s, "Could not auto-register migration: [auth] "+test.signature)
+			})).Once()
+			ctx.EXPECT().Warning("Add manually to your migrations registration:").Once()
+			ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+				return strings.HasPrefix(s, "  &migrations.") && strings.HasSuffix(s, "Create"+str.Of(test.table).Studly().String()+"Table{},")
+			})).Once()
+			ctx.EXPECT().Info("Run `./artisan migrate` to apply it.").Once()
+
+			assert.Equal(t, test.signature, test.command.Signature())
+			assert.NoError(t, test.command.Handle(ctx))
+
+			entries, err := os.ReadDir("database/migrations")
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+			assert.Contains(t, entries[0].Name(), "_create_"+test.table+"_table.go")
+
+			content, err := os.ReadFile("database/migrations/" + entries[0].Name())
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `Create("`+test.table+`", func`)
+		})
+	}
+}
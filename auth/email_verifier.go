@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/spf13/cast"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/database"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+var _ contractsauth.EmailVerifier = (*EmailVerifier)(nil)
+
+// VerificationRoute is the name of the route that the verification link points to,
+// the id and hash are passed as the route parameters.
+const VerificationRoute = "verification.verify"
+
+type EmailVerifier struct {
+	config       config.Config
+	notification notification.Manager
+	url          route.URL
+}
+
+func NewEmailVerifier(config config.Config, notification notification.Manager, url route.URL) *EmailVerifier {
+	return &EmailVerifier{
+		config:       config,
+		notification: notification,
+		url:          url,
+	}
+}
+
+func (r *EmailVerifier) SendVerificationNotification(user contractsauth.MustVerifyEmail) error {
+	if user.HasVerifiedEmail() {
+		return nil
+	}
+	if r.notification == nil {
+		return errors.NotificationFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	url, err := r.VerificationURL(user)
+	if err != nil {
+		return err
+	}
+
+	return r.notification.Send(user, &VerifyEmail{
+		Url: url,
+	})
+}
+
+func (r *EmailVerifier) VerificationURL(user contractsauth.MustVerifyEmail) (string, error) {
+	if r.url == nil {
+		return "", errors.URLFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	id := database.GetID(user)
+	if id == nil {
+		return "", errors.AuthNoPrimaryKeyField
+	}
+
+	expire := r.config.GetInt("auth.verification.expire", 60)
+
+	return r.url.TemporarySignedRoute(VerificationRoute, carbon.Now().AddMinutes(expire).StdTime(), map[string]any{
+		"id":   id,
+		"hash": emailHash(user.GetEmailForVerification()),
+	})
+}
+
+func (r *EmailVerifier) Verify(ctx http.Context, user contractsauth.MustVerifyEmail) error {
+	if err := supporthttp.VerifyURL(r.config.GetString("app.key"), ctx.Request().Origin().URL, carbon.Now().StdTime()); err != nil {
+		return err
+	}
+
+	if ctx.Request().Route("id") != cast.ToString(database.GetID(user)) {
+		return errors.AuthEmailVerificationInvalid
+	}
+	if subtle.ConstantTimeCompare([]byte(ctx.Request().Route("hash")), []byte(emailHash(user.GetEmailForVerification()))) != 1 {
+		return errors.AuthEmailVerificationInvalid
+	}
+
+	if user.HasVerifiedEmail() {
+		return nil
+	}
+
+	return user.MarkEmailAsVerified()
+}
+
+func emailHash(email string) string {
+	sum := sha1.Sum([]byte(email))
+
+	return hex.EncodeToString(sum[:])
+}
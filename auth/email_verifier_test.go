@@ -0,0 +1,169 @@
+package auth
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockshttp "github.com/goravel/framework/mocks/http"
+	mocksnotification "github.com/goravel/framework/mocks/notification"
+	mocksroute "github.com/goravel/framework/mocks/route"
+	"github.com/goravel/framework/support/carbon"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+type verifiableUser struct {
+	ID       uint `gorm:"primaryKey"`
+	Email    string
+	verified bool
+}
+
+func (r *verifiableUser) GetEmailForVerification() string {
+	return r.Email
+}
+
+func (r *verifiableUser) HasVerifiedEmail() bool {
+	return r.verified
+}
+
+func (r *verifiableUser) MarkEmailAsVerified() error {
+	r.verified = true
+
+	return nil
+}
+
+func (r *verifiableUser) RouteNotificationFor(string) any {
+	return r.Email
+}
+
+func TestEmailVerifier(t *testing.T) {
+	var (
+		mockConfig       *mocksconfig.Config
+		mockNotification *mocksnotification.Manager
+		mockURL          *mocksroute.URL
+		verifier         *EmailVerifier
+	)
+
+	key := "12345678901234567890123456789012"
+	hash := emailHash("goravel@example.com")
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockNotification = mocksnotification.NewManager(t)
+		mockURL = mocksroute.NewURL(t)
+		verifier = NewEmailVerifier(mockConfig, mockNotification, mockURL)
+	}
+
+	mockRequest := func(rawURL, id, hash string) *mockshttp.Context {
+		ctx := mockshttp.NewContext(t)
+		request := mockshttp.NewContextRequest(t)
+		ctx.EXPECT().Request().Return(request)
+		request.EXPECT().Origin().Return(httptest.NewRequest(nethttp.MethodGet, rawURL, nil)).Once()
+		request.EXPECT().Route("id").Return(id).Maybe()
+		request.EXPECT().Route("hash").Return(hash).Maybe()
+
+		return ctx
+	}
+
+	t.Run("VerificationURL", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 1, Email: "goravel@example.com"}
+		mockConfig.EXPECT().GetInt("auth.verification.expire", 60).Return(30).Once()
+		mockURL.EXPECT().TemporarySignedRoute(VerificationRoute, now.Copy().AddMinutes(30).StdTime(), map[string]any{
+			"id":   uint(1),
+			"hash": hash,
+		}).Return("https://goravel.dev/email/verify/1/"+hash+"?expires=1&signature=a", nil).Once()
+
+		url, err := verifier.VerificationURL(user)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://goravel.dev/email/verify/1/"+hash+"?expires=1&signature=a", url)
+	})
+
+	t.Run("VerificationURL without the primary key", func(t *testing.T) {
+		beforeEach()
+
+		url, err := verifier.VerificationURL(&verifiableUser{Email: "goravel@example.com"})
+		assert.Empty(t, url)
+		assert.ErrorIs(t, err, errors.AuthNoPrimaryKeyField)
+	})
+
+	t.Run("SendVerificationNotification", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 1, Email: "goravel@example.com"}
+		mockConfig.EXPECT().GetInt("auth.verification.expire", 60).Return(60).Once()
+		mockURL.EXPECT().TemporarySignedRoute(VerificationRoute, mock.Anything, mock.Anything).Return("https://goravel.dev/email/verify", nil).Once()
+		mockNotification.EXPECT().Send(user, mock.MatchedBy(func(n notification.Notification) bool {
+			verifyEmail, ok := n.(*VerifyEmail)
+
+			return ok && verifyEmail.Url == "https://goravel.dev/email/verify"
+		})).Return(nil).Once()
+
+		assert.NoError(t, verifier.SendVerificationNotification(user))
+	})
+
+	t.Run("SendVerificationNotification when the email has been verified", func(t *testing.T) {
+		beforeEach()
+
+		assert.NoError(t, verifier.SendVerificationNotification(&verifiableUser{ID: 1, verified: true}))
+	})
+
+	signed, err := supporthttp.SignURL(key, "https://goravel.dev/email/verify/1/"+hash, now.Copy().AddHour().StdTime())
+	assert.NoError(t, err)
+
+	t.Run("Verify", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 1, Email: "goravel@example.com"}
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		assert.NoError(t, verifier.Verify(mockRequest(signed, "1", hash), user))
+		assert.True(t, user.verified)
+	})
+
+	t.Run("Verify with an invalid signature", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 1, Email: "goravel@example.com"}
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		assert.ErrorIs(t, verifier.Verify(mockRequest("https://goravel.dev/email/verify/1/"+hash, "1", hash), user), errors.HttpSignatureInvalid)
+		assert.False(t, user.verified)
+	})
+
+	t.Run("Verify with the id of another user", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 2, Email: "goravel@example.com"}
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		assert.ErrorIs(t, verifier.Verify(mockRequest(signed, "1", hash), user), errors.AuthEmailVerificationInvalid)
+		assert.False(t, user.verified)
+	})
+
+	t.Run("Verify with the hash of another email", func(t *testing.T) {
+		beforeEach()
+		user := &verifiableUser{ID: 1, Email: "other@example.com"}
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		assert.ErrorIs(t, verifier.Verify(mockRequest(signed, "1", hash), user), errors.AuthEmailVerificationInvalid)
+		assert.False(t, user.verified)
+	})
+}
+
+func TestVerifyEmailNotification(t *testing.T) {
+	verifyEmail := &VerifyEmail{Url: "https://goravel.dev/email/verify/1/hash?expires=1&signature=a"}
+	user := &verifiableUser{Email: "goravel@example.com"}
+
+	assert.Equal(t, []string{notification.ChannelMail}, verifyEmail.Via(user))
+
+	message := verifyEmail.ToMail(user)
+	assert.Equal(t, "Verify Email Address", message.Subject)
+	assert.Contains(t, message.Content.Html, `<a href="https://goravel.dev/email/verify/1/hash?expires=1&amp;signature=a">Verify Email Address</a>`)
+	assert.Contains(t, message.Content.Text, "Verify Email Address: https://goravel.dev/email/verify/1/hash?expires=1&signature=a")
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/notification/mail"
+)
+
+// ResetPassword is the notification sent by PasswordBroker.SendResetLink, implement
+// auth.CanResetPasswordWithNotification on the user to send a custom one.
+type ResetPassword struct {
+	Url    string
+	Expire int
+}
+
+func (r *ResetPassword) Via(notification.Notifiable) []string {
+	return []string{notification.ChannelMail}
+}
+
+func (r *ResetPassword) ToMail(notification.Notifiable) notification.MailMessage {
+	lines := []string{
+		"You are receiving this email because we received a password reset request for your account.",
+		fmt.Sprintf("This password reset link will expire in %d minutes.", r.Expire),
+		"If you did not request a password reset, no further action is required.",
+	}
+
+	return mail.NewMessage().
+		Subject("Reset Password Notification").
+		Html(fmt.Sprintf(`<p>%s</p><p><a href="%s">Reset Password</a></p><p>%s</p><p>%s</p>`, lines[0], html.EscapeString(r.Url), lines[1], lines[2])).
+		Text(fmt.Sprintf("%s\n\nReset Password: %s\n\n%s\n\n%s", lines[0], r.Url, lines[1], lines[2])).
+		Build()
+}
+
+// VerifyEmail is the notification sent by EmailVerifier.SendVerificationNotification.
+type VerifyEmail struct {
+	Url string
+}
+
+func (r *VerifyEmail) Via(notification.Notifiable) []string {
+	return []string{notification.ChannelMail}
+}
+
+func (r *VerifyEmail) ToMail(notification.Notifiable) notification.MailMessage {
+	lines := []string{
+		"Please click the button below to verify your email address.",
+		"If you did not create an account, no further action is required.",
+	}
+
+	return mail.NewMessage().
+		Subject("Verify Email Address").
+		Html(fmt.Sprintf(`<p>%s</p><p><a href="%s">Verify Email Address</a></p><p>%s</p>`, lines[0], html.EscapeString(r.Url), lines[1])).
+		Text(fmt.Sprintf("%s\n\nVerify Email Address: %s\n\n%s", lines[0], r.Url, lines[1])).
+		Build()
+}
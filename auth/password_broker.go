@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"fmt"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/contracts/hash"
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/str"
+)
+
+var _ contractsauth.PasswordBroker = (*PasswordBroker)(nil)
+
+// PasswordResetRoute is the name of the route that the reset link points to, the
+// token and email are passed as the route parameters.
+const PasswordResetRoute = "password.reset"
+
+// PasswordResetToken is a row of the password_reset_tokens table.
+type PasswordResetToken struct {
+	CreatedAt *carbon.DateTime `db:"created_at"`
+	Email     string           `db:"email"`
+	Token     string           `db:"token"`
+}
+
+type PasswordBroker struct {
+	db           db.DB
+	hash         hash.Hash
+	notification notification.Manager
+	url          route.URL
+	table        string
+	expire       int
+	throttle     int
+}
+
+func NewPasswordBroker(config config.Config, db db.DB, hash hash.Hash, notification notification.Manager, url route.URL, name string) *PasswordBroker {
+	return &PasswordBroker{
+		db:           db,
+		hash:         hash,
+		notification: notification,
+		url:          url,
+		table:        config.GetString(fmt.Sprintf("auth.passwords.%s.table", name), "password_reset_tokens"),
+		expire:       config.GetInt(fmt.Sprintf("auth.passwords.%s.expire", name), 60),
+		throttle:     config.GetInt(fmt.Sprintf("auth.passwords.%s.throttle", name), 60),
+	}
+}
+
+func (r *PasswordBroker) CreateToken(user contractsauth.CanResetPassword) (string, error) {
+	if r.db == nil {
+		return "", errors.DBFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+	if r.hash == nil {
+		return "", errors.HashFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	email := user.GetEmailForPasswordReset()
+	row, err := r.find(email)
+	if err != nil {
+		return "", err
+	}
+	if r.recentlyCreated(row) {
+		return "", errors.AuthPasswordResetThrottled
+	}
+
+	token := str.Random(64)
+	hashed, err := r.hash.Make(token)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.DeleteToken(user); err != nil {
+		return "", err
+	}
+
+	if _, err := r.db.Table(r.table).Insert(&PasswordResetToken{
+		CreatedAt: carbon.NewDateTime(carbon.Now()),
+		Email:     email,
+		Token:     hashed,
+	}); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (r *PasswordBroker) DeleteToken(user contractsauth.CanResetPassword) error {
+	if r.db == nil {
+		return errors.DBFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	_, err := r.db.Table(r.table).Where("email", user.GetEmailForPasswordReset()).Delete()
+
+	return err
+}
+
+func (r *PasswordBroker) Reset(user contractsauth.CanResetPassword, token string, callback func() error) error {
+	if !r.TokenExists(user, token) {
+		return errors.AuthPasswordResetTokenInvalid
+	}
+
+	if err := callback(); err != nil {
+		return err
+	}
+
+	return r.DeleteToken(user)
+}
+
+func (r *PasswordBroker) SendResetLink(user contractsauth.CanResetPassword) error {
+	token, err := r.CreateToken(user)
+	if err != nil {
+		return err
+	}
+
+	if notifier, ok := user.(contractsauth.CanResetPasswordWithNotification); ok {
+		return notifier.SendPasswordResetNotification(token)
+	}
+
+	if r.notification == nil {
+		return errors.NotificationFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+	if r.url == nil {
+		return errors.URLFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	url, err := r.url.Route(PasswordResetRoute, map[string]any{
+		"token": token,
+		"email": user.GetEmailForPasswordReset(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.notification.Send(user, &ResetPassword{
+		Url:    url,
+		Expire: r.expire,
+	})
+}
+
+func (r *PasswordBroker) TokenExists(user contractsauth.CanResetPassword, token string) bool {
+	if r.db == nil || r.hash == nil || token == "" {
+		return false
+	}
+
+	row, err := r.find(user.GetEmailForPasswordReset())
+	if err != nil || row.Email == "" || row.CreatedAt == nil {
+		return false
+	}
+	if !carbon.Now().Lt(row.CreatedAt.Copy().AddMinutes(r.expire)) {
+		return false
+	}
+
+	return r.hash.Check(token, row.Token)
+}
+
+func (r *PasswordBroker) find(email string) (PasswordResetToken, error) {
+	var row PasswordResetToken
+	err := r.db.Table(r.table).Where("email", email).First(&row)
+
+	return row, err
+}
+
+func (r *PasswordBroker) recentlyCreated(row PasswordResetToken) bool {
+	if r.throttle <= 0 || row.Email == "" || row.CreatedAt == nil {
+		return false
+	}
+
+	return carbon.Now().Lt(row.CreatedAt.Copy().AddSeconds(r.throttle))
+}
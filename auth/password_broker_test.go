@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mockshash "github.com/goravel/framework/mocks/hash"
+	mocksnotification "github.com/goravel/framework/mocks/notification"
+	mocksroute "github.com/goravel/framework/mocks/route"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type resettableUser struct {
+	ID    uint `gorm:"primaryKey"`
+	Email string
+	token string
+}
+
+func (r *resettableUser) GetEmailForPasswordReset() string {
+	return r.Email
+}
+
+func (r *resettableUser) RouteNotificationFor(string) any {
+	return r.Email
+}
+
+type customResettableUser struct {
+	resettableUser
+}
+
+func (r *customResettableUser) SendPasswordResetNotification(token string) error {
+	r.token = token
+
+	return nil
+}
+
+func TestPasswordBroker(t *testing.T) {
+	var (
+		mockConfig       *mocksconfig.Config
+		mockDB           *mocksdb.DB
+		mockHash         *mockshash.Hash
+		mockNotification *mocksnotification.Manager
+		mockURL          *mocksroute.URL
+		broker           *PasswordBroker
+	)
+
+	user := &resettableUser{ID: 1, Email: "goravel@example.com"}
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockDB = mocksdb.NewDB(t)
+		mockHash = mockshash.NewHash(t)
+		mockNotification = mocksnotification.NewManager(t)
+		mockURL = mocksroute.NewURL(t)
+		mockConfig.EXPECT().GetString("auth.passwords.users.table", "password_reset_tokens").Return("password_reset_tokens").Once()
+		mockConfig.EXPECT().GetInt("auth.passwords.users.expire", 60).Return(60).Once()
+		mockConfig.EXPECT().GetInt("auth.passwords.users.throttle", 60).Return(60).Once()
+
+		broker = NewPasswordBroker(mockConfig, mockDB, mockHash, mockNotification, mockURL, "users")
+	}
+
+	expectFind := func(row PasswordResetToken) {
+		query := mocksdb.NewQuery(t)
+		mockDB.EXPECT().Table("password_reset_tokens").Return(query).Once()
+		query.EXPECT().Where("email", user.Email).Return(query).Once()
+		query.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*PasswordResetToken) = row
+		}).Return(nil).Once()
+	}
+
+	expectDelete := func() {
+		query := mocksdb.NewQuery(t)
+		mockDB.EXPECT().Table("password_reset_tokens").Return(query).Once()
+		query.EXPECT().Where("email", user.Email).Return(query).Once()
+		query.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+	}
+
+	expectCreate := func() {
+		expectFind(PasswordResetToken{})
+		mockHash.EXPECT().Make(mock.AnythingOfType("string")).Return("hashed", nil).Once()
+		expectDelete()
+		query := mocksdb.NewQuery(t)
+		mockDB.EXPECT().Table("password_reset_tokens").Return(query).Once()
+		query.EXPECT().Insert(mock.MatchedBy(func(row *PasswordResetToken) bool {
+			return row.Email == user.Email && row.Token == "hashed" && row.CreatedAt.Timestamp() == now.Timestamp()
+		})).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+	}
+
+	t.Run("CreateToken", func(t *testing.T) {
+		beforeEach()
+		expectCreate()
+
+		token, err := broker.CreateToken(user)
+		assert.NoError(t, err)
+		assert.Len(t, token, 64)
+	})
+
+	t.Run("CreateToken is throttled", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now.Copy().SubSeconds(30))})
+
+		token, err := broker.CreateToken(user)
+		assert.Empty(t, token)
+		assert.ErrorIs(t, err, errors.AuthPasswordResetThrottled)
+	})
+
+	t.Run("CreateToken after the throttle seconds", func(t *testing.T) {
+		beforeEach()
+		query := mocksdb.NewQuery(t)
+		mockDB.EXPECT().Table("password_reset_tokens").Return(query).Times(3)
+		query.EXPECT().Where("email", user.Email).Return(query).Twice()
+		query.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*PasswordResetToken) = PasswordResetToken{Email: user.Email, Token: "old", CreatedAt: carbon.NewDateTime(now.Copy().SubSeconds(61))}
+		}).Return(nil).Once()
+		mockHash.EXPECT().Make(mock.AnythingOfType("string")).Return("hashed", nil).Once()
+		query.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+		query.EXPECT().Insert(mock.Anything).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+		token, err := broker.CreateToken(user)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+	})
+
+	t.Run("TokenExists", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now.Copy().SubMinutes(59))})
+		mockHash.EXPECT().Check("token", "hashed").Return(true).Once()
+
+		assert.True(t, broker.TokenExists(user, "token"))
+	})
+
+	t.Run("TokenExists when the token has expired", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now.Copy().SubMinutes(60))})
+
+		assert.False(t, broker.TokenExists(user, "token"))
+	})
+
+	t.Run("TokenExists when the token doesn't match", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now)})
+		mockHash.EXPECT().Check("token", "hashed").Return(false).Once()
+
+		assert.False(t, broker.TokenExists(user, "token"))
+	})
+
+	t.Run("TokenExists when the token doesn't exist", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{})
+
+		assert.False(t, broker.TokenExists(user, "token"))
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now)})
+		mockHash.EXPECT().Check("token", "hashed").Return(true).Once()
+		expectDelete()
+
+		called := false
+		assert.NoError(t, broker.Reset(user, "token", func() error {
+			called = true
+
+			return nil
+		}))
+		assert.True(t, called)
+	})
+
+	t.Run("Reset with an invalid token", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now)})
+		mockHash.EXPECT().Check("token", "hashed").Return(false).Once()
+
+		err := broker.Reset(user, "token", func() error {
+			t.Fatal("the callback shouldn't be called")
+
+			return nil
+		})
+		assert.ErrorIs(t, err, errors.AuthPasswordResetTokenInvalid)
+	})
+
+	t.Run("Reset keeps the token if the callback fails", func(t *testing.T) {
+		beforeEach()
+		expectFind(PasswordResetToken{Email: user.Email, Token: "hashed", CreatedAt: carbon.NewDateTime(now)})
+		mockHash.EXPECT().Check("token", "hashed").Return(true).Once()
+
+		assert.EqualError(t, broker.Reset(user, "token", func() error {
+			return assert.AnError
+		}), assert.AnError.Error())
+	})
+
+	t.Run("SendResetLink", func(t *testing.T) {
+		beforeEach()
+		expectCreate()
+		mockURL.EXPECT().Route(PasswordResetRoute, mock.MatchedBy(func(parameters map[string]any) bool {
+			return len(parameters["token"].(string)) == 64 && parameters["email"] == user.Email
+		})).Return("https://goravel.dev/reset-password/token?email=goravel%40example.com", nil).Once()
+		mockNotification.EXPECT().Send(user, mock.MatchedBy(func(n notification.Notification) bool {
+			resetPassword, ok := n.(*ResetPassword)
+
+			return ok && resetPassword.Url == "https://goravel.dev/reset-password/token?email=goravel%40example.com" && resetPassword.Expire == 60
+		})).Return(nil).Once()
+
+		assert.NoError(t, broker.SendResetLink(user))
+	})
+
+	t.Run("SendResetLink with a custom notification", func(t *testing.T) {
+		beforeEach()
+		expectCreate()
+		customUser := &customResettableUser{resettableUser: *user}
+
+		assert.NoError(t, broker.SendResetLink(customUser))
+		assert.Len(t, customUser.token, 64)
+	})
+
+	t.Run("SendResetLink when the notification facade is not set", func(t *testing.T) {
+		beforeEach()
+		expectCreate()
+		broker.notification = nil
+
+		assert.ErrorIs(t, broker.SendResetLink(user), errors.NotificationFacadeNotSet)
+	})
+}
+
+func TestResetPasswordNotification(t *testing.T) {
+	resetPassword := &ResetPassword{Url: "https://goravel.dev/reset-password/token?email=a&b", Expire: 60}
+	user := &resettableUser{Email: "goravel@example.com"}
+
+	assert.Equal(t, []string{notification.ChannelMail}, resetPassword.Via(user))
+
+	message := resetPassword.ToMail(user)
+	assert.Equal(t, "Reset Password Notification", message.Subject)
+	assert.Contains(t, message.Content.Html, `<a href="https://goravel.dev/reset-password/token?email=a&amp;b">Reset Password</a>`)
+	assert.Contains(t, message.Content.Html, "This password reset link will expire in 60 minutes.")
+	assert.Contains(t, message.Content.Text, "Reset Password: https://goravel.dev/reset-password/token?email=a&b")
+}
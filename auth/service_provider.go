@@ -9,17 +9,27 @@ import (
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
 	contractconsole "github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/hash"
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/contracts/route"
 	"github.com/goravel/framework/errors"
 	"github.com/goravel/framework/support/binding"
 )
 
 var (
-	cacheFacade  cache.Cache
-	configFacade config.Config
-	ormFacade    orm.Orm
+	cacheFacade        cache.Cache
+	configFacade       config.Config
+	cryptFacade        crypt.Crypt
+	dbFacade           db.DB
+	hashFacade         hash.Hash
+	notificationFacade notification.Manager
+	ormFacade          orm.Orm
+	urlFacade          route.URL
 )
 
 type ServiceProvider struct {
@@ -31,9 +41,19 @@ func (r *ServiceProvider) Relationship() contractsbinding.Relationship {
 		contractsbinding.Gate,
 	}
 
+	// The facades used by the optional features (eg: the token guard needs DB) aren't required
+	// by the bindings, they are declared here so they are booted first if they are installed.
+	dependencies := append(binding.Dependencies(bindings...),
+		contractsbinding.Crypt,
+		contractsbinding.DB,
+		contractsbinding.Hash,
+		contractsbinding.Notification,
+		contractsbinding.URL,
+	)
+
 	return contractsbinding.Relationship{
 		Bindings:     bindings,
-		Dependencies: binding.Dependencies(bindings...),
+		Dependencies: dependencies,
 		ProvideFor:   []string{},
 	}
 }
@@ -59,13 +79,21 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 		return NewAuth(nil, configFacade, log)
 	})
 	app.Singleton(contractsbinding.Gate, func(app foundation.Application) (any, error) {
-		return access.NewGate(context.Background()), nil
+		gate := access.NewGate(context.Background())
+		gate.Before(checkTokenAbilities)
+
+		return gate, nil
 	})
 }
 
 func (r *ServiceProvider) Boot(app foundation.Application) {
 	cacheFacade = app.MakeCache()
+	cryptFacade = app.MakeCrypt()
+	dbFacade = app.MakeDB()
+	hashFacade = app.MakeHash()
+	notificationFacade = app.MakeNotification()
 	ormFacade = app.MakeOrm()
+	urlFacade = app.MakeURL()
 
 	r.registerCommands(app)
 }
@@ -74,5 +102,8 @@ func (r *ServiceProvider) registerCommands(app foundation.Application) {
 	app.Commands([]contractconsole.Command{
 		console.NewJwtSecretCommand(app.MakeConfig()),
 		console.NewPolicyMakeCommand(),
+		console.NewPasswordResetTableCommand(),
+		console.NewRememberTableCommand(),
+		console.NewTokenTableCommand(),
 	})
 }
@@ -13,15 +13,20 @@ import (
 	frameworkerrors "github.com/goravel/framework/errors"
 	mockscache "github.com/goravel/framework/mocks/cache"
 	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockscrypt "github.com/goravel/framework/mocks/crypt"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	mockshash "github.com/goravel/framework/mocks/hash"
 	mockshttp "github.com/goravel/framework/mocks/http"
 	mockslog "github.com/goravel/framework/mocks/log"
-	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mocksnotification "github.com/goravel/framework/mocks/notification"
+	mocksroute "github.com/goravel/framework/mocks/route"
 	mockssession "github.com/goravel/framework/mocks/session"
 	"github.com/goravel/framework/support/binding"
 )
 
-const expectedAuthCommandCount = 2
+const expectedAuthCommandCount = 5
 
 func TestAuthServiceProviderRelationship(t *testing.T) {
 	provider := &ServiceProvider{}
@@ -30,7 +35,13 @@ func TestAuthServiceProviderRelationship(t *testing.T) {
 	bindings := []string{contractsbinding.Auth, contractsbinding.Gate}
 
 	assert.Equal(t, bindings, relationship.Bindings)
-	assert.Equal(t, binding.Dependencies(bindings...), relationship.Dependencies)
+	assert.Equal(t, append(binding.Dependencies(bindings...),
+		contractsbinding.Crypt,
+		contractsbinding.DB,
+		contractsbinding.Hash,
+		contractsbinding.Notification,
+		contractsbinding.URL,
+	), relationship.Dependencies)
 	assert.Empty(t, relationship.ProvideFor)
 }
 
@@ -136,17 +147,37 @@ func TestAuthServiceProviderBoot(t *testing.T) {
 	app := mocksfoundation.NewApplication(t)
 	cache := mockscache.NewCache(t)
 	config := mocksconfig.NewConfig(t)
+	crypt := mockscrypt.NewCrypt(t)
+	db := mocksdb.NewDB(t)
+	hash := mockshash.NewHash(t)
+	notification := mocksnotification.NewManager(t)
 	orm := mocksorm.NewOrm(t)
+	url := mocksroute.NewURL(t)
 
 	originCacheFacade := cacheFacade
+	originCryptFacade := cryptFacade
+	originDBFacade := dbFacade
+	originHashFacade := hashFacade
+	originNotificationFacade := notificationFacade
 	originOrmFacade := ormFacade
+	originURLFacade := urlFacade
 	t.Cleanup(func() {
 		cacheFacade = originCacheFacade
+		cryptFacade = originCryptFacade
+		dbFacade = originDBFacade
+		hashFacade = originHashFacade
+		notificationFacade = originNotificationFacade
 		ormFacade = originOrmFacade
+		urlFacade = originURLFacade
 	})
 
 	app.EXPECT().MakeCache().Return(cache).Once()
+	app.EXPECT().MakeCrypt().Return(crypt).Once()
+	app.EXPECT().MakeDB().Return(db).Once()
+	app.EXPECT().MakeHash().Return(hash).Once()
+	app.EXPECT().MakeNotification().Return(notification).Once()
 	app.EXPECT().MakeOrm().Return(orm).Once()
+	app.EXPECT().MakeURL().Return(url).Once()
 	app.EXPECT().MakeConfig().Return(config).Once()
 	app.EXPECT().Commands(mock.MatchedBy(func(commands []contractsconsole.Command) bool {
 		if len(commands) != expectedAuthCommandCount {
@@ -166,5 +197,10 @@ func TestAuthServiceProviderBoot(t *testing.T) {
 	provider.Boot(app)
 
 	assert.Same(t, cache, cacheFacade)
+	assert.Same(t, crypt, cryptFacade)
+	assert.Same(t, db, dbFacade)
+	assert.Same(t, hash, hashFacade)
+	assert.Same(t, notification, notificationFacade)
 	assert.Same(t, orm, ormFacade)
+	assert.Same(t, url, urlFacade)
 }
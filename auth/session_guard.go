@@ -2,21 +2,40 @@ package auth
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cast"
 
 	contractsauth "github.com/goravel/framework/contracts/auth"
+	"github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/http"
 	contractsession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/errors"
 	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/str"
 )
 
+var _ contractsauth.SessionGuard = (*SessionGuard)(nil)
+
+// RememberToken is a row of the remember_tokens table, every remember me cookie has a
+// row, so the user can stay logged in on several devices.
+type RememberToken struct {
+	CreatedAt *carbon.DateTime `db:"created_at"`
+	ExpiresAt *carbon.DateTime `db:"expires_at"`
+	ID        string           `db:"id"`
+	Guard     string           `db:"guard"`
+	UserID    string           `db:"user_id"`
+	Token     string           `db:"token"`
+}
+
 type SessionGuard struct {
-	session  contractsession.Session
-	ctx      http.Context
-	provider contractsauth.UserProvider
-	guard    string
+	session     contractsession.Session
+	ctx         http.Context
+	provider    contractsauth.UserProvider
+	guard       string
+	recalled    bool
+	viaRemember bool
 }
 
 func NewSessionGuard(ctx http.Context, name string, userProvider contractsauth.UserProvider) (contractsauth.GuardDriver, error) {
@@ -36,6 +55,59 @@ func NewSessionGuard(ctx http.Context, name string, userProvider contractsauth.U
 	}, nil
 }
 
+// ChallengeTwoFactor completes the login of the user that has passed the password check,
+// the TOTP code is checked first, then the recovery codes. The challenge is cleared after
+// too many failed attempts, so the user must log in with the password again.
+func (r *SessionGuard) ChallengeTwoFactor(user any, code string) error {
+	id, ok := r.session.Get(r.getTwoFactorName("id"), nil).(string)
+	if !ok || id == "" {
+		return errors.AuthTwoFactorChallengeNotFound
+	}
+
+	attempts := cast.ToInt(r.session.Get(r.getTwoFactorName("attempts"), 0))
+	if attempts >= r.getTwoFactorMaxAttempts() {
+		r.session.Forget(r.getTwoFactorName("id"), r.getTwoFactorName("remember"), r.getTwoFactorName("attempts"))
+
+		return errors.AuthTwoFactorTooManyAttempts
+	}
+
+	if err := r.provider.RetriveByID(user, id); err != nil {
+		return err
+	}
+
+	authenticatable, ok := user.(contractsauth.TwoFactorAuthenticatable)
+	if !ok {
+		return errors.AuthTwoFactorNotEnabled
+	}
+
+	twoFactor := NewTwoFactor(configFacade, cryptFacade, cacheFacade)
+	if !twoFactor.Verify(authenticatable, code) {
+		used, err := twoFactor.UseRecoveryCode(authenticatable, code)
+		if err != nil {
+			return err
+		}
+		if !used {
+			attempts++
+			if attempts >= r.getTwoFactorMaxAttempts() {
+				r.session.Forget(r.getTwoFactorName("id"), r.getTwoFactorName("remember"), r.getTwoFactorName("attempts"))
+
+				return errors.AuthTwoFactorTooManyAttempts
+			}
+
+			r.session.Put(r.getTwoFactorName("attempts"), attempts)
+
+			return errors.AuthTwoFactorCodeInvalid
+		}
+	}
+
+	remember := cast.ToBool(r.session.Get(r.getTwoFactorName("remember"), false))
+	r.session.Forget(r.getTwoFactorName("id"), r.getTwoFactorName("remember"), r.getTwoFactorName("attempts"))
+
+	_, err := r.login(id, remember)
+
+	return err
+}
+
 func (r *SessionGuard) Check() bool {
 	_, err := r.ID()
 
@@ -46,12 +118,18 @@ func (r *SessionGuard) Guest() bool {
 	return !r.Check()
 }
 
+func (r *SessionGuard) HasTwoFactorChallenge() bool {
+	id, ok := r.session.Get(r.getTwoFactorName("id"), nil).(string)
+
+	return ok && id != ""
+}
+
 func (r *SessionGuard) ID() (token string, err error) {
 	sessionName := r.getSessionName()
 	userID := r.session.Get(sessionName, nil)
 
 	if userID == nil {
-		return "", errors.AuthInvalidKey
+		return r.recall()
 	}
 
 	if id, ok := userID.(string); ok {
@@ -62,34 +140,56 @@ func (r *SessionGuard) ID() (token string, err error) {
 }
 
 func (r *SessionGuard) Login(user any) (token string, err error) {
+	return r.LoginRemember(user, false)
+}
+
+func (r *SessionGuard) LoginRemember(user any, remember bool) (token string, err error) {
 	id, err := r.provider.GetID(user)
 	if err != nil {
 		return "", err
 	}
 
-	return r.LoginUsingID(id)
-}
-
-func (r *SessionGuard) LoginUsingID(id any) (token string, err error) {
-	sessionName := r.getSessionName()
-	key := cast.ToString(id)
+	// The user is kept in the session until the two-factor code is verified, the login is
+	// completed by ChallengeTwoFactor.
+	if authenticatable, ok := user.(contractsauth.TwoFactorAuthenticatable); ok && authenticatable.GetTwoFactorSecret() != "" {
+		key := cast.ToString(id)
+		if key == "" {
+			return "", errors.AuthInvalidKey
+		}
 
-	if key == "" {
-		return "", errors.AuthInvalidKey
-	}
+		r.session.Put(r.getTwoFactorName("id"), key)
+		r.session.Put(r.getTwoFactorName("remember"), remember)
 
-	if err := r.session.Regenerate(true); err != nil {
-		return "", err
+		return "", errors.AuthTwoFactorRequired
 	}
 
-	r.session.Put(sessionName, key)
-	session.WriteCookie(r.ctx, r.session)
+	return r.login(id, remember)
+}
 
-	return "", nil
+// LoginUsingID logs the given user ID into the application, the two-factor challenge is
+// skipped since the user isn't available.
+func (r *SessionGuard) LoginUsingID(id any) (token string, err error) {
+	return r.login(id, false)
 }
 
+// Logout forgets the user and destroys the current session, so that it's no
+// longer listed in the sessions of the user. The remember me token of the
+// current device is revoked as well, the other devices are logged out too if
+// the "logout_other_devices" option of the guard is enabled.
 func (r *SessionGuard) Logout() error {
-	r.session.Forget(r.getSessionName())
+	if configFacade.GetBool(fmt.Sprintf("auth.guards.%s.logout_other_devices", r.guard)) {
+		if id, err := r.ID(); err == nil {
+			if err := r.logoutOtherDevices(id); err != nil {
+				return err
+			}
+		}
+	}
+
+	r.session.Forget(r.getSessionName(), contractsession.AttributeUserID, r.getTwoFactorName("id"), r.getTwoFactorName("remember"))
+
+	if err := r.forgetRememberToken(); err != nil {
+		return err
+	}
 
 	if err := r.session.Regenerate(true); err != nil {
 		return err
@@ -100,6 +200,17 @@ func (r *SessionGuard) Logout() error {
 	return nil
 }
 
+// LogoutOtherDevices destroys the sessions and the remember me tokens of the current user
+// except the current ones.
+func (r *SessionGuard) LogoutOtherDevices() error {
+	id, err := r.ID()
+	if err != nil {
+		return err
+	}
+
+	return r.logoutOtherDevices(id)
+}
+
 func (r *SessionGuard) Parse(token string) (*contractsauth.Payload, error) {
 	return nil, errors.AuthUnsupportedDriverMethod.Args("session")
 }
@@ -108,6 +219,21 @@ func (r *SessionGuard) Refresh() (token string, err error) {
 	return "", errors.AuthUnsupportedDriverMethod.Args("session")
 }
 
+// Sessions returns the active sessions of the current user.
+func (r *SessionGuard) Sessions() ([]contractsession.Activity, error) {
+	id, err := r.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := r.userDriver()
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.Sessions(id)
+}
+
 func (r *SessionGuard) User(user any) error {
 	id, err := r.ID()
 
@@ -118,6 +244,240 @@ func (r *SessionGuard) User(user any) error {
 	return r.provider.RetriveByID(user, id)
 }
 
+// ViaRemember determines if the user of the current request is authenticated by the remember
+// me cookie.
+func (r *SessionGuard) ViaRemember() bool {
+	return r.viaRemember
+}
+
+func (r *SessionGuard) createRememberToken(userID string) error {
+	if dbFacade == nil {
+		return errors.DBFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+	if hashFacade == nil {
+		return errors.HashFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	// Same as the personal access tokens, only the secret is hashed.
+	id := str.Random(20)
+	secret := str.Random(60)
+	hashed, err := hashFacade.Make(secret)
+	if err != nil {
+		return err
+	}
+
+	now := carbon.Now()
+	expiresAt := now.Copy().AddMinutes(r.getRememberLifetime())
+	if _, err := dbFacade.Table(r.getRememberTable()).Insert(&RememberToken{
+		CreatedAt: carbon.NewDateTime(now),
+		ExpiresAt: carbon.NewDateTime(expiresAt),
+		ID:        id,
+		Guard:     r.guard,
+		UserID:    userID,
+		Token:     hashed,
+	}); err != nil {
+		return err
+	}
+
+	r.ctx.Response().Cookie(http.Cookie{
+		Name:     r.getRememberName(),
+		Value:    id + "|" + secret,
+		Expires:  expiresAt.StdTime(),
+		Path:     configFacade.GetString("session.path"),
+		Domain:   configFacade.GetString("session.domain"),
+		Secure:   configFacade.GetBool("session.secure"),
+		HttpOnly: configFacade.GetBool("session.http_only"),
+		SameSite: configFacade.GetString("session.same_site"),
+	})
+
+	return nil
+}
+
+// expireRememberToken shortens the lifetime of the used token to the grace window, it returns
+// false if the token has already been used in the window.
+func (r *SessionGuard) expireRememberToken(row *RememberToken) (bool, error) {
+	expiresAt := carbon.NewDateTime(carbon.Now().AddSeconds(r.getRememberGrace()))
+	result, err := r.rememberQuery().Where("id", row.ID).Where(func(query db.Query) db.Query {
+		return query.Where("expires_at > ?", expiresAt).OrWhereNull("expires_at")
+	}).Update("expires_at", expiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *SessionGuard) forgetRememberToken() error {
+	value := r.ctx.Request().Cookie(r.getRememberName())
+	if value == "" {
+		return nil
+	}
+
+	r.ctx.Response().WithoutCookie(r.getRememberName())
+
+	id, _, _ := strings.Cut(value, "|")
+	if id == "" || dbFacade == nil {
+		return nil
+	}
+
+	_, err := r.rememberQuery().Where("id", id).Delete()
+
+	return err
+}
+
+func (r *SessionGuard) getRememberGrace() int {
+	return configFacade.GetInt(fmt.Sprintf("auth.guards.%s.remember.grace", r.guard), 30)
+}
+
+func (r *SessionGuard) getRememberLifetime() int {
+	// 400 days, the maximum lifetime of a cookie that browsers allow.
+	return configFacade.GetInt(fmt.Sprintf("auth.guards.%s.remember.lifetime", r.guard), 576000)
+}
+
+func (r *SessionGuard) getRememberName() string {
+	return fmt.Sprintf("remember_%s", r.guard)
+}
+
+func (r *SessionGuard) getRememberTable() string {
+	return configFacade.GetString(fmt.Sprintf("auth.guards.%s.remember.table", r.guard), "remember_tokens")
+}
+
 func (r *SessionGuard) getSessionName() string {
 	return fmt.Sprintf("auth_%s_id", r.guard)
 }
+
+func (r *SessionGuard) getTwoFactorMaxAttempts() int {
+	return configFacade.GetInt(fmt.Sprintf("auth.guards.%s.two_factor.max_attempts", r.guard), 5)
+}
+
+func (r *SessionGuard) getTwoFactorName(key string) string {
+	return fmt.Sprintf("auth_%s_two_factor_%s", r.guard, key)
+}
+
+func (r *SessionGuard) login(id any, remember bool) (token string, err error) {
+	key := cast.ToString(id)
+	if key == "" {
+		return "", errors.AuthInvalidKey
+	}
+
+	if err := r.updateSession(key); err != nil {
+		return "", err
+	}
+
+	if remember {
+		if err := r.createRememberToken(key); err != nil {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+func (r *SessionGuard) logoutOtherDevices(id string) error {
+	driver, err := r.userDriver()
+	if err != nil {
+		return err
+	}
+
+	if err := driver.DestroyOthers(id, r.session.GetID()); err != nil {
+		return err
+	}
+
+	if dbFacade == nil {
+		return nil
+	}
+
+	query := r.rememberQuery().Where("user_id", id)
+	if current, _, _ := strings.Cut(r.ctx.Request().Cookie(r.getRememberName()), "|"); current != "" {
+		query = query.WhereNot("id", current)
+	}
+	_, err = query.Delete()
+
+	return err
+}
+
+// recall logs the user in again by the remember me cookie once the session has expired. The
+// token is rotated after it's used, the used one stays valid for a short grace window only, so
+// the parallel requests of the device that send the same cookie are still logged in, but a
+// stolen cookie can't be used once the window has passed.
+func (r *SessionGuard) recall() (string, error) {
+	if r.recalled || dbFacade == nil || hashFacade == nil {
+		return "", errors.AuthInvalidKey
+	}
+	r.recalled = true
+
+	value := r.ctx.Request().Cookie(r.getRememberName())
+	if value == "" {
+		return "", errors.AuthInvalidKey
+	}
+
+	row, err := r.retrieveRememberToken(value)
+	if err != nil {
+		r.ctx.Response().WithoutCookie(r.getRememberName())
+
+		return "", err
+	}
+
+	rotated, err := r.expireRememberToken(row)
+	if err != nil {
+		return "", err
+	}
+	if err := r.updateSession(row.UserID); err != nil {
+		return "", err
+	}
+	// A parallel request has already rotated the token and sent the new cookie.
+	if rotated {
+		if err := r.createRememberToken(row.UserID); err != nil {
+			return "", err
+		}
+	}
+
+	r.viaRemember = true
+
+	return row.UserID, nil
+}
+
+func (r *SessionGuard) rememberQuery() db.Query {
+	return dbFacade.Table(r.getRememberTable()).Where("guard", r.guard)
+}
+
+func (r *SessionGuard) retrieveRememberToken(value string) (*RememberToken, error) {
+	id, secret, ok := strings.Cut(value, "|")
+	if !ok || id == "" || secret == "" {
+		return nil, errors.AuthInvalidToken
+	}
+
+	var row RememberToken
+	if err := r.rememberQuery().Where("id", id).First(&row); err != nil {
+		return nil, err
+	}
+	if row.ID == "" || !hashFacade.Check(secret, row.Token) {
+		return nil, errors.AuthInvalidToken
+	}
+	if row.ExpiresAt != nil && !carbon.Now().Lt(row.ExpiresAt.Carbon) {
+		return nil, errors.AuthTokenExpired
+	}
+
+	return &row, nil
+}
+
+func (r *SessionGuard) updateSession(id string) error {
+	if err := r.session.Regenerate(true); err != nil {
+		return err
+	}
+
+	r.session.Put(r.getSessionName(), id)
+	r.session.Put(contractsession.AttributeUserID, id)
+	session.WriteCookie(r.ctx, r.session)
+
+	return nil
+}
+
+func (r *SessionGuard) userDriver() (contractsession.UserDriver, error) {
+	userDriver, ok := r.session.GetDriver().(contractsession.UserDriver)
+	if !ok {
+		return nil, errors.SessionDriverNotSupportUserSessions.SetModule(errors.ModuleAuth)
+	}
+
+	return userDriver, nil
+}
@@ -1,18 +1,26 @@
 package auth
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/goravel/framework/contracts/config"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/http"
+	contractssession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/errors"
 	mocksauth "github.com/goravel/framework/mocks/auth"
 	mockscache "github.com/goravel/framework/mocks/cache"
 	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockscrypt "github.com/goravel/framework/mocks/crypt"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockshash "github.com/goravel/framework/mocks/hash"
 	mockshttp "github.com/goravel/framework/mocks/http"
 	mockslog "github.com/goravel/framework/mocks/log"
 	mockssession "github.com/goravel/framework/mocks/session"
@@ -26,6 +34,7 @@ type SessionGuardTestSuite struct {
 	mockCache          *mockscache.Cache
 	mockConfig         *mocksconfig.Config
 	mockContext        *mockshttp.Context
+	mockRequest        *mockshttp.ContextRequest
 	mockDB             *mocksorm.Query
 	mockLog            *mockslog.Log
 	mockUserProvider   *mocksauth.UserProvider
@@ -44,6 +53,9 @@ func (s *SessionGuardTestSuite) TearDownSuite() {
 
 func (s *SessionGuardTestSuite) TearDownTest() {
 	session.ConfigFacade = s.originConfigFacade
+	cryptFacade = nil
+	dbFacade = nil
+	hashFacade = nil
 }
 
 func (s *SessionGuardTestSuite) SetupTest() {
@@ -62,9 +74,13 @@ func (s *SessionGuardTestSuite) SetupTest() {
 	mockContext.EXPECT().Request().Return(mockRequest)
 
 	s.mockContext = mockContext
+	s.mockRequest = mockRequest
 
 	cacheFacade = s.mockCache
 	configFacade = s.mockConfig
+	cryptFacade = nil
+	dbFacade = nil
+	hashFacade = nil
 
 	s.originConfigFacade = session.ConfigFacade
 	session.ConfigFacade = s.mockConfig
@@ -140,6 +156,7 @@ func (s *SessionGuardTestSuite) TestCheck_LoginUsingID_Logout() {
 
 	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
 	s.mockSession.EXPECT().Put("auth_user_id", "1").Return(nil).Once()
+	s.mockSession.EXPECT().Put("_user_id", "1").Return(nil).Once()
 	s.expectWriteCookie("login-session-id")
 	token, err := s.sessionGuard.LoginUsingID(1)
 	s.Nil(err)
@@ -149,9 +166,11 @@ func (s *SessionGuardTestSuite) TestCheck_LoginUsingID_Logout() {
 	s.True(s.sessionGuard.Check())
 	s.False(s.sessionGuard.Guest())
 
-	s.mockSession.EXPECT().Forget("auth_user_id").Return(nil).Once()
+	s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+	s.mockRequest.EXPECT().Cookie("remember_user").Return("").Once()
 	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
 	s.expectWriteCookie("logout-session-id")
+	s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(false).Once()
 	s.NoError(s.sessionGuard.Logout())
 
 	s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
@@ -170,6 +189,7 @@ func (s *SessionGuardTestSuite) Test_Login() {
 	s.mockUserProvider.EXPECT().GetID(&user).Return("2", nil).Once()
 	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
 	s.mockSession.EXPECT().Put("auth_user_id", "2").Return(nil).Once()
+	s.mockSession.EXPECT().Put("_user_id", "2").Return(nil).Once()
 	s.expectWriteCookie("login-session-id")
 	token, err := s.sessionGuard.Login(&user)
 	s.Nil(err)
@@ -179,9 +199,11 @@ func (s *SessionGuardTestSuite) Test_Login() {
 	s.True(s.sessionGuard.Check())
 	s.False(s.sessionGuard.Guest())
 
-	s.mockSession.EXPECT().Forget("auth_user_id").Return(nil).Once()
+	s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+	s.mockRequest.EXPECT().Cookie("remember_user").Return("").Once()
 	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
 	s.expectWriteCookie("logout-session-id")
+	s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(false).Once()
 	s.NoError(s.sessionGuard.Logout())
 
 	s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
@@ -206,9 +228,11 @@ func (s *SessionGuardTestSuite) Test_LoginFailed() {
 	s.False(s.sessionGuard.Check())
 	s.True(s.sessionGuard.Guest())
 
-	s.mockSession.EXPECT().Forget("auth_user_id").Return(nil).Once()
+	s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+	s.mockRequest.EXPECT().Cookie("remember_user").Return("").Once()
 	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
 	s.expectWriteCookie("logout-session-id")
+	s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(false).Once()
 	s.NoError(s.sessionGuard.Logout())
 
 	s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
@@ -268,8 +292,433 @@ func (s *SessionGuardTestSuite) Test_LoginUsingID_RegenerateError() {
 }
 
 func (s *SessionGuardTestSuite) Test_Logout_RegenerateError() {
-	s.mockSession.EXPECT().Forget("auth_user_id").Return(nil).Once()
+	s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+	s.mockRequest.EXPECT().Cookie("remember_user").Return("").Once()
 	s.mockSession.EXPECT().Regenerate(true).Return(assert.AnError).Once()
 
+	s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(false).Once()
 	s.ErrorIs(s.sessionGuard.Logout(), assert.AnError)
 }
+
+func (s *SessionGuardTestSuite) Test_Sessions() {
+	s.Run("not logged in", func() {
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Twice()
+
+		sessions, err := s.sessionGuard.Sessions()
+		s.Nil(sessions)
+		s.ErrorIs(err, errors.AuthInvalidKey)
+		s.ErrorIs(s.sessionGuard.LogoutOtherDevices(), errors.AuthInvalidKey)
+	})
+
+	s.Run("driver doesn't index users", func() {
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return("1").Once()
+		s.mockSession.EXPECT().GetDriver().Return(mockssession.NewDriver(s.T())).Once()
+
+		sessions, err := s.sessionGuard.Sessions()
+		s.Nil(sessions)
+		s.ErrorIs(err, errors.SessionDriverNotSupportUserSessions)
+	})
+
+	s.Run("list and revoke", func() {
+		mockDriver := mockssession.NewUserDriver(s.T())
+		activities := []contractssession.Activity{{ID: "current", UserID: "1"}, {ID: "other", UserID: "1"}}
+
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return("1").Twice()
+		s.mockSession.EXPECT().GetDriver().Return(mockDriver).Twice()
+		mockDriver.EXPECT().Sessions("1").Return(activities, nil).Once()
+
+		sessions, err := s.sessionGuard.Sessions()
+		s.Nil(err)
+		s.Equal(activities, sessions)
+
+		s.mockSession.EXPECT().GetID().Return("current").Once()
+		mockDriver.EXPECT().DestroyOthers("1", "current").Return(nil).Once()
+		s.NoError(s.sessionGuard.LogoutOtherDevices())
+	})
+
+	s.Run("logout other devices", func() {
+		mockDriver := mockssession.NewUserDriver(s.T())
+
+		s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(true).Once()
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return("1").Once()
+		s.mockSession.EXPECT().GetDriver().Return(mockDriver).Once()
+		s.mockSession.EXPECT().GetID().Return("current").Once()
+		mockDriver.EXPECT().DestroyOthers("1", "current").Return(nil).Once()
+		s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("").Once()
+		s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+		s.expectWriteCookie("new")
+
+		s.NoError(s.sessionGuard.Logout())
+	})
+}
+
+func (s *SessionGuardTestSuite) expectRememberCookie() {
+	s.mockConfig.EXPECT().GetInt("auth.guards.user.remember.lifetime", 576000).Return(60).Once()
+	s.mockConfig.EXPECT().GetString("session.path").Return("/").Once()
+	s.mockConfig.EXPECT().GetString("session.domain").Return("").Once()
+	s.mockConfig.EXPECT().GetBool("session.secure").Return(false).Once()
+	s.mockConfig.EXPECT().GetBool("session.http_only").Return(true).Once()
+	s.mockConfig.EXPECT().GetString("session.same_site").Return("").Once()
+
+	mockResponse := mockshttp.NewContextResponse(s.T())
+	mockResponse.EXPECT().Cookie(mock.MatchedBy(func(cookie http.Cookie) bool {
+		id, secret, _ := strings.Cut(cookie.Value, "|")
+
+		return cookie.Name == "remember_user" && len(id) == 20 && len(secret) == 60 &&
+			cookie.Expires.Equal(s.now.Copy().AddMinutes(60).StdTime()) && cookie.Path == "/" && cookie.HttpOnly
+	})).Return(mockResponse).Once()
+	s.mockContext.EXPECT().Response().Return(mockResponse).Once()
+}
+
+func (s *SessionGuardTestSuite) Test_LoginRemember() {
+	mockDB := mocksdb.NewDB(s.T())
+	mockHash := mockshash.NewHash(s.T())
+	mockQuery := mocksdb.NewQuery(s.T())
+	dbFacade = mockDB
+	hashFacade = mockHash
+
+	var user User
+	user.ID = 2
+
+	s.mockUserProvider.EXPECT().GetID(&user).Return("2", nil).Once()
+	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+	s.mockSession.EXPECT().Put("auth_user_id", "2").Return(nil).Once()
+	s.mockSession.EXPECT().Put("_user_id", "2").Return(nil).Once()
+	s.expectWriteCookie("login-session-id")
+
+	mockHash.EXPECT().Make(mock.AnythingOfType("string")).Return("hashed", nil).Once()
+	s.mockConfig.EXPECT().GetString("auth.guards.user.remember.table", "remember_tokens").Return("remember_tokens").Once()
+	mockDB.EXPECT().Table("remember_tokens").Return(mockQuery).Once()
+	mockQuery.EXPECT().Insert(mock.MatchedBy(func(row *RememberToken) bool {
+		return len(row.ID) == 20 && row.Guard == "user" && row.UserID == "2" && row.Token == "hashed" &&
+			row.ExpiresAt.Timestamp() == s.now.Copy().AddMinutes(60).Timestamp()
+	})).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+	s.expectRememberCookie()
+
+	token, err := s.sessionGuard.LoginRemember(&user, true)
+	s.NoError(err)
+	s.Empty(token)
+	s.False(s.sessionGuard.ViaRemember())
+}
+
+func (s *SessionGuardTestSuite) Test_LoginRemember_DBFacadeNotSet() {
+	var user User
+	user.ID = 2
+
+	s.mockUserProvider.EXPECT().GetID(&user).Return("2", nil).Once()
+	s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+	s.mockSession.EXPECT().Put("auth_user_id", "2").Return(nil).Once()
+	s.mockSession.EXPECT().Put("_user_id", "2").Return(nil).Once()
+	s.expectWriteCookie("login-session-id")
+
+	token, err := s.sessionGuard.LoginRemember(&user, true)
+	s.ErrorIs(err, errors.DBFacadeNotSet)
+	s.Empty(token)
+}
+
+func (s *SessionGuardTestSuite) Test_Recall() {
+	var (
+		mockDB    *mocksdb.DB
+		mockHash  *mockshash.Hash
+		mockQuery *mocksdb.Query
+	)
+
+	beforeEach := func() {
+		mockDB = mocksdb.NewDB(s.T())
+		mockHash = mockshash.NewHash(s.T())
+		mockQuery = mocksdb.NewQuery(s.T())
+		dbFacade = mockDB
+		hashFacade = mockHash
+		s.sessionGuard.recalled = false
+		s.sessionGuard.viaRemember = false
+
+		s.mockConfig.EXPECT().GetString("auth.guards.user.remember.table", "remember_tokens").Return("remember_tokens")
+		mockDB.EXPECT().Table("remember_tokens").Return(mockQuery)
+		mockQuery.EXPECT().Where("guard", "user").Return(mockQuery)
+	}
+
+	expectFind := func(row RememberToken) {
+		mockQuery.EXPECT().Where("id", "token-id").Return(mockQuery)
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*RememberToken) = row
+		}).Return(nil).Once()
+	}
+
+	expectExpire := func(rowsAffected int64) {
+		s.mockConfig.EXPECT().GetInt("auth.guards.user.remember.grace", 30).Return(30).Once()
+		mockQuery.EXPECT().Where(mock.AnythingOfType("func(db.Query) db.Query")).Run(func(query any, args ...any) {
+			mockCondition := mocksdb.NewQuery(s.T())
+			mockCondition.EXPECT().Where("expires_at > ?", carbon.NewDateTime(s.now.Copy().AddSeconds(30))).Return(mockCondition).Once()
+			mockCondition.EXPECT().OrWhereNull("expires_at").Return(mockCondition).Once()
+			query.(func(contractsdb.Query) contractsdb.Query)(mockCondition)
+		}).Return(mockQuery).Once()
+		mockQuery.EXPECT().Update("expires_at", carbon.NewDateTime(s.now.Copy().AddSeconds(30))).Return(&contractsdb.Result{RowsAffected: rowsAffected}, nil).Once()
+	}
+
+	s.Run("logs the user in and rotates the token", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("token-id|secret").Once()
+		expectFind(RememberToken{ID: "token-id", Guard: "user", UserID: "1", Token: "hashed", ExpiresAt: carbon.NewDateTime(s.now.Copy().AddHour())})
+		mockHash.EXPECT().Check("secret", "hashed").Return(true).Once()
+		expectExpire(1)
+		s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+		s.mockSession.EXPECT().Put("auth_user_id", "1").Return(nil).Once()
+		s.mockSession.EXPECT().Put("_user_id", "1").Return(nil).Once()
+		s.expectWriteCookie("recall-session-id")
+		mockHash.EXPECT().Make(mock.AnythingOfType("string")).Return("new-hashed", nil).Once()
+		mockQuery.EXPECT().Insert(mock.MatchedBy(func(row *RememberToken) bool {
+			return len(row.ID) == 20 && row.Guard == "user" && row.UserID == "1" && row.Token == "new-hashed"
+		})).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+		s.expectRememberCookie()
+
+		id, err := s.sessionGuard.ID()
+		s.NoError(err)
+		s.Equal("1", id)
+		s.True(s.sessionGuard.ViaRemember())
+
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return("1").Once()
+		s.True(s.sessionGuard.Check())
+	})
+
+	s.Run("a parallel request uses the token in the grace window", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("token-id|secret").Once()
+		expectFind(RememberToken{ID: "token-id", Guard: "user", UserID: "1", Token: "hashed", ExpiresAt: carbon.NewDateTime(s.now.Copy().AddSeconds(20))})
+		mockHash.EXPECT().Check("secret", "hashed").Return(true).Once()
+		expectExpire(0)
+		s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+		s.mockSession.EXPECT().Put("auth_user_id", "1").Return(nil).Once()
+		s.mockSession.EXPECT().Put("_user_id", "1").Return(nil).Once()
+		s.expectWriteCookie("recall-session-id")
+
+		id, err := s.sessionGuard.ID()
+		s.NoError(err)
+		s.Equal("1", id)
+		s.True(s.sessionGuard.ViaRemember())
+	})
+
+	s.Run("the token doesn't match", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Twice()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("token-id|secret").Once()
+		expectFind(RememberToken{ID: "token-id", Guard: "user", UserID: "1", Token: "hashed"})
+		mockHash.EXPECT().Check("secret", "hashed").Return(false).Once()
+		mockResponse := mockshttp.NewContextResponse(s.T())
+		mockResponse.EXPECT().WithoutCookie("remember_user").Return(mockResponse).Once()
+		s.mockContext.EXPECT().Response().Return(mockResponse).Once()
+
+		id, err := s.sessionGuard.ID()
+		s.ErrorIs(err, errors.AuthInvalidToken)
+		s.Empty(id)
+		s.False(s.sessionGuard.ViaRemember())
+
+		// The cookie is only checked once.
+		s.True(s.sessionGuard.Guest())
+	})
+
+	s.Run("the token has expired", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("token-id|secret").Once()
+		expectFind(RememberToken{ID: "token-id", Guard: "user", UserID: "1", Token: "hashed", ExpiresAt: carbon.NewDateTime(s.now.Copy().SubSecond())})
+		mockHash.EXPECT().Check("secret", "hashed").Return(true).Once()
+		mockResponse := mockshttp.NewContextResponse(s.T())
+		mockResponse.EXPECT().WithoutCookie("remember_user").Return(mockResponse).Once()
+		s.mockContext.EXPECT().Response().Return(mockResponse).Once()
+
+		id, err := s.sessionGuard.ID()
+		s.ErrorIs(err, errors.AuthTokenExpired)
+		s.Empty(id)
+	})
+
+	s.Run("logout revokes the token", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Forget("auth_user_id", "_user_id", "auth_user_two_factor_id", "auth_user_two_factor_remember").Return(nil).Once()
+		s.mockRequest.EXPECT().Cookie("remember_user").Return("token-id|secret").Once()
+		mockResponse := mockshttp.NewContextResponse(s.T())
+		mockResponse.EXPECT().WithoutCookie("remember_user").Return(mockResponse).Once()
+		s.mockContext.EXPECT().Response().Return(mockResponse).Once()
+		mockQuery.EXPECT().Where("id", "token-id").Return(mockQuery).Once()
+		mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+		s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+		s.expectWriteCookie("logout-session-id")
+
+		s.mockConfig.EXPECT().GetBool("auth.guards.user.logout_other_devices").Return(false).Once()
+		s.NoError(s.sessionGuard.Logout())
+	})
+}
+
+type twoFactorUser struct {
+	User
+	secret        string
+	recoveryCodes string
+}
+
+func (r *twoFactorUser) GetTwoFactorSecret() string {
+	return r.secret
+}
+
+func (r *twoFactorUser) GetTwoFactorRecoveryCodes() string {
+	return r.recoveryCodes
+}
+
+func (r *twoFactorUser) SetTwoFactorSecret(secret string) error {
+	r.secret = secret
+
+	return nil
+}
+
+func (r *twoFactorUser) SetTwoFactorRecoveryCodes(codes string) error {
+	r.recoveryCodes = codes
+
+	return nil
+}
+
+func (s *SessionGuardTestSuite) Test_TwoFactor() {
+	var mockCrypt *mockscrypt.Crypt
+
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	key, err := base32NoPadding.DecodeString(secret)
+	s.Require().NoError(err)
+	code := totp(key, uint64(s.now.Timestamp()/totpPeriod))
+
+	beforeEach := func() {
+		mockCrypt = mockscrypt.NewCrypt(s.T())
+		cryptFacade = mockCrypt
+	}
+
+	expectLogin := func() {
+		s.mockSession.EXPECT().Forget("auth_user_two_factor_id", "auth_user_two_factor_remember", "auth_user_two_factor_attempts").Return(nil).Once()
+		s.mockSession.EXPECT().Regenerate(true).Return(nil).Once()
+		s.mockSession.EXPECT().Put("auth_user_id", "2").Return(nil).Once()
+		s.mockSession.EXPECT().Put("_user_id", "2").Return(nil).Once()
+		s.expectWriteCookie("login-session-id")
+	}
+
+	expectAttempts := func(attempts any) {
+		s.mockSession.EXPECT().Get("auth_user_two_factor_id", nil).Return("2").Once()
+		s.mockSession.EXPECT().Get("auth_user_two_factor_attempts", 0).Return(attempts).Once()
+		s.mockConfig.EXPECT().GetInt("auth.guards.user.two_factor.max_attempts", 5).Return(5).Once()
+	}
+
+	retrieveUser := func() {
+		expectAttempts(nil)
+		s.mockUserProvider.EXPECT().RetriveByID(mock.Anything, "2").RunAndReturn(func(user any, _ any) error {
+			user.(*twoFactorUser).ID = 2
+			user.(*twoFactorUser).secret = "encrypted-secret"
+			user.(*twoFactorUser).recoveryCodes = "encrypted-codes"
+
+			return nil
+		}).Once()
+	}
+
+	s.Run("login starts the challenge", func() {
+		beforeEach()
+		user := &twoFactorUser{secret: "encrypted-secret"}
+		user.ID = 2
+
+		s.mockUserProvider.EXPECT().GetID(user).Return(uint(2), nil).Once()
+		s.mockSession.EXPECT().Put("auth_user_two_factor_id", "2").Return(nil).Once()
+		s.mockSession.EXPECT().Put("auth_user_two_factor_remember", true).Return(nil).Once()
+
+		token, err := s.sessionGuard.LoginRemember(user, true)
+		s.ErrorIs(err, errors.AuthTwoFactorRequired)
+		s.Empty(token)
+
+		s.mockSession.EXPECT().Get("auth_user_two_factor_id", nil).Return("2").Once()
+		s.True(s.sessionGuard.HasTwoFactorChallenge())
+
+		s.mockSession.EXPECT().Get("auth_user_id", nil).Return(nil).Once()
+		s.False(s.sessionGuard.Check())
+	})
+
+	s.Run("challenge with the TOTP code", func() {
+		beforeEach()
+		retrieveUser()
+		mockCrypt.EXPECT().DecryptString("encrypted-secret").Return(secret, nil).Once()
+		s.mockCache.EXPECT().Add(mock.AnythingOfType("string"), true, 90*time.Second).Return(true).Once()
+		s.mockSession.EXPECT().Get("auth_user_two_factor_remember", false).Return(false).Once()
+		expectLogin()
+
+		var user twoFactorUser
+		s.NoError(s.sessionGuard.ChallengeTwoFactor(&user, code))
+		s.Equal(uint(2), user.ID)
+	})
+
+	s.Run("challenge with a used TOTP code", func() {
+		beforeEach()
+		retrieveUser()
+		mockCrypt.EXPECT().DecryptString("encrypted-secret").Return(secret, nil).Once()
+		s.mockCache.EXPECT().Add(mock.AnythingOfType("string"), true, 90*time.Second).Return(false).Once()
+		mockCrypt.EXPECT().DecryptString("encrypted-codes").Return(`["recovery-code"]`, nil).Once()
+		s.mockConfig.EXPECT().GetInt("auth.guards.user.two_factor.max_attempts", 5).Return(5).Once()
+		s.mockSession.EXPECT().Put("auth_user_two_factor_attempts", 1).Return(nil).Once()
+
+		var user twoFactorUser
+		s.ErrorIs(s.sessionGuard.ChallengeTwoFactor(&user, code), errors.AuthTwoFactorCodeInvalid)
+	})
+
+	s.Run("challenge with a recovery code", func() {
+		beforeEach()
+		retrieveUser()
+		mockCrypt.EXPECT().DecryptString("encrypted-secret").Return(secret, nil).Once()
+		mockCrypt.EXPECT().DecryptString("encrypted-codes").Return(`["other-code","recovery-code"]`, nil).Once()
+		mockCrypt.EXPECT().EncryptString(`["other-code"]`).Return("encrypted-remaining-codes", nil).Once()
+		s.mockSession.EXPECT().Get("auth_user_two_factor_remember", false).Return(false).Once()
+		expectLogin()
+
+		var user twoFactorUser
+		s.NoError(s.sessionGuard.ChallengeTwoFactor(&user, "recovery-code"))
+		s.Equal("encrypted-remaining-codes", user.recoveryCodes)
+	})
+
+	s.Run("challenge with an invalid code", func() {
+		beforeEach()
+		retrieveUser()
+		mockCrypt.EXPECT().DecryptString("encrypted-secret").Return(secret, nil).Once()
+		mockCrypt.EXPECT().DecryptString("encrypted-codes").Return(`["recovery-code"]`, nil).Once()
+		s.mockConfig.EXPECT().GetInt("auth.guards.user.two_factor.max_attempts", 5).Return(5).Once()
+		s.mockSession.EXPECT().Put("auth_user_two_factor_attempts", 1).Return(nil).Once()
+
+		var user twoFactorUser
+		s.ErrorIs(s.sessionGuard.ChallengeTwoFactor(&user, "000000"), errors.AuthTwoFactorCodeInvalid)
+	})
+
+	s.Run("the last failed attempt clears the challenge", func() {
+		beforeEach()
+		expectAttempts(4)
+		s.mockUserProvider.EXPECT().RetriveByID(mock.Anything, "2").RunAndReturn(func(user any, _ any) error {
+			user.(*twoFactorUser).secret = "encrypted-secret"
+			user.(*twoFactorUser).recoveryCodes = "encrypted-codes"
+
+			return nil
+		}).Once()
+		mockCrypt.EXPECT().DecryptString("encrypted-secret").Return(secret, nil).Once()
+		mockCrypt.EXPECT().DecryptString("encrypted-codes").Return(`["recovery-code"]`, nil).Once()
+		s.mockConfig.EXPECT().GetInt("auth.guards.user.two_factor.max_attempts", 5).Return(5).Once()
+		s.mockSession.EXPECT().Forget("auth_user_two_factor_id", "auth_user_two_factor_remember", "auth_user_two_factor_attempts").Return(nil).Once()
+
+		var user twoFactorUser
+		s.ErrorIs(s.sessionGuard.ChallengeTwoFactor(&user, "000000"), errors.AuthTwoFactorTooManyAttempts)
+	})
+
+	s.Run("challenge after too many attempts", func() {
+		beforeEach()
+		expectAttempts(5)
+		s.mockSession.EXPECT().Forget("auth_user_two_factor_id", "auth_user_two_factor_remember", "auth_user_two_factor_attempts").Return(nil).Once()
+
+		var user twoFactorUser
+		s.ErrorIs(s.sessionGuard.ChallengeTwoFactor(&user, code), errors.AuthTwoFactorTooManyAttempts)
+	})
+
+	s.Run("challenge without login", func() {
+		beforeEach()
+		s.mockSession.EXPECT().Get("auth_user_two_factor_id", nil).Return(nil).Once()
+
+		var user twoFactorUser
+		s.ErrorIs(s.sessionGuard.ChallengeTwoFactor(&user, code), errors.AuthTwoFactorChallengeNotFound)
+	})
+}
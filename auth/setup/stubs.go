@@ -22,7 +22,8 @@ func init() {
 		// reset options for your application. You may change these defaults
 		// as required, but they're a perfect start for most applications.
 		"defaults": map[string]any{
-			"guard": "user",
+			"guard":     "user",
+			"passwords": "users",
 		},
 
 		// Authentication Guards
@@ -35,7 +36,20 @@ func init() {
 		// users are actually retrieved out of your database or other storage
 		// mechanisms used by this application to persist your user's data.
 		//
-		// Supported drivers: "jwt", "session"
+		// Supported drivers: "jwt", "session", "token"
+		//
+		// The "token" driver stores the personal access tokens in the database, run
+		// "./artisan auth:token-table" to create the table. The "table" and "expiration"
+		// (in minutes, never expires if 0) options can be set for the guard.
+		//
+		// The "session" driver keeps the users logged in with the remember me cookie
+		// when LoginRemember is used, run "./artisan auth:remember-table" to create the
+		// table. The "remember.table", "remember.lifetime" (in minutes) and
+		// "remember.grace" (in seconds, the used token stays valid for the parallel
+		// requests, 30 by default) options can be set for the guard, and
+		// "logout_other_devices" logs out the other devices of the user when Logout is
+		// called. The two-factor challenge is cleared after "two_factor.max_attempts"
+		// (5 by default) failed attempts.
 		"guards": map[string]any{
 			"user": map[string]any{
 				"driver":   "jwt",
@@ -49,6 +63,28 @@ func init() {
 				"driver": "orm",
 			},
 		},
+
+		// Resetting Passwords
+		//
+		// The "expire" time is the number of minutes that each reset token will be
+		// considered valid, and the "throttle" is the number of seconds a user must
+		// wait before generating more password reset tokens. Run
+		// "./artisan auth:password-reset-table" to create the table.
+		"passwords": map[string]any{
+			"users": map[string]any{
+				"table":    "password_reset_tokens",
+				"expire":   60,
+				"throttle": 60,
+			},
+		},
+
+		// Email Verification
+		//
+		// The "expire" time is the number of minutes that the signed verification
+		// link will be considered valid.
+		"verification": map[string]any{
+			"expire": 60,
+		},
 	})
 }
 `
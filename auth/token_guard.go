@@ -0,0 +1,428 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/auth/access"
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	contractsaccess "github.com/goravel/framework/contracts/auth/access"
+	"github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/str"
+)
+
+var (
+	_ contractsauth.GuardFunc  = NewTokenGuard
+	_ contractsauth.TokenGuard = (*TokenGuard)(nil)
+)
+
+const ctxTokenKey = "GoravelAuthToken"
+
+// AccessTokens are the tokens of the current request, keyed by the guard name.
+type AccessTokens map[string]*contractsauth.AccessToken
+
+// PersonalAccessToken is a row of the personal_access_tokens table.
+type PersonalAccessToken struct {
+	CreatedAt  *carbon.DateTime `db:"created_at"`
+	UpdatedAt  *carbon.DateTime `db:"updated_at"`
+	LastUsedAt *carbon.DateTime `db:"last_used_at"`
+	ExpiresAt  *carbon.DateTime `db:"expires_at"`
+	ID         string           `db:"id"`
+	Guard      string           `db:"guard"`
+	UserID     string           `db:"user_id"`
+	Name       string           `db:"name"`
+	Token      string           `db:"token"`
+	Abilities  string           `db:"abilities"`
+}
+
+type TokenGuard struct {
+	ctx        http.Context
+	db         db.DB
+	provider   contractsauth.UserProvider
+	guard      string
+	table      string
+	expiration int
+}
+
+func NewTokenGuard(ctx http.Context, name string, userProvider contractsauth.UserProvider) (contractsauth.GuardDriver, error) {
+	if ctx == nil {
+		return nil, errors.InvalidHttpContext.SetModule(errors.ModuleAuth)
+	}
+
+	guard, err := newTokenGuard(ctx, name, userProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return guard, nil
+}
+
+// newTokenGuard creates the guard without checking ctx, the tokens of a user can be managed
+// without a request, but the current token can't be got.
+func newTokenGuard(ctx http.Context, name string, userProvider contractsauth.UserProvider) (*TokenGuard, error) {
+	if dbFacade == nil {
+		return nil, errors.DBFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	return &TokenGuard{
+		ctx:        ctx,
+		db:         dbFacade,
+		provider:   userProvider,
+		guard:      name,
+		table:      configFacade.GetString(fmt.Sprintf("auth.guards.%s.table", name), "personal_access_tokens"),
+		expiration: configFacade.GetInt(fmt.Sprintf("auth.guards.%s.expiration", name)),
+	}, nil
+}
+
+func (r *TokenGuard) Can(ability string) bool {
+	token, err := r.CurrentToken()
+	if err != nil {
+		return false
+	}
+
+	return tokenCan(token, ability)
+}
+
+func (r *TokenGuard) Cant(ability string) bool {
+	return !r.Can(ability)
+}
+
+func (r *TokenGuard) Check() bool {
+	_, err := r.ID()
+
+	return err == nil
+}
+
+func (r *TokenGuard) CreateToken(user any, name string, abilities []string, expiresAt ...time.Time) (*contractsauth.NewAccessToken, error) {
+	id, err := r.userID(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.createToken(id, name, abilities, expiresAt...)
+}
+
+func (r *TokenGuard) CurrentToken() (*contractsauth.AccessToken, error) {
+	if tokens, ok := r.ctx.Value(ctxTokenKey).(AccessTokens); ok {
+		if token, exist := tokens[r.guard]; exist && token != nil {
+			return token, nil
+		}
+	}
+
+	token := r.ctx.Request().Header("Authorization", "")
+	if token == "" {
+		return nil, errors.AuthParseTokenFirst
+	}
+	if _, err := r.Parse(token); err != nil {
+		return nil, err
+	}
+
+	return r.ctx.Value(ctxTokenKey).(AccessTokens)[r.guard], nil
+}
+
+func (r *TokenGuard) Guest() bool {
+	return !r.Check()
+}
+
+func (r *TokenGuard) ID() (string, error) {
+	token, err := r.CurrentToken()
+	if err != nil {
+		return "", err
+	}
+
+	return token.UserID, nil
+}
+
+func (r *TokenGuard) Login(user any) (token string, err error) {
+	id, err := r.userID(user)
+	if err != nil {
+		return "", err
+	}
+
+	return r.LoginUsingID(id)
+}
+
+// LoginUsingID creates a token with all the abilities for the user, and uses it for the current request.
+func (r *TokenGuard) LoginUsingID(id any) (token string, err error) {
+	key := cast.ToString(id)
+	if key == "" {
+		return "", errors.AuthInvalidKey
+	}
+
+	newToken, err := r.createToken(key, r.guard, nil)
+	if err != nil {
+		return "", err
+	}
+
+	r.makeAuthContext(&newToken.AccessToken)
+
+	return newToken.PlainTextToken, nil
+}
+
+// Logout revokes the token of the current request.
+func (r *TokenGuard) Logout() error {
+	token, err := r.CurrentToken()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.query().Where("id", token.ID).Delete(); err != nil {
+		return err
+	}
+
+	tokens := r.ctx.Value(ctxTokenKey).(AccessTokens)
+	delete(tokens, r.guard)
+	r.ctx.WithValue(ctxTokenKey, tokens)
+
+	return nil
+}
+
+func (r *TokenGuard) Parse(token string) (*contractsauth.Payload, error) {
+	id, secret, ok := strings.Cut(strings.TrimSpace(strings.TrimPrefix(token, "Bearer ")), "|")
+	if !ok || id == "" || secret == "" {
+		return nil, errors.AuthInvalidToken
+	}
+
+	var row PersonalAccessToken
+	if err := r.query().Where("id", id).First(&row); err != nil {
+		return nil, err
+	}
+	if row.ID == "" || subtle.ConstantTimeCompare([]byte(hashToken(secret)), []byte(row.Token)) != 1 {
+		return nil, errors.AuthInvalidToken
+	}
+
+	now := carbon.Now()
+	if row.ExpiresAt != nil && !now.Lt(row.ExpiresAt.Carbon) {
+		return nil, errors.AuthTokenExpired
+	}
+
+	row.LastUsedAt = carbon.NewDateTime(now)
+	if _, err := r.query().Where("id", row.ID).Update("last_used_at", row.LastUsedAt); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := toAccessToken(row)
+	if err != nil {
+		return nil, err
+	}
+
+	r.makeAuthContext(accessToken)
+
+	payload := &contractsauth.Payload{
+		Guard:    r.guard,
+		Key:      accessToken.UserID,
+		IssuedAt: accessToken.CreatedAt,
+	}
+	if accessToken.ExpiresAt != nil {
+		payload.ExpireAt = *accessToken.ExpiresAt
+	}
+
+	return payload, nil
+}
+
+func (r *TokenGuard) Refresh() (token string, err error) {
+	return "", errors.AuthUnsupportedDriverMethod.Args("token")
+}
+
+func (r *TokenGuard) RevokeToken(user any, id string) error {
+	userID, err := r.userID(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.query().Where("id", id).Where("user_id", userID).Delete()
+
+	return err
+}
+
+func (r *TokenGuard) RevokeTokens(user any) error {
+	userID, err := r.userID(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.query().Where("user_id", userID).Delete()
+
+	return err
+}
+
+func (r *TokenGuard) Tokens(user any) ([]contractsauth.AccessToken, error) {
+	userID, err := r.userID(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []PersonalAccessToken
+	if err := r.query().Where("user_id", userID).OrderBy("created_at").Get(&rows); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]contractsauth.AccessToken, 0, len(rows))
+	for _, row := range rows {
+		token, err := toAccessToken(row)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, *token)
+	}
+
+	return tokens, nil
+}
+
+func (r *TokenGuard) User(user any) error {
+	token, err := r.CurrentToken()
+	if err != nil {
+		return err
+	}
+
+	return r.provider.RetriveByID(user, token.UserID)
+}
+
+func (r *TokenGuard) createToken(userID, name string, abilities []string, expiresAt ...time.Time) (*contractsauth.NewAccessToken, error) {
+	if len(abilities) == 0 {
+		abilities = []string{"*"}
+	}
+
+	encodedAbilities, err := json.Marshal(abilities)
+	if err != nil {
+		return nil, err
+	}
+
+	// The id is stored as it is to find the token, only the secret is hashed. The secret is
+	// random, so SHA-256 is enough, a slow password hash would run on every request.
+	id := str.Random(20)
+	secret := str.Random(40)
+
+	now := carbon.Now()
+	row := PersonalAccessToken{
+		CreatedAt: carbon.NewDateTime(now),
+		UpdatedAt: carbon.NewDateTime(now),
+		ID:        id,
+		Guard:     r.guard,
+		UserID:    userID,
+		Name:      name,
+		Token:     hashToken(secret),
+		Abilities: string(encodedAbilities),
+	}
+	if len(expiresAt) > 0 && !expiresAt[0].IsZero() {
+		row.ExpiresAt = carbon.NewDateTime(carbon.FromStdTime(expiresAt[0]))
+	} else if r.expiration > 0 {
+		row.ExpiresAt = carbon.NewDateTime(now.Copy().AddMinutes(r.expiration))
+	}
+
+	if _, err := r.db.Table(r.table).Insert(&row); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := toAccessToken(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractsauth.NewAccessToken{
+		AccessToken:    *accessToken,
+		PlainTextToken: id + "|" + secret,
+	}, nil
+}
+
+func (r *TokenGuard) makeAuthContext(token *contractsauth.AccessToken) {
+	tokens, ok := r.ctx.Value(ctxTokenKey).(AccessTokens)
+	if !ok {
+		tokens = make(AccessTokens)
+	}
+
+	tokens[r.guard] = token
+	r.ctx.WithValue(ctxTokenKey, tokens)
+}
+
+func (r *TokenGuard) query() db.Query {
+	return r.db.Table(r.table).Where("guard", r.guard)
+}
+
+func (r *TokenGuard) userID(user any) (string, error) {
+	id, err := r.provider.GetID(user)
+	if err != nil {
+		return "", err
+	}
+	if id == nil {
+		return "", errors.AuthNoPrimaryKeyField
+	}
+
+	key := cast.ToString(id)
+	if key == "" {
+		return "", errors.AuthInvalidKey
+	}
+
+	return key, nil
+}
+
+// checkTokenAbilities is registered as a Gate before callback, it denies the abilities that the
+// tokens of the current request don't have, so a token can't be used beyond its abilities even
+// if the user is allowed. The abilities the tokens have are still checked by the definitions.
+func checkTokenAbilities(ctx context.Context, ability string, _ map[string]any) contractsaccess.Response {
+	if ctx == nil {
+		return nil
+	}
+
+	tokens, ok := ctx.Value(ctxTokenKey).(AccessTokens)
+	if !ok {
+		return nil
+	}
+
+	for _, token := range tokens {
+		if token != nil && !tokenCan(token, ability) {
+			return access.NewDenyResponse(fmt.Sprintf("the access token doesn't have the ability: %s", ability))
+		}
+	}
+
+	return nil
+}
+
+func toAccessToken(row PersonalAccessToken) (*contractsauth.AccessToken, error) {
+	token := &contractsauth.AccessToken{
+		ID:     row.ID,
+		Name:   row.Name,
+		UserID: row.UserID,
+	}
+	if row.Abilities != "" {
+		if err := json.Unmarshal([]byte(row.Abilities), &token.Abilities); err != nil {
+			return nil, err
+		}
+	}
+	if row.CreatedAt != nil {
+		token.CreatedAt = row.CreatedAt.StdTime()
+	}
+	if row.ExpiresAt != nil {
+		expiresAt := row.ExpiresAt.StdTime()
+		token.ExpiresAt = &expiresAt
+	}
+	if row.LastUsedAt != nil {
+		lastUsedAt := row.LastUsedAt.StdTime()
+		token.LastUsedAt = &lastUsedAt
+	}
+
+	return token, nil
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func tokenCan(token *contractsauth.AccessToken, ability string) bool {
+	return slices.Contains(token.Abilities, "*") || slices.Contains(token.Abilities, ability)
+}
@@ -0,0 +1,355 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/auth/access"
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	contractsaccess "github.com/goravel/framework/contracts/auth/access"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/errors"
+	mocksauth "github.com/goravel/framework/mocks/auth"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mockshttp "github.com/goravel/framework/mocks/http"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type TokenGuardTestSuite struct {
+	suite.Suite
+	tokenGuard       *TokenGuard
+	mockConfig       *mocksconfig.Config
+	mockContext      *Context
+	mockDB           *mocksdb.DB
+	mockQuery        *mocksdb.Query
+	mockRequest      *mockshttp.ContextRequest
+	mockUserProvider *mocksauth.UserProvider
+	now              *carbon.Carbon
+}
+
+func TestTokenGuardTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenGuardTestSuite))
+}
+
+func (s *TokenGuardTestSuite) TearDownSuite() {
+	carbon.ClearTestNow()
+}
+
+func (s *TokenGuardTestSuite) SetupTest() {
+	s.mockConfig = mocksconfig.NewConfig(s.T())
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockQuery = mocksdb.NewQuery(s.T())
+	s.mockRequest = mockshttp.NewContextRequest(s.T())
+	s.mockUserProvider = mocksauth.NewUserProvider(s.T())
+	s.mockContext = &Context{
+		ctx:     context.Background(),
+		request: s.mockRequest,
+		values:  make(map[any]any),
+	}
+
+	configFacade = s.mockConfig
+	dbFacade = s.mockDB
+
+	s.mockConfig.EXPECT().GetString("auth.guards.user.table", "personal_access_tokens").Return("personal_access_tokens").Once()
+	s.mockConfig.EXPECT().GetInt("auth.guards.user.expiration").Return(0).Once()
+
+	tokenGuard, err := NewTokenGuard(s.mockContext, testUserGuard, s.mockUserProvider)
+	s.Require().Nil(err)
+
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	s.now = now
+	s.tokenGuard = tokenGuard.(*TokenGuard)
+}
+
+func (s *TokenGuardTestSuite) TestNewTokenGuard() {
+	originDBFacade := dbFacade
+	defer func() {
+		dbFacade = originDBFacade
+	}()
+
+	guard, err := NewTokenGuard(nil, testUserGuard, s.mockUserProvider)
+	s.Nil(guard)
+	s.ErrorIs(err, errors.InvalidHttpContext)
+
+	dbFacade = nil
+	guard, err = NewTokenGuard(s.mockContext, testUserGuard, s.mockUserProvider)
+	s.Nil(guard)
+	s.ErrorIs(err, errors.DBFacadeNotSet)
+}
+
+func (s *TokenGuardTestSuite) TestCreateToken() {
+	user := &User{ID: 1}
+	expiresAt := s.now.Copy().AddHour().StdTime()
+	var hashed string
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Insert(mock.MatchedBy(func(row *PersonalAccessToken) bool {
+		hashed = row.Token
+
+		return row.Guard == testUserGuard &&
+			row.UserID == "1" &&
+			row.Name == "deploy" &&
+			row.Abilities == `["servers:read","servers:deploy"]` &&
+			row.ExpiresAt != nil && row.ExpiresAt.Timestamp() == expiresAt.Unix()
+	})).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	token, err := s.tokenGuard.CreateToken(user, "deploy", []string{"servers:read", "servers:deploy"}, expiresAt)
+	s.Require().NoError(err)
+
+	id, secret, ok := strings.Cut(token.PlainTextToken, "|")
+	s.True(ok)
+	s.Equal(token.ID, id)
+	s.Len(id, 20)
+	s.Len(secret, 40)
+	s.Equal(hashToken(secret), hashed)
+	s.Equal("1", token.UserID)
+	s.Equal("deploy", token.Name)
+	s.Equal([]string{"servers:read", "servers:deploy"}, token.Abilities)
+	s.Equal(expiresAt.Unix(), token.ExpiresAt.Unix())
+
+	_, exist := s.mockContext.Value(ctxTokenKey).(AccessTokens)
+	s.False(exist)
+}
+
+func (s *TokenGuardTestSuite) TestCreateToken_DefaultAbilitiesAndExpiration() {
+	s.tokenGuard.expiration = 60
+	user := &User{ID: 1}
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Insert(mock.Anything).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	token, err := s.tokenGuard.CreateToken(user, "cli", nil)
+	s.Require().NoError(err)
+	s.Equal([]string{"*"}, token.Abilities)
+	s.Equal(s.now.Copy().AddMinutes(60).Timestamp(), token.ExpiresAt.Unix())
+}
+
+func (s *TokenGuardTestSuite) TestCreateToken_NoPrimaryKey() {
+	user := &User{}
+	s.mockUserProvider.EXPECT().GetID(user).Return(nil, nil).Once()
+
+	token, err := s.tokenGuard.CreateToken(user, "cli", nil)
+	s.Nil(token)
+	s.ErrorIs(err, errors.AuthNoPrimaryKeyField)
+}
+
+func (s *TokenGuardTestSuite) TestParse() {
+	s.expectFindToken("abc", PersonalAccessToken{
+		CreatedAt: carbon.NewDateTime(s.now.Copy().SubDay()),
+		ID:        "abc",
+		Guard:     testUserGuard,
+		UserID:    "1",
+		Name:      "deploy",
+		Token:     hashToken("secret"),
+		Abilities: `["servers:read"]`,
+	})
+	s.expectTouchToken("abc")
+
+	payload, err := s.tokenGuard.Parse("Bearer abc|secret")
+	s.Require().NoError(err)
+	s.Equal(testUserGuard, payload.Guard)
+	s.Equal("1", payload.Key)
+	s.True(payload.ExpireAt.IsZero())
+	s.Equal(s.now.Copy().SubDay().Timestamp(), payload.IssuedAt.Unix())
+
+	id, err := s.tokenGuard.ID()
+	s.NoError(err)
+	s.Equal("1", id)
+	s.True(s.tokenGuard.Check())
+	s.True(s.tokenGuard.Can("servers:read"))
+	s.True(s.tokenGuard.Cant("servers:deploy"))
+
+	token, err := s.tokenGuard.CurrentToken()
+	s.NoError(err)
+	s.Equal("deploy", token.Name)
+	s.Equal(s.now.Timestamp(), token.LastUsedAt.Unix())
+
+	var user User
+	s.mockUserProvider.EXPECT().RetriveByID(&user, "1").Return(nil).Once()
+	s.NoError(s.tokenGuard.User(&user))
+}
+
+func (s *TokenGuardTestSuite) TestParse_InvalidFormat() {
+	payload, err := s.tokenGuard.Parse("Bearer abc")
+	s.Nil(payload)
+	s.ErrorIs(err, errors.AuthInvalidToken)
+}
+
+func (s *TokenGuardTestSuite) TestParse_NotFound() {
+	s.expectFindToken("abc", PersonalAccessToken{})
+
+	payload, err := s.tokenGuard.Parse("abc|secret")
+	s.Nil(payload)
+	s.ErrorIs(err, errors.AuthInvalidToken)
+}
+
+func (s *TokenGuardTestSuite) TestParse_HashMismatch() {
+	s.expectFindToken("abc", PersonalAccessToken{ID: "abc", Token: hashToken("other")})
+
+	payload, err := s.tokenGuard.Parse("abc|secret")
+	s.Nil(payload)
+	s.ErrorIs(err, errors.AuthInvalidToken)
+}
+
+func (s *TokenGuardTestSuite) TestParse_Expired() {
+	s.expectFindToken("abc", PersonalAccessToken{
+		ExpiresAt: carbon.NewDateTime(s.now.Copy().SubSecond()),
+		ID:        "abc",
+		Token:     hashToken("secret"),
+	})
+
+	payload, err := s.tokenGuard.Parse("abc|secret")
+	s.Nil(payload)
+	s.ErrorIs(err, errors.AuthTokenExpired)
+}
+
+func (s *TokenGuardTestSuite) TestCurrentToken_FromHeader() {
+	s.mockRequest.EXPECT().Header("Authorization", "").Return("Bearer abc|secret").Once()
+	s.expectFindToken("abc", PersonalAccessToken{ID: "abc", UserID: "1", Token: hashToken("secret"), Abilities: `["*"]`})
+	s.expectTouchToken("abc")
+
+	s.True(s.tokenGuard.Can("servers:deploy"))
+	s.False(s.tokenGuard.Guest())
+}
+
+func (s *TokenGuardTestSuite) TestCurrentToken_WithoutHeader() {
+	s.mockRequest.EXPECT().Header("Authorization", "").Return("").Once()
+
+	token, err := s.tokenGuard.CurrentToken()
+	s.Nil(token)
+	s.ErrorIs(err, errors.AuthParseTokenFirst)
+}
+
+func (s *TokenGuardTestSuite) TestLoginAndLogout() {
+	user := &User{ID: 1}
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Insert(mock.Anything).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	plainTextToken, err := s.tokenGuard.Login(user)
+	s.Require().NoError(err)
+	s.NotEmpty(plainTextToken)
+
+	token, err := s.tokenGuard.CurrentToken()
+	s.Require().NoError(err)
+	s.Equal(testUserGuard, token.Name)
+	s.True(strings.HasPrefix(plainTextToken, token.ID+"|"))
+	s.True(s.tokenGuard.Can("anything"))
+
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("id", token.ID).Return(guardQuery).Once()
+	guardQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	s.NoError(s.tokenGuard.Logout())
+
+	s.mockRequest.EXPECT().Header("Authorization", "").Return("").Once()
+	s.True(s.tokenGuard.Guest())
+}
+
+func (s *TokenGuardTestSuite) TestLoginUsingID_InvalidKey() {
+	token, err := s.tokenGuard.LoginUsingID("")
+	s.Empty(token)
+	s.ErrorIs(err, errors.AuthInvalidKey)
+}
+
+func (s *TokenGuardTestSuite) TestRefresh() {
+	token, err := s.tokenGuard.Refresh()
+	s.Empty(token)
+	s.ErrorIs(err, errors.AuthUnsupportedDriverMethod)
+}
+
+func (s *TokenGuardTestSuite) TestRevokeToken() {
+	user := &User{ID: 1}
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("id", "abc").Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("user_id", "1").Return(guardQuery).Once()
+	guardQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	s.NoError(s.tokenGuard.RevokeToken(user, "abc"))
+}
+
+func (s *TokenGuardTestSuite) TestRevokeTokens() {
+	user := &User{ID: 1}
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("user_id", "1").Return(guardQuery).Once()
+	guardQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 2}, nil).Once()
+
+	s.NoError(s.tokenGuard.RevokeTokens(user))
+}
+
+func (s *TokenGuardTestSuite) TestTokens() {
+	user := &User{ID: 1}
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockUserProvider.EXPECT().GetID(user).Return(uint(1), nil).Once()
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("user_id", "1").Return(guardQuery).Once()
+	guardQuery.EXPECT().OrderBy("created_at").Return(guardQuery).Once()
+	guardQuery.EXPECT().Get(mock.Anything).Run(func(dest any) {
+		*dest.(*[]PersonalAccessToken) = []PersonalAccessToken{
+			{ID: "abc", UserID: "1", Name: "deploy", Abilities: `["servers:deploy"]`},
+			{ID: "def", UserID: "1", Name: "cli", Abilities: `["*"]`},
+		}
+	}).Return(nil).Once()
+
+	tokens, err := s.tokenGuard.Tokens(user)
+	s.NoError(err)
+	s.Equal([]contractsauth.AccessToken{
+		{ID: "abc", UserID: "1", Name: "deploy", Abilities: []string{"servers:deploy"}},
+		{ID: "def", UserID: "1", Name: "cli", Abilities: []string{"*"}},
+	}, tokens)
+}
+
+func (s *TokenGuardTestSuite) TestCheckTokenAbilities() {
+	gate := access.NewGate(context.Background())
+	gate.Before(checkTokenAbilities)
+	gate.Define("servers:deploy", func(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+		return access.NewAllowResponse()
+	})
+	gate.Define("servers:read", func(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+		return access.NewAllowResponse()
+	})
+
+	s.True(gate.Allows("servers:deploy", nil))
+
+	s.mockContext.WithValue(ctxTokenKey, AccessTokens{
+		testUserGuard: {Abilities: []string{"servers:read"}},
+	})
+	withToken := gate.WithContext(s.mockContext)
+	s.True(withToken.Allows("servers:read", nil))
+	s.True(withToken.Denies("servers:deploy", nil))
+	s.Equal("the access token doesn't have the ability: servers:deploy", withToken.Inspect("servers:deploy", nil).Message())
+	s.True(withToken.Denies("servers:delete", nil))
+}
+
+func (s *TokenGuardTestSuite) expectFindToken(id string, row PersonalAccessToken) {
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("id", id).Return(guardQuery).Once()
+	guardQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		*dest.(*PersonalAccessToken) = row
+	}).Return(nil).Once()
+}
+
+func (s *TokenGuardTestSuite) expectTouchToken(id string) {
+	guardQuery := mocksdb.NewQuery(s.T())
+	s.mockDB.EXPECT().Table("personal_access_tokens").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("guard", testUserGuard).Return(guardQuery).Once()
+	guardQuery.EXPECT().Where("id", id).Return(guardQuery).Once()
+	guardQuery.EXPECT().Update("last_used_at", mock.Anything).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/str"
+)
+
+var _ contractsauth.TwoFactor = (*TwoFactor)(nil)
+
+const (
+	// The TOTP parameters of RFC 6238 that the authenticator apps support by default.
+	totpDigits = 6
+	totpPeriod = 30
+	// totpWindow is the number of periods before and after the current one that are
+	// accepted, to allow the clock drift of the device.
+	totpWindow = 1
+
+	recoveryCodeCount = 8
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TwoFactor struct {
+	cache  cache.Cache
+	config config.Config
+	crypt  crypt.Crypt
+}
+
+func NewTwoFactor(config config.Config, crypt crypt.Crypt, cache cache.Cache) *TwoFactor {
+	return &TwoFactor{
+		cache:  cache,
+		config: config,
+		crypt:  crypt,
+	}
+}
+
+func (r *TwoFactor) Disable(user contractsauth.TwoFactorAuthenticatable) error {
+	if err := user.SetTwoFactorSecret(""); err != nil {
+		return err
+	}
+
+	return user.SetTwoFactorRecoveryCodes("")
+}
+
+func (r *TwoFactor) Enable(user contractsauth.TwoFactorAuthenticatable, account string) (*contractsauth.TwoFactorSetup, error) {
+	if r.crypt == nil {
+		return nil, errors.CryptFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	secret, err := generateTwoFactorSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := r.crypt.EncryptString(secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.SetTwoFactorSecret(encrypted); err != nil {
+		return nil, err
+	}
+
+	codes, err := r.RegenerateRecoveryCodes(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractsauth.TwoFactorSetup{
+		Secret:        secret,
+		URL:           twoFactorURL(r.config.GetString("app.name"), account, secret),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (r *TwoFactor) Enabled(user contractsauth.TwoFactorAuthenticatable) bool {
+	return user.GetTwoFactorSecret() != ""
+}
+
+func (r *TwoFactor) RecoveryCodes(user contractsauth.TwoFactorAuthenticatable) ([]string, error) {
+	if !r.Enabled(user) {
+		return nil, errors.AuthTwoFactorNotEnabled
+	}
+	if r.crypt == nil {
+		return nil, errors.CryptFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+	if user.GetTwoFactorRecoveryCodes() == "" {
+		return []string{}, nil
+	}
+
+	decrypted, err := r.crypt.DecryptString(user.GetTwoFactorRecoveryCodes())
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	if err := json.Unmarshal([]byte(decrypted), &codes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (r *TwoFactor) RegenerateRecoveryCodes(user contractsauth.TwoFactorAuthenticatable) ([]string, error) {
+	if !r.Enabled(user) {
+		return nil, errors.AuthTwoFactorNotEnabled
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i] = str.Random(10) + "-" + str.Random(10)
+	}
+
+	if err := r.saveRecoveryCodes(user, codes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (r *TwoFactor) UseRecoveryCode(user contractsauth.TwoFactorAuthenticatable, code string) (bool, error) {
+	codes, err := r.RecoveryCodes(user)
+	if err != nil {
+		return false, err
+	}
+
+	index := slices.IndexFunc(codes, func(recoveryCode string) bool {
+		return subtle.ConstantTimeCompare([]byte(recoveryCode), []byte(strings.TrimSpace(code))) == 1
+	})
+	if index == -1 {
+		return false, nil
+	}
+
+	if err := r.saveRecoveryCodes(user, slices.Delete(codes, index, index+1)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *TwoFactor) Verify(user contractsauth.TwoFactorAuthenticatable, code string) bool {
+	if !r.Enabled(user) || r.crypt == nil || r.cache == nil {
+		return false
+	}
+
+	secret, err := r.crypt.DecryptString(user.GetTwoFactorSecret())
+	if err != nil {
+		return false
+	}
+
+	code = strings.ReplaceAll(code, " ", "")
+	if !verifyTOTP(secret, code, carbon.Now().StdTime()) {
+		return false
+	}
+
+	// The code stays valid during the window, remember it to prevent it from being replayed.
+	sum := sha256.Sum256([]byte(secret + "|" + code))
+	key := "goravel:auth:two_factor:" + hex.EncodeToString(sum[:])
+
+	return r.cache.Add(key, true, time.Duration((2*totpWindow+1)*totpPeriod)*time.Second)
+}
+
+func (r *TwoFactor) saveRecoveryCodes(user contractsauth.TwoFactorAuthenticatable, codes []string) error {
+	if r.crypt == nil {
+		return errors.CryptFacadeNotSet.SetModule(errors.ModuleAuth)
+	}
+
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.crypt.EncryptString(string(encoded))
+	if err != nil {
+		return err
+	}
+
+	return user.SetTwoFactorRecoveryCodes(encrypted)
+}
+
+// generateTwoFactorSecret returns a 160 bits secret encoded by base32, the length recommended
+// by RFC 4226 for HMAC-SHA1.
+func generateTwoFactorSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// totp generates the code of RFC 6238 for the given counter with HMAC-SHA1.
+func totp(key []byte, counter uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range totpDigits {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+func twoFactorURL(issuer, account, secret string) string {
+	label := account
+	if issuer != "" {
+		label = issuer + ":" + account
+	}
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + url.PathEscape(label) + "?" + query.Encode()
+}
+
+func verifyTOTP(secret, code string, now time.Time) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return false
+	}
+
+	counter := now.Unix() / totpPeriod
+	for i := -totpWindow; i <= totpWindow; i++ {
+		if counter+int64(i) < 0 {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totp(key, uint64(counter+int64(i)))), []byte(code)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
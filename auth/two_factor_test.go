@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/errors"
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockscrypt "github.com/goravel/framework/mocks/crypt"
+)
+
+func TestTOTP(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238, truncated to 6 digits.
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		code string
+	}{
+		{time: 59, code: "287082"},
+		{time: 1111111109, code: "081804"},
+		{time: 1111111111, code: "050471"},
+		{time: 1234567890, code: "005924"},
+		{time: 2000000000, code: "279037"},
+		{time: 20000000000, code: "353130"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.code, totp(key, uint64(test.time/totpPeriod)))
+	}
+
+	secret := base32NoPadding.EncodeToString(key)
+	now := time.Unix(1111111109, 0)
+
+	assert.True(t, verifyTOTP(secret, "081804", now))
+	assert.True(t, verifyTOTP(secret, "081804", now.Add(totpPeriod*time.Second)))
+	assert.True(t, verifyTOTP(strings.ToLower(secret), "081804", now))
+	assert.False(t, verifyTOTP(secret, "081804", now.Add(2*totpPeriod*time.Second)))
+	assert.False(t, verifyTOTP(secret, "081805", now))
+	assert.False(t, verifyTOTP(secret, "81804", now))
+	assert.False(t, verifyTOTP("invalid secret", "081804", now))
+}
+
+func TestTwoFactorURL(t *testing.T) {
+	assert.Equal(t,
+		"otpauth://totp/Goravel:goravel@example.com?algorithm=SHA1&digits=6&issuer=Goravel&period=30&secret=SECRET",
+		twoFactorURL("Goravel", "goravel@example.com", "SECRET"))
+	assert.Equal(t,
+		"otpauth://totp/goravel@example.com?algorithm=SHA1&digits=6&period=30&secret=SECRET",
+		twoFactorURL("", "goravel@example.com", "SECRET"))
+}
+
+func TestTwoFactor(t *testing.T) {
+	var (
+		mockCache  *mockscache.Cache
+		mockConfig *mocksconfig.Config
+		mockCrypt  *mockscrypt.Crypt
+		twoFactor  *TwoFactor
+	)
+
+	beforeEach := func() {
+		mockCache = mockscache.NewCache(t)
+		mockConfig = mocksconfig.NewConfig(t)
+		mockCrypt = mockscrypt.NewCrypt(t)
+		twoFactor = NewTwoFactor(mockConfig, mockCrypt, mockCache)
+
+		// The values are "encrypted" with a prefix, so they can be checked.
+		mockCrypt.EXPECT().EncryptString(mock.Anything).RunAndReturn(func(value string) (string, error) {
+			return "encrypted:" + value, nil
+		}).Maybe()
+		mockCrypt.EXPECT().DecryptString(mock.Anything).RunAndReturn(func(payload string) (string, error) {
+			return strings.TrimPrefix(payload, "encrypted:"), nil
+		}).Maybe()
+	}
+
+	t.Run("Enable", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.name").Return("Goravel").Once()
+		user := &twoFactorUser{}
+
+		setup, err := twoFactor.Enable(user, "goravel@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, setup.Secret, 32)
+		assert.Equal(t, "encrypted:"+setup.Secret, user.secret)
+		assert.Equal(t, twoFactorURL("Goravel", "goravel@example.com", setup.Secret), setup.URL)
+		assert.Len(t, setup.RecoveryCodes, recoveryCodeCount)
+		for _, code := range setup.RecoveryCodes {
+			assert.Len(t, code, 21)
+		}
+		assert.True(t, twoFactor.Enabled(user))
+
+		codes, err := json.Marshal(setup.RecoveryCodes)
+		assert.NoError(t, err)
+		assert.Equal(t, "encrypted:"+string(codes), user.recoveryCodes)
+
+		recoveryCodes, err := twoFactor.RecoveryCodes(user)
+		assert.NoError(t, err)
+		assert.Equal(t, setup.RecoveryCodes, recoveryCodes)
+	})
+
+	t.Run("Enable when the crypt facade is not set", func(t *testing.T) {
+		twoFactor := NewTwoFactor(mocksconfig.NewConfig(t), nil, nil)
+
+		setup, err := twoFactor.Enable(&twoFactorUser{}, "goravel@example.com")
+		assert.Nil(t, setup)
+		assert.ErrorIs(t, err, errors.CryptFacadeNotSet)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		beforeEach()
+		user := &twoFactorUser{secret: "encrypted:SECRET", recoveryCodes: "encrypted:[]"}
+
+		assert.NoError(t, twoFactor.Disable(user))
+		assert.False(t, twoFactor.Enabled(user))
+		assert.Empty(t, user.recoveryCodes)
+
+		codes, err := twoFactor.RecoveryCodes(user)
+		assert.Nil(t, codes)
+		assert.ErrorIs(t, err, errors.AuthTwoFactorNotEnabled)
+	})
+
+	t.Run("RegenerateRecoveryCodes", func(t *testing.T) {
+		beforeEach()
+		user := &twoFactorUser{secret: "encrypted:SECRET", recoveryCodes: `encrypted:["code"]`}
+
+		codes, err := twoFactor.RegenerateRecoveryCodes(user)
+		assert.NoError(t, err)
+		assert.Len(t, codes, recoveryCodeCount)
+		assert.NotContains(t, codes, "code")
+
+		_, err = twoFactor.RegenerateRecoveryCodes(&twoFactorUser{})
+		assert.ErrorIs(t, err, errors.AuthTwoFactorNotEnabled)
+	})
+
+	t.Run("UseRecoveryCode", func(t *testing.T) {
+		beforeEach()
+		user := &twoFactorUser{secret: "encrypted:SECRET", recoveryCodes: `encrypted:["first","second"]`}
+
+		used, err := twoFactor.UseRecoveryCode(user, " second ")
+		assert.NoError(t, err)
+		assert.True(t, used)
+		assert.Equal(t, `encrypted:["first"]`, user.recoveryCodes)
+
+		used, err = twoFactor.UseRecoveryCode(user, "second")
+		assert.NoError(t, err)
+		assert.False(t, used)
+		assert.Equal(t, `encrypted:["first"]`, user.recoveryCodes)
+	})
+
+	t.Run("Verify", func(t *testing.T) {
+		beforeEach()
+		secret := base32NoPadding.EncodeToString([]byte("12345678901234567890"))
+		user := &twoFactorUser{secret: "encrypted:" + secret}
+		code := totp([]byte("12345678901234567890"), uint64(time.Now().Unix()/totpPeriod))
+
+		mockCache.EXPECT().Add(mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "goravel:auth:two_factor:")
+		}), true, 90*time.Second).Return(true).Once()
+		assert.True(t, twoFactor.Verify(user, code[:3]+" "+code[3:]))
+
+		// The code can't be replayed.
+		mockCache.EXPECT().Add(mock.Anything, true, 90*time.Second).Return(false).Once()
+		assert.False(t, twoFactor.Verify(user, code))
+
+		assert.False(t, twoFactor.Verify(&twoFactorUser{}, code))
+
+		// The code can't be checked against the replays without the cache.
+		assert.False(t, NewTwoFactor(mocksconfig.NewConfig(t), twoFactor.crypt, nil).Verify(user, code))
+	})
+}
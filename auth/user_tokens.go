@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"time"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+)
+
+var _ contractsauth.UserTokens = (*UserTokens)(nil)
+
+// UserTokens manages the personal access tokens of a user via the token guard, the error of
+// creating the guard is returned by all the methods.
+type UserTokens struct {
+	err   error
+	guard *TokenGuard
+	user  any
+}
+
+func (r *UserTokens) All() ([]contractsauth.AccessToken, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.guard.Tokens(r.user)
+}
+
+func (r *UserTokens) Create(name string, abilities []string, expiresAt ...time.Time) (*contractsauth.NewAccessToken, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.guard.CreateToken(r.user, name, abilities, expiresAt...)
+}
+
+func (r *UserTokens) Revoke(id string) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return r.guard.RevokeToken(r.user, id)
+}
+
+func (r *UserTokens) RevokeAll() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return r.guard.RevokeTokens(r.user)
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+)
+
+func TestUserTokens(t *testing.T) {
+	var (
+		mockConfig *mocksconfig.Config
+		mockDB     *mocksdb.DB
+		auth       *Auth
+	)
+
+	originConfigFacade, originDBFacade, originOrmFacade := configFacade, dbFacade, ormFacade
+	t.Cleanup(func() {
+		configFacade, dbFacade, ormFacade = originConfigFacade, originDBFacade, originOrmFacade
+	})
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockDB = mocksdb.NewDB(t)
+		configFacade = mockConfig
+		dbFacade = mockDB
+		ormFacade = mocksorm.NewOrm(t)
+
+		mockConfig.EXPECT().GetString("auth.defaults.guard").Return("user").Once()
+
+		var err error
+		auth, err = NewAuth(nil, mockConfig, nil)
+		assert.NoError(t, err)
+	}
+
+	t.Run("the guard doesn't use the token driver", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("auth.guards.user.driver").Return("jwt").Once()
+
+		tokens := auth.Tokens(&User{ID: 1})
+
+		token, err := tokens.Create("cli", nil)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, errors.AuthGuardNotTokenDriver)
+		assert.ErrorIs(t, tokens.Revoke("abc"), errors.AuthGuardNotTokenDriver)
+		assert.ErrorIs(t, tokens.RevokeAll(), errors.AuthGuardNotTokenDriver)
+	})
+
+	t.Run("revokes the tokens of the user", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("auth.guards.api.driver").Return("token").Once()
+		mockConfig.EXPECT().GetString("auth.guards.api.provider").Return("user").Once()
+		mockConfig.EXPECT().GetString("auth.providers.user.driver").Return("orm").Once()
+		mockConfig.EXPECT().GetString("auth.guards.api.table", "personal_access_tokens").Return("personal_access_tokens").Once()
+		mockConfig.EXPECT().GetInt("auth.guards.api.expiration").Return(0).Once()
+
+		mockQuery := mocksdb.NewQuery(t)
+		mockDB.EXPECT().Table("personal_access_tokens").Return(mockQuery).Twice()
+		mockQuery.EXPECT().Where("guard", "api").Return(mockQuery).Twice()
+		mockQuery.EXPECT().Where("user_id", "1").Return(mockQuery).Twice()
+		mockQuery.EXPECT().Where("id", "abc").Return(mockQuery).Once()
+		mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Twice()
+
+		tokens := auth.Tokens(&User{ID: 1}, "api")
+
+		assert.NoError(t, tokens.Revoke("abc"))
+		assert.NoError(t, tokens.RevokeAll())
+	})
+}
@@ -224,6 +224,25 @@ func (a *Application) dispatchAsync(_ context.Context, event broadcasting.Should
 	return job.Dispatch()
 }
 
+// Server creates the WebSocket server of the connection, the events broadcast by the
+// websocket driver of the connection are delivered to the clients of the server.
+func (a *Application) Server(args broadcasting.ServerArgs) (broadcasting.Server, error) {
+	name := args.Connection
+	if name == "" {
+		name = a.config.DefaultConnection()
+	}
+
+	conn, err := a.config.Connection(name)
+	if err != nil {
+		return nil, err
+	}
+	if conn.Driver != "websocket" {
+		return nil, errors.BroadcastServerDriverNotSupported.Args(name, conn.Driver)
+	}
+
+	return NewServer(conn, args)
+}
+
 func (a *Application) Authenticate(ctx contractshttp.Context) contractshttp.Response {
 	socketID := ctx.Request().Input("socket_id")
 	channelName := ctx.Request().Input("channel_name")
@@ -923,3 +923,25 @@ func TestApplication_Authenticate(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 }
+
+func TestApplication_Server(t *testing.T) {
+	app := &Application{config: &Config{
+		Default: "websocket",
+		Connections: map[string]broadcasting.ConnectionConfig{
+			"websocket": {Driver: "websocket", AppID: "app", Key: "key", Secret: "secret"},
+			"pusher":    {Driver: "pusher", AppID: "app", Key: "key", Secret: "secret"},
+		},
+	}}
+
+	server, err := app.Server(broadcasting.ServerArgs{Port: 6001})
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0.0.0:6001", server.Address())
+
+	server, err = app.Server(broadcasting.ServerArgs{Connection: "pusher"})
+	assert.Nil(t, server)
+	assert.ErrorIs(t, err, errors.BroadcastServerDriverNotSupported)
+
+	server, err = app.Server(broadcasting.ServerArgs{Connection: "unknown"})
+	assert.Nil(t, server)
+	assert.ErrorIs(t, err, errors.BroadcastConnectionNotFound)
+}
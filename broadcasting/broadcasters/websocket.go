@@ -0,0 +1,23 @@
+package broadcasters
+
+import (
+	"maps"
+
+	"github.com/goravel/framework/contracts/broadcasting"
+	"github.com/goravel/framework/contracts/http/client"
+)
+
+// NewWebSocketDriver publishes the events to the server started by the broadcast:serve
+// command. The server implements the HTTP API of Pusher, so the Pusher driver is reused,
+// only the defaults of the options point to the local server.
+func NewWebSocketDriver(conn broadcasting.ConnectionConfig, httpClient client.Factory) (*PusherDriver, error) {
+	options := map[string]any{
+		"host":   "127.0.0.1",
+		"port":   8080,
+		"scheme": "http",
+	}
+	maps.Copy(options, conn.Options)
+	conn.Options = options
+
+	return NewPusherDriver(conn, httpClient)
+}
@@ -0,0 +1,34 @@
+package broadcasters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/broadcasting"
+	"github.com/goravel/framework/errors"
+	mocksclient "github.com/goravel/framework/mocks/http/client"
+)
+
+func TestNewWebSocketDriver(t *testing.T) {
+	conn := broadcasting.ConnectionConfig{
+		Driver: "websocket",
+		Key:    "key",
+		Secret: "secret",
+		AppID:  "app",
+	}
+
+	driver, err := NewWebSocketDriver(conn, mocksclient.NewFactory(t))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080/apps/app", driver.baseURL)
+
+	conn.Options = map[string]any{"host": "ws.goravel.dev", "port": 443, "scheme": "https"}
+	driver, err = NewWebSocketDriver(conn, mocksclient.NewFactory(t))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ws.goravel.dev:443/apps/app", driver.baseURL)
+
+	conn.Key = ""
+	driver, err = NewWebSocketDriver(conn, mocksclient.NewFactory(t))
+	assert.Nil(t, driver)
+	assert.ErrorIs(t, err, errors.BroadcastPusherKeyRequired)
+}
@@ -0,0 +1,90 @@
+package console
+
+import (
+	"sync"
+
+	"github.com/goravel/framework/contracts/broadcasting"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+)
+
+type ServeCommand struct {
+	broadcast broadcasting.Broadcast
+	server    broadcasting.Server
+	mu        sync.Mutex
+}
+
+func NewServeCommand(broadcast broadcasting.Broadcast) *ServeCommand {
+	return &ServeCommand{
+		broadcast: broadcast,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *ServeCommand) Signature() string {
+	return "broadcast:serve"
+}
+
+// Description The console command description.
+func (r *ServeCommand) Description() string {
+	return "Start the WebSocket server of the broadcasting"
+}
+
+// Extend The console command extend.
+func (r *ServeCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "broadcast",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "connection",
+				Aliases: []string{"c"},
+				Usage:   "The name of the websocket connection to serve",
+			},
+			&command.StringFlag{
+				Name:  "host",
+				Usage: "The host that the server listens on",
+			},
+			&command.IntFlag{
+				Name:  "port",
+				Usage: "The port that the server listens on",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *ServeCommand) Handle(ctx console.Context) error {
+	server, err := r.broadcast.Server(broadcasting.ServerArgs{
+		Connection: ctx.Option("connection"),
+		Host:       ctx.Option("host"),
+		Port:       ctx.OptionInt("port"),
+	})
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	r.mu.Lock()
+	r.server = server
+	r.mu.Unlock()
+
+	ctx.Info("Broadcast server is running on " + server.Address())
+
+	if err := server.Run(); err != nil {
+		ctx.Error(err.Error())
+	}
+
+	return nil
+}
+
+// Shutdown stops the server when the process receives SIGINT or SIGTERM.
+func (r *ServeCommand) Shutdown(ctx console.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.server == nil {
+		return nil
+	}
+
+	return r.server.Shutdown(ctx)
+}
@@ -0,0 +1,80 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsbroadcasting "github.com/goravel/framework/contracts/broadcasting"
+	mocksbroadcasting "github.com/goravel/framework/mocks/broadcasting"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+)
+
+func TestServeCommand(t *testing.T) {
+	var (
+		mockBroadcast *mocksbroadcasting.Broadcast
+		mockCtx       *mocksconsole.Context
+		mockServer    *mocksbroadcasting.Server
+	)
+
+	beforeEach := func() {
+		mockBroadcast = mocksbroadcasting.NewBroadcast(t)
+		mockCtx = mocksconsole.NewContext(t)
+		mockServer = mocksbroadcasting.NewServer(t)
+
+		mockCtx.EXPECT().Option("connection").Return("websocket").Once()
+		mockCtx.EXPECT().Option("host").Return("127.0.0.1").Once()
+		mockCtx.EXPECT().OptionInt("port").Return(6001).Once()
+	}
+
+	t.Run("run and shutdown", func(t *testing.T) {
+		beforeEach()
+		command := NewServeCommand(mockBroadcast)
+		assert.NoError(t, command.Shutdown(mockCtx))
+
+		mockBroadcast.EXPECT().Server(contractsbroadcasting.ServerArgs{
+			Connection: "websocket",
+			Host:       "127.0.0.1",
+			Port:       6001,
+		}).Return(mockServer, nil).Once()
+		mockServer.EXPECT().Address().Return("127.0.0.1:6001").Once()
+		mockCtx.EXPECT().Info("Broadcast server is running on 127.0.0.1:6001").Once()
+		mockServer.EXPECT().Run().Return(nil).Once()
+		mockServer.EXPECT().Shutdown(mockCtx).Return(nil).Once()
+
+		assert.NoError(t, command.Handle(mockCtx))
+		assert.NoError(t, command.Shutdown(mockCtx))
+	})
+
+	t.Run("failed to create the server", func(t *testing.T) {
+		beforeEach()
+		command := NewServeCommand(mockBroadcast)
+
+		mockBroadcast.EXPECT().Server(contractsbroadcasting.ServerArgs{
+			Connection: "websocket",
+			Host:       "127.0.0.1",
+			Port:       6001,
+		}).Return(nil, assert.AnError).Once()
+		mockCtx.EXPECT().Error(assert.AnError.Error()).Once()
+
+		assert.NoError(t, command.Handle(mockCtx))
+		assert.NoError(t, command.Shutdown(mockCtx))
+	})
+
+	t.Run("failed to run the server", func(t *testing.T) {
+		beforeEach()
+		command := NewServeCommand(mockBroadcast)
+
+		mockBroadcast.EXPECT().Server(contractsbroadcasting.ServerArgs{
+			Connection: "websocket",
+			Host:       "127.0.0.1",
+			Port:       6001,
+		}).Return(mockServer, nil).Once()
+		mockServer.EXPECT().Address().Return("127.0.0.1:6001").Once()
+		mockCtx.EXPECT().Info("Broadcast server is running on 127.0.0.1:6001").Once()
+		mockServer.EXPECT().Run().Return(assert.AnError).Once()
+		mockCtx.EXPECT().Error(assert.AnError.Error()).Once()
+
+		assert.NoError(t, command.Handle(mockCtx))
+	})
+}
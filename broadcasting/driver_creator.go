@@ -15,6 +15,12 @@ func CreateDriver(conn broadcasting.ConnectionConfig, app foundation.Application
 			return nil, errors.HttpFacadeNotSet.SetModule(errors.ModuleBroadcast)
 		}
 		return broadcasters.NewPusherDriver(conn, httpClient)
+	case "websocket":
+		httpClient := app.MakeHttp()
+		if httpClient == nil {
+			return nil, errors.HttpFacadeNotSet.SetModule(errors.ModuleBroadcast)
+		}
+		return broadcasters.NewWebSocketDriver(conn, httpClient)
 	case "log":
 		logFacade := app.MakeLog()
 		if logFacade == nil {
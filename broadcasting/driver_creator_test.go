@@ -65,3 +65,21 @@ func TestCreateDriver_Unknown(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, driver)
 }
+
+func TestCreateDriver_WebSocket(t *testing.T) {
+	conn := broadcasting.ConnectionConfig{
+		Driver: "websocket",
+		Key:    "test-key",
+		Secret: "test-secret",
+		AppID:  "test-app",
+	}
+
+	mockHttp := mockshttp.NewFactory(t)
+
+	app := mocksfoundation.NewApplication(t)
+	app.EXPECT().MakeHttp().Return(mockHttp).Once()
+
+	driver, err := CreateDriver(conn, app)
+	assert.NoError(t, err)
+	assert.NotNil(t, driver)
+}
@@ -0,0 +1,686 @@
+package broadcasting
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/cast"
+	"golang.org/x/net/websocket"
+
+	"github.com/goravel/framework/contracts/broadcasting"
+	"github.com/goravel/framework/errors"
+)
+
+var _ broadcasting.Server = (*Server)(nil)
+
+// The error codes of the Pusher protocol, the clients reconnect according to the range
+// of the code.
+const (
+	pusherErrorApplicationDoesNotExist = 4001
+	pusherErrorUnauthorized            = 4009
+	pusherErrorReconnectImmediately    = 4200
+	pusherErrorGeneric                 = 4300
+)
+
+// The signatures of the HTTP API are valid for 10 minutes, the same as Pusher.
+const serverSignatureTimeout = 600
+
+// Server implements the protocol version 7 of Pusher, so the Pusher and Laravel Echo
+// clients can connect to it directly. The events are published through the HTTP API
+// of Pusher, which is what the websocket driver calls.
+type Server struct {
+	httpServer         *http.Server
+	appID              string
+	key                string
+	secret             string
+	allowedOrigins     []string
+	activityTimeout    time.Duration
+	maxMessageSize     int
+	maxPendingMessages int
+
+	channels    map[string]*serverChannel
+	connections map[string]*serverConnection
+	mu          sync.RWMutex
+}
+
+type serverChannel struct {
+	connections map[string]*serverConnection
+	// members of the presence channel, keyed by the socket id.
+	members map[string]presenceMember
+}
+
+type serverConnection struct {
+	ws       *websocket.Conn
+	socketID string
+	// channels is guarded by the mutex of the server.
+	channels map[string]struct{}
+	// queue buffers the messages until the writer goroutine sends them, so a slow client
+	// doesn't block the others. It's closed once, guarded by mu.
+	queue   chan string
+	closed  bool
+	dropped atomic.Bool
+	done    chan struct{}
+	mu      sync.Mutex
+}
+
+type presenceMember struct {
+	UserID   string `json:"user_id"`
+	UserInfo any    `json:"user_info,omitempty"`
+}
+
+type serverMessage struct {
+	Event   string `json:"event"`
+	Channel string `json:"channel,omitempty"`
+	Data    any    `json:"data,omitempty"`
+	UserID  string `json:"user_id,omitempty"`
+}
+
+type clientMessage struct {
+	Event   string          `json:"event"`
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type serverEvent struct {
+	Name     string          `json:"name"`
+	Channels []string        `json:"channels"`
+	Channel  string          `json:"channel"`
+	Data     json.RawMessage `json:"data"`
+	SocketID string          `json:"socket_id"`
+}
+
+// NewServer creates the server of a websocket connection, the options of the connection:
+//
+//   - port: the port that the server listens on, default 8080.
+//   - allowed_origins: the hosts that the browsers can connect from, default ["*"].
+//   - activity_timeout: the seconds that a client pings the server after being idle, default 30.
+//   - max_message_size: the maximum bytes of a message sent by a client, default 10000.
+//   - max_pending_messages: the maximum messages waiting to be sent to a client, the client
+//     is disconnected if it doesn't read fast enough to stay under it, default 100.
+func NewServer(conn broadcasting.ConnectionConfig, args broadcasting.ServerArgs) (*Server, error) {
+	if conn.AppID == "" {
+		return nil, errors.BroadcastPusherAppIDRequired
+	}
+	if conn.Key == "" {
+		return nil, errors.BroadcastPusherKeyRequired
+	}
+	if conn.Secret == "" {
+		return nil, errors.BroadcastPusherSecretRequired
+	}
+
+	host := args.Host
+	if host == "" {
+		host = "0.0.0.0"
+	}
+	port := args.Port
+	if port == 0 {
+		port = cast.ToInt(conn.Options["port"])
+	}
+	if port == 0 {
+		port = 8080
+	}
+
+	allowedOrigins := cast.ToStringSlice(conn.Options["allowed_origins"])
+	if len(allowedOrigins) == 0 {
+		allowedOrigins = []string{"*"}
+	}
+	activityTimeout := cast.ToInt(conn.Options["activity_timeout"])
+	if activityTimeout <= 0 {
+		activityTimeout = 30
+	}
+	maxMessageSize := cast.ToInt(conn.Options["max_message_size"])
+	if maxMessageSize <= 0 {
+		maxMessageSize = 10000
+	}
+	maxPendingMessages := cast.ToInt(conn.Options["max_pending_messages"])
+	if maxPendingMessages <= 0 {
+		maxPendingMessages = 100
+	}
+
+	server := &Server{
+		appID:              conn.AppID,
+		key:                conn.Key,
+		secret:             conn.Secret,
+		allowedOrigins:     allowedOrigins,
+		activityTimeout:    time.Duration(activityTimeout) * time.Second,
+		maxMessageSize:     maxMessageSize,
+		maxPendingMessages: maxPendingMessages,
+		channels:           make(map[string]*serverChannel),
+		connections:        make(map[string]*serverConnection),
+	}
+	server.httpServer = &http.Server{
+		Addr:              net.JoinHostPort(host, strconv.Itoa(port)),
+		Handler:           server.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server, nil
+}
+
+func (r *Server) Address() string {
+	return r.httpServer.Addr
+}
+
+// Handler returns the handler of the WebSocket endpoint and the HTTP API, it can be mounted
+// on another server instead of calling Run.
+func (r *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /app/{key}", websocket.Server{
+		Handshake: r.handshake,
+		Handler:   r.handleConnection,
+	})
+	mux.HandleFunc("POST /apps/{app}/events", r.handleEvents)
+
+	return mux
+}
+
+func (r *Server) Run() error {
+	if err := r.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown stops the HTTP server, then asks the clients to reconnect and closes the
+// connections once the pending messages are sent, since the hijacked connections aren't
+// closed by the HTTP server.
+func (r *Server) Shutdown(ctx context.Context) error {
+	err := r.httpServer.Shutdown(ctx)
+
+	r.mu.RLock()
+	connections := make([]*serverConnection, 0, len(r.connections))
+	for _, connection := range r.connections {
+		connections = append(connections, connection)
+	}
+	r.mu.RUnlock()
+
+	for _, connection := range connections {
+		_ = connection.send(errorMessage(pusherErrorReconnectImmediately, "Server is shutting down"))
+		connection.close()
+	}
+
+	return err
+}
+
+func (r *Server) handshake(_ *websocket.Config, req *http.Request) error {
+	if slices.Contains(r.allowedOrigins, "*") {
+		return nil
+	}
+
+	origin := req.Header.Get("Origin")
+	if parsed, err := url.Parse(origin); err == nil && parsed.Host != "" && slices.Contains(r.allowedOrigins, parsed.Host) {
+		return nil
+	}
+
+	return errors.BroadcastServerOriginNotAllowed.Args(origin)
+}
+
+func (r *Server) handleConnection(ws *websocket.Conn) {
+	ws.MaxPayloadBytes = r.maxMessageSize
+	connection := newServerConnection(ws, r.maxPendingMessages)
+	go connection.write()
+	defer func() {
+		// Wait for the pending messages, the hijacked connection is closed once the handler returns.
+		connection.close()
+		<-connection.done
+	}()
+
+	if ws.Request().PathValue("key") != r.key {
+		_ = connection.send(errorMessage(pusherErrorApplicationDoesNotExist, "Application does not exist"))
+		return
+	}
+
+	r.mu.Lock()
+	r.connections[connection.socketID] = connection
+	r.mu.Unlock()
+	defer r.disconnect(connection)
+
+	established, _ := json.Marshal(map[string]any{
+		"socket_id":        connection.socketID,
+		"activity_timeout": int(r.activityTimeout.Seconds()),
+	})
+	if err := connection.send(serverMessage{Event: "pusher:connection_established", Data: string(established)}); err != nil {
+		return
+	}
+
+	for {
+		// The client pings the server after being idle for the activity timeout, the connection
+		// is considered lost if nothing is received in twice of the time.
+		_ = ws.SetReadDeadline(time.Now().Add(2 * r.activityTimeout))
+
+		var payload string
+		if err := websocket.Message.Receive(ws, &payload); err != nil {
+			if errors.Is(err, websocket.ErrFrameTooLarge) {
+				_ = connection.send(errorMessage(pusherErrorGeneric, "Message is too large"))
+				continue
+			}
+
+			return
+		}
+
+		r.handleMessage(connection, payload)
+	}
+}
+
+func (r *Server) handleMessage(connection *serverConnection, payload string) {
+	var message clientMessage
+	if err := json.Unmarshal([]byte(payload), &message); err != nil {
+		_ = connection.send(errorMessage(pusherErrorGeneric, "Invalid message format"))
+		return
+	}
+
+	switch {
+	case message.Event == "pusher:ping":
+		_ = connection.send(serverMessage{Event: "pusher:pong", Data: "{}"})
+	case message.Event == "pusher:subscribe":
+		r.subscribe(connection, message.Data)
+	case message.Event == "pusher:unsubscribe":
+		var data struct {
+			Channel string `json:"channel"`
+		}
+		if err := json.Unmarshal(message.Data, &data); err == nil {
+			r.unsubscribe(connection, data.Channel)
+		}
+	case strings.HasPrefix(message.Event, "client-"):
+		r.clientEvent(connection, message)
+	}
+}
+
+func (r *Server) subscribe(connection *serverConnection, payload json.RawMessage) {
+	var data struct {
+		Channel     string `json:"channel"`
+		Auth        string `json:"auth"`
+		ChannelData string `json:"channel_data"`
+	}
+	if err := json.Unmarshal(payload, &data); err != nil || data.Channel == "" {
+		_ = connection.send(errorMessage(pusherErrorGeneric, "Invalid subscription"))
+		return
+	}
+
+	presence := IsPresenceChannel(data.Channel)
+	if presence || IsPrivateChannel(data.Channel) {
+		channelData := ""
+		if presence {
+			channelData = data.ChannelData
+		}
+
+		// The same signature as the one returned by Application.Authenticate.
+		expected := r.key + ":" + computeAuthSignature(r.secret, connection.socketID, data.Channel, channelData)
+		if !hmac.Equal([]byte(expected), []byte(data.Auth)) {
+			_ = connection.send(errorMessage(pusherErrorUnauthorized, "Connection is unauthorized"))
+			return
+		}
+	}
+
+	var member presenceMember
+	if presence {
+		var channelData map[string]any
+		if err := json.Unmarshal([]byte(data.ChannelData), &channelData); err != nil {
+			_ = connection.send(errorMessage(pusherErrorGeneric, "Invalid channel data"))
+			return
+		}
+
+		member = presenceMember{UserID: cast.ToString(channelData["user_id"]), UserInfo: channelData["user_info"]}
+		if member.UserID == "" {
+			_ = connection.send(errorMessage(pusherErrorGeneric, "Invalid channel data"))
+			return
+		}
+	}
+
+	r.mu.Lock()
+	channel, ok := r.channels[data.Channel]
+	if !ok {
+		channel = &serverChannel{
+			connections: make(map[string]*serverConnection),
+			members:     make(map[string]presenceMember),
+		}
+		r.channels[data.Channel] = channel
+	}
+
+	added := false
+	if presence {
+		added = !channel.hasUser(member.UserID)
+		channel.members[connection.socketID] = member
+	}
+	channel.connections[connection.socketID] = connection
+	connection.channels[data.Channel] = struct{}{}
+
+	succeeded := "{}"
+	if presence {
+		succeeded = channel.presenceData()
+	}
+	others := channel.others(connection.socketID)
+	r.mu.Unlock()
+
+	_ = connection.send(serverMessage{Event: "pusher_internal:subscription_succeeded", Channel: data.Channel, Data: succeeded})
+
+	if added {
+		encoded, _ := json.Marshal(member)
+		sendAll(others, serverMessage{Event: "pusher_internal:member_added", Channel: data.Channel, Data: string(encoded)})
+	}
+}
+
+func (r *Server) unsubscribe(connection *serverConnection, name string) {
+	r.mu.Lock()
+	channel, ok := r.channels[name]
+	if !ok {
+		r.mu.Unlock()
+		return
+	}
+
+	delete(connection.channels, name)
+	delete(channel.connections, connection.socketID)
+
+	member, isMember := channel.members[connection.socketID]
+	delete(channel.members, connection.socketID)
+	removed := isMember && !channel.hasUser(member.UserID)
+
+	others := channel.others(connection.socketID)
+	if len(channel.connections) == 0 {
+		delete(r.channels, name)
+	}
+	r.mu.Unlock()
+
+	if removed {
+		encoded, _ := json.Marshal(map[string]string{"user_id": member.UserID})
+		sendAll(others, serverMessage{Event: "pusher_internal:member_removed", Channel: name, Data: string(encoded)})
+	}
+}
+
+func (r *Server) disconnect(connection *serverConnection) {
+	r.mu.Lock()
+	delete(r.connections, connection.socketID)
+	channels := make([]string, 0, len(connection.channels))
+	for name := range connection.channels {
+		channels = append(channels, name)
+	}
+	r.mu.Unlock()
+
+	for _, name := range channels {
+		r.unsubscribe(connection, name)
+	}
+}
+
+// clientEvent forwards the event to the other subscribers of the channel, the clients can
+// only trigger events on the private and presence channels they have subscribed to.
+func (r *Server) clientEvent(connection *serverConnection, message clientMessage) {
+	if !IsPrivateChannel(message.Channel) && !IsPresenceChannel(message.Channel) {
+		_ = connection.send(errorMessage(pusherErrorGeneric, "Client events are only supported on private and presence channels"))
+		return
+	}
+
+	r.mu.RLock()
+	channel, ok := r.channels[message.Channel]
+	if ok {
+		_, ok = channel.connections[connection.socketID]
+	}
+	if !ok {
+		r.mu.RUnlock()
+		_ = connection.send(errorMessage(pusherErrorGeneric, "Client is not subscribed to the channel"))
+		return
+	}
+
+	event := serverMessage{Event: message.Event, Channel: message.Channel, Data: message.Data}
+	if member, isMember := channel.members[connection.socketID]; isMember {
+		event.UserID = member.UserID
+	}
+	others := channel.others(connection.socketID)
+	r.mu.RUnlock()
+
+	sendAll(others, event)
+}
+
+func (r *Server) handleEvents(w http.ResponseWriter, req *http.Request) {
+	if req.PathValue("app") != r.appID {
+		writeServerError(w, http.StatusNotFound, errors.BroadcastConnectionNotFound.Args(req.PathValue("app")))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, 10<<20))
+	if err != nil {
+		writeServerError(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+
+	if !r.verifySignature(req, body) {
+		writeServerError(w, http.StatusUnauthorized, errors.BroadcastServerSignatureInvalid)
+		return
+	}
+
+	var event serverEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		writeServerError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	channels := event.Channels
+	if event.Channel != "" {
+		channels = append(channels, event.Channel)
+	}
+	if event.Name == "" || len(channels) == 0 {
+		writeServerError(w, http.StatusBadRequest, errors.BroadcastAuthMissingParams)
+		return
+	}
+
+	// The data is a JSON encoded string in the Pusher API, an object is accepted as well.
+	var data string
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		data = string(event.Data)
+	}
+
+	r.publish(channels, event.Name, data, event.SocketID)
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte("{}"))
+}
+
+func (r *Server) publish(channels []string, event, data, exceptSocketID string) {
+	for _, name := range channels {
+		r.mu.RLock()
+		channel, ok := r.channels[name]
+		var connections []*serverConnection
+		if ok {
+			connections = channel.others(exceptSocketID)
+		}
+		r.mu.RUnlock()
+
+		sendAll(connections, serverMessage{Event: event, Channel: name, Data: data})
+	}
+}
+
+// verifySignature verifies the request signed by the authentication of the Pusher HTTP API.
+func (r *Server) verifySignature(req *http.Request, body []byte) bool {
+	query := req.URL.Query()
+	if query.Get("auth_key") != r.key {
+		return false
+	}
+
+	timestamp, err := strconv.ParseInt(query.Get("auth_timestamp"), 10, 64)
+	if err != nil {
+		return false
+	}
+	if diff := time.Now().Unix() - timestamp; diff > serverSignatureTimeout || diff < -serverSignatureTimeout {
+		return false
+	}
+
+	if len(body) > 0 && query.Get("body_md5") != fmt.Sprintf("%x", md5.Sum(body)) {
+		return false
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if key != "auth_signature" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	params := make([]string, len(keys))
+	for i, key := range keys {
+		params[i] = strings.ToLower(key) + "=" + query.Get(key)
+	}
+
+	mac := hmac.New(sha256.New, []byte(r.secret))
+	_, _ = mac.Write([]byte(req.Method + "\n" + req.URL.Path + "\n" + strings.Join(params, "&")))
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(query.Get("auth_signature")))
+}
+
+func (r *serverChannel) hasUser(userID string) bool {
+	for _, member := range r.members {
+		if member.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *serverChannel) others(socketID string) []*serverConnection {
+	connections := make([]*serverConnection, 0, len(r.connections))
+	for id, connection := range r.connections {
+		if id != socketID {
+			connections = append(connections, connection)
+		}
+	}
+
+	return connections
+}
+
+// presenceData returns the members of the channel, a user connected from several sockets
+// is only listed once.
+func (r *serverChannel) presenceData() string {
+	ids := make([]string, 0, len(r.members))
+	hash := make(map[string]any, len(r.members))
+	for _, member := range r.members {
+		if _, exist := hash[member.UserID]; exist {
+			continue
+		}
+
+		ids = append(ids, member.UserID)
+		hash[member.UserID] = member.UserInfo
+	}
+	sort.Strings(ids)
+
+	encoded, _ := json.Marshal(map[string]any{
+		"presence": map[string]any{
+			"ids":   ids,
+			"hash":  hash,
+			"count": len(ids),
+		},
+	})
+
+	return string(encoded)
+}
+
+func newServerConnection(ws *websocket.Conn, maxPendingMessages int) *serverConnection {
+	return &serverConnection{
+		ws:       ws,
+		socketID: fmt.Sprintf("%d.%d", rand.IntN(1e9), rand.IntN(1e9)),
+		channels: make(map[string]struct{}),
+		queue:    make(chan string, maxPendingMessages),
+		done:     make(chan struct{}),
+	}
+}
+
+// send queues the message without blocking. A client whose queue is full doesn't read fast
+// enough, it's dropped instead of delaying the messages of the other clients, and it can
+// reconnect and subscribe again.
+func (r *serverConnection) send(message serverMessage) error {
+	encoded, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return errors.BroadcastServerConnectionClosed
+	}
+
+	select {
+	case r.queue <- string(encoded):
+		return nil
+	default:
+		r.dropped.Store(true)
+		r.closed = true
+		close(r.queue)
+
+		return errors.BroadcastServerSlowConsumer.Args(r.socketID)
+	}
+}
+
+// write sends the queued messages until the queue is closed, then closes the connection,
+// which stops the read loop as well.
+func (r *serverConnection) write() {
+	defer close(r.done)
+	defer func() {
+		_ = r.ws.Close()
+	}()
+
+	failed := false
+	for message := range r.queue {
+		// The pending messages of a dropped client are discarded.
+		if failed || r.dropped.Load() {
+			continue
+		}
+
+		_ = r.ws.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		if err := websocket.Message.Send(r.ws, message); err != nil {
+			failed = true
+		}
+	}
+}
+
+// close stops accepting messages, the writer sends the pending ones then closes the connection.
+func (r *serverConnection) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+}
+
+func errorMessage(code int, message string) serverMessage {
+	return serverMessage{
+		Event: "pusher:error",
+		Data: map[string]any{
+			"code":    code,
+			"message": message,
+		},
+	}
+}
+
+func sendAll(connections []*serverConnection, message serverMessage) {
+	for _, connection := range connections {
+		_ = connection.send(message)
+	}
+}
+
+func writeServerError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,368 @@
+package broadcasting
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/goravel/framework/contracts/broadcasting"
+	"github.com/goravel/framework/errors"
+)
+
+type testServerClient struct {
+	t        *testing.T
+	ws       *websocket.Conn
+	socketID string
+}
+
+func (r *testServerClient) send(event string, data any) {
+	encoded, err := json.Marshal(map[string]any{"event": event, "data": data})
+	require.NoError(r.t, err)
+	require.NoError(r.t, websocket.Message.Send(r.ws, string(encoded)))
+}
+
+func (r *testServerClient) sendClientEvent(event, channel string, data any) {
+	encoded, err := json.Marshal(map[string]any{"event": event, "channel": channel, "data": data})
+	require.NoError(r.t, err)
+	require.NoError(r.t, websocket.Message.Send(r.ws, string(encoded)))
+}
+
+func (r *testServerClient) receive() map[string]any {
+	require.NoError(r.t, r.ws.SetReadDeadline(time.Now().Add(2*time.Second)))
+
+	var payload string
+	require.NoError(r.t, websocket.Message.Receive(r.ws, &payload))
+
+	var message map[string]any
+	require.NoError(r.t, json.Unmarshal([]byte(payload), &message))
+
+	return message
+}
+
+func (r *testServerClient) subscribe(channel, channelData string) map[string]any {
+	data := map[string]any{"channel": channel}
+	if IsPrivateChannel(channel) || IsPresenceChannel(channel) {
+		data["auth"] = "key:" + computeAuthSignature("secret", r.socketID, channel, channelData)
+	}
+	if channelData != "" {
+		data["channel_data"] = channelData
+	}
+	r.send("pusher:subscribe", data)
+
+	return r.receive()
+}
+
+func newTestServer(t *testing.T, options map[string]any) (*Server, *httptest.Server) {
+	server, err := NewServer(broadcasting.ConnectionConfig{
+		Driver:  "websocket",
+		AppID:   "app",
+		Key:     "key",
+		Secret:  "secret",
+		Options: options,
+	}, broadcasting.ServerArgs{})
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server.Handler())
+	t.Cleanup(httpServer.Close)
+
+	return server, httpServer
+}
+
+func dialTestServer(t *testing.T, httpServer *httptest.Server, key string) *testServerClient {
+	ws, err := websocket.Dial(strings.Replace(httpServer.URL, "http", "ws", 1)+"/app/"+key, "", httpServer.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ws.Close()
+	})
+
+	return &testServerClient{t: t, ws: ws}
+}
+
+func connectTestServer(t *testing.T, httpServer *httptest.Server) *testServerClient {
+	client := dialTestServer(t, httpServer, "key")
+
+	message := client.receive()
+	require.Equal(t, "pusher:connection_established", message["event"])
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(message["data"].(string)), &data))
+	client.socketID = data["socket_id"].(string)
+
+	return client
+}
+
+func postTestEvent(t *testing.T, httpServer *httptest.Server, secret string, body []byte) *http.Response {
+	path := "/apps/app/events"
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	bodyMD5 := fmt.Sprintf("%x", md5.Sum(body))
+	query := fmt.Sprintf("auth_key=key&auth_timestamp=%s&auth_version=1.0&body_md5=%s", timestamp, bodyMD5)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte("POST\n" + path + "\n" + query))
+
+	resp, err := http.Post(httpServer.URL+path+"?"+query+"&auth_signature="+hex.EncodeToString(mac.Sum(nil)), "application/json", strings.NewReader(string(body)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+
+	return resp
+}
+
+func TestNewServer(t *testing.T) {
+	tests := []struct {
+		name        string
+		conn        broadcasting.ConnectionConfig
+		args        broadcasting.ServerArgs
+		wantAddress string
+		wantErr     error
+	}{
+		{
+			name:        "defaults",
+			conn:        broadcasting.ConnectionConfig{AppID: "app", Key: "key", Secret: "secret"},
+			wantAddress: "0.0.0.0:8080",
+		},
+		{
+			name:        "port from the options",
+			conn:        broadcasting.ConnectionConfig{AppID: "app", Key: "key", Secret: "secret", Options: map[string]any{"port": 6001}},
+			wantAddress: "0.0.0.0:6001",
+		},
+		{
+			name:        "args override the options",
+			conn:        broadcasting.ConnectionConfig{AppID: "app", Key: "key", Secret: "secret", Options: map[string]any{"port": 6001}},
+			args:        broadcasting.ServerArgs{Host: "127.0.0.1", Port: 6002},
+			wantAddress: "127.0.0.1:6002",
+		},
+		{
+			name:    "missing app id",
+			conn:    broadcasting.ConnectionConfig{Key: "key", Secret: "secret"},
+			wantErr: errors.BroadcastPusherAppIDRequired,
+		},
+		{
+			name:    "missing key",
+			conn:    broadcasting.ConnectionConfig{AppID: "app", Secret: "secret"},
+			wantErr: errors.BroadcastPusherKeyRequired,
+		},
+		{
+			name:    "missing secret",
+			conn:    broadcasting.ConnectionConfig{AppID: "app", Key: "key"},
+			wantErr: errors.BroadcastPusherSecretRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewServer(tt.conn, tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, server)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAddress, server.Address())
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Run("connection established and ping", func(t *testing.T) {
+		_, httpServer := newTestServer(t, map[string]any{"activity_timeout": 60})
+		client := dialTestServer(t, httpServer, "key")
+
+		message := client.receive()
+		assert.Equal(t, "pusher:connection_established", message["event"])
+
+		var data map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(message["data"].(string)), &data))
+		assert.Regexp(t, `^\d+\.\d+$`, data["socket_id"])
+		assert.Equal(t, float64(60), data["activity_timeout"])
+
+		client.send("pusher:ping", map[string]any{})
+		assert.Equal(t, "pusher:pong", client.receive()["event"])
+	})
+
+	t.Run("the key doesn't exist", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+		client := dialTestServer(t, httpServer, "unknown")
+
+		message := client.receive()
+		assert.Equal(t, "pusher:error", message["event"])
+		assert.Equal(t, float64(4001), message["data"].(map[string]any)["code"])
+	})
+
+	t.Run("the origin isn't allowed", func(t *testing.T) {
+		_, httpServer := newTestServer(t, map[string]any{"allowed_origins": []string{"example.com"}})
+
+		_, err := websocket.Dial(strings.Replace(httpServer.URL, "http", "ws", 1)+"/app/key", "", "http://goravel.dev")
+		assert.Error(t, err)
+
+		ws, err := websocket.Dial(strings.Replace(httpServer.URL, "http", "ws", 1)+"/app/key", "", "https://example.com")
+		assert.NoError(t, err)
+		_ = ws.Close()
+	})
+
+	t.Run("public channel receives the events of the HTTP API", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+		first := connectTestServer(t, httpServer)
+		second := connectTestServer(t, httpServer)
+
+		message := first.subscribe("orders", "")
+		assert.Equal(t, "pusher_internal:subscription_succeeded", message["event"])
+		assert.Equal(t, "orders", message["channel"])
+		second.subscribe("orders", "")
+
+		body, err := json.Marshal(map[string]any{
+			"name":      "OrderShipped",
+			"channels":  []string{"orders"},
+			"data":      `{"id":1}`,
+			"socket_id": second.socketID,
+		})
+		assert.NoError(t, err)
+
+		resp := postTestEvent(t, httpServer, "secret", body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		message = first.receive()
+		assert.Equal(t, "OrderShipped", message["event"])
+		assert.Equal(t, "orders", message["channel"])
+		assert.Equal(t, `{"id":1}`, message["data"])
+
+		// The socket id is excluded, the next message of the second client is the pong.
+		second.send("pusher:ping", map[string]any{})
+		assert.Equal(t, "pusher:pong", second.receive()["event"])
+	})
+
+	t.Run("the signature of the HTTP API is invalid", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+
+		resp := postTestEvent(t, httpServer, "invalid", []byte(`{"name":"OrderShipped","channels":["orders"],"data":"{}"}`))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, err := http.Post(httpServer.URL+"/apps/unknown/events", "application/json", strings.NewReader("{}"))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+
+	t.Run("private channel", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+		client := connectTestServer(t, httpServer)
+
+		client.send("pusher:subscribe", map[string]any{"channel": "private-orders.1", "auth": "key:invalid"})
+		message := client.receive()
+		assert.Equal(t, "pusher:error", message["event"])
+		assert.Equal(t, float64(4009), message["data"].(map[string]any)["code"])
+
+		message = client.subscribe("private-orders.1", "")
+		assert.Equal(t, "pusher_internal:subscription_succeeded", message["event"])
+	})
+
+	t.Run("presence channel", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+		first := connectTestServer(t, httpServer)
+		second := connectTestServer(t, httpServer)
+		third := connectTestServer(t, httpServer)
+
+		message := first.subscribe("presence-chat", `{"user_id":"1","user_info":{"name":"Alice"}}`)
+		assert.Equal(t, "pusher_internal:subscription_succeeded", message["event"])
+		assert.JSONEq(t, `{"presence":{"ids":["1"],"hash":{"1":{"name":"Alice"}},"count":1}}`, message["data"].(string))
+
+		message = second.subscribe("presence-chat", `{"user_id":"2","user_info":{"name":"Bob"}}`)
+		assert.JSONEq(t, `{"presence":{"ids":["1","2"],"hash":{"1":{"name":"Alice"},"2":{"name":"Bob"}},"count":2}}`, message["data"].(string))
+
+		message = first.receive()
+		assert.Equal(t, "pusher_internal:member_added", message["event"])
+		assert.JSONEq(t, `{"user_id":"2","user_info":{"name":"Bob"}}`, message["data"].(string))
+
+		// The same user from another socket isn't added again.
+		message = third.subscribe("presence-chat", `{"user_id":"2","user_info":{"name":"Bob"}}`)
+		assert.JSONEq(t, `{"presence":{"ids":["1","2"],"hash":{"1":{"name":"Alice"},"2":{"name":"Bob"}},"count":2}}`, message["data"].(string))
+
+		second.send("pusher:unsubscribe", map[string]any{"channel": "presence-chat"})
+		assert.NoError(t, third.ws.Close())
+
+		message = first.receive()
+		assert.Equal(t, "pusher_internal:member_removed", message["event"])
+		assert.JSONEq(t, `{"user_id":"2"}`, message["data"].(string))
+	})
+
+	t.Run("client events", func(t *testing.T) {
+		_, httpServer := newTestServer(t, nil)
+		first := connectTestServer(t, httpServer)
+		second := connectTestServer(t, httpServer)
+
+		first.subscribe("presence-chat", `{"user_id":"1"}`)
+		second.subscribe("presence-chat", `{"user_id":"2"}`)
+		first.receive()
+
+		second.sendClientEvent("client-typing", "presence-chat", map[string]any{"typing": true})
+		message := first.receive()
+		assert.Equal(t, "client-typing", message["event"])
+		assert.Equal(t, "presence-chat", message["channel"])
+		assert.Equal(t, "2", message["user_id"])
+		assert.Equal(t, map[string]any{"typing": true}, message["data"])
+
+		first.subscribe("orders", "")
+		first.sendClientEvent("client-typing", "orders", map[string]any{})
+		message = first.receive()
+		assert.Equal(t, "pusher:error", message["event"])
+
+		first.sendClientEvent("client-typing", "private-orders.1", map[string]any{})
+		message = first.receive()
+		assert.Equal(t, "pusher:error", message["event"])
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		server, httpServer := newTestServer(t, nil)
+		client := connectTestServer(t, httpServer)
+
+		assert.NoError(t, server.Shutdown(t.Context()))
+
+		message := client.receive()
+		assert.Equal(t, "pusher:error", message["event"])
+		assert.Equal(t, float64(4200), message["data"].(map[string]any)["code"])
+	})
+}
+
+func TestServerConnectionSend(t *testing.T) {
+	t.Run("queues the messages", func(t *testing.T) {
+		connection := newServerConnection(nil, 2)
+
+		assert.NoError(t, connection.send(serverMessage{Event: "first"}))
+		assert.NoError(t, connection.send(serverMessage{Event: "second"}))
+		assert.Equal(t, `{"event":"first"}`, <-connection.queue)
+		assert.False(t, connection.dropped.Load())
+	})
+
+	t.Run("drops the slow consumer", func(t *testing.T) {
+		connection := newServerConnection(nil, 1)
+
+		assert.NoError(t, connection.send(serverMessage{Event: "first"}))
+		assert.ErrorIs(t, connection.send(serverMessage{Event: "second"}), errors.BroadcastServerSlowConsumer)
+		assert.True(t, connection.dropped.Load())
+		assert.ErrorIs(t, connection.send(serverMessage{Event: "third"}), errors.BroadcastServerConnectionClosed)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		connection := newServerConnection(nil, 1)
+		connection.close()
+		connection.close()
+
+		assert.ErrorIs(t, connection.send(serverMessage{Event: "first"}), errors.BroadcastServerConnectionClosed)
+		assert.False(t, connection.dropped.Load())
+	})
+}
@@ -69,8 +69,13 @@ func (r *ServiceProvider) Boot(app foundation.Application) {
 
 	artisanFacade := app.MakeArtisan()
 	if artisanFacade != nil {
-		artisanFacade.Register([]contractsconsole.Command{
+		commands := []contractsconsole.Command{
 			console.NewChannelMakeCommand(),
-		})
+		}
+		if broadcastFacade := app.MakeBroadcast(); broadcastFacade != nil {
+			commands = append(commands, console.NewServeCommand(broadcastFacade))
+		}
+
+		artisanFacade.Register(commands)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	contractsconsole "github.com/goravel/framework/contracts/console"
 	contractshttp "github.com/goravel/framework/contracts/http"
 	mocksconfig "github.com/goravel/framework/mocks/config"
 	mocksconsole "github.com/goravel/framework/mocks/console"
@@ -36,9 +37,11 @@ func TestBroadcastServiceProviderBoot_Middleware(t *testing.T) {
 	})).Return(nil).Once()
 
 	mockApp.EXPECT().MakeRoute().Return(mockRoute).Once()
-	mockApp.EXPECT().MakeBroadcast().Return(&Application{config: &Config{Default: "log"}}).Once()
+	mockApp.EXPECT().MakeBroadcast().Return(&Application{config: &Config{Default: "log"}}).Twice()
 	mockApp.EXPECT().MakeArtisan().Return(mockArtisan).Once()
-	mockArtisan.EXPECT().Register(mock.Anything).Once()
+	mockArtisan.EXPECT().Register(mock.MatchedBy(func(commands []contractsconsole.Command) bool {
+		return len(commands) == 2
+	})).Once()
 
 	mockRoute.EXPECT().Middleware(testMiddleware).Return(mockRoute).Once()
 	mockRoute.EXPECT().Post("/broadcasting/auth", mock.Anything).Return(mocksroute.NewAction(t)).Once()
@@ -63,8 +66,11 @@ func TestBroadcastServiceProviderBoot_AuthDisabled(t *testing.T) {
 		return true
 	})).Return(nil).Once()
 
+	mockApp.EXPECT().MakeBroadcast().Return(&Application{config: &Config{Default: "log"}}).Once()
 	mockApp.EXPECT().MakeArtisan().Return(mockArtisan).Once()
-	mockArtisan.EXPECT().Register(mock.Anything).Once()
+	mockArtisan.EXPECT().Register(mock.MatchedBy(func(commands []contractsconsole.Command) bool {
+		return len(commands) == 2
+	})).Once()
 
 	provider := &ServiceProvider{}
 	assert.NotPanics(t, func() { provider.Boot(mockApp) })
@@ -48,6 +48,21 @@ func init() {
 					"scheme":  config.Env("PUSHER_SCHEME", "https"),
 				},
 			},
+			// The server of the websocket driver is started by the broadcast:serve command,
+			// it's compatible with the Pusher clients, e.g. Laravel Echo.
+			"websocket": map[string]any{
+				"driver":  "websocket",
+				"key":     config.Env("BROADCAST_WEBSOCKET_KEY", ""),
+				"secret":  config.Env("BROADCAST_WEBSOCKET_SECRET", ""),
+				"app_id":  config.Env("BROADCAST_WEBSOCKET_APP_ID", ""),
+				"options": map[string]any{
+					"host":             config.Env("BROADCAST_WEBSOCKET_HOST", "127.0.0.1"),
+					"port":             config.Env("BROADCAST_WEBSOCKET_PORT", 8080),
+					"scheme":           config.Env("BROADCAST_WEBSOCKET_SCHEME", "http"),
+					"allowed_origins":  []string{"*"},
+					"activity_timeout": 30,
+				},
+			},
 			"log": map[string]any{
 				"driver": "log",
 			},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/log"
 )
 
@@ -17,8 +18,8 @@ type Application struct {
 	stores   map[string]cache.Driver
 }
 
-func NewApplication(config config.Config, log log.Log, store string) (*Application, error) {
-	driver := NewDriver(config)
+func NewApplication(config config.Config, log log.Log, db func() contractsdb.DB, store string) (*Application, error) {
+	driver := NewDriver(config, db)
 	instance, err := driver.New(store)
 	if err != nil {
 		return nil, err
@@ -61,3 +62,13 @@ func (app *Application) Store(name string) cache.Driver {
 
 	return instance
 }
+
+// RestoreLock returns the lock of the given key owned by the given owner in the default
+// store, nil is returned if the store can't restore locks.
+func (app *Application) RestoreLock(key, owner string) cache.Lock {
+	if driver, ok := app.Driver.(cache.DriverWithRestoreLock); ok {
+		return driver.RestoreLock(key, owner)
+	}
+
+	return nil
+}
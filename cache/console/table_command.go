@@ -0,0 +1,157 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/packages/modify"
+	"github.com/goravel/framework/support/env"
+)
+
+// Usage:
+//
+//	./artisan cache:table
+//	./artisan cache:table --store=database
+//	./artisan migrate
+type TableCommand struct {
+	config config.Config
+}
+
+func NewTableCommand(config config.Config) *TableCommand {
+	return &TableCommand{
+		config: config,
+	}
+}
+
+func (c *TableCommand) Signature() string {
+	return "cache:table"
+}
+
+func (c *TableCommand) Description() string {
+	return "Create a migration for the cache database tables"
+}
+
+func (c *TableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "cache",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "store",
+				Value: "database",
+				Usage: "The cache store whose table and lock_table are created",
+			},
+		},
+	}
+}
+
+func (c *TableCommand) Handle(ctx console.Context) error {
+	timestamp := time.Now().Format("20060102150405")
+	filename := timestamp + "_create_cache_table.go"
+	dest := filepath.Join("database", "migrations", filename)
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dest); err == nil {
+		ctx.Warning("Migration already exists: " + dest)
+		return nil
+	}
+
+	store := ctx.Option("store")
+	table := c.config.GetString(fmt.Sprintf("cache.stores.%s.table", store), "cache")
+	lockTable := c.config.GetString(fmt.Sprintf("cache.stores.%s.lock_table", store), "cache_locks")
+
+	if err := os.WriteFile(dest, []byte(migrationStub(timestamp, table, lockTable)), 0o644); err != nil {
+		return err
+	}
+
+	ctx.Info("Migration created successfully: " + dest)
+
+	structName := "M" + timestamp + "CreateCacheTable"
+	if err := c.registerMigration(structName); err != nil {
+		ctx.Warning("Could not auto-register migration: " + err.Error())
+		ctx.Warning("Add manually to your migrations registration:")
+		ctx.Info("  &migrations." + structName + "{},")
+	} else {
+		ctx.Info("Migration registered successfully")
+	}
+
+	ctx.Info("Run `./artisan migrate` to apply it.")
+	return nil
+}
+
+func (c *TableCommand) registerMigration(structName string) error {
+	if !env.IsBootstrapSetup() {
+		return errors.CacheTableRequiresBootstrapSetup
+	}
+
+	modulePath := "goravel"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		modulePath = info.Main.Path
+	}
+	pkgImportPath := modulePath + "/database/migrations"
+	entry := fmt.Sprintf("&migrations.%s{}", structName)
+
+	return modify.AddMigration(pkgImportPath, entry)
+}
+
+// Column shape here MUST stay in sync with DatabaseItem and DatabaseLockItem
+// in cache/database.go and cache/database_lock.go.
+func migrationStub(timestamp, table, lockTable string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreateCacheTable struct{}
+
+func (r *M` + timestamp + `CreateCacheTable) Signature() string {
+	return "` + timestamp + `_create_cache_table"
+}
+
+func (r *M` + timestamp + `CreateCacheTable) Up() error {
+	if !facades.Schema().HasTable("` + table + `") {
+		if err := facades.Schema().Create("` + table + `", func(table schema.Blueprint) {
+			table.String("cache_key")
+			table.MediumText("value")
+			table.BigInteger("expiration")
+			table.Primary("cache_key")
+		}); err != nil {
+			return err
+		}
+	}
+
+	if !facades.Schema().HasTable("` + lockTable + `") {
+		if err := facades.Schema().Create("` + lockTable + `", func(table schema.Blueprint) {
+			table.String("cache_key")
+			table.String("owner")
+			table.BigInteger("expiration")
+			table.Primary("cache_key")
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *M` + timestamp + `CreateCacheTable) Down() error {
+	if err := facades.Schema().DropIfExists("` + table + `"); err != nil {
+		return err
+	}
+
+	return facades.Schema().DropIfExists("` + lockTable + `")
+}
+`
+}
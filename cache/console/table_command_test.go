@@ -0,0 +1,49 @@
+package console
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+)
+
+func TestTableCommand(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.stores.database.table", "cache").Return("cache_items").Once()
+	mockConfig.EXPECT().GetString("cache.stores.database.lock_table", "cache_locks").Return("cache_item_locks").Once()
+
+	ctx := mocksconsole.NewContext(t)
+	ctx.EXPECT().Option("store").Return("database").Once()
+	ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "Migration created successfully:")
+	})).Once()
+	ctx.EXPECT().Warning(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "Could not auto-register migration:")
+	})).Once()
+	ctx.EXPECT().Warning("Add manually to your migrations registration:").Once()
+	ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "  &migrations.")
+	})).Once()
+	ctx.EXPECT().Info("Run `./artisan migrate` to apply it.").Once()
+
+	cmd := NewTableCommand(mockConfig)
+	assert.Equal(t, "cache:table", cmd.Signature())
+	assert.NoError(t, cmd.Handle(ctx))
+
+	entries, err := os.ReadDir("database/migrations")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries[0].Name(), "_create_cache_table.go")
+
+	content, err := os.ReadFile("database/migrations/" + entries[0].Name())
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `Create("cache_items", func`)
+	assert.Contains(t, string(content), `Create("cache_item_locks", func`)
+}
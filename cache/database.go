@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/spf13/cast"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/contracts/testing/docker"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// DatabaseItem is a row of the cache table, the expiration is a unix timestamp
+// in seconds, 0 means the item never expires. The key is stored in the cache_key
+// column, as key is a reserved word in MySQL.
+type DatabaseItem struct {
+	Key        string `db:"cache_key"`
+	Value      string `db:"value"`
+	Expiration int64  `db:"expiration"`
+}
+
+type Database struct {
+	ctx       context.Context
+	db        contractsdb.DB
+	prefix    string
+	table     string
+	lockTable string
+}
+
+func NewDatabase(config config.Config, db contractsdb.DB, store string) (*Database, error) {
+	if db == nil {
+		return nil, errors.CacheDatabaseNotSet.Args(store)
+	}
+
+	if connection := config.GetString(fmt.Sprintf("cache.stores.%s.connection", store)); connection != "" {
+		db = db.Connection(connection)
+	}
+
+	return &Database{
+		db:        db,
+		prefix:    prefix(config),
+		table:     config.GetString(fmt.Sprintf("cache.stores.%s.table", store), "cache"),
+		lockTable: config.GetString(fmt.Sprintf("cache.stores.%s.lock_table", store), "cache_locks"),
+	}, nil
+}
+
+// Add an item in the cache if the key does not exist.
+func (r *Database) Add(key string, value any, t time.Duration) bool {
+	encoded, err := serialize(value)
+	if err != nil {
+		return false
+	}
+
+	item := DatabaseItem{
+		Key:        r.key(key),
+		Value:      encoded,
+		Expiration: expiration(t),
+	}
+
+	if result, err := r.query().Insert(&item); err == nil && result.RowsAffected > 0 {
+		return true
+	}
+
+	// The key exists, it can only be replaced when it has expired.
+	result, err := r.query().Where("cache_key", item.Key).Where("expiration > ?", 0).Where("expiration <= ?", carbon.Now().Timestamp()).Update(map[string]any{
+		"value":      item.Value,
+		"expiration": item.Expiration,
+	})
+
+	return err == nil && result.RowsAffected > 0
+}
+
+// Decrement decrements the value of an item in the cache.
+func (r *Database) Decrement(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	return r.increment(key, -value[0])
+}
+
+func (r *Database) Docker() (docker.CacheDriver, error) {
+	return nil, errors.CacheDatabaseDriverNotSupportDocker
+}
+
+// Flexible Get an item from the cache, a stale item is returned while it's refreshed in the background.
+func (r *Database) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return Flexible(r, key, fresh, stale, callback)
+}
+
+// Forever Put an item in the cache indefinitely.
+func (r *Database) Forever(key string, value any) bool {
+	if err := r.Put(key, value, NoExpiration); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Forget Remove an item from the cache.
+func (r *Database) Forget(key string) bool {
+	_, err := r.query().Where("cache_key", r.key(key)).Delete()
+
+	return err == nil
+}
+
+// Flush Remove all items from the cache.
+func (r *Database) Flush() bool {
+	_, err := r.query().WhereLike("cache_key", r.prefix+"%").Delete()
+
+	return err == nil
+}
+
+// Get Retrieve an item from the cache by key.
+func (r *Database) Get(key string, def ...any) any {
+	if item, ok := r.item(key); ok {
+		return unserialize(item.Value)
+	}
+
+	if len(def) == 0 {
+		return nil
+	}
+
+	switch s := def[0].(type) {
+	case func() any:
+		return s()
+	default:
+		return s
+	}
+}
+
+func (r *Database) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *Database) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *Database) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *Database) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+// Has Checks an item exists in the cache.
+func (r *Database) Has(key string) bool {
+	_, ok := r.item(key)
+
+	return ok
+}
+
+func (r *Database) Increment(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	return r.increment(key, value[0])
+}
+
+func (r *Database) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return NewDatabaseLock(r.db, r.lockTable, r.key(key), t...)
+}
+
+// RestoreLock returns the lock of the given key owned by the given owner.
+func (r *Database) RestoreLock(key, owner string) contractscache.Lock {
+	lock := NewDatabaseLock(r.db, r.lockTable, r.key(key))
+	lock.owner = owner
+
+	return lock
+}
+
+// Pull Retrieve an item from the cache and delete it.
+func (r *Database) Pull(key string, def ...any) any {
+	res := r.Get(key, def...)
+	r.Forget(key)
+
+	return res
+}
+
+// Put an item in the cache for a given number of seconds.
+func (r *Database) Put(key string, value any, t time.Duration) error {
+	encoded, err := serialize(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.query().UpdateOrInsert(map[string]any{"cache_key": r.key(key)}, map[string]any{
+		"value":      encoded,
+		"expiration": expiration(t),
+	})
+
+	return err
+}
+
+// Remember Get an item from the cache, or execute the given Closure and store the result.
+func (r *Database) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	val := r.Get(key, nil)
+	if val != nil {
+		return val, nil
+	}
+
+	val, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Put(key, val, ttl); err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *Database) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.Remember(key, NoExpiration, callback)
+}
+
+// Tags returns a tagged cache whose entries are grouped by the given tags.
+func (r *Database) Tags(names ...string) contractscache.Driver {
+	return NewTaggedCache(r, names...)
+}
+
+func (r *Database) WithContext(ctx context.Context) contractscache.Driver {
+	return &Database{
+		ctx:       ctx,
+		db:        r.db.WithContext(ctx),
+		prefix:    r.prefix,
+		table:     r.table,
+		lockTable: r.lockTable,
+	}
+}
+
+func (r *Database) increment(key string, value int64) (int64, error) {
+	result, missing, err := r.incrementItem(key, value, false)
+	if err != nil || !missing {
+		return result, err
+	}
+
+	// LockForUpdate has no row to lock when the key is missing, so concurrent
+	// increments could both start from zero. The row is inserted first instead,
+	// the insert fails harmlessly when a concurrent increment has inserted it.
+	_, _ = r.query().Insert(&DatabaseItem{Key: r.key(key), Value: "0"})
+
+	result, _, err = r.incrementItem(key, value, true)
+
+	return result, err
+}
+
+// incrementItem increments the locked row of the key, a missing row is only
+// inserted when insert is true, otherwise missing is returned.
+func (r *Database) incrementItem(key string, value int64, insert bool) (result int64, missing bool, err error) {
+	err = r.db.Transaction(func(tx contractsdb.Tx) error {
+		var item DatabaseItem
+		if err := tx.Table(r.table).LockForUpdate().Where("cache_key", r.key(key)).First(&item); err != nil {
+			return err
+		}
+
+		if item.Key == "" && !insert {
+			missing = true
+			return nil
+		}
+
+		if item.Key == "" || isExpired(item.Expiration) {
+			result = value
+			_, err := tx.Table(r.table).UpdateOrInsert(map[string]any{"cache_key": r.key(key)}, map[string]any{
+				"value":      cast.ToString(result),
+				"expiration": 0,
+			})
+
+			return err
+		}
+
+		current, err := cast.ToInt64E(unserialize(item.Value))
+		if err != nil {
+			return errors.CacheInvalidIntValueType.Args(key)
+		}
+
+		result = current + value
+		_, err = tx.Table(r.table).Where("cache_key", r.key(key)).Update("value", cast.ToString(result))
+
+		return err
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return result, missing, nil
+}
+
+// TTL returns the remaining lifetime of an item.
+func (r *Database) TTL(key string) (time.Duration, bool) {
+	item, ok := r.item(key)
+	if !ok {
+		return 0, false
+	}
+
+	return remainingTTL(item.Expiration), true
+}
+
+// item gets the row of the key, an expired row is removed.
+func (r *Database) item(key string) (DatabaseItem, bool) {
+	var item DatabaseItem
+	if err := r.query().Where("cache_key", r.key(key)).First(&item); err != nil || item.Key == "" {
+		return item, false
+	}
+
+	if isExpired(item.Expiration) {
+		r.Forget(key)
+
+		return item, false
+	}
+
+	return item, true
+}
+
+func (r *Database) key(key string) string {
+	return r.prefix + key
+}
+
+func (r *Database) query() contractsdb.Query {
+	return r.db.Table(r.table)
+}
+
+// expiration converts a ttl to a unix timestamp in seconds, 0 means the item never expires.
+func expiration(t time.Duration) int64 {
+	if t == NoExpiration {
+		return 0
+	}
+
+	return carbon.Now().Timestamp() + int64(math.Ceil(t.Seconds()))
+}
+
+// remainingTTL converts a unix timestamp in seconds to the remaining ttl, 0
+// means the item never expires.
+func remainingTTL(expiration int64) time.Duration {
+	if expiration == 0 {
+		return NoExpiration
+	}
+
+	return time.Duration(max(expiration-carbon.Now().Timestamp(), 1)) * time.Second
+}
+
+func isExpired(expiration int64) bool {
+	return expiration > 0 && expiration <= carbon.Now().Timestamp()
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// defaultLockTimeout is the expiration of the locks without a ttl, the rows of
+// the crashed processes would block the lock forever otherwise.
+const defaultLockTimeout = 24 * time.Hour
+
+// DatabaseLockItem is a row of the cache_locks table.
+type DatabaseLockItem struct {
+	Key        string `db:"cache_key"`
+	Owner      string `db:"owner"`
+	Expiration int64  `db:"expiration"`
+}
+
+type DatabaseLock struct {
+	db    contractsdb.DB
+	table string
+	key   string
+	owner string
+	time  time.Duration
+}
+
+func NewDatabaseLock(db contractsdb.DB, table, key string, t ...time.Duration) *DatabaseLock {
+	lock := &DatabaseLock{
+		db:    db,
+		table: table,
+		key:   key,
+		owner: uuid.NewString(),
+		time:  defaultLockTimeout,
+	}
+
+	if len(t) > 0 && t[0] > 0 {
+		lock.time = t[0]
+	}
+
+	return lock
+}
+
+func (r *DatabaseLock) Block(t time.Duration, callback ...func()) bool {
+	return r.BlockWithTicker(t, 1*time.Second, callback...)
+}
+
+func (r *DatabaseLock) BlockWithTicker(t time.Duration, ti time.Duration, callback ...func()) bool {
+	return blockWithTicker(r.Get, t, ti, callback...)
+}
+
+func (r *DatabaseLock) Get(callback ...func()) bool {
+	if !r.acquire() {
+		return false
+	}
+
+	if len(callback) == 0 {
+		return true
+	}
+
+	callback[0]()
+
+	return r.Release()
+}
+
+// Release the lock if it's owned by this instance.
+func (r *DatabaseLock) Release() bool {
+	result, err := r.db.Table(r.table).Where("cache_key", r.key).Where("owner", r.owner).Delete()
+
+	return err == nil && result.RowsAffected > 0
+}
+
+func (r *DatabaseLock) ForceRelease() bool {
+	_, err := r.db.Table(r.table).Where("cache_key", r.key).Delete()
+
+	return err == nil
+}
+
+func (r *DatabaseLock) Owner() string {
+	return r.owner
+}
+
+func (r *DatabaseLock) acquire() bool {
+	item := DatabaseLockItem{
+		Key:        r.key,
+		Owner:      r.owner,
+		Expiration: expiration(r.time),
+	}
+
+	if result, err := r.db.Table(r.table).Insert(&item); err == nil && result.RowsAffected > 0 {
+		return true
+	}
+
+	// The lock is held, it can be taken over when it has expired or is already owned by this instance.
+	result, err := r.db.Table(r.table).Where("cache_key", r.key).Where(func(query contractsdb.Query) contractsdb.Query {
+		return query.Where("owner", r.owner).OrWhere("expiration <= ?", carbon.Now().Timestamp())
+	}).Update(map[string]any{
+		"owner":      r.owner,
+		"expiration": item.Expiration,
+	})
+
+	return err == nil && result.RowsAffected > 0
+}
@@ -0,0 +1,248 @@
+package cache
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	databasedb "github.com/goravel/framework/database/db"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksdriver "github.com/goravel/framework/mocks/database/driver"
+	mockslogger "github.com/goravel/framework/mocks/database/logger"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type DatabaseTestSuite struct {
+	suite.Suite
+	mockDB    *mocksdb.DB
+	mockQuery *mocksdb.Query
+	database  *Database
+	now       *carbon.Carbon
+}
+
+func TestDatabaseTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseTestSuite))
+}
+
+func (s *DatabaseTestSuite) SetupTest() {
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockQuery = mocksdb.NewQuery(s.T())
+	s.database = &Database{
+		db:        s.mockDB,
+		prefix:    "goravel:",
+		table:     "cache",
+		lockTable: "cache_locks",
+	}
+	s.now = carbon.Now()
+	carbon.SetTestNow(s.now)
+}
+
+func (s *DatabaseTestSuite) TearDownTest() {
+	carbon.ClearTestNow()
+}
+
+func (s *DatabaseTestSuite) TestNewDatabase() {
+	mockConfig := mocksconfig.NewConfig(s.T())
+	_, err := NewDatabase(mockConfig, nil, "database")
+	s.Equal(errors.CacheDatabaseNotSet.Args("database"), err)
+
+	mockConfig.EXPECT().GetString("cache.stores.database.connection").Return("mysql").Once()
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel").Once()
+	mockConfig.EXPECT().GetString("cache.stores.database.table", "cache").Return("cache").Once()
+	mockConfig.EXPECT().GetString("cache.stores.database.lock_table", "cache_locks").Return("cache_locks").Once()
+	s.mockDB.EXPECT().Connection("mysql").Return(s.mockDB).Once()
+
+	database, err := NewDatabase(mockConfig, s.mockDB, "database")
+	s.NoError(err)
+	s.Equal("goravel:", database.prefix)
+	s.Equal("cache", database.table)
+	s.Equal("cache_locks", database.lockTable)
+}
+
+func (s *DatabaseTestSuite) TestAdd() {
+	s.Run("insert a new item", func() {
+		s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Insert(&DatabaseItem{
+			Key:        "goravel:name",
+			Value:      `"Goravel"`,
+			Expiration: s.now.Timestamp() + 60,
+		}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+		s.True(s.database.Add("name", "Goravel", time.Minute))
+	})
+
+	s.Run("replace an expired item", func() {
+		s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Twice()
+		s.mockQuery.EXPECT().Insert(mock.Anything).Return(nil, errors.New("duplicate key")).Once()
+		s.mockQuery.EXPECT().Where("cache_key", "goravel:name").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("expiration > ?", 0).Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("expiration <= ?", s.now.Timestamp()).Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Update(map[string]any{
+			"value":      `"Goravel"`,
+			"expiration": int64(0),
+		}).Return(&contractsdb.Result{RowsAffected: 0}, nil).Once()
+
+		s.False(s.database.Add("name", "Goravel", NoExpiration))
+	})
+}
+
+func (s *DatabaseTestSuite) TestGet() {
+	s.Run("hit", func() {
+		s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("cache_key", "goravel:name").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*DatabaseItem) = DatabaseItem{Key: "goravel:name", Value: `"Goravel"`}
+		}).Return(nil).Once()
+
+		s.Equal("Goravel", s.database.Get("name"))
+	})
+
+	s.Run("miss", func() {
+		s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("cache_key", "goravel:name").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+
+		s.Equal("default", s.database.Get("name", "default"))
+	})
+
+	s.Run("expired", func() {
+		s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Twice()
+		s.mockQuery.EXPECT().Where("cache_key", "goravel:count").Return(s.mockQuery).Twice()
+		s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*DatabaseItem) = DatabaseItem{Key: "goravel:count", Value: "1", Expiration: s.now.Timestamp()}
+		}).Return(nil).Once()
+		s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+		s.Equal(int64(0), s.database.GetInt64("count"))
+	})
+}
+
+func (s *DatabaseTestSuite) TestPut() {
+	s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().UpdateOrInsert(map[string]any{"cache_key": "goravel:user"}, map[string]any{
+		"value":      `{"name":"Goravel"}`,
+		"expiration": s.now.Timestamp() + 2,
+	}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	s.NoError(s.database.Put("user", map[string]any{"name": "Goravel"}, 1500*time.Millisecond))
+}
+
+func (s *DatabaseTestSuite) TestFlush() {
+	s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().WhereLike("cache_key", "goravel:%").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 2}, nil).Once()
+
+	s.True(s.database.Flush())
+}
+
+func (s *DatabaseTestSuite) TestIncrement() {
+	mockTx := mocksdb.NewTx(s.T())
+	s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(contractsdb.Tx) error) error {
+		return txFunc(mockTx)
+	}).Twice()
+	mockTx.EXPECT().Table("cache").Return(s.mockQuery)
+	s.mockQuery.EXPECT().LockForUpdate().Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Where("cache_key", "goravel:count").Return(s.mockQuery)
+
+	s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		*dest.(*DatabaseItem) = DatabaseItem{Key: "goravel:count", Value: "2"}
+	}).Return(nil).Once()
+	s.mockQuery.EXPECT().Update("value", "5").Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	value, err := s.database.Increment("count", 3)
+	s.NoError(err)
+	s.Equal(int64(5), value)
+
+	s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		*dest.(*DatabaseItem) = DatabaseItem{Key: "goravel:count", Value: `"Goravel"`}
+	}).Return(nil).Once()
+
+	_, err = s.database.Decrement("count")
+	s.Equal(errors.CacheInvalidIntValueType.Args("count"), err)
+}
+
+func (s *DatabaseTestSuite) TestIncrementMissingKey() {
+	mockTx := mocksdb.NewTx(s.T())
+	s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(contractsdb.Tx) error) error {
+		return txFunc(mockTx)
+	}).Twice()
+	mockTx.EXPECT().Table("cache").Return(s.mockQuery)
+	s.mockQuery.EXPECT().LockForUpdate().Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Where("cache_key", "goravel:count").Return(s.mockQuery)
+
+	// The row is inserted before it's locked, a concurrent increment may insert it first.
+	s.mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+	s.mockDB.EXPECT().Table("cache").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Insert(&DatabaseItem{Key: "goravel:count", Value: "0"}).Return(nil, assert.AnError).Once()
+	s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		*dest.(*DatabaseItem) = DatabaseItem{Key: "goravel:count", Value: "1"}
+	}).Return(nil).Once()
+	s.mockQuery.EXPECT().Update("value", "4").Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	value, err := s.database.Increment("count", 3)
+	s.NoError(err)
+	s.Equal(int64(4), value)
+}
+
+func (s *DatabaseTestSuite) TestLock() {
+	lock := s.database.Lock("lock", time.Minute).(*DatabaseLock)
+	s.Equal("goravel:lock", lock.key)
+
+	s.mockDB.EXPECT().Table("cache_locks").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Insert(&DatabaseLockItem{
+		Key:        "goravel:lock",
+		Owner:      lock.owner,
+		Expiration: s.now.Timestamp() + 60,
+	}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+	s.mockQuery.EXPECT().Where("cache_key", "goravel:lock").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("owner", lock.owner).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	var called bool
+	s.True(lock.Get(func() {
+		called = true
+	}))
+	s.True(called)
+}
+
+func (s *DatabaseTestSuite) TestLockHeldByAnotherOwner() {
+	lock := NewDatabaseLock(s.mockDB, "cache_locks", "goravel:lock")
+
+	s.mockDB.EXPECT().Table("cache_locks").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Insert(mock.Anything).Return(nil, errors.New("duplicate key")).Once()
+	s.mockQuery.EXPECT().Where("cache_key", "goravel:lock").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(mock.Anything).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Update(mock.Anything).Return(&contractsdb.Result{RowsAffected: 0}, nil).Once()
+
+	s.False(lock.Get())
+}
+
+func (s *DatabaseTestSuite) TestLockTakeOverExpired() {
+	mockBuilder := mocksdb.NewBuilder(s.T())
+	mockGrammar := mocksdriver.NewGrammar(s.T())
+	mockLogger := mockslogger.NewLogger(s.T())
+	lock := NewDatabaseLock(s.mockDB, "cache_locks", "goravel:lock", time.Minute)
+
+	s.mockDB.EXPECT().Table("cache_locks").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Insert(mock.Anything).Return(nil, errors.New("duplicate key")).Once()
+	s.mockDB.EXPECT().Table("cache_locks").Return(databasedb.NewQuery(context.Background(), mockBuilder, mockBuilder, mockGrammar, mockLogger, "cache_locks", nil)).Once()
+	mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	mockGrammar.EXPECT().CompileJsonColumnsUpdate(mock.Anything).RunAndReturn(func(data map[string]any) (map[string]any, error) {
+		return data, nil
+	}).Once()
+	sql := "UPDATE cache_locks SET expiration = ?, owner = ? WHERE (cache_key = ? AND (owner = ? OR expiration <= ?))"
+	args := []any{s.now.Timestamp() + 60, lock.owner, "goravel:lock", lock.owner, s.now.Timestamp()}
+	mockBuilder.EXPECT().ExecContext(mock.Anything, sql, args...).Return(sqldriver.RowsAffected(1), nil).Once()
+	mockBuilder.EXPECT().Explain(sql, args...).Return("").Once()
+	mockLogger.EXPECT().Trace(mock.Anything, mock.Anything, "", int64(1), nil).Return().Once()
+
+	s.True(lock.Get())
+}
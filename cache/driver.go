@@ -2,27 +2,48 @@ package cache
 
 import (
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/cast"
 
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/errors"
 )
 
 type Driver struct {
 	config config.Config
+	// db is resolved only when a database store is used, so the cache doesn't
+	// need a database connection by default.
+	db func() contractsdb.DB
 }
 
-func NewDriver(config config.Config) *Driver {
+func NewDriver(config config.Config, db func() contractsdb.DB) *Driver {
 	return &Driver{
 		config: config,
+		db:     db,
 	}
 }
 
 func (d *Driver) New(store string) (cache.Driver, error) {
+	return d.create(store, nil)
+}
+
+// create creates the driver of a store, resolving contains the tiered stores
+// that are being created, to detect the circular references.
+func (d *Driver) create(store string, resolving []string) (cache.Driver, error) {
 	driver := d.config.GetString(fmt.Sprintf("cache.stores.%s.driver", store))
 	switch driver {
 	case "memory":
 		return d.memory()
+	case "database":
+		return d.database(store)
+	case "file":
+		return d.file(store)
+	case "tiered":
+		return d.tiered(store, resolving)
 	case "custom":
 		return d.custom(store)
 	default:
@@ -34,6 +55,47 @@ func (d *Driver) memory() (cache.Driver, error) {
 	return NewMemory(d.config)
 }
 
+func (d *Driver) database(store string) (cache.Driver, error) {
+	var db contractsdb.DB
+	if d.db != nil {
+		db = d.db()
+	}
+
+	return NewDatabase(d.config, db, store)
+}
+
+func (d *Driver) file(store string) (cache.Driver, error) {
+	return NewFile(d.config, store)
+}
+
+func (d *Driver) tiered(store string, resolving []string) (cache.Driver, error) {
+	resolving = append(resolving, store)
+
+	names := cast.ToStringSlice(d.config.Get(fmt.Sprintf("cache.stores.%s.stores", store)))
+	if len(names) == 0 {
+		return nil, errors.CacheTieredStoresRequired.Args(store)
+	}
+
+	levels := make([]TieredLevel, len(names))
+	for i, name := range names {
+		if slices.Contains(resolving, name) {
+			return nil, errors.CacheTieredStoreRecursive.Args(store)
+		}
+
+		instance, err := d.create(name, resolving)
+		if err != nil {
+			return nil, err
+		}
+
+		levels[i] = TieredLevel{
+			Store: instance,
+			TTL:   time.Duration(d.config.GetInt(fmt.Sprintf("cache.stores.%s.ttl.%s", store, name))) * time.Second,
+		}
+	}
+
+	return NewTiered(levels...), nil
+}
+
 func (d *Driver) custom(store string) (cache.Driver, error) {
 	if custom, ok := d.config.Get(fmt.Sprintf("cache.stores.%s.via", store)).(cache.Driver); ok {
 		return custom, nil
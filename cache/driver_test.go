@@ -29,11 +29,12 @@ func TestDriverTestSuite(t *testing.T) {
 func (s *DriverTestSuite) SetupTest() {
 	s.mockConfig = &configmock.Config{}
 	s.mockLog = &logmock.Log{}
-	s.driver = NewDriver(s.mockConfig)
+	s.driver = NewDriver(s.mockConfig, nil)
 }
 
 func (s *DriverTestSuite) TestMemory() {
 	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetBool", "cache.coalesce").Return(false).Once()
 	memory, err := s.driver.memory()
 	s.NotNil(memory)
 	s.Nil(err)
@@ -78,7 +79,7 @@ func (s *DriverTestSuite) TestNew_UnsupportedDriver() {
 
 	store, err := s.driver.New("store")
 	s.Nil(store)
-	s.EqualError(err, "invalid driver: redis, only support memory, custom")
+	s.EqualError(err, "invalid driver: redis, only support memory, database, file, tiered, custom")
 
 	s.mockConfig.AssertExpectations(s.T())
 }
@@ -86,8 +87,9 @@ func (s *DriverTestSuite) TestNew_UnsupportedDriver() {
 func (s *DriverTestSuite) TestStore() {
 	s.mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
 	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetBool", "cache.coalesce").Return(false).Once()
 
-	memory, err := NewApplication(s.mockConfig, s.mockLog, "memory")
+	memory, err := NewApplication(s.mockConfig, s.mockLog, nil, "memory")
 	s.NotNil(memory)
 	s.Nil(err)
 	s.True(memory.Add("hello", "goravel", 5*time.Second))
@@ -110,8 +112,9 @@ func (s *DriverTestSuite) TestStore() {
 func (s *DriverTestSuite) TestStoreConcurrent() {
 	s.mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
 	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetBool", "cache.coalesce").Return(false).Once()
 
-	memory, err := NewApplication(s.mockConfig, s.mockLog, "memory")
+	memory, err := NewApplication(s.mockConfig, s.mockLog, nil, "memory")
 	s.NotNil(memory)
 	s.Nil(err)
 
@@ -236,6 +239,14 @@ func (r *Store) RememberForever(key string, callback func() (any, error)) (any,
 	return "", nil
 }
 
+func (r *Store) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return callback()
+}
+
+func (r *Store) Tags(names ...string) cache.Driver {
+	return r
+}
+
 func (r *Store) WithContext(ctx context.Context) cache.Driver {
 	return r
 }
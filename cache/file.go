@@ -0,0 +1,378 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/testing/docker"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/path"
+)
+
+// File stores each item in a file named by the hash of its key, the first line
+// of the file is the expiration of the item, the rest is the encoded value.
+type File struct {
+	ctx      context.Context
+	path     string
+	lockPath string
+	prefix   string
+}
+
+func NewFile(config config.Config, store string) (*File, error) {
+	dir := config.GetString(fmt.Sprintf("cache.stores.%s.path", store))
+	if dir == "" {
+		dir = path.Storage("framework", "cache", "data")
+	}
+
+	lockDir := config.GetString(fmt.Sprintf("cache.stores.%s.lock_path", store))
+	if lockDir == "" {
+		lockDir = path.Storage("framework", "cache", "locks")
+	}
+
+	return &File{
+		path:     dir,
+		lockPath: lockDir,
+		prefix:   prefix(config),
+	}, nil
+}
+
+// Add an item in the cache if the key does not exist.
+func (r *File) Add(key string, value any, t time.Duration) bool {
+	var added bool
+	r.atomic(key, func() {
+		if r.Has(key) {
+			return
+		}
+
+		added = r.Put(key, value, t) == nil
+	})
+
+	return added
+}
+
+// Decrement decrements the value of an item in the cache.
+func (r *File) Decrement(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	return r.increment(key, -value[0])
+}
+
+func (r *File) Docker() (docker.CacheDriver, error) {
+	return nil, errors.CacheFileDriverNotSupportDocker
+}
+
+// Flexible Get an item from the cache, a stale item is returned while it's refreshed in the background.
+func (r *File) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return Flexible(r, key, fresh, stale, callback)
+}
+
+// Forever Put an item in the cache indefinitely.
+func (r *File) Forever(key string, value any) bool {
+	if err := r.Put(key, value, NoExpiration); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Forget Remove an item from the cache.
+func (r *File) Forget(key string) bool {
+	err := os.Remove(r.filePath(key))
+
+	return err == nil || os.IsNotExist(err)
+}
+
+// Flush Remove all items from the cache.
+func (r *File) Flush() bool {
+	entries, err := os.ReadDir(r.path)
+	if err != nil {
+		return os.IsNotExist(err)
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(r.path, entry.Name())); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get Retrieve an item from the cache by key.
+func (r *File) Get(key string, def ...any) any {
+	if value, ok := r.read(key); ok {
+		return unserialize(value)
+	}
+
+	if len(def) == 0 {
+		return nil
+	}
+
+	switch s := def[0].(type) {
+	case func() any:
+		return s()
+	default:
+		return s
+	}
+}
+
+func (r *File) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *File) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *File) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *File) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+// Has Checks an item exists in the cache.
+func (r *File) Has(key string) bool {
+	_, ok := r.read(key)
+
+	return ok
+}
+
+// TTL returns the remaining lifetime of an item.
+func (r *File) TTL(key string) (time.Duration, bool) {
+	data, ok := r.readWithExpiration(key)
+	if !ok {
+		return 0, false
+	}
+
+	return remainingTTL(data.expiration), true
+}
+
+func (r *File) Increment(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	return r.increment(key, value[0])
+}
+
+func (r *File) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return NewFileLock(r.lockPath, r.key(key), t...)
+}
+
+// RestoreLock returns the lock of the given key owned by the given owner.
+func (r *File) RestoreLock(key, owner string) contractscache.Lock {
+	lock := NewFileLock(r.lockPath, r.key(key))
+	lock.owner = owner
+
+	return lock
+}
+
+// Pull Retrieve an item from the cache and delete it.
+func (r *File) Pull(key string, def ...any) any {
+	res := r.Get(key, def...)
+	r.Forget(key)
+
+	return res
+}
+
+// Put an item in the cache for a given number of seconds.
+func (r *File) Put(key string, value any, t time.Duration) error {
+	encoded, err := serialize(value)
+	if err != nil {
+		return err
+	}
+
+	return r.write(key, encoded, expiration(t))
+}
+
+// Remember Get an item from the cache, or execute the given Closure and store the result.
+func (r *File) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	val := r.Get(key, nil)
+	if val != nil {
+		return val, nil
+	}
+
+	val, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Put(key, val, ttl); err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *File) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.Remember(key, NoExpiration, callback)
+}
+
+// Tags returns a tagged cache whose entries are grouped by the given tags.
+func (r *File) Tags(names ...string) contractscache.Driver {
+	return NewTaggedCache(r, names...)
+}
+
+func (r *File) WithContext(ctx context.Context) contractscache.Driver {
+	return &File{
+		ctx:      ctx,
+		path:     r.path,
+		lockPath: r.lockPath,
+		prefix:   r.prefix,
+	}
+}
+
+// atomic runs the callback while holding a lock file of the key, so that the
+// read-modify-write operations are atomic across processes.
+func (r *File) atomic(key string, callback func()) bool {
+	return NewFileLock(r.lockPath, "goravel:cache:file:"+r.key(key), 10*time.Second).
+		BlockWithTicker(10*time.Second, 10*time.Millisecond, callback)
+}
+
+func (r *File) filePath(key string) string {
+	hash := sha1.Sum([]byte(r.key(key)))
+	name := hex.EncodeToString(hash[:])
+
+	return filepath.Join(r.path, name[0:2], name[2:4], name)
+}
+
+func (r *File) increment(key string, value int64) (int64, error) {
+	var (
+		result int64
+		err    error
+	)
+
+	if !r.atomic(key, func() {
+		current := int64(0)
+		expiresAt := int64(0)
+
+		if data, exist := r.readWithExpiration(key); exist {
+			current, err = cast.ToInt64E(unserialize(data.value))
+			if err != nil {
+				err = errors.CacheInvalidIntValueType.Args(key)
+				return
+			}
+
+			expiresAt = data.expiration
+		}
+
+		result = current + value
+		err = r.write(key, cast.ToString(result), expiresAt)
+	}) {
+		return 0, errors.CacheFileLockTimeout.Args(key)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func (r *File) key(key string) string {
+	return r.prefix + key
+}
+
+func (r *File) read(key string) (string, bool) {
+	data, ok := r.readWithExpiration(key)
+
+	return data.value, ok
+}
+
+type fileData struct {
+	value      string
+	expiration int64
+}
+
+// readWithExpiration reads the item of the key, an expired item is removed.
+func (r *File) readWithExpiration(key string) (fileData, bool) {
+	content, err := os.ReadFile(r.filePath(key))
+	if err != nil {
+		return fileData{}, false
+	}
+
+	line, value, found := strings.Cut(string(content), "\n")
+	if !found {
+		return fileData{}, false
+	}
+
+	expiresAt, err := strconv.ParseInt(line, 10, 64)
+	if err != nil {
+		return fileData{}, false
+	}
+
+	if isExpired(expiresAt) {
+		r.Forget(key)
+
+		return fileData{}, false
+	}
+
+	return fileData{value: value, expiration: expiresAt}, true
+}
+
+// write writes the item to a temporary file and renames it, so that the
+// readers never see a partially written item.
+func (r *File) write(key, value string, expiresAt int64) error {
+	file := r.filePath(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(file), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := temp.WriteString(strconv.FormatInt(expiresAt, 10) + "\n" + value); err != nil {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+
+		return err
+	}
+
+	if err := temp.Close(); err != nil {
+		_ = os.Remove(temp.Name())
+
+		return err
+	}
+
+	if err := os.Rename(temp.Name(), file); err != nil {
+		_ = os.Remove(temp.Name())
+
+		return err
+	}
+
+	return nil
+}
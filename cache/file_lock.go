@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileLock is a lock represented by a file linked exclusively into the lock
+// directory, the file contains the owner and the expiration of the lock.
+type FileLock struct {
+	path  string
+	owner string
+	time  time.Duration
+}
+
+func NewFileLock(dir, key string, t ...time.Duration) *FileLock {
+	hash := sha1.Sum([]byte(key))
+
+	lock := &FileLock{
+		path:  filepath.Join(dir, hex.EncodeToString(hash[:])),
+		owner: uuid.NewString(),
+		time:  defaultLockTimeout,
+	}
+
+	if len(t) > 0 && t[0] > 0 {
+		lock.time = t[0]
+	}
+
+	return lock
+}
+
+func (r *FileLock) Block(t time.Duration, callback ...func()) bool {
+	return r.BlockWithTicker(t, 1*time.Second, callback...)
+}
+
+func (r *FileLock) BlockWithTicker(t time.Duration, ti time.Duration, callback ...func()) bool {
+	return blockWithTicker(r.Get, t, ti, callback...)
+}
+
+func (r *FileLock) Get(callback ...func()) bool {
+	if !r.acquire() {
+		return false
+	}
+
+	if len(callback) == 0 {
+		return true
+	}
+
+	callback[0]()
+
+	return r.Release()
+}
+
+// Release the lock if it's owned by this instance.
+func (r *FileLock) Release() bool {
+	owner, _, ok := r.read()
+	if !ok || owner != r.owner {
+		return false
+	}
+
+	return os.Remove(r.path) == nil
+}
+
+func (r *FileLock) ForceRelease() bool {
+	err := os.Remove(r.path)
+
+	return err == nil || os.IsNotExist(err)
+}
+
+func (r *FileLock) Owner() string {
+	return r.owner
+}
+
+func (r *FileLock) acquire() bool {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return false
+	}
+
+	if r.create() {
+		return true
+	}
+
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		return r.create()
+	}
+
+	owner, expiresAt, ok := parseFileLock(content)
+	if ok && owner == r.owner {
+		return true
+	}
+
+	if ok && !isExpired(expiresAt) {
+		return false
+	}
+
+	// A lock file that can't be parsed is held until it's as old as the lock
+	// timeout, the lock of a crashed process is taken over once it has expired.
+	if !ok {
+		info, err := os.Stat(r.path)
+		if err == nil && time.Since(info.ModTime()) < r.time {
+			return false
+		}
+	}
+
+	return r.takeOver(content)
+}
+
+// takeOver replaces the expired lock file, the file is renamed to a unique name
+// first, so that only one process takes it over. The renamed file is put back if
+// another process has replaced the expired lock in the meantime.
+func (r *FileLock) takeOver(expired []byte) bool {
+	stale := r.path + ".stale-" + uuid.NewString()
+	if err := os.Rename(r.path, stale); err != nil {
+		return r.create()
+	}
+
+	defer func() {
+		_ = os.Remove(stale)
+	}()
+
+	if content, err := os.ReadFile(stale); err != nil || !bytes.Equal(content, expired) {
+		_ = os.Link(stale, r.path)
+		return false
+	}
+
+	return r.create()
+}
+
+// create writes the lock to a temporary file and links it into place, so
+// that the lock file is never seen empty or partially written, the link fails
+// when the lock file already exists.
+func (r *FileLock) create() bool {
+	file, err := os.CreateTemp(filepath.Dir(r.path), ".lock-*")
+	if err != nil {
+		return false
+	}
+
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	_, err = file.WriteString(r.owner + "\n" + strconv.FormatInt(expiration(r.time), 10))
+	if closeErr := file.Close(); err != nil || closeErr != nil {
+		return false
+	}
+
+	return os.Link(file.Name(), r.path) == nil
+}
+
+func (r *FileLock) read() (string, int64, bool) {
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return parseFileLock(content)
+}
+
+func parseFileLock(content []byte) (string, int64, bool) {
+	owner, line, found := strings.Cut(string(content), "\n")
+	if !found {
+		return "", 0, false
+	}
+
+	expiresAt, err := strconv.ParseInt(line, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return owner, expiresAt, true
+}
@@ -0,0 +1,246 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type FileTestSuite struct {
+	suite.Suite
+	file *File
+}
+
+func TestFileTestSuite(t *testing.T) {
+	suite.Run(t, new(FileTestSuite))
+}
+
+func (s *FileTestSuite) SetupTest() {
+	dir := s.T().TempDir()
+	s.file = &File{
+		path:     filepath.Join(dir, "data"),
+		lockPath: filepath.Join(dir, "locks"),
+		prefix:   "goravel:",
+	}
+}
+
+func (s *FileTestSuite) TestNewFile() {
+	mockConfig := mocksconfig.NewConfig(s.T())
+	mockConfig.EXPECT().GetString("cache.stores.file.path").Return("/tmp/data").Once()
+	mockConfig.EXPECT().GetString("cache.stores.file.lock_path").Return("/tmp/locks").Once()
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel").Once()
+
+	file, err := NewFile(mockConfig, "file")
+	s.NoError(err)
+	s.Equal("/tmp/data", file.path)
+	s.Equal("/tmp/locks", file.lockPath)
+	s.Equal("goravel:", file.prefix)
+}
+
+func (s *FileTestSuite) TestPutAndGet() {
+	s.Nil(s.file.Get("name"))
+	s.Equal("default", s.file.Get("name", "default"))
+	s.Equal("default", s.file.Get("name", func() any {
+		return "default"
+	}))
+
+	s.NoError(s.file.Put("name", "Goravel", time.Minute))
+	s.True(s.file.Has("name"))
+	s.Equal("Goravel", s.file.GetString("name"))
+
+	s.NoError(s.file.Put("user", map[string]any{"age": 1}, NoExpiration))
+	s.Equal(map[string]any{"age": int64(1)}, s.file.Get("user"))
+
+	s.True(s.file.Forget("name"))
+	s.False(s.file.Has("name"))
+}
+
+func (s *FileTestSuite) TestExpiration() {
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	s.NoError(s.file.Put("name", "Goravel", time.Second))
+	s.True(s.file.Has("name"))
+
+	carbon.SetTestNow(now.AddSecond())
+	s.False(s.file.Has("name"))
+	s.NoFileExists(s.file.filePath("name"))
+}
+
+func (s *FileTestSuite) TestTTL() {
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	s.NoError(s.file.Put("name", "Goravel", time.Minute))
+	ttl, ok := s.file.TTL("name")
+	s.True(ok)
+	s.Equal(time.Minute, ttl)
+
+	s.True(s.file.Forever("forever", "Goravel"))
+	ttl, ok = s.file.TTL("forever")
+	s.True(ok)
+	s.Equal(NoExpiration, ttl)
+
+	_, ok = s.file.TTL("missing")
+	s.False(ok)
+}
+
+func (s *FileTestSuite) TestAdd() {
+	s.True(s.file.Add("name", "Goravel", time.Minute))
+	s.False(s.file.Add("name", "World", time.Minute))
+	s.Equal("Goravel", s.file.Get("name"))
+}
+
+func (s *FileTestSuite) TestForeverAndPull() {
+	s.True(s.file.Forever("name", "Goravel"))
+	s.Equal("Goravel", s.file.Pull("name"))
+	s.False(s.file.Has("name"))
+}
+
+func (s *FileTestSuite) TestIncrementAndDecrement() {
+	value, err := s.file.Increment("count")
+	s.NoError(err)
+	s.Equal(int64(1), value)
+
+	value, err = s.file.Increment("count", 2)
+	s.NoError(err)
+	s.Equal(int64(3), value)
+
+	value, err = s.file.Decrement("count", 4)
+	s.NoError(err)
+	s.Equal(int64(-1), value)
+
+	s.NoError(s.file.Put("name", "Goravel", time.Minute))
+	_, err = s.file.Increment("name")
+	s.Equal(errors.CacheInvalidIntValueType.Args("name"), err)
+}
+
+func (s *FileTestSuite) TestIncrementConcurrently() {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.file.Increment("count")
+			s.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(10, s.file.GetInt("count"))
+}
+
+func (s *FileTestSuite) TestFlush() {
+	s.NoError(s.file.Put("name", "Goravel", time.Minute))
+	s.True(s.file.Flush())
+	s.False(s.file.Has("name"))
+
+	entries, err := os.ReadDir(s.file.path)
+	s.NoError(err)
+	s.Empty(entries)
+}
+
+func (s *FileTestSuite) TestRemember() {
+	value, err := s.file.Remember("name", time.Minute, func() (any, error) {
+		return "Goravel", nil
+	})
+	s.NoError(err)
+	s.Equal("Goravel", value)
+
+	value, err = s.file.RememberForever("name", func() (any, error) {
+		return "World", nil
+	})
+	s.NoError(err)
+	s.Equal("Goravel", value)
+}
+
+func (s *FileTestSuite) TestTags() {
+	s.NoError(s.file.Tags("users").Put("name", "Goravel", time.Minute))
+	s.Equal("Goravel", s.file.Tags("users").Get("name"))
+	s.False(s.file.Has("name"))
+}
+
+func (s *FileTestSuite) TestLock() {
+	lock := s.file.Lock("lock", time.Minute)
+	s.True(lock.Get())
+	s.False(s.file.Lock("lock").Get())
+	s.True(lock.Release())
+
+	s.True(s.file.Lock("lock").Get(func() {}))
+	s.True(s.file.Lock("lock").Get())
+	s.True(s.file.Lock("lock").ForceRelease())
+}
+
+func TestFileLock(t *testing.T) {
+	dir := t.TempDir()
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	lock := NewFileLock(dir, "lock", time.Second)
+	assert.True(t, lock.Get())
+	assert.False(t, NewFileLock(dir, "lock").Get())
+	assert.False(t, NewFileLock(dir, "lock").Release())
+
+	// An expired lock can be taken over by another owner.
+	carbon.SetTestNow(now.AddSecond())
+	another := NewFileLock(dir, "lock")
+	assert.True(t, another.Get())
+	assert.False(t, lock.Release())
+	assert.True(t, another.Release())
+
+	// A lock replaced by another process after it was read as expired is put back.
+	replaced := NewFileLock(dir, "replaced")
+	assert.True(t, replaced.Get())
+	assert.False(t, NewFileLock(dir, "replaced").takeOver([]byte("expired")))
+	assert.True(t, replaced.Release())
+
+	// Only one of the concurrent owners takes over an expired lock.
+	expired := NewFileLock(dir, "expired", time.Second)
+	assert.True(t, expired.Get())
+	carbon.SetTestNow(now.AddSeconds(2))
+
+	var (
+		wg       sync.WaitGroup
+		acquired atomic.Int32
+	)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if NewFileLock(dir, "expired").Get() {
+				acquired.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), acquired.Load())
+	assert.True(t, NewFileLock(dir, "expired").ForceRelease())
+
+	assert.True(t, NewFileLock(dir, "block").BlockWithTicker(time.Second, 10*time.Millisecond, func() {}))
+
+	// A lock file that can't be parsed is held until it's as old as the lock timeout.
+	unparsable := NewFileLock(dir, "unparsable", time.Minute)
+	assert.NoError(t, os.WriteFile(unparsable.path, nil, 0o644))
+	assert.False(t, unparsable.Get())
+	assert.NoError(t, os.Chtimes(unparsable.path, time.Now().Add(-time.Minute), time.Now().Add(-time.Minute)))
+	assert.True(t, unparsable.Get())
+	assert.True(t, unparsable.Release())
+
+	// No temporary file is left behind.
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// Flexible gets an item from the cache with the stale-while-revalidate strategy on top of the given
+// driver, custom drivers can implement Flexible by calling it. The item is fresh for the fresh
+// duration, after that the stale item is still returned until the stale duration is reached, while
+// a single background refresh guarded by a lock recomputes it. The callback is called synchronously
+// only when the item is missing or older than the stale duration, which has to be greater than the
+// fresh duration.
+func Flexible(store contractscache.Driver, key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	if stale <= fresh {
+		return nil, errors.CacheFlexibleInvalidDuration.Args(stale, key, fresh).SetModule(errors.ModuleCache)
+	}
+
+	createdKey := "goravel:cache:flexible:created:" + key
+
+	value := store.Get(key)
+	createdAt := store.GetInt64(createdKey)
+	if value == nil || createdAt == 0 {
+		return putFlexible(store, key, createdKey, stale, callback)
+	}
+
+	if time.Duration(carbon.Now().TimestampNano()-createdAt) < fresh {
+		return value, nil
+	}
+
+	go func() {
+		// The lock expires with the stale item, so a crashed refresh doesn't block the later ones.
+		lock := store.Lock("goravel:cache:flexible:lock:"+key, stale)
+		lock.Get(func() {
+			// Another refresh has finished between the read and acquiring the lock.
+			if store.GetInt64(createdKey) != createdAt {
+				return
+			}
+
+			_, _ = putFlexible(store, key, createdKey, stale, callback)
+		})
+	}()
+
+	return value, nil
+}
+
+func putFlexible(store contractscache.Driver, key, createdKey string, stale time.Duration, callback func() (any, error)) (any, error) {
+	value, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Put(key, value, stale); err != nil {
+		return nil, err
+	}
+
+	if err := store.Put(createdKey, carbon.Now().TimestampNano(), stale); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestFlexible(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	var calls atomic.Int32
+	refreshed := make(chan struct{}, 10)
+	callback := func() (any, error) {
+		refreshed <- struct{}{}
+		return calls.Add(1), nil
+	}
+
+	// The item is missing, the callback is called synchronously.
+	val, err := memory.Flexible("flexible", time.Minute, time.Hour, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), val)
+	<-refreshed
+
+	// The item is fresh.
+	carbon.SetTestNow(now.AddSeconds(30))
+	val, err = memory.Flexible("flexible", time.Minute, time.Hour, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), val)
+
+	// The item is stale, it's returned and refreshed once in the background.
+	carbon.SetTestNow(now.AddMinutes(2))
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			val, err := memory.Flexible("flexible", time.Minute, time.Hour, callback)
+			assert.Nil(t, err)
+			assert.NotNil(t, val)
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("the item should be refreshed")
+	}
+
+	assert.Eventually(t, func() bool {
+		return memory.Get("flexible") == int32(2)
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+
+	val, err = memory.Flexible("flexible", time.Minute, time.Hour, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), val)
+}
+
+func TestFlexibleWithError(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	val, err := memory.Flexible("flexible", time.Minute, time.Hour, func() (any, error) {
+		return nil, assert.AnError
+	})
+	assert.Equal(t, assert.AnError, err)
+	assert.Nil(t, val)
+	assert.False(t, memory.Has("flexible"))
+}
+
+func TestFlexibleWithInvalidDuration(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	val, err := memory.Flexible("flexible", time.Hour, time.Minute, func() (any, error) {
+		return "value", nil
+	})
+	assert.ErrorIs(t, err, errors.CacheFlexibleInvalidDuration)
+	assert.Nil(t, val)
+	assert.False(t, memory.Has("flexible"))
+}
@@ -3,14 +3,17 @@ package cache
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	contractscache "github.com/goravel/framework/contracts/cache"
 )
 
+// Lock is a lock stored as a cache item, the value of the item is the owner of the lock.
 type Lock struct {
 	store contractscache.Driver
 	time  *time.Duration
 	key   string
-	get   bool
+	owner string
 }
 
 func NewLock(instance contractscache.Driver, key string, t ...time.Duration) *Lock {
@@ -18,6 +21,7 @@ func NewLock(instance contractscache.Driver, key string, t ...time.Duration) *Lo
 		return &Lock{
 			store: instance,
 			key:   key,
+			owner: uuid.NewString(),
 		}
 	}
 
@@ -25,6 +29,7 @@ func NewLock(instance contractscache.Driver, key string, t ...time.Duration) *Lo
 		store: instance,
 		key:   key,
 		time:  &t[0],
+		owner: uuid.NewString(),
 	}
 }
 
@@ -33,53 +38,21 @@ func (r *Lock) Block(t time.Duration, callback ...func()) bool {
 }
 
 func (r *Lock) BlockWithTicker(t time.Duration, ti time.Duration, callback ...func()) bool {
-	// If the lock is already acquired, return true. Otherwise, try to get after one second (Ticker).
-	if r.Get(callback...) {
-		return true
-	}
-
-	timer := time.NewTimer(t)
-	ticker := time.NewTicker(ti)
-	defer ticker.Stop()
-
-	res := make(chan bool, 1)
-	go func() {
-		for {
-			select {
-			case <-timer.C:
-				if r.Get(callback...) {
-					res <- true
-					return
-				}
-
-				res <- false
-				return
-			case <-ticker.C:
-				if r.Get(callback...) {
-					res <- true
-					return
-				}
-			}
-		}
-	}()
-
-	return <-res
+	return blockWithTicker(r.Get, t, ti, callback...)
 }
 
 func (r *Lock) Get(callback ...func()) bool {
 	var res bool
 	if r.time == nil {
-		res = r.store.Add(r.key, 1, NoExpiration)
+		res = r.store.Add(r.key, r.owner, NoExpiration)
 	} else {
-		res = r.store.Add(r.key, 1, *r.time)
+		res = r.store.Add(r.key, r.owner, *r.time)
 	}
 
 	if !res {
 		return false
 	}
 
-	r.get = true
-
 	if len(callback) == 0 {
 		return true
 	}
@@ -89,14 +62,55 @@ func (r *Lock) Get(callback ...func()) bool {
 	return r.Release()
 }
 
+// Release the lock if it's owned by this instance.
 func (r *Lock) Release() bool {
-	if r.get {
-		return r.ForceRelease()
+	if r.store.Get(r.key) != r.owner {
+		return false
 	}
 
-	return false
+	return r.ForceRelease()
 }
 
 func (r *Lock) ForceRelease() bool {
 	return r.store.Forget(r.key)
 }
+
+func (r *Lock) Owner() string {
+	return r.owner
+}
+
+// blockWithTicker attempts to acquire a lock with the given get function until the timeout,
+// it's shared by the locks of the different stores.
+func blockWithTicker(get func(callback ...func()) bool, t time.Duration, ti time.Duration, callback ...func()) bool {
+	// If the lock is already acquired, return true. Otherwise, try to get after one second (Ticker).
+	if get(callback...) {
+		return true
+	}
+
+	timer := time.NewTimer(t)
+	ticker := time.NewTicker(ti)
+	defer ticker.Stop()
+
+	res := make(chan bool, 1)
+	go func() {
+		for {
+			select {
+			case <-timer.C:
+				if get(callback...) {
+					res <- true
+					return
+				}
+
+				res <- false
+				return
+			case <-ticker.C:
+				if get(callback...) {
+					res <- true
+					return
+				}
+			}
+		}
+	}()
+
+	return <-res
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/cast"
+	"golang.org/x/sync/singleflight"
 
 	contractscache "github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
@@ -18,23 +19,32 @@ type Memory struct {
 	ctx      context.Context
 	prefix   string
 	instance sync.Map
+	// expirations contains the expiration time of the items that expire.
+	expirations sync.Map
+	// timers contains the timers removing the items that expire, the timer of
+	// an item is stopped when the item is replaced or removed.
+	timers   map[string]*time.Timer
+	timersMu sync.Mutex
+	group    *singleflight.Group
+	coalesce bool
 }
 
 func NewMemory(config config.Config) (*Memory, error) {
 	return &Memory{
-		prefix: prefix(config),
+		prefix:   prefix(config),
+		timers:   make(map[string]*time.Timer),
+		group:    &singleflight.Group{},
+		coalesce: config.GetBool("cache.coalesce"),
 	}, nil
 }
 
 // Add an item in the cache if the key does not exist.
 func (r *Memory) Add(key string, value any, t time.Duration) bool {
-	if t != NoExpiration {
-		time.AfterFunc(t, func() {
-			r.Forget(key)
-		})
+	_, loaded := r.instance.LoadOrStore(r.key(key), value)
+	if !loaded {
+		r.setExpiration(key, t)
 	}
 
-	_, loaded := r.instance.LoadOrStore(r.key(key), value)
 	return !loaded
 }
 
@@ -64,6 +74,11 @@ func (r *Memory) Docker() (docker.CacheDriver, error) {
 	return nil, errors.CacheMemoryDriverNotSupportDocker
 }
 
+// Flexible Get an item from the cache, a stale item is returned while it's refreshed in the background.
+func (r *Memory) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return Flexible(r, key, fresh, stale, callback)
+}
+
 // Forever Put an item in the cache indefinitely.
 func (r *Memory) Forever(key string, value any) bool {
 	if err := r.Put(key, value, NoExpiration); err != nil {
@@ -75,6 +90,7 @@ func (r *Memory) Forever(key string, value any) bool {
 
 // Forget Remove an item from the cache.
 func (r *Memory) Forget(key string) bool {
+	r.setExpiration(key, NoExpiration)
 	r.instance.Delete(r.key(key))
 
 	return true
@@ -82,7 +98,15 @@ func (r *Memory) Forget(key string) bool {
 
 // Flush Remove all items from the cache.
 func (r *Memory) Flush() bool {
+	r.timersMu.Lock()
+	for key, timer := range r.timers {
+		timer.Stop()
+		delete(r.timers, key)
+	}
+	r.timersMu.Unlock()
+
 	r.instance = sync.Map{}
+	r.expirations = sync.Map{}
 	return true
 }
 
@@ -168,6 +192,14 @@ func (r *Memory) Lock(key string, t ...time.Duration) contractscache.Lock {
 	return NewLock(r, key, t...)
 }
 
+// RestoreLock returns the lock of the given key owned by the given owner.
+func (r *Memory) RestoreLock(key, owner string) contractscache.Lock {
+	lock := NewLock(r, key)
+	lock.owner = owner
+
+	return lock
+}
+
 // Pull Retrieve an item from the cache and delete it.
 func (r *Memory) Pull(key string, def ...any) any {
 	var res any
@@ -183,60 +215,119 @@ func (r *Memory) Pull(key string, def ...any) any {
 
 // Put an item in the cache for a given number of seconds.
 func (r *Memory) Put(key string, value any, t time.Duration) error {
-	if t != NoExpiration {
-		time.AfterFunc(t, func() {
-			r.Forget(key)
-		})
-	}
-
 	r.instance.Store(r.key(key), value)
+	r.setExpiration(key, t)
+
 	return nil
 }
 
 // Remember Get an item from the cache, or execute the given Closure and store the result.
 func (r *Memory) Remember(key string, seconds time.Duration, callback func() (any, error)) (any, error) {
-	val := r.Get(key, nil)
-	if val != nil {
-		return val, nil
-	}
+	return r.remember(key, seconds, callback)
+}
 
-	var err error
-	val, err = callback()
-	if err != nil {
-		return nil, err
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *Memory) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.remember(key, NoExpiration, callback)
+}
+
+// TTL returns the remaining lifetime of an item.
+func (r *Memory) TTL(key string) (time.Duration, bool) {
+	if !r.Has(key) {
+		return 0, false
 	}
 
-	if err := r.Put(key, val, seconds); err != nil {
-		return nil, err
+	expiresAt, ok := r.expirations.Load(r.key(key))
+	if !ok {
+		return NoExpiration, true
 	}
 
-	return val, nil
+	return max(time.Until(expiresAt.(time.Time)), time.Nanosecond), true
 }
 
-// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
-func (r *Memory) RememberForever(key string, callback func() (any, error)) (any, error) {
+// Tags returns a tagged cache whose entries are grouped by the given tags.
+func (r *Memory) Tags(names ...string) contractscache.Driver {
+	return NewTaggedCache(r, names...)
+}
+
+func (r *Memory) WithContext(ctx context.Context) contractscache.Driver {
+	r.ctx = ctx
+
+	return r
+}
+
+// remember calls the callback when the item is missing, the concurrent misses of a key share
+// a single call of the callback when cache.coalesce is enabled.
+func (r *Memory) remember(key string, t time.Duration, callback func() (any, error)) (any, error) {
 	val := r.Get(key, nil)
 	if val != nil {
 		return val, nil
 	}
 
-	var err error
-	val, err = callback()
+	if !r.coalesce {
+		return r.put(key, t, callback)
+	}
+
+	val, err, _ := r.group.Do(r.key(key), func() (any, error) {
+		// The item may be stored by a call that finished before this one started.
+		if val := r.Get(key, nil); val != nil {
+			return val, nil
+		}
+
+		return r.put(key, t, callback)
+	})
+
+	return val, err
+}
+
+func (r *Memory) put(key string, t time.Duration, callback func() (any, error)) (any, error) {
+	val, err := callback()
 	if err != nil {
 		return nil, err
 	}
 
-	if err = r.Put(key, val, NoExpiration); err != nil {
+	if err := r.Put(key, val, t); err != nil {
 		return nil, err
 	}
 
 	return val, nil
 }
 
-func (r *Memory) WithContext(ctx context.Context) contractscache.Driver {
-	r.ctx = ctx
+// setExpiration replaces the timer of the item, the timer of the previous item
+// of the key is stopped, so that it doesn't remove the new item.
+func (r *Memory) setExpiration(key string, t time.Duration) {
+	key = r.key(key)
 
-	return r
+	r.timersMu.Lock()
+	defer r.timersMu.Unlock()
+
+	if timer, ok := r.timers[key]; ok {
+		timer.Stop()
+		delete(r.timers, key)
+	}
+
+	if t == NoExpiration {
+		r.expirations.Delete(key)
+		return
+	}
+
+	r.expirations.Store(key, time.Now().Add(t))
+
+	var timer *time.Timer
+	timer = time.AfterFunc(t, func() {
+		r.timersMu.Lock()
+		defer r.timersMu.Unlock()
+
+		// The timer fired while the item was being replaced.
+		if r.timers[key] != timer {
+			return
+		}
+
+		delete(r.timers, key)
+		r.instance.Delete(key)
+		r.expirations.Delete(key)
+	})
+	r.timers[key] = timer
 }
 
 func (r *Memory) key(key string) string {
@@ -3,11 +3,13 @@ package cache
 import (
 	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	contractscache "github.com/goravel/framework/contracts/cache"
 	configmock "github.com/goravel/framework/mocks/config"
 )
 
@@ -251,7 +253,21 @@ func (s *MemoryTestSuite) TestLock() {
 				s.False(lock1.Release())
 				s.True(lock1.ForceRelease())
 
-				s.True(lock.Release())
+				s.False(lock.Release())
+			},
+		},
+		{
+			name: "lock can be released by the restored lock of the owner",
+			setup: func() {
+				lock := s.memory.Lock("lock")
+				s.True(lock.Get())
+
+				s.False(s.memory.RestoreLock("lock", "other").Release())
+				s.True(s.memory.RestoreLock("lock", lock.(contractscache.LockWithOwner).Owner()).Release())
+
+				lock1 := s.memory.Lock("lock")
+				s.True(lock1.Get())
+				s.True(lock1.Release())
 			},
 		},
 		{
@@ -376,6 +392,17 @@ func (s *MemoryTestSuite) TestPut() {
 	s.Equal("Goravel", s.memory.Get("name", "").(string))
 	time.Sleep(2 * time.Second)
 	s.False(s.memory.Has("name"))
+
+	s.Run("the expiration of the replaced item is cancelled", func() {
+		s.Nil(s.memory.Put("name", "Goravel", 100*time.Millisecond))
+		s.Nil(s.memory.Put("name", "World", 1*time.Second))
+		time.Sleep(300 * time.Millisecond)
+		s.Equal("World", s.memory.Get("name", "").(string))
+
+		s.True(s.memory.Forever("name", "Forever"))
+		time.Sleep(1 * time.Second)
+		s.Equal("Forever", s.memory.Get("name", "").(string))
+	})
 }
 
 func (s *MemoryTestSuite) TestRemember() {
@@ -402,6 +429,34 @@ func (s *MemoryTestSuite) TestRemember() {
 	s.Nil(value)
 }
 
+func (s *MemoryTestSuite) TestRememberWithCoalesce() {
+	s.memory.coalesce = true
+
+	var (
+		calls atomic.Int32
+		wg    sync.WaitGroup
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			val, err := s.memory.Remember("coalesce", time.Minute, func() (any, error) {
+				calls.Add(1)
+				time.Sleep(100 * time.Millisecond)
+
+				return "Goravel", nil
+			})
+			s.Nil(err)
+			s.Equal("Goravel", val)
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(int32(1), calls.Load())
+	s.True(s.memory.Flush())
+}
+
 func (s *MemoryTestSuite) TestRememberForever() {
 	s.Nil(s.memory.Put("name", "Goravel", 1*time.Second))
 	value, err := s.memory.RememberForever("name", func() (any, error) {
@@ -427,6 +482,7 @@ func (s *MemoryTestSuite) TestRememberForever() {
 func getMemoryStore() (*Memory, error) {
 	mockConfig := &configmock.Config{}
 	mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	mockConfig.On("GetBool", "cache.coalesce").Return(false).Once()
 
 	memory, err := NewMemory(mockConfig)
 	if err != nil {
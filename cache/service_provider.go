@@ -34,7 +34,7 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 
 		store := config.GetString("cache.default")
 
-		return NewApplication(config, log, store)
+		return NewApplication(config, log, app.MakeDB, store)
 	})
 }
 
@@ -45,5 +45,6 @@ func (r *ServiceProvider) Boot(app foundation.Application) {
 func (r *ServiceProvider) registerCommands(app foundation.Application) {
 	app.Commands([]contractsconsole.Command{
 		console.NewClearCommand(app.MakeCache()),
+		console.NewTableCommand(app.MakeConfig()),
 	})
 }
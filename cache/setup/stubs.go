@@ -28,11 +28,32 @@ func init() {
 		// Here you may define all the cache "stores" for your application as
 		// well as their drivers. You may even define multiple stores for the
 		// same cache driver to group types of items stored in your caches.
-		// Available Drivers: "memory", "custom"
+		// Available Drivers: "memory", "database", "file", "tiered", "custom"
 		"stores": map[string]any{
 			"memory": map[string]any{
 				"driver": "memory",
 			},
+			"database": map[string]any{
+				"driver":     "database",
+				"connection": config.Env("DB_CONNECTION"),
+				"table":      "cache",
+				"lock_table": "cache_locks",
+			},
+			"file": map[string]any{
+				"driver": "file",
+				// The directories default to storage/framework/cache/data and storage/framework/cache/locks.
+				"path":      "",
+				"lock_path": "",
+			},
+			// The tiered store reads the stores in order and writes to all of them,
+			// the ttl (in seconds) caps how long an item is kept in a store.
+			"tiered": map[string]any{
+				"driver": "tiered",
+				"stores": []string{"memory", "database"},
+				"ttl": map[string]any{
+					"memory": 60,
+				},
+			},
 		},
 
 		// Cache Key Prefix
@@ -42,6 +63,12 @@ func init() {
 		// value to get prefixed to all our keys, so we can avoid collisions.
 		// Must: a-zA-Z0-9_-
 		"prefix": config.GetString("APP_NAME", "goravel") + "_cache",
+
+		// Remember Coalescing
+		//
+		// When enabled, the concurrent misses of a key in Remember share a single call
+		// of the callback in the process, to avoid recomputing hot keys many times.
+		"coalesce": false,
 	})
 }
 `
@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/testing/docker"
+)
+
+// TagSet stores a version for each tag in the underlying store, the keys of a
+// tagged cache are namespaced by the versions of its tags, so resetting the
+// version of a tag invalidates all of the entries stored with the tag.
+type TagSet struct {
+	store contractscache.Driver
+	names []string
+}
+
+func NewTagSet(store contractscache.Driver, names []string) *TagSet {
+	return &TagSet{
+		store: store,
+		names: names,
+	}
+}
+
+// Names returns the names of the tags.
+func (r *TagSet) Names() []string {
+	return r.names
+}
+
+// Namespace returns the namespace built from the current versions of the tags.
+func (r *TagSet) Namespace() string {
+	ids := make([]string, len(r.names))
+	for i, name := range r.names {
+		ids[i] = r.tagID(name)
+	}
+
+	return strings.Join(ids, "|")
+}
+
+// Reset gives all of the tags a new version.
+func (r *TagSet) Reset() {
+	for _, name := range r.names {
+		r.store.Forever(r.tagKey(name), uuid.NewString())
+	}
+}
+
+func (r *TagSet) tagID(name string) string {
+	key := r.tagKey(name)
+	if id := r.store.GetString(key); id != "" {
+		return id
+	}
+
+	// Another process may set the version at the same time, Add keeps the first one.
+	id := uuid.NewString()
+	if r.store.Add(key, id, NoExpiration) {
+		return id
+	}
+
+	return r.store.GetString(key)
+}
+
+func (r *TagSet) tagKey(name string) string {
+	return "tag:" + name + ":key"
+}
+
+// TaggedCache is a cache driver whose entries are grouped by tags, Flush only
+// removes the entries stored with the tags. Custom drivers can implement Tags
+// by returning NewTaggedCache(driver, names...).
+type TaggedCache struct {
+	store contractscache.Driver
+	tags  *TagSet
+}
+
+func NewTaggedCache(store contractscache.Driver, names ...string) *TaggedCache {
+	return &TaggedCache{
+		store: store,
+		tags:  NewTagSet(store, names),
+	}
+}
+
+// Add an item in the cache if the key does not exist.
+func (r *TaggedCache) Add(key string, value any, t time.Duration) bool {
+	return r.store.Add(r.key(key), value, t)
+}
+
+// Decrement decrements the value of an item in the cache.
+func (r *TaggedCache) Decrement(key string, value ...int64) (int64, error) {
+	return r.store.Decrement(r.key(key), value...)
+}
+
+func (r *TaggedCache) Docker() (docker.CacheDriver, error) {
+	return r.store.Docker()
+}
+
+// Flexible Get an item from the cache, a stale item is returned while it's refreshed in the background.
+func (r *TaggedCache) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return r.store.Flexible(r.key(key), fresh, stale, callback)
+}
+
+// Forever Put an item in the cache indefinitely.
+func (r *TaggedCache) Forever(key string, value any) bool {
+	return r.store.Forever(r.key(key), value)
+}
+
+// Forget Remove an item from the cache.
+func (r *TaggedCache) Forget(key string) bool {
+	return r.store.Forget(r.key(key))
+}
+
+// Flush Remove all items stored with the tags from the cache.
+func (r *TaggedCache) Flush() bool {
+	r.tags.Reset()
+
+	return true
+}
+
+// Get Retrieve an item from the cache by key.
+func (r *TaggedCache) Get(key string, def ...any) any {
+	return r.store.Get(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetBool(key string, def ...bool) bool {
+	return r.store.GetBool(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetInt(key string, def ...int) int {
+	return r.store.GetInt(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetInt64(key string, def ...int64) int64 {
+	return r.store.GetInt64(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetString(key string, def ...string) string {
+	return r.store.GetString(r.key(key), def...)
+}
+
+// Has Checks an item exists in the cache.
+func (r *TaggedCache) Has(key string) bool {
+	return r.store.Has(r.key(key))
+}
+
+func (r *TaggedCache) Increment(key string, value ...int64) (int64, error) {
+	return r.store.Increment(r.key(key), value...)
+}
+
+func (r *TaggedCache) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.store.Lock(r.key(key), t...)
+}
+
+// RestoreLock returns the lock of the given key owned by the given owner, nil is
+// returned if the store can't restore locks.
+func (r *TaggedCache) RestoreLock(key, owner string) contractscache.Lock {
+	if store, ok := r.store.(contractscache.DriverWithRestoreLock); ok {
+		return store.RestoreLock(r.key(key), owner)
+	}
+
+	return nil
+}
+
+// Pull Retrieve an item from the cache and delete it.
+func (r *TaggedCache) Pull(key string, def ...any) any {
+	return r.store.Pull(r.key(key), def...)
+}
+
+// Put an item in the cache for a given number of seconds.
+func (r *TaggedCache) Put(key string, value any, t time.Duration) error {
+	return r.store.Put(r.key(key), value, t)
+}
+
+// Remember Get an item from the cache, or execute the given Closure and store the result.
+func (r *TaggedCache) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return r.store.Remember(r.key(key), ttl, callback)
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *TaggedCache) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.store.RememberForever(r.key(key), callback)
+}
+
+// Tags returns a tagged cache with the tags of this cache and the given tags.
+func (r *TaggedCache) Tags(names ...string) contractscache.Driver {
+	return NewTaggedCache(r.store, append(append([]string{}, r.tags.Names()...), names...)...)
+}
+
+func (r *TaggedCache) WithContext(ctx context.Context) contractscache.Driver {
+	return &TaggedCache{
+		store: r.store.WithContext(ctx),
+		tags:  r.tags,
+	}
+}
+
+func (r *TaggedCache) key(key string) string {
+	hash := sha1.Sum([]byte(r.tags.Namespace()))
+
+	return hex.EncodeToString(hash[:]) + ":" + key
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TaggedCacheTestSuite struct {
+	suite.Suite
+	memory *Memory
+}
+
+func TestTaggedCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(TaggedCacheTestSuite))
+}
+
+func (s *TaggedCacheTestSuite) SetupTest() {
+	memoryStore, err := getMemoryStore()
+	s.Nil(err)
+	s.memory = memoryStore
+}
+
+func (s *TaggedCacheTestSuite) TestFlush() {
+	s.Nil(s.memory.Put("name", "Goravel", NoExpiration))
+	s.Nil(s.memory.Tags("tenant:1").Put("report", "one", NoExpiration))
+	s.Nil(s.memory.Tags("tenant:2").Put("report", "two", NoExpiration))
+	s.Nil(s.memory.Tags("tenant:1", "reports").Put("daily", "daily", NoExpiration))
+
+	s.Equal("one", s.memory.Tags("tenant:1").Get("report"))
+	s.Equal("two", s.memory.Tags("tenant:2").Get("report"))
+	s.Nil(s.memory.Get("report"))
+
+	s.True(s.memory.Tags("tenant:1").Flush())
+
+	s.False(s.memory.Tags("tenant:1").Has("report"))
+	s.False(s.memory.Tags("tenant:1", "reports").Has("daily"))
+	s.Equal("two", s.memory.Tags("tenant:2").Get("report"))
+	s.Equal("Goravel", s.memory.Get("name"))
+
+	s.Nil(s.memory.Tags("tenant:1").Put("report", "new", NoExpiration))
+	s.Equal("new", s.memory.Tags("tenant:1").Get("report"))
+}
+
+func (s *TaggedCacheTestSuite) TestOrderOfTags() {
+	s.Nil(s.memory.Tags("a", "b").Put("key", "value", NoExpiration))
+
+	s.Equal("value", s.memory.Tags("a", "b").Get("key"))
+	s.Equal("value", s.memory.Tags("a").Tags("b").Get("key"))
+	s.Nil(s.memory.Tags("b", "a").Get("key"))
+}
+
+func (s *TaggedCacheTestSuite) TestOperations() {
+	tagged := s.memory.Tags("users")
+
+	s.True(tagged.Add("add", "value", time.Second))
+	s.False(tagged.Add("add", "value", time.Second))
+
+	res, err := tagged.Increment("count", 2)
+	s.Nil(err)
+	s.Equal(int64(2), res)
+	res, err = tagged.Decrement("count")
+	s.Nil(err)
+	s.Equal(int64(1), res)
+	s.Equal(int64(1), tagged.GetInt64("count"))
+
+	s.True(tagged.Forever("forever", true))
+	s.True(tagged.GetBool("forever"))
+	s.True(tagged.Forget("forever"))
+	s.False(tagged.Has("forever"))
+
+	val, err := tagged.Remember("remember", time.Minute, func() (any, error) {
+		return "remembered", nil
+	})
+	s.Nil(err)
+	s.Equal("remembered", val)
+	s.Equal("remembered", tagged.GetString("remember"))
+	s.Equal("remembered", tagged.Pull("remember"))
+	s.False(tagged.Has("remember"))
+
+	lock := tagged.Lock("lock")
+	s.True(lock.Get())
+	s.False(s.memory.Tags("users").Lock("lock").Get())
+	s.True(s.memory.Lock("lock").Get())
+	s.True(lock.Release())
+}
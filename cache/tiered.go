@@ -0,0 +1,314 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cast"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/testing/docker"
+	"github.com/goravel/framework/errors"
+)
+
+// TieredLevel is a level of the tiered cache, the ttl of the items stored in
+// the level is capped by TTL, 0 means no cap.
+type TieredLevel struct {
+	Store contractscache.Driver
+	TTL   time.Duration
+}
+
+// Tiered combines several stores ordered from the fastest to the slowest one,
+// the reads go through the levels until an item is found, the writes go to all
+// levels. The last level is the source of truth for Add, Increment and Lock.
+type Tiered struct {
+	ctx    context.Context
+	levels []TieredLevel
+}
+
+func NewTiered(levels ...TieredLevel) *Tiered {
+	return &Tiered{
+		levels: levels,
+	}
+}
+
+// Add an item in the cache if the key does not exist.
+func (r *Tiered) Add(key string, value any, t time.Duration) bool {
+	if !r.last().Store.Add(key, value, r.ttl(len(r.levels)-1, t)) {
+		return false
+	}
+
+	for i, level := range r.upper() {
+		_ = level.Store.Put(key, value, r.ttl(i, t))
+	}
+
+	return true
+}
+
+// Decrement decrements the value of an item in the cache.
+func (r *Tiered) Decrement(key string, value ...int64) (int64, error) {
+	res, err := r.last().Store.Decrement(key, value...)
+	if err != nil {
+		return 0, err
+	}
+
+	r.forgetUpper(key)
+
+	return res, nil
+}
+
+func (r *Tiered) Docker() (docker.CacheDriver, error) {
+	return nil, errors.CacheTieredDriverNotSupportDocker
+}
+
+// Flexible Get an item from the cache, a stale item is returned while it's refreshed in the background.
+func (r *Tiered) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return Flexible(r, key, fresh, stale, callback)
+}
+
+// Forever Put an item in the cache indefinitely.
+func (r *Tiered) Forever(key string, value any) bool {
+	if err := r.Put(key, value, NoExpiration); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Forget Remove an item from the cache.
+func (r *Tiered) Forget(key string) bool {
+	res := true
+	for _, level := range r.levels {
+		if !level.Store.Forget(key) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+// Flush Remove all items from the cache.
+func (r *Tiered) Flush() bool {
+	res := true
+	for _, level := range r.levels {
+		if !level.Store.Flush() {
+			res = false
+		}
+	}
+
+	return res
+}
+
+// Get Retrieve an item from the cache by key, the item found in a lower level
+// is copied to the upper levels when its remaining ttl is known.
+func (r *Tiered) Get(key string, def ...any) any {
+	for i, level := range r.levels {
+		if !level.Store.Has(key) {
+			continue
+		}
+
+		value := level.Store.Get(key)
+		if i > 0 {
+			r.backfill(i, key, value)
+		}
+
+		return value
+	}
+
+	if len(def) == 0 {
+		return nil
+	}
+
+	switch s := def[0].(type) {
+	case func() any:
+		return s()
+	default:
+		return s
+	}
+}
+
+func (r *Tiered) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *Tiered) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *Tiered) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *Tiered) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+// Has Checks an item exists in the cache.
+func (r *Tiered) Has(key string) bool {
+	for _, level := range r.levels {
+		if level.Store.Has(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Tiered) Increment(key string, value ...int64) (int64, error) {
+	res, err := r.last().Store.Increment(key, value...)
+	if err != nil {
+		return 0, err
+	}
+
+	r.forgetUpper(key)
+
+	return res, nil
+}
+
+// Lock is delegated to the last level, which is shared by all processes.
+func (r *Tiered) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.last().Store.Lock(key, t...)
+}
+
+// RestoreLock is delegated to the last level like Lock, nil is returned if the
+// store can't restore locks.
+func (r *Tiered) RestoreLock(key, owner string) contractscache.Lock {
+	if store, ok := r.last().Store.(contractscache.DriverWithRestoreLock); ok {
+		return store.RestoreLock(key, owner)
+	}
+
+	return nil
+}
+
+// Pull Retrieve an item from the cache and delete it.
+func (r *Tiered) Pull(key string, def ...any) any {
+	res := r.Get(key, def...)
+	r.Forget(key)
+
+	return res
+}
+
+// Put an item in all levels, the ttl is capped by the ttl of each level.
+func (r *Tiered) Put(key string, value any, t time.Duration) error {
+	for i := len(r.levels) - 1; i >= 0; i-- {
+		if err := r.levels[i].Store.Put(key, value, r.ttl(i, t)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remember Get an item from the cache, or execute the given Closure and store the result.
+func (r *Tiered) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	val := r.Get(key, nil)
+	if val != nil {
+		return val, nil
+	}
+
+	val, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Put(key, val, ttl); err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *Tiered) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.Remember(key, NoExpiration, callback)
+}
+
+// Tags returns a tagged cache whose entries are grouped by the given tags.
+func (r *Tiered) Tags(names ...string) contractscache.Driver {
+	return NewTaggedCache(r, names...)
+}
+
+func (r *Tiered) WithContext(ctx context.Context) contractscache.Driver {
+	levels := make([]TieredLevel, len(r.levels))
+	for i, level := range r.levels {
+		levels[i] = TieredLevel{
+			Store: level.Store.WithContext(ctx),
+			TTL:   level.TTL,
+		}
+	}
+
+	return &Tiered{
+		ctx:    ctx,
+		levels: levels,
+	}
+}
+
+// forgetUpper removes the item from the upper levels, so that the next read
+// gets the latest value from the last level.
+func (r *Tiered) forgetUpper(key string) {
+	for _, level := range r.upper() {
+		level.Store.Forget(key)
+	}
+}
+
+func (r *Tiered) last() TieredLevel {
+	return r.levels[len(r.levels)-1]
+}
+
+// ttl caps the ttl by the ttl of the level.
+func (r *Tiered) ttl(level int, t time.Duration) time.Duration {
+	limit := r.levels[level].TTL
+	if limit > 0 && (t == NoExpiration || t > limit) {
+		return limit
+	}
+
+	return t
+}
+
+func (r *Tiered) upper() []TieredLevel {
+	return r.levels[:len(r.levels)-1]
+}
+
+// backfill copies the item found in the level to the upper levels, the ttl is
+// capped by the remaining ttl of the item so that it doesn't outlive the item.
+// If the level can't report the remaining ttl, the item is only copied to the
+// upper levels with a ttl cap, which bounds how long it outlives the item.
+func (r *Tiered) backfill(level int, key string, value any) {
+	remaining, known := NoExpiration, false
+	if store, ok := r.levels[level].Store.(contractscache.DriverWithTTL); ok {
+		if remaining, ok = store.TTL(key); !ok {
+			return
+		}
+
+		known = true
+	}
+
+	for j := 0; j < level; j++ {
+		ttl := r.levels[j].TTL
+		if !known && ttl == NoExpiration {
+			continue
+		}
+
+		if known && (ttl == NoExpiration || (remaining != NoExpiration && remaining < ttl)) {
+			ttl = remaining
+		}
+
+		_ = r.levels[j].Store.Put(key, value, ttl)
+	}
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/errors"
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+type TieredTestSuite struct {
+	suite.Suite
+	l1     *Memory
+	l2     *Memory
+	tiered *Tiered
+}
+
+func TestTieredTestSuite(t *testing.T) {
+	suite.Run(t, new(TieredTestSuite))
+}
+
+func (s *TieredTestSuite) SetupTest() {
+	var err error
+	s.l1, err = getMemoryStore()
+	s.Require().NoError(err)
+	s.l2, err = getMemoryStore()
+	s.Require().NoError(err)
+
+	s.tiered = NewTiered(
+		TieredLevel{Store: s.l1, TTL: time.Second},
+		TieredLevel{Store: s.l2},
+	)
+}
+
+func (s *TieredTestSuite) TestPutWritesThroughAllLevels() {
+	s.NoError(s.tiered.Put("name", "Goravel", time.Minute))
+	s.Equal("Goravel", s.l1.Get("name"))
+	s.Equal("Goravel", s.l2.Get("name"))
+
+	// The ttl of the first level is capped.
+	time.Sleep(1100 * time.Millisecond)
+	s.False(s.l1.Has("name"))
+	s.True(s.l2.Has("name"))
+	s.Equal("Goravel", s.tiered.Get("name"))
+}
+
+func (s *TieredTestSuite) TestGetReadsThroughAndBackfills() {
+	s.NoError(s.l2.Put("name", "Goravel", time.Minute))
+	s.False(s.l1.Has("name"))
+
+	s.True(s.tiered.Has("name"))
+	s.Equal("Goravel", s.tiered.Get("name"))
+	s.Equal("Goravel", s.l1.Get("name"))
+
+	s.Equal("default", s.tiered.Get("missing", "default"))
+	s.Equal("default", s.tiered.GetString("missing", "default"))
+	s.Nil(s.tiered.Get("missing"))
+}
+
+func (s *TieredTestSuite) TestBackfillIsCappedByTheRemainingTTL() {
+	s.tiered = NewTiered(
+		TieredLevel{Store: s.l1, TTL: time.Minute},
+		TieredLevel{Store: s.l2},
+	)
+
+	s.NoError(s.l2.Put("name", "Goravel", time.Second))
+	s.Equal("Goravel", s.tiered.Get("name"))
+
+	ttl, ok := s.l1.TTL("name")
+	s.True(ok)
+	s.LessOrEqual(ttl, time.Second)
+
+	// The item is copied with the ttl cap of the upper level when the remaining
+	// ttl of the level is unknown, and isn't copied to the levels without a cap.
+	l0, err := getMemoryStore()
+	s.Require().NoError(err)
+	s.tiered = NewTiered(
+		TieredLevel{Store: l0},
+		TieredLevel{Store: s.l1, TTL: time.Minute},
+		TieredLevel{Store: &Store{}},
+	)
+	s.Equal("other", s.tiered.Get("other"))
+	s.False(l0.Has("other"))
+
+	ttl, ok = s.l1.TTL("other")
+	s.True(ok)
+	s.LessOrEqual(ttl, time.Minute)
+	s.Greater(ttl, time.Second)
+}
+
+func (s *TieredTestSuite) TestAdd() {
+	s.NoError(s.l2.Put("name", "Goravel", time.Minute))
+	s.False(s.tiered.Add("name", "World", time.Minute))
+	s.False(s.l1.Has("name"))
+
+	s.True(s.tiered.Add("name1", "World", time.Minute))
+	s.Equal("World", s.l1.Get("name1"))
+	s.Equal("World", s.l2.Get("name1"))
+}
+
+func (s *TieredTestSuite) TestIncrementAndDecrement() {
+	res, err := s.tiered.Increment("count", 3)
+	s.NoError(err)
+	s.Equal(int64(3), res)
+	s.Equal(3, s.tiered.GetInt("count"))
+	s.True(s.l1.Has("count"))
+
+	// The stale value in the upper levels is removed.
+	res, err = s.tiered.Decrement("count")
+	s.NoError(err)
+	s.Equal(int64(2), res)
+	s.False(s.l1.Has("count"))
+	s.Equal(int64(2), s.tiered.GetInt64("count"))
+}
+
+func (s *TieredTestSuite) TestForgetPullAndFlush() {
+	s.True(s.tiered.Forever("name", "Goravel"))
+	s.True(s.tiered.Forget("name"))
+	s.False(s.l1.Has("name"))
+	s.False(s.l2.Has("name"))
+
+	s.True(s.tiered.Forever("name", "Goravel"))
+	s.Equal("Goravel", s.tiered.Pull("name"))
+	s.False(s.tiered.Has("name"))
+
+	s.True(s.tiered.Forever("name", "Goravel"))
+	s.True(s.tiered.Flush())
+	s.False(s.tiered.Has("name"))
+}
+
+func (s *TieredTestSuite) TestRemember() {
+	value, err := s.tiered.Remember("name", time.Minute, func() (any, error) {
+		return "Goravel", nil
+	})
+	s.NoError(err)
+	s.Equal("Goravel", value)
+	s.True(s.l2.Has("name"))
+
+	value, err = s.tiered.RememberForever("name", func() (any, error) {
+		return "World", nil
+	})
+	s.NoError(err)
+	s.Equal("Goravel", value)
+}
+
+func (s *TieredTestSuite) TestLock() {
+	lock := s.tiered.Lock("lock", time.Minute)
+	s.True(lock.Get())
+	s.False(s.l2.Lock("lock").Get())
+	s.True(lock.Release())
+}
+
+func (s *TieredTestSuite) TestDriver() {
+	mockConfig := &configmock.Config{}
+	driver := NewDriver(mockConfig, nil)
+
+	mockConfig.On("GetString", "cache.stores.tiered.driver").Return("tiered").Once()
+	mockConfig.On("Get", "cache.stores.tiered.stores").Return([]string{"memory", "tiered"}).Once()
+	mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
+	mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	mockConfig.On("GetBool", "cache.coalesce").Return(false).Once()
+	mockConfig.On("GetInt", "cache.stores.tiered.ttl.memory").Return(60).Once()
+
+	_, err := driver.New("tiered")
+	s.Equal(errors.CacheTieredStoreRecursive.Args("tiered"), err)
+
+	// The circular references through another tiered store are detected as well.
+	mockConfig.On("GetString", "cache.stores.a.driver").Return("tiered").Once()
+	mockConfig.On("Get", "cache.stores.a.stores").Return([]string{"b"}).Once()
+	mockConfig.On("GetString", "cache.stores.b.driver").Return("tiered").Once()
+	mockConfig.On("Get", "cache.stores.b.stores").Return([]string{"a"}).Once()
+
+	_, err = driver.New("a")
+	s.Equal(errors.CacheTieredStoreRecursive.Args("b"), err)
+
+	mockConfig.On("GetString", "cache.stores.tiered.driver").Return("tiered").Once()
+	mockConfig.On("Get", "cache.stores.tiered.stores").Return(nil).Once()
+
+	_, err = driver.New("tiered")
+	s.Equal(errors.CacheTieredStoresRequired.Args("tiered"), err)
+
+	mockConfig.AssertExpectations(s.T())
+}
@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/goravel/framework/contracts/config"
 )
 
@@ -12,3 +15,49 @@ func prefix(config config.Config) string {
 
 	return p + ":"
 }
+
+// serialize encodes a value for the stores that persist values as strings.
+func serialize(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// unserialize decodes a value encoded by serialize, integers are decoded as int64.
+func unserialize(data string) any {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+
+	return convertNumbers(value)
+}
+
+// convertNumbers converts the json.Number in the decoded value to int64 or float64.
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case map[string]any:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+	}
+
+	return value
+}
@@ -4,13 +4,28 @@ import (
 	"time"
 
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/session"
 )
 
 type Auth interface {
 	GuardDriver
 	Guard(name string) GuardDriver
 	Extend(name string, fn GuardFunc)
+	// Password returns the password broker, the default broker is used if the name isn't given.
+	Password(name ...string) PasswordBroker
 	Provider(name string, fn UserProviderFunc)
+	// Tokens returns the personal access tokens of the user, the default guard is used if
+	// the guard isn't given. A request isn't required, so the user model can manage its
+	// tokens, eg:
+	//
+	//	func (r *User) CreateToken(name string, abilities ...string) (*auth.NewAccessToken, error) {
+	//		return facades.Auth().Tokens(r, "api").Create(name, abilities)
+	//	}
+	Tokens(user any, guard ...string) UserTokens
+	// TwoFactor returns the two-factor authenticator.
+	TwoFactor() TwoFactor
+	// Verification returns the email verifier.
+	Verification() EmailVerifier
 }
 
 type GuardDriver interface {
@@ -34,6 +49,66 @@ type GuardDriver interface {
 	User(user any) error
 }
 
+// SessionGuard is implemented by the guards keeping the user in the session, the
+// sessions of a user can be listed and revoked when the session driver implements
+// session.UserDriver.
+type SessionGuard interface {
+	GuardDriver
+	// ChallengeTwoFactor completes the login that is paused by the two-factor challenge,
+	// code is either the TOTP code or a recovery code. The user is retrieved into user.
+	ChallengeTwoFactor(user any, code string) error
+	// HasTwoFactorChallenge determines if a login is waiting for the two-factor code.
+	HasTwoFactorChallenge() bool
+	// LoginRemember logs a user into the application, and keeps the user logged in with
+	// a remember me cookie after the session expires if remember is true. The
+	// AuthTwoFactorRequired error is returned if the user has enabled the two-factor
+	// authentication, the login is completed by ChallengeTwoFactor.
+	LoginRemember(user any, remember bool) (token string, err error)
+	// LogoutOtherDevices destroys the other sessions of the current user.
+	LogoutOtherDevices() error
+	// Sessions returns the active sessions of the current user.
+	Sessions() ([]session.Activity, error)
+	// ViaRemember determines if the user is authenticated by the remember me cookie.
+	ViaRemember() bool
+}
+
+// TokenGuard is implemented by the guards authenticating the requests with the personal
+// access tokens stored in the database, the tokens can be revoked individually.
+type TokenGuard interface {
+	GuardDriver
+	// Can determines if the token of the current request has the given ability.
+	Can(ability string) bool
+	// Cant determines if the token of the current request doesn't have the given ability.
+	Cant(ability string) bool
+	// CreateToken creates a personal access token for the user, the plain text token
+	// is only available in the returned value. The token never expires if expiresAt
+	// and the expiration of the guard are both not set.
+	CreateToken(user any, name string, abilities []string, expiresAt ...time.Time) (*NewAccessToken, error)
+	// CurrentToken returns the token of the current request, the token is parsed from
+	// the Authorization header if Parse hasn't been called.
+	CurrentToken() (*AccessToken, error)
+	// RevokeToken revokes the given token of the user.
+	RevokeToken(user any, id string) error
+	// RevokeTokens revokes all the tokens of the user.
+	RevokeTokens(user any) error
+	// Tokens returns the tokens of the user.
+	Tokens(user any) ([]AccessToken, error)
+}
+
+// UserTokens manages the personal access tokens of a user.
+type UserTokens interface {
+	// All returns the tokens of the user.
+	All() ([]AccessToken, error)
+	// Create creates a personal access token for the user, the plain text token is only
+	// available in the returned value. The token never expires if expiresAt and the
+	// expiration of the guard are both not set.
+	Create(name string, abilities []string, expiresAt ...time.Time) (*NewAccessToken, error)
+	// Revoke revokes the given token of the user.
+	Revoke(id string) error
+	// RevokeAll revokes all the tokens of the user.
+	RevokeAll() error
+}
+
 type UserProvider interface {
 	// GetID returns the user id.
 	GetID(user any) (any, error)
@@ -48,6 +123,23 @@ type Payload struct {
 	Key      string
 }
 
+type AccessToken struct {
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	ID         string
+	Name       string
+	UserID     string
+	// Abilities of the token, "*" grants all the abilities.
+	Abilities []string
+}
+
+type NewAccessToken struct {
+	AccessToken
+	// PlainTextToken is sent to the client, its secret is hashed with SHA-256 before being stored.
+	PlainTextToken string
+}
+
 type GuardFunc func(ctx http.Context, name string, userProvider UserProvider) (GuardDriver, error)
 
 type UserProviderFunc func(ctx http.Context) (UserProvider, error)
@@ -0,0 +1,32 @@
+package auth
+
+import "github.com/goravel/framework/contracts/notification"
+
+type CanResetPassword interface {
+	notification.Notifiable
+	// GetEmailForPasswordReset returns the email address the reset link is sent to.
+	GetEmailForPasswordReset() string
+}
+
+// CanResetPasswordWithNotification is implemented by the users that send a custom
+// notification instead of the default one when a reset link is requested.
+type CanResetPasswordWithNotification interface {
+	CanResetPassword
+	// SendPasswordResetNotification sends the reset link with the given token to the user.
+	SendPasswordResetNotification(token string) error
+}
+
+type PasswordBroker interface {
+	// CreateToken creates a reset token for the user, only the hash of the token is stored.
+	CreateToken(user CanResetPassword) (string, error)
+	// DeleteToken deletes the reset token of the user.
+	DeleteToken(user CanResetPassword) error
+	// Reset calls the callback to update the password if the token is valid, and
+	// consumes the token once the callback succeeds.
+	Reset(user CanResetPassword, token string, callback func() error) error
+	// SendResetLink creates a reset token and sends the reset link to the user, it
+	// fails if a token was created for the user within the throttle seconds.
+	SendResetLink(user CanResetPassword) error
+	// TokenExists determines if the token is valid for the user and hasn't expired.
+	TokenExists(user CanResetPassword, token string) bool
+}
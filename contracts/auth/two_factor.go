@@ -0,0 +1,46 @@
+package auth
+
+// TwoFactorAuthenticatable is implemented by the users that can enable the two-factor
+// authentication. The secret and the recovery codes are stored encrypted, the setters
+// should persist the value since they are called by the session guard when a recovery
+// code is used.
+type TwoFactorAuthenticatable interface {
+	// GetTwoFactorSecret returns the encrypted TOTP secret, it's empty if the two-factor
+	// authentication isn't enabled.
+	GetTwoFactorSecret() string
+	// GetTwoFactorRecoveryCodes returns the encrypted recovery codes.
+	GetTwoFactorRecoveryCodes() string
+	// SetTwoFactorSecret stores the encrypted TOTP secret.
+	SetTwoFactorSecret(secret string) error
+	// SetTwoFactorRecoveryCodes stores the encrypted recovery codes.
+	SetTwoFactorRecoveryCodes(codes string) error
+}
+
+type TwoFactor interface {
+	// Disable removes the secret and the recovery codes of the user.
+	Disable(user TwoFactorAuthenticatable) error
+	// Enable generates a new secret and recovery codes for the user, account is shown in
+	// the authenticator app, e.g. the email of the user.
+	Enable(user TwoFactorAuthenticatable, account string) (*TwoFactorSetup, error)
+	// Enabled determines if the user has enabled the two-factor authentication.
+	Enabled(user TwoFactorAuthenticatable) bool
+	// RecoveryCodes returns the unused recovery codes of the user.
+	RecoveryCodes(user TwoFactorAuthenticatable) ([]string, error)
+	// RegenerateRecoveryCodes replaces the recovery codes of the user.
+	RegenerateRecoveryCodes(user TwoFactorAuthenticatable) ([]string, error)
+	// UseRecoveryCode consumes the given recovery code, false is returned if the code is
+	// not one of the recovery codes of the user.
+	UseRecoveryCode(user TwoFactorAuthenticatable, code string) (bool, error)
+	// Verify determines if the given code is the current TOTP code of the user, a code can
+	// only be used once. The used codes are remembered in the cache, so the codes are always
+	// rejected if the cache is not set.
+	Verify(user TwoFactorAuthenticatable, code string) bool
+}
+
+type TwoFactorSetup struct {
+	// Secret is the base32 encoded secret, it can be entered in the authenticator app manually.
+	Secret string
+	// URL is the otpauth:// URL that is encoded in the QR code.
+	URL           string
+	RecoveryCodes []string
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/notification"
+)
+
+type MustVerifyEmail interface {
+	notification.Notifiable
+	// GetEmailForVerification returns the email address to be verified.
+	GetEmailForVerification() string
+	// HasVerifiedEmail determines if the user has verified the email address.
+	HasVerifiedEmail() bool
+	// MarkEmailAsVerified marks the email address as verified and saves the user.
+	MarkEmailAsVerified() error
+}
+
+type EmailVerifier interface {
+	// SendVerificationNotification sends the signed verification link to the user.
+	SendVerificationNotification(user MustVerifyEmail) error
+	// VerificationURL returns the signed verification link of the user, it expires
+	// after auth.verification.expire minutes.
+	VerificationURL(user MustVerifyEmail) (string, error)
+	// Verify validates the signature of the request and that the id and hash route
+	// parameters belong to the user, then marks the email address as verified.
+	Verify(ctx http.Context, user MustVerifyEmail) error
+}
@@ -30,6 +30,7 @@ const (
 	Storage      = "goravel.storage"
 	Telemetry    = "goravel.telemetry"
 	Testing      = "goravel.testing"
+	URL          = "goravel.url"
 	Validation   = "goravel.validation"
 	View         = "goravel.view"
 )
@@ -109,7 +110,7 @@ var (
 			},
 		},
 		Auth: {
-			Description: "Provides support for JWT and Session drivers.",
+			Description: "Provides support for JWT, Session and Token drivers.",
 			PkgPath:     "github.com/goravel/framework/auth",
 			Dependencies: []string{
 				Cache,
@@ -233,7 +234,10 @@ var (
 			PkgPath:     "github.com/goravel/framework/mail",
 			Dependencies: []string{
 				Config,
+				Log,
+				Process,
 				Queue,
+				Storage,
 			},
 		},
 		Notification: {
@@ -432,6 +436,14 @@ var (
 				Session,
 			},
 		},
+		URL: {
+			Description: "Generates URLs and signed URLs to the named routes.",
+			PkgPath:     "github.com/goravel/framework/route",
+			Dependencies: []string{
+				Config,
+				Route,
+			},
+		},
 		Validation: {
 			Description: "Provides validation services for incoming data.",
 			PkgPath:     "github.com/goravel/framework/validation",
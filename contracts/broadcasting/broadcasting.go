@@ -24,6 +24,28 @@ type Driver interface {
 type Broadcast interface {
 	Channel(pattern string, callback ChannelAuthFunc)
 	Dispatch(ctx context.Context, event ShouldBroadcast) error
+	// Server creates the WebSocket server of a connection using the websocket driver.
+	Server(args ServerArgs) (Server, error)
+}
+
+// Server is a WebSocket server that speaks the Pusher protocol, the events are published
+// to it by the websocket driver.
+type Server interface {
+	// Address returns the address that the server listens on.
+	Address() string
+	// Run starts the server, it blocks until the server is shut down.
+	Run() error
+	// Shutdown stops accepting new connections and closes the current ones.
+	Shutdown(ctx context.Context) error
+}
+
+type ServerArgs struct {
+	// Connection is the name of the broadcast connection, the default connection is used if empty.
+	Connection string
+	// Host is the host that the server listens on, default "0.0.0.0".
+	Host string
+	// Port overrides the "port" option of the connection.
+	Port int
 }
 
 type ShouldBroadcast interface {
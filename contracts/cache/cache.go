@@ -19,6 +19,10 @@ type Driver interface {
 	Decrement(key string, value ...int64) (int64, error)
 	// Docker gets the docker driver.
 	Docker() (docker.CacheDriver, error)
+	// Flexible gets an item from the cache with the stale-while-revalidate strategy, the item is fresh for
+	// the fresh duration, then the stale item is returned until the stale duration is reached while it's
+	// refreshed in the background.
+	Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error)
 	// Forever add an item in the cache indefinitely.
 	Forever(key string, value any) bool
 	// Forget removes an item from the cache.
@@ -49,10 +53,29 @@ type Driver interface {
 	Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error)
 	// RememberForever get an item from the cache, or execute the given Closure and store the result forever.
 	RememberForever(key string, callback func() (any, error)) (any, error)
+	// Tags returns a driver whose entries are grouped by the given tags, Flush on it only removes
+	// the entries stored with the tags.
+	Tags(names ...string) Driver
 	// WithContext returns a new Cache instance with the given context.
 	WithContext(ctx context.Context) Driver
 }
 
+// DriverWithTTL is an optional interface for drivers that can report the
+// remaining lifetime of an item, the tiered store uses it to cap the lifetime
+// of the items copied to its upper levels.
+type DriverWithTTL interface {
+	// TTL returns the remaining lifetime of an item, 0 means the item never
+	// expires, false is returned when the item doesn't exist.
+	TTL(key string) (time.Duration, bool)
+}
+
+// DriverWithRestoreLock is an optional interface for drivers that can restore a lock
+// from its owner token, another process can release the lock through the restored lock.
+type DriverWithRestoreLock interface {
+	// RestoreLock returns the lock of the given key owned by the given owner.
+	RestoreLock(key, owner string) Lock
+}
+
 type Lock interface {
 	// Block attempt to acquire the lock for the given number of seconds.
 	Block(t time.Duration, callback ...func()) bool
@@ -66,3 +89,9 @@ type Lock interface {
 	// ForceRelease releases the lock in disregard of ownership.
 	ForceRelease() bool
 }
+
+// LockWithOwner is an optional interface for locks that can report their owner token.
+type LockWithOwner interface {
+	// Owner returns the owner token of the lock.
+	Owner() string
+}
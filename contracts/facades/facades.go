@@ -32,6 +32,7 @@ const (
 	Storage      = "Storage"
 	Telemetry    = "Telemetry"
 	Testing      = "Testing"
+	URL          = "URL"
 	Validation   = "Validation"
 	View         = "View"
 )
@@ -66,6 +67,7 @@ var FacadeToBinding = map[string]string{
 	Storage:      binding.Storage,
 	Telemetry:    binding.Telemetry,
 	Testing:      binding.Testing,
+	URL:          binding.URL,
 	Validation:   binding.Validation,
 	View:         binding.View,
 }
@@ -2,9 +2,36 @@ package filesystem
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
+type Visibility string
+
+const (
+	VisibilityPublic  Visibility = "public"
+	VisibilityPrivate Visibility = "private"
+)
+
+// WriteOptions are the options of a written file, the drivers return an error for the options they don't support.
+type WriteOptions struct {
+	// Visibility of the file, the default visibility of the driver is used when it's empty.
+	Visibility Visibility
+	// ContentType of the file, it's detected by the driver when it's empty.
+	ContentType string
+	// Metadata is the custom metadata stored with the file.
+	Metadata map[string]string
+}
+
+// Metadata describes a file.
+type Metadata struct {
+	ContentType  string
+	LastModified time.Time
+	Metadata     map[string]string
+	Size         int64
+	Visibility   Visibility
+}
+
 type Storage interface {
 	Driver
 	// Disk gets the instance of the given disk.
@@ -60,6 +87,25 @@ type Driver interface {
 	Url(file string) string
 }
 
+// DriverWithStream is an optional interface for drivers that support streaming the
+// files, the partial writes, the visibility and the metadata of the files.
+type DriverWithStream interface {
+	// Append appends the content to the end of the file, the file is created if it doesn't exist.
+	Append(file, content string) error
+	// Metadata gets the metadata of the file.
+	Metadata(file string) (Metadata, error)
+	// Prepend prepends the content to the beginning of the file, the file is created if it doesn't exist.
+	Prepend(file, content string) error
+	// ReadStream gets a reader of the file, the reader must be closed by the caller.
+	ReadStream(file string) (io.ReadCloser, error)
+	// SetVisibility sets the visibility of the file.
+	SetVisibility(file string, visibility Visibility) error
+	// Visibility gets the visibility of the file.
+	Visibility(file string) (Visibility, error)
+	// WriteStream writes the content of the reader to the file without loading it into memory.
+	WriteStream(file string, reader io.Reader, options ...WriteOptions) error
+}
+
 type File interface {
 	// Disk gets the instance of the given disk.
 	Disk(disk string) File
@@ -200,6 +200,8 @@ type Application interface {
 	MakeTelemetry() telemetry.Telemetry
 	// MakeTesting resolves the testing instance.
 	MakeTesting() testing.Testing
+	// MakeURL resolves the url instance.
+	MakeURL() route.URL
 	// MakeValidation resolves the validation instance.
 	MakeValidation() validation.Validation
 	// MakeView resolves the view instance.
@@ -0,0 +1,42 @@
+package mail
+
+// Fake captures the mails in memory instead of sending them, the mails passed to the
+// callbacks implement Mailable and MailableWithAttachments with the rendered content and the
+// resolved envelope.
+type Fake interface {
+	// Mail embeds the Mail interface, allowing direct usage like Fake.To().Send().
+	Mail
+
+	// AssertNotQueued verifies that no mail matching the given assertion was queued.
+	AssertNotQueued(assertion func(mail Mailable) bool) bool
+
+	// AssertNotSent verifies that no mail matching the given assertion was sent.
+	AssertNotSent(assertion func(mail Mailable) bool) bool
+
+	// AssertNothingQueued verifies that no mails were queued at all.
+	AssertNothingQueued() bool
+
+	// AssertNothingSent verifies that no mails were sent at all.
+	AssertNothingSent() bool
+
+	// AssertQueued verifies that at least one mail matching the given assertion was queued.
+	AssertQueued(assertion func(mail Mailable) bool) bool
+
+	// AssertQueuedCount verifies that the specific number of mails were queued.
+	AssertQueuedCount(count int) bool
+
+	// AssertSent verifies that at least one mail matching the given assertion was sent.
+	AssertSent(assertion func(mail Mailable) bool) bool
+
+	// AssertSentCount verifies that the specific number of mails were sent.
+	AssertSentCount(count int) bool
+
+	// Queued returns the mails that have been queued.
+	Queued() []Mailable
+
+	// Reset stops faking, the mails are sent by the transports again.
+	Reset()
+
+	// Sent returns the mails that have been sent.
+	Sent() []Mailable
+}
@@ -3,16 +3,22 @@ package mail
 type Mail interface {
 	// Attach attaches files to the Mail.
 	Attach(files []string) Mail
+	// AttachWith attaches the in-memory data, the files of the filesystem disks and the inline files to the Mail.
+	AttachWith(attachments ...Attachment) Mail
 	// Bcc adds a "blind carbon copy" address to the Mail.
 	Bcc(addresses []string) Mail
 	// Cc adds a "carbon copy" address to the Mail.
 	Cc(addresses []string) Mail
 	// Content set the content of Mail.
 	Content(content Content) Mail
+	// Fake captures the mails in memory instead of sending them, it affects all the mail instances.
+	Fake() Fake
 	// From set the sender of Mail.
 	From(address Address) Mail
 	// Headers adds custom headers to the Mail.
 	Headers(headers map[string]string) Mail
+	// Mailer sets the mailer that sends the Mail, the default mailer is used if it's not set.
+	Mailer(name string) Mail
 	// Queue a given Mail
 	Queue(mailable ...Mailable) error
 	// Send the Mail
@@ -36,11 +42,37 @@ type Mailable interface {
 	Queue() *Queue
 }
 
+// MailableWithAttachments is implemented by the Mailable that attaches the in-memory data, the
+// files of the filesystem disks or the inline files.
+type MailableWithAttachments interface {
+	// Attachables set the attachments of Mailable, they are attached in addition to Attachments.
+	Attachables() []Attachment
+}
+
+// Attachment is the file attached to the Mail, the content is read from Data, or from Path of
+// Disk if Data is empty, Path is a local file path if Disk is empty.
+type Attachment struct {
+	// Data is the in-memory content of the attachment.
+	Data []byte `json:"data,omitempty"`
+	// Disk is the filesystem disk that stores Path.
+	Disk string `json:"disk,omitempty"`
+	// Inline embeds the attachment into the HTML, it can be referenced via "cid:" + Name.
+	Inline bool `json:"inline,omitempty"`
+	// MimeType is detected by the extension of Name if it's empty.
+	MimeType string `json:"mime_type,omitempty"`
+	// Name is the file name of the attachment, the base name of Path is used if it's empty.
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
 type Content struct {
 	Html     string
 	Text     string
 	HtmlView string
 	TextView string
+	// Markdown is the markdown view that is rendered with the mail components and theme, the
+	// plain text alternative is generated from it if Text and TextView aren't set.
+	Markdown string
 	With     map[string]any
 }
 
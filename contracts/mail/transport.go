@@ -0,0 +1,23 @@
+package mail
+
+// Transport delivers the messages of a mailer, it's configured by the "transport" option
+// of the mailer.
+type Transport interface {
+	// Send delivers the message.
+	Send(message *Message) error
+}
+
+// Message is the message passed to the transports, the views have been rendered and the
+// default sender has been applied. The attachments of the filesystem disks have been loaded
+// to Data, so the transports only need to read Data or the local Path.
+type Message struct {
+	From        Address
+	To          []string
+	Cc          []string
+	Bcc         []string
+	Subject     string
+	Html        string
+	Text        string
+	Attachments []Attachment
+	Headers     map[string]string
+}
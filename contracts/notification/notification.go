@@ -15,6 +15,12 @@ const (
 
 	// ChannelDatabase is the name of the built-in database delivery channel.
 	ChannelDatabase = "database"
+
+	// ChannelBroadcast is the name of the built-in broadcast delivery channel.
+	ChannelBroadcast = "broadcast"
+
+	// ChannelWebhook is the name of the built-in webhook delivery channel.
+	ChannelWebhook = "webhook"
 )
 
 type Notification interface {
@@ -108,6 +114,22 @@ type DatabaseRoutable interface {
 	RouteNotificationForDatabase() string
 }
 
+// BroadcastRoutable is implemented by a Notifiable to provide the
+// broadcasting channels the notification is pushed to, e.g.
+// "private-users.1". Like the other typed routes, an empty result falls
+// back to RouteNotificationFor(ChannelBroadcast), which accepts a string
+// or []string.
+type BroadcastRoutable interface {
+	RouteNotificationForBroadcast(notification Notification) []string
+}
+
+// WebhookRoutable is implemented by a Notifiable to provide the URL the
+// webhook channel POSTs to. An empty result falls back to
+// RouteNotificationFor(ChannelWebhook).
+type WebhookRoutable interface {
+	RouteNotificationForWebhook(notification Notification) string
+}
+
 // Channel is the interface every delivery driver must satisfy. Register
 // custom channels via Manager.Extend.
 type Channel interface {
@@ -130,6 +152,10 @@ type ResolvableChannel interface {
 }
 
 type Manager interface {
+	// Fake replaces the delivery with a recorder for testing, the sent
+	// notifications can be asserted via the returned Fake.
+	Fake() Fake
+
 	Send(notifiable Notifiable, notification Notification) error
 
 	SendNow(notifiable Notifiable, notification Notification) error
@@ -141,6 +167,34 @@ type Manager interface {
 	Route(channel string, route any) OnDemandNotifiable
 }
 
+// Fake records the notifications instead of delivering them. The
+// notification arguments of the assertions are only used for their type,
+// so a zero value such as &InvoicePaid{} is enough.
+type Fake interface {
+	Manager
+
+	// AssertCount reports whether the given number of notifications were sent.
+	AssertCount(count int) bool
+
+	// AssertNothingSent reports whether no notification was sent.
+	AssertNothingSent() bool
+
+	// AssertNotSentTo reports whether no notification of the given type
+	// was sent to the notifiable and matched the optional assertion.
+	AssertNotSentTo(notifiable Notifiable, notification Notification, assertion ...func(notification Notification, channels []string) bool) bool
+
+	// AssertSentTo reports whether a notification of the given type was
+	// sent to the notifiable and matched the optional assertion. channels
+	// are the channels it would have been delivered on, after ShouldSend.
+	AssertSentTo(notifiable Notifiable, notification Notification, assertion ...func(notification Notification, channels []string) bool) bool
+
+	// Reset restores the delivery of the manager.
+	Reset()
+
+	// Sent returns the notifications of the given type sent to the notifiable.
+	Sent(notifiable Notifiable, notification Notification) []Notification
+}
+
 type OnDemandNotifiable interface {
 	Notifiable
 
@@ -176,6 +230,18 @@ type NotificationWithDatabaseConnection interface {
 	DatabaseConnection() string
 }
 
+type BroadcastNotification interface {
+	Notification
+	// ToBroadcast returns the message pushed through the broadcasting facade.
+	ToBroadcast(notifiable Notifiable) BroadcastMessage
+}
+
+type WebhookNotification interface {
+	Notification
+	// ToWebhook returns the message POSTed as JSON to the webhook URL.
+	ToWebhook(notifiable Notifiable) WebhookMessage
+}
+
 type ShouldQueue interface {
 	// OnQueue returns the queue name to use. Return "" for the default queue.
 	OnQueue() string
@@ -204,3 +270,30 @@ type MailMessage struct {
 	// Headers are arbitrary additional email headers.
 	Headers map[string]string
 }
+
+type BroadcastMessage struct {
+	// Channels overrides the broadcasting channels. Leave empty to use
+	// RouteNotificationFor(ChannelBroadcast) / BroadcastRoutable.
+	Channels []string
+	// Event is the broadcast event name. Defaults to the notification type name.
+	Event string
+	// Data is the event payload, the notification's "id" and "type" are
+	// added unless they are set.
+	Data map[string]any
+}
+
+type WebhookMessage struct {
+	// Url overrides the webhook URL. Leave empty to use
+	// RouteNotificationFor(ChannelWebhook) / WebhookRoutable.
+	Url string
+	// Payload is JSON-encoded as the request body.
+	Payload any
+	// Headers are arbitrary additional request headers.
+	Headers map[string]string
+	// SecretKey is the config key of the secret, e.g. "services.billing.webhook_secret".
+	// The request is signed when set: the X-Webhook-Signature header carries
+	// "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)), where
+	// timestamp is the X-Webhook-Timestamp header in Unix seconds. Only the key
+	// is stored in the queued payload, the secret is read when it's sent.
+	SecretKey string
+}
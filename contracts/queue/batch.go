@@ -0,0 +1,66 @@
+package queue
+
+import "github.com/goravel/framework/support/carbon"
+
+type PendingBatch interface {
+	// AllowFailures keeps the batch running after a job fails instead of cancelling it.
+	AllowFailures() PendingBatch
+	// Catch registers a job to be called when the first job of the batch fails,
+	// the job receives the batch ID and the error message as arguments.
+	Catch(job Job) PendingBatch
+	// Dispatch stores the batch and dispatches its jobs.
+	Dispatch() (Batch, error)
+	// Finally registers a job to be called when every job of the batch has run,
+	// the job receives the batch ID as argument.
+	Finally(job Job) PendingBatch
+	// Name sets the name of the batch.
+	Name(name string) PendingBatch
+	// OnConnection sets the connection of the batch.
+	OnConnection(connection string) PendingBatch
+	// OnQueue sets the queue of the batch.
+	OnQueue(queue string) PendingBatch
+	// Then registers a job to be called when every job of the batch has succeeded,
+	// the job receives the batch ID as argument.
+	Then(job Job) PendingBatch
+}
+
+type Batch interface {
+	// AllowsFailures determines if the batch keeps running after a job fails.
+	AllowsFailures() bool
+	// Cancel cancels the batch, the pending jobs will be skipped.
+	Cancel() error
+	// Cancelled determines if the batch has been cancelled.
+	Cancelled() bool
+	// CancelledAt returns the time the batch was cancelled.
+	CancelledAt() *carbon.DateTime
+	// CreatedAt returns the time the batch was created.
+	CreatedAt() *carbon.DateTime
+	// Delete deletes the batch from the storage.
+	Delete() error
+	// FailedJobIDs returns the UUIDs of the failed jobs.
+	FailedJobIDs() []string
+	// FailedJobs returns the number of failed jobs.
+	FailedJobs() int
+	// Finished determines if all jobs of the batch have succeeded.
+	Finished() bool
+	// FinishedAt returns the time the batch was finished.
+	FinishedAt() *carbon.DateTime
+	// Fresh reloads the batch from the storage.
+	Fresh() (Batch, error)
+	// HasFailures determines if the batch has failed jobs.
+	HasFailures() bool
+	// ID returns the ID of the batch.
+	ID() string
+	// Name returns the name of the batch.
+	Name() string
+	// PendingJobs returns the number of jobs that have not succeeded yet.
+	PendingJobs() int
+	// ProcessedJobs returns the number of succeeded jobs.
+	ProcessedJobs() int
+	// Progress returns the percentage (0-100) of processed jobs.
+	Progress() int
+	// SkippedJobs returns the number of jobs skipped because the batch was cancelled.
+	SkippedJobs() int
+	// TotalJobs returns the number of jobs of the batch.
+	TotalJobs() int
+}
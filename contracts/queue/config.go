@@ -6,6 +6,8 @@ import (
 
 type Config interface {
 	config.Config
+	BatchingDatabase() string
+	BatchingTable() string
 	Debug() bool
 	DefaultConnection() string
 	DefaultQueue() string
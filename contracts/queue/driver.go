@@ -31,3 +31,26 @@ type DriverWithReceive interface {
 	// Returns empty slice and nil error if no messages available within deadline.
 	Receive(ctx context.Context, queue string, count int) ([]ReservedJob, error)
 }
+
+// DriverWithPopFrom is an optional interface for drivers that can pop the next
+// job of several queues at once. When a driver implements this interface, the
+// Worker uses PopFrom instead of calling Pop on each of its queues in turn.
+type DriverWithPopFrom interface {
+	// PopFrom pops the next job off of the first non-empty queue in the given
+	// order, and returns the queue the job was popped from.
+	PopFrom(queues []string) (string, ReservedJob, error)
+}
+
+// DriverWithClear is an optional interface for drivers that support removing
+// all of the jobs of a queue, it's used by the queue:clear command.
+type DriverWithClear interface {
+	// Clear removes all of the jobs of the queue and returns the number of removed jobs.
+	Clear(queue string) (int64, error)
+}
+
+// DriverWithSize is an optional interface for drivers that support counting
+// the pending jobs of a queue, it's used by the queue:monitor command.
+type DriverWithSize interface {
+	// Size returns the number of jobs of the queue.
+	Size(queue string) (int64, error)
+}
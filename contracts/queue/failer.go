@@ -4,6 +4,10 @@ import "github.com/goravel/framework/support/carbon"
 
 type Failer interface {
 	All() ([]FailedJob, error)
+	// Flush deletes the failed jobs that failed more than the given hours ago, all of them if hours is 0.
+	Flush(hours int) (int64, error)
+	// Forget deletes a failed job by UUID.
+	Forget(uuid string) error
 	Get(connection, queue string, uuids []string) ([]FailedJob, error)
 }
 
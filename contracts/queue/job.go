@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"time"
 )
 
@@ -47,9 +48,10 @@ type JobStorer interface {
 type Jobs = ChainJob
 
 type ChainJob struct {
-	Delay time.Time `json:"delay"`
-	Job   Job       `json:"job"`
-	Args  []Arg     `json:"args"`
+	Delay      time.Time `json:"delay"`
+	RetryUntil time.Time `json:"retry_until"`
+	Job        Job       `json:"job"`
+	Args       []Arg     `json:"args"`
 }
 
 type JobWithShouldRetry interface {
@@ -61,3 +63,74 @@ type JobWithShouldRetry interface {
 	// Implementations without their own retry policy fall back to maxTries.
 	ShouldRetry(err error, attempt, maxTries int) (retryable bool, delay time.Duration)
 }
+
+type JobWithTries interface {
+	// Tries returns the maximum number of attempts of the job, it overrides the
+	// queue worker's Tries config. 0 means the worker's Tries config applies.
+	Tries() int
+}
+
+type JobWithBackoff interface {
+	// Backoff returns the delay before each retry attempt, in order; the last
+	// value repeats for subsequent attempts.
+	Backoff() []time.Duration
+}
+
+type JobWithRetryUntil interface {
+	// RetryUntil returns the time until which the job can be retried, it's
+	// evaluated when the job is dispatched and takes precedence over the tries.
+	RetryUntil() time.Time
+}
+
+type JobWithTimeout interface {
+	// Timeout returns the maximum duration of an attempt, the context passed to
+	// HandleWithContext is cancelled when the timeout is reached and the attempt
+	// is marked as failed once the job returns. Handle can't be interrupted, the
+	// attempt of a job without JobWithContext is marked as failed when the timeout
+	// is reached while Handle keeps running in the background.
+	Timeout() time.Duration
+}
+
+type JobWithContext interface {
+	// HandleWithContext executes the job instead of Handle when the job is
+	// processed by a queue worker, the context is cancelled when the job times out.
+	HandleWithContext(ctx context.Context, args ...any) error
+}
+
+type JobWithUniqueID interface {
+	// UniqueID returns the ID that identifies the job among the jobs with the same
+	// signature, a job is not dispatched while another one with the same ID is
+	// queued or running.
+	UniqueID(args ...any) string
+}
+
+type JobWithUniqueFor interface {
+	// UniqueFor returns how long the unique lock is kept if the job is never
+	// processed, the lock is kept until the job is processed if it isn't implemented.
+	UniqueFor() time.Duration
+}
+
+type JobWithMiddleware interface {
+	// Middleware returns the middleware the job should pass through.
+	Middleware(args ...any) []JobMiddleware
+}
+
+type JobMiddleware interface {
+	// Handle handles the job, call next to pass the job to the next middleware.
+	Handle(job QueuedJob, next func() error) error
+}
+
+type QueuedJob interface {
+	// Args returns the arguments of the job.
+	Args() []any
+	// Attempts returns the number of times the job has been attempted so far.
+	Attempts() int
+	// Job returns the job instance.
+	Job() Job
+	// MaxTries returns the number of times the job may be attempted, the
+	// tries of the job or the worker.
+	MaxTries() int
+	// Release releases the job back onto the queue after the given delay
+	// instead of failing it, the job is skipped on the sync driver.
+	Release(delay time.Duration)
+}
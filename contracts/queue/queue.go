@@ -1,12 +1,18 @@
 package queue
 
+import "time"
+
 type Queue interface {
+	// Batch creates a batch of jobs to be processed in parallel and tracked together
+	Batch(jobs []ChainJob) PendingBatch
 	// Connection gets a driver instance by connection name
 	Connection(name string) (Driver, error)
 	// Chain creates a chain of jobs to be processed one by one, passing
 	Chain(jobs []ChainJob) PendingJob
 	// Failer gets failed jobs
 	Failer() Failer
+	// FindBatch gets a batch by ID
+	FindBatch(id string) (Batch, error)
 	// GetJob gets job by signature
 	GetJob(signature string) (Job, error)
 	// GetJobs gets all jobs
@@ -29,12 +35,21 @@ type Worker interface {
 type Args struct {
 	// Specify connection
 	Connection string
-	// Specify queue
+	// Specify queue, multiple queues are separated by commas in priority order, e.g. high,default,low,
+	// append a weight to consume them in weighted round-robin instead, e.g. high:3,default:2,low:1
 	Queue string
 	// Concurrent num
 	Concurrent int
 	// Tries maximum attempts
 	Tries int
+	// MaxJobs stops the worker after processing the given number of jobs, 0 means no limit
+	MaxJobs int
+	// MaxTime stops the worker after running for the given duration, 0 means no limit
+	MaxTime time.Duration
+	// Sleep is the duration to wait before polling again when no job is available, default 1s
+	Sleep time.Duration
+	// StopWhenEmpty stops the worker when the queue is empty
+	StopWhenEmpty bool
 }
 
 type Arg struct {
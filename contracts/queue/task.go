@@ -2,6 +2,9 @@ package queue
 
 type Task struct {
 	ChainJob
-	UUID  string     `json:"uuid"`
-	Chain []ChainJob `json:"chain"`
+	UUID    string     `json:"uuid"`
+	BatchID string     `json:"batch_id"`
+	Chain   []ChainJob `json:"chain"`
+	// UniqueOwner is the owner token of the unique lock acquired when the task was dispatched.
+	UniqueOwner string `json:"unique_owner"`
 }
@@ -0,0 +1,16 @@
+package route
+
+import (
+	"time"
+)
+
+type URL interface {
+	// Route generates the URL to the named route, the parameters that aren't in the route path are appended to the query.
+	Route(name string, parameters ...map[string]any) (string, error)
+	// SignedRoute generates a signed URL to the named route, the URL never expires.
+	SignedRoute(name string, parameters ...map[string]any) (string, error)
+	// TemporarySignedRoute generates a signed URL to the named route, the URL expires at the given time.
+	TemporarySignedRoute(name string, expiration time.Time, parameters ...map[string]any) (string, error)
+	// To generates an absolute URL to the given path.
+	To(path string, query ...map[string]any) string
+}
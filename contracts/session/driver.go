@@ -1,5 +1,17 @@
 package session
 
+import "time"
+
+// The session attributes holding the information indexed by the drivers implementing UserDriver.
+const (
+	// AttributeUserID is the ID of the authenticated user, it's set by the session guard.
+	AttributeUserID = "_user_id"
+	// AttributeIPAddress is the IP address of the client, it's set by the StartSession middleware.
+	AttributeIPAddress = "_ip_address"
+	// AttributeUserAgent is the user agent of the client, it's set by the StartSession middleware.
+	AttributeUserAgent = "_user_agent"
+)
+
 // Driver is the interface for Session handlers.
 type Driver interface {
 	// Close closes the session handler.
@@ -15,3 +27,21 @@ type Driver interface {
 	// Write writes the session data associated with the given ID.
 	Write(id string, data string) error
 }
+
+// UserDriver is implemented by the session handlers that can index the sessions by user.
+type UserDriver interface {
+	Driver
+	// DestroyOthers destroys all sessions of the user except the session with the given ID.
+	DestroyOthers(userID string, exceptID string) error
+	// Sessions returns the active sessions of the user.
+	Sessions(userID string) ([]Activity, error)
+}
+
+// Activity describes an active session of a user.
+type Activity struct {
+	ID           string
+	UserID       string
+	IPAddress    string
+	UserAgent    string
+	LastActivity time.Time
+}
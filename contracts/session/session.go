@@ -14,6 +14,8 @@ type Session interface {
 	Forget(keys ...string) Session
 	// Get retrieves the value of a key from the session attributes.
 	Get(key string, defaultValue ...any) any
+	// GetDriver returns the driver of the session.
+	GetDriver() Driver
 	// GetName returns the name of the session.
 	GetName() string
 	// GetID returns the ID of the session.
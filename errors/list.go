@@ -6,12 +6,14 @@ var (
 	CacheFacadeNotSet                = New("cache facade is not initialized")
 	ConfigFacadeNotSet               = New("config facade is not initialized")
 	ConsoleFacadeNotSet              = New("console facade is not initialized, skipping artisan command execution")
+	CryptFacadeNotSet                = New("crypt facade is not initialized")
 	DBFacadeNotSet                   = New("db facade is not initialized")
 	HashFacadeNotSet                 = New("hash facade is not initialized")
 	HttpFacadeNotSet                 = New("http facade is not initialized")
 	JSONParserNotSet                 = New("JSON parser is not initialized")
 	LogFacadeNotSet                  = New("log facade is not initialized")
 	MailFacadeNotSet                 = New("mail facade is not initialized")
+	NotificationFacadeNotSet         = New("notification facade is not initialized")
 
 	OrmFacadeNotSet         = New("orm facade is not initialized")
 	ProcessFacadeNotSet     = New("process facade is not initialized")
@@ -24,20 +26,31 @@ var (
 	SessionFacadeNotSet     = New("session facade is not initialized")
 	ServiceProviderCycle    = New("circular dependency detected between providers: %s")
 	TelemetryFacadeNotSet   = New("telemetry facade is not initialized")
-
-	AuthEmptySecret             = New("authentication secret is missing or required")
-	AuthGuardMismatch           = New("authentication token guard mismatch: expected %s, got %s")
-	AuthInvalidClaims           = New("authentication token contains invalid claims")
-	AuthInvalidKey              = New("authentication key is invalid")
-	AuthInvalidToken            = New("authentication token is invalid")
-	AuthNoPrimaryKeyField       = New("no primary key field found in the model, ensure primary key is set, e.g., orm.Model")
-	AuthParseTokenFirst         = New("authentication token must be parsed first")
-	AuthRefreshTimeExceeded     = New("authentication refresh time limit exceeded")
-	AuthTokenDisabled           = New("authentication token has been disabled")
-	AuthTokenExpired            = New("authentication token has expired")
-	AuthGuardDriverNotFound     = New("driver %s for guard %s was not found")
-	AuthProviderDriverNotFound  = New("driver %s for user provider %s was not found")
-	AuthUnsupportedDriverMethod = New("The method was not supported for the driver %s")
+	URLFacadeNotSet         = New("url facade is not initialized")
+
+	AuthEmailVerificationInvalid    = New("email verification link is invalid")
+	AuthEmptySecret                 = New("authentication secret is missing or required")
+	AuthGuardNotTokenDriver         = New("guard %s doesn't use the token driver")
+	AuthGuardMismatch               = New("authentication token guard mismatch: expected %s, got %s")
+	AuthInvalidClaims               = New("authentication token contains invalid claims")
+	AuthInvalidKey                  = New("authentication key is invalid")
+	AuthInvalidToken                = New("authentication token is invalid")
+	AuthNoPrimaryKeyField           = New("no primary key field found in the model, ensure primary key is set, e.g., orm.Model")
+	AuthParseTokenFirst             = New("authentication token must be parsed first")
+	AuthPasswordResetThrottled      = New("password reset was requested recently, please wait before retrying")
+	AuthPasswordResetTokenInvalid   = New("password reset token is invalid or has expired")
+	AuthRefreshTimeExceeded         = New("authentication refresh time limit exceeded")
+	AuthTokenDisabled               = New("authentication token has been disabled")
+	AuthTokenExpired                = New("authentication token has expired")
+	AuthTwoFactorChallengeNotFound  = New("two-factor challenge was not found, the user must log in first")
+	AuthTwoFactorCodeInvalid        = New("two-factor authentication code is invalid")
+	AuthTwoFactorNotEnabled         = New("two-factor authentication is not enabled for the user")
+	AuthTwoFactorTooManyAttempts    = New("too many two-factor authentication attempts, the user must log in again")
+	AuthTwoFactorRequired           = New("two-factor authentication is required to complete the login")
+	AuthTableRequiresBootstrapSetup = New("%s auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleAuth)
+	AuthGuardDriverNotFound         = New("driver %s for guard %s was not found")
+	AuthProviderDriverNotFound      = New("driver %s for user provider %s was not found")
+	AuthUnsupportedDriverMethod     = New("The method was not supported for the driver %s")
 
 	AIProviderNotSupported                = New("ai provider not found: %s")
 	AIProviderContractNotFulfilled        = New("%s.via must be contracts/ai.Provider or func() (contracts/ai.Provider, error)")
@@ -75,11 +88,21 @@ var (
 	AIToolExecutionFailed                 = New("ai: tool %q execution failed: %v")
 	AIToolNotFound                        = New("ai: tool %q not found")
 
-	CacheDriverNotSupported           = New("invalid driver: %s, only support memory, custom")
-	CacheForeverFailed                = New("cache forever is failed")
-	CacheMemoryDriverNotSupportDocker = New("memory driver doesn't support docker")
-	CacheMemoryInvalidIntValueType    = New("value type of %s is not *atomic.Int64 or *int64 or *atomic.Int32 or *int32")
-	CacheStoreContractNotFulfilled    = New("%s doesn't implement contracts/cache/store")
+	CacheDatabaseDriverNotSupportDocker = New("database driver doesn't support docker")
+	CacheDatabaseNotSet                 = New("the database is required by the database driver of cache store %s")
+	CacheDriverNotSupported             = New("invalid driver: %s, only support memory, database, file, tiered, custom")
+	CacheFileDriverNotSupportDocker     = New("file driver doesn't support docker")
+	CacheFileLockTimeout                = New("timeout to acquire the lock of cache key %s")
+	CacheFlexibleInvalidDuration        = New("the stale duration %s of cache key %s must be greater than the fresh duration %s")
+	CacheForeverFailed                  = New("cache forever is failed")
+	CacheInvalidIntValueType            = New("value of %s is not an integer")
+	CacheMemoryDriverNotSupportDocker   = New("memory driver doesn't support docker")
+	CacheMemoryInvalidIntValueType      = New("value type of %s is not *atomic.Int64 or *int64 or *atomic.Int32 or *int32")
+	CacheStoreContractNotFulfilled      = New("%s doesn't implement contracts/cache/store")
+	CacheTableRequiresBootstrapSetup    = New("cache:table auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleCache)
+	CacheTieredDriverNotSupportDocker   = New("tiered driver doesn't support docker")
+	CacheTieredStoreRecursive           = New("the tiered cache store %s can't contain itself")
+	CacheTieredStoresRequired           = New("the tiered cache store %s requires at least one store")
 
 	CommandEmptyPackageName = New("the package name cannot be empty")
 
@@ -124,11 +147,16 @@ var (
 
 	EventListenerNotBind = New("event %v doesn't bind listeners")
 
-	FilesystemDefaultDiskNotSet   = New("please set default disk")
-	FilesystemDeleteDirectory     = New("can't delete directory, please use DeleteDirectory")
-	FilesystemDriverNotSupported  = New("invalid driver: %s, only support local, custom")
-	FilesystemFileNotExist        = New("file doesn't exist")
-	FilesystemInvalidCustomDriver = New("init %s disk fail: via must be implement filesystem.Driver or func() (filesystem.Driver, error)")
+	FilesystemDefaultDiskNotSet       = New("please set default disk")
+	FilesystemDeleteDirectory         = New("can't delete directory, please use DeleteDirectory")
+	FilesystemDiskReadOnly            = New("disk %s is read-only")
+	FilesystemDriverNotSupported      = New("invalid driver: %s, only support local, memory, scoped, custom")
+	FilesystemFileNotExist            = New("file doesn't exist")
+	FilesystemInvalidCustomDriver     = New("init %s disk fail: via must be implement filesystem.Driver or func() (filesystem.Driver, error)")
+	FilesystemScopedDiskNotSet        = New("please set the wrapped disk of the scoped disk %s")
+	FilesystemScopedDiskRecursive     = New("scoped disk %s can't wrap itself, directly or via other scoped disks")
+	FilesystemStreamNotSupported      = New("the driver doesn't support streams, it should implement filesystem.DriverWithStream")
+	FilesystemWriteOptionNotSupported = New("the %s write option isn't supported by the %s driver")
 
 	FileAlreadyExists = New("file %s already exists")
 	FileNotExist      = New("file %s does not exist")
@@ -145,6 +173,11 @@ var (
 	HttpClientResponseIsNil           = New("response is nil").SetModule(ModuleHttp)
 	HttpClientConfigNotSet            = New("http client config is nil").SetModule(ModuleHttp)
 
+	HttpSignatureExpired    = New("the signature has expired").SetModule(ModuleHttp)
+	HttpSignatureInvalid    = New("invalid signature").SetModule(ModuleHttp)
+	HttpSignatureInvalidURL = New("invalid url: %s").SetModule(ModuleHttp)
+	HttpSignatureKeyNotSet  = New("APP_KEY is required to sign URLs").SetModule(ModuleHttp)
+
 	HttpRateLimitFailedToTakeToken     = New("failed to take token")
 	HttpRateLimitFailedToCheckThrottle = New("failed to check throttle: %s")
 	HttpClientHandlerReturnedNil       = New("mock handler returned a nil response").SetModule(ModuleHttp)
@@ -165,7 +198,7 @@ var (
 	BroadcastChannelUnauthorized        = New("channel authorization denied for channel %s").SetModule(ModuleBroadcast)
 	BroadcastChannelDataMarshalFailed   = New("broadcasting: failed to marshal channel_data").SetModule(ModuleBroadcast)
 	BroadcastConnectionNotFound         = New("broadcast connection %q not found").SetModule(ModuleBroadcast)
-	BroadcastDriverNotSupported         = New("unknown broadcast driver: %s, only support pusher, log, null").SetModule(ModuleBroadcast)
+	BroadcastDriverNotSupported         = New("unknown broadcast driver: %s, only support pusher, websocket, log, null").SetModule(ModuleBroadcast)
 	BroadcastInvalidQueuePayload        = New("broadcasting: invalid or missing queue payload").SetModule(ModuleBroadcast)
 	BroadcastPusherHTTPError            = New("pusher: HTTP %d: request to %s failed").SetModule(ModuleBroadcast)
 	BroadcastPusherHostRequired         = New("pusher: host is required, either configure PUSHER_HOST or PUSHER_APP_CLUSTER").SetModule(ModuleBroadcast)
@@ -175,6 +208,11 @@ var (
 	BroadcastPusherAppIDRequired        = New("pusher: app_id is required").SetModule(ModuleBroadcast)
 	BroadcastPusherKeyRequired          = New("pusher: key is required").SetModule(ModuleBroadcast)
 	BroadcastPusherSecretRequired       = New("pusher: secret is required").SetModule(ModuleBroadcast)
+	BroadcastServerDriverNotSupported   = New("broadcasting: connection %s uses the %s driver, the server requires the websocket driver").SetModule(ModuleBroadcast)
+	BroadcastServerConnectionClosed     = New("broadcasting: the connection is closed").SetModule(ModuleBroadcast)
+	BroadcastServerSlowConsumer         = New("broadcasting: the connection %s is dropped since it doesn't read the messages fast enough").SetModule(ModuleBroadcast)
+	BroadcastServerOriginNotAllowed     = New("broadcasting: origin %s is not allowed").SetModule(ModuleBroadcast)
+	BroadcastServerSignatureInvalid     = New("broadcasting: the signature of the request is invalid").SetModule(ModuleBroadcast)
 	BroadcastAuthConfigMissing          = New("broadcasting: auth endpoint requires key and secret in the default broadcast connection").SetModule(ModuleBroadcast)
 
 	MailTemplateParseFailed         = New("failed to parse template %s: %w").SetModule(ModuleMail)
@@ -183,6 +221,21 @@ var (
 	MailTemplateEngineViaRequired   = New("custom template engine '%s' must specify 'via' factory function").SetModule(ModuleMail)
 	MailTemplateEngineViaInvalid    = New("invalid via type for template engine '%s'").SetModule(ModuleMail)
 	MailTemplateEngineFactoryFailed = New("factory for template engine '%s' failed: %w").SetModule(ModuleMail)
+	MailMailerNotFound              = New("mailer '%s' is not defined").SetModule(ModuleMail)
+	MailMailerCircularReference     = New("mailer '%s' references itself").SetModule(ModuleMail)
+	MailMailersRequired             = New("%s mailer '%s' must specify the mailers").SetModule(ModuleMail)
+	MailTransportNotSupported       = New("mail transport not supported: %s").SetModule(ModuleMail)
+	MailTransportViaRequired        = New("custom transport of mailer '%s' must specify 'via' factory function").SetModule(ModuleMail)
+	MailTransportViaInvalid         = New("invalid via type for transport of mailer '%s'").SetModule(ModuleMail)
+	MailTransportFactoryFailed      = New("factory for transport of mailer '%s' failed: %w").SetModule(ModuleMail)
+	MailSendmailFailed              = New("sendmail failed with exit code %d: %s").SetModule(ModuleMail)
+	MailMarkdownThemeNotFound       = New("mail markdown theme not found: %s").SetModule(ModuleMail)
+	MailMarkdownComponentNotFound   = New("mail markdown component not found: %s").SetModule(ModuleMail)
+	MailMarkdownRenderFailed        = New("failed to render markdown %s: %w").SetModule(ModuleMail)
+	MailInlineCssFailed             = New("failed to inline css: %w").SetModule(ModuleMail)
+	MailAttachmentNotLoaded         = New("attachment %s of disk %s is not loaded").SetModule(ModuleMail)
+	MailAttachmentEmpty             = New("attachment %s has neither data nor path").SetModule(ModuleMail)
+	MailAttachmentDiskInvalid       = New("failed to resolve disk %s of the attachments: %v").SetModule(ModuleMail)
 
 	MiddlewareRegisterFailed      = New("failed to register middleware '%s': %v")
 	MaintenanceCacheDeleteFailed  = New("failed to delete maintenance mode from cache")
@@ -200,20 +253,30 @@ var (
 	MigrationResetFailed     = New("migration reset failed: %v")
 	MigrationRollbackFailed  = New("migration rollback failed: %v")
 
-	NotificationChannelNotFound               = New("notification channel not found: %s").SetModule(ModuleNotification)
-	NotificationChannelNotQueueable           = New("notification channel %q does not support queued dispatch (does not implement ResolvableChannel)").SetModule(ModuleNotification)
-	NotificationInvalidQueuePayload           = New("notification queue payload is missing or malformed").SetModule(ModuleNotification)
-	NotificationMailEmptyRoute                = New("mail channel: %T returned an empty address").SetModule(ModuleNotification)
-	NotificationMailMarshalPayloadFailed      = New("mail channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
-	NotificationMailUnmarshalPayloadFailed    = New("mail channel: failed to unmarshal payload: %w").SetModule(ModuleNotification)
-	NotificationMailSendFailed                = New("mail channel: failed to send: %w").SetModule(ModuleNotification)
-	NotificationDatabaseEmptyRoute            = New("database channel: %T returned an empty ID").SetModule(ModuleNotification)
-	NotificationDatabaseMarshalDataFailed     = New("database channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
-	NotificationDatabaseMarshalRecordFailed   = New("database channel: failed to marshal record: %w").SetModule(ModuleNotification)
-	NotificationDatabaseUnmarshalRecordFailed = New("database channel: failed to unmarshal record: %w").SetModule(ModuleNotification)
-	NotificationDatabaseInsertFailed          = New("database channel: failed to insert notification record: %w").SetModule(ModuleNotification)
-	NotificationTableRequiresBootstrapSetup   = New("notifications:table auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleNotification)
-	NotificationQueuePayloadDecodeFailed      = New("notification queue payload could not be decoded: %v").SetModule(ModuleNotification)
+	NotificationChannelNotFound                 = New("notification channel not found: %s").SetModule(ModuleNotification)
+	NotificationChannelNotQueueable             = New("notification channel %q does not support queued dispatch (does not implement ResolvableChannel)").SetModule(ModuleNotification)
+	NotificationInvalidQueuePayload             = New("notification queue payload is missing or malformed").SetModule(ModuleNotification)
+	NotificationMailEmptyRoute                  = New("mail channel: %T returned an empty address").SetModule(ModuleNotification)
+	NotificationMailMarshalPayloadFailed        = New("mail channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
+	NotificationMailUnmarshalPayloadFailed      = New("mail channel: failed to unmarshal payload: %w").SetModule(ModuleNotification)
+	NotificationMailSendFailed                  = New("mail channel: failed to send: %w").SetModule(ModuleNotification)
+	NotificationDatabaseEmptyRoute              = New("database channel: %T returned an empty ID").SetModule(ModuleNotification)
+	NotificationDatabaseMarshalDataFailed       = New("database channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
+	NotificationDatabaseMarshalRecordFailed     = New("database channel: failed to marshal record: %w").SetModule(ModuleNotification)
+	NotificationDatabaseUnmarshalRecordFailed   = New("database channel: failed to unmarshal record: %w").SetModule(ModuleNotification)
+	NotificationDatabaseInsertFailed            = New("database channel: failed to insert notification record: %w").SetModule(ModuleNotification)
+	NotificationBroadcastEmptyRoute             = New("broadcast channel: %T returned no channels").SetModule(ModuleNotification)
+	NotificationBroadcastMarshalPayloadFailed   = New("broadcast channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
+	NotificationBroadcastUnmarshalPayloadFailed = New("broadcast channel: failed to unmarshal payload: %w").SetModule(ModuleNotification)
+	NotificationBroadcastSendFailed             = New("broadcast channel: failed to broadcast: %w").SetModule(ModuleNotification)
+	NotificationWebhookEmptyRoute               = New("webhook channel: %T returned an empty URL").SetModule(ModuleNotification)
+	NotificationWebhookMarshalPayloadFailed     = New("webhook channel: failed to marshal payload for %T: %w").SetModule(ModuleNotification)
+	NotificationWebhookUnmarshalPayloadFailed   = New("webhook channel: failed to unmarshal payload: %w").SetModule(ModuleNotification)
+	NotificationWebhookSendFailed               = New("webhook channel: failed to send: %w").SetModule(ModuleNotification)
+	NotificationWebhookSecretEmpty              = New("webhook channel: the secret of config key %s is empty").SetModule(ModuleNotification)
+	NotificationWebhookRequestFailed            = New("webhook channel: request to %s failed with status %d").SetModule(ModuleNotification)
+	NotificationTableRequiresBootstrapSetup     = New("notifications:table auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleNotification)
+	NotificationQueuePayloadDecodeFailed        = New("notification queue payload could not be decoded: %v").SetModule(ModuleNotification)
 
 	OrmDriverNotSupported          = New("invalid driver: %s, only support mysql, postgres, sqlite and sqlserver")
 	OrmFailedToGenerateDNS         = New("failed to generate DSN, please check the database configuration")
@@ -266,37 +329,52 @@ var (
 
 	ProviderRegisterFailed = New("failed to register provider '%s': %v")
 
-	QueueDriverFailedToPop           = New("failed to pop job from %s queue: %v")
-	QueueDriverFailedToReceive       = New("failed to receive jobs from %s queue: %v")
-	QueueDriverInvalid               = New("%s doesn't implement contracts/queue/driver")
-	QueueDriverNoJobFound            = New("no job found in %s queue")
-	QueueDriverNotSupported          = New("unknown queue driver: %s")
-	QueueDriverSyncNotNeedToRun      = New("the driver of queue %s is sync, not need to run")
-	QueueDuplicateJobSignature       = New("duplicate job signature: %s")
-	QueueEmptyJobSignature           = New("job signature can't be empty")
-	QueueFailedJobNotFound           = New("Unable to find failed job with ID [%s]")
-	QueueFailedToCallJob             = New("failed to call job")
-	QueueFailedToConvertTaskToJson   = New("failed to convert task to json: %v, task: %+v")
-	QueueFailedToDeleteFailedJob     = New("failed to delete failed job: %+v, err: %v")
-	QueueFailedToDeleteReservedJob   = New("failed to delete reserved job: %+v, err: %v")
-	QueueFailedToGetFailedJob        = New("failed to get failed job: %+v, err: %v")
-	QueueFailedToReleaseReservedJob  = New("failed to release reserved job: %+v, err: %v")
-	QueueFailedToInsertJobToDatabase = New("failed to insert job to database: %+v, err: %v")
-	QueueFailedToReserveJob          = New("failed to reserve job: %+v, err: %v")
-	QueuePopIsLocked                 = New("queue %s is locked, please remove the cache lock key manually: %s")
-	QueueFailedToRetryJob            = New("failed to retry job: %+v, err: %v")
-	QueueFailedToSaveFailedJob       = New("failed to save failed job: %v")
-	QueueInvalidDatabaseConnection   = New("invalid database connection: %s")
-	QueueNoRetryableJobsFound        = New("no retryable jobs found")
-	QueueJobNotFound                 = New("job not found: %s")
-	QueueJobRegisterFailed           = New("job register failed: %v")
-	QueueJobFailed                   = New("job failed: %v")
-	QueueProcessingJobs              = New("Processing jobs from [%s] connection and [%s] queue")
-	QueuePushingFailedJob            = New("Pushing failed queue jobs back onto the queue")
-	QueueNoFailedJobsFound           = New("no failed jobs found")
+	QueueBatchesTableRequiresBootstrapSetup = New("queue:batches-table auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleQueue)
+	QueueBatchEmpty                         = New("batch must contain at least one job")
+	QueueBatchNotFound                      = New("batch not found: %s")
+	QueueBatchingNotConfigured              = New("job batching requires queue.batching.database to be configured")
+	QueueDriverFailedToPop                  = New("failed to pop job from %s queue: %v")
+	QueueDriverFailedToReceive              = New("failed to receive jobs from %s queue: %v")
+	QueueDriverInvalid                      = New("%s doesn't implement contracts/queue/driver")
+	QueueDriverNoJobFound                   = New("no job found in %s queue")
+	QueueDriverNotSupported                 = New("unknown queue driver: %s")
+	QueueDriverNotSupportClear              = New("the driver of queue connection %s doesn't support clearing jobs")
+	QueueDriverNotSupportSize               = New("the driver of queue connection %s doesn't support counting jobs")
+	QueueDriverSyncNotNeedToRun             = New("the driver of queue %s is sync, not need to run")
+	QueueDuplicateJobSignature              = New("duplicate job signature: %s")
+	QueueEmptyJobSignature                  = New("job signature can't be empty")
+	QueueFailedJobNotFound                  = New("Unable to find failed job with ID [%s]")
+	QueueFailedJobUUIDRequired              = New("the uuid of the failed job is required")
+	QueueFailedToCallJob                    = New("failed to call job")
+	QueueFailedToConvertTaskToJson          = New("failed to convert task to json: %v, task: %+v")
+	QueueFailedToDeleteFailedJob            = New("failed to delete failed job: %+v, err: %v")
+	QueueFailedToDeleteReservedJob          = New("failed to delete reserved job: %+v, err: %v")
+	QueueFailedToGetFailedJob               = New("failed to get failed job: %+v, err: %v")
+	QueueFailedToReleaseReservedJob         = New("failed to release reserved job: %+v, err: %v")
+	QueueFailedToInsertJobToDatabase        = New("failed to insert job to database: %+v, err: %v")
+	QueueFailedToReserveJob                 = New("failed to reserve job: %+v, err: %v")
+	QueueFailedToUpdateBatch                = New("failed to update batch %s: %v")
+	QueuePopIsLocked                        = New("queue %s is locked, please remove the cache lock key manually: %s")
+	QueueFailedToRetryJob                   = New("failed to retry job: %+v, err: %v")
+	QueueFailedToSaveFailedJob              = New("failed to save failed job: %v")
+	QueueInvalidDatabaseConnection          = New("invalid database connection: %s")
+	QueueInvalidPriority                    = New("invalid queues %s, the queues should be like high,default,low or high:3,default:1")
+	QueueMonitorQueuesRequired              = New("the queues to monitor are required, e.g. database:default,database:high")
+	QueueNoRetryableJobsFound               = New("no retryable jobs found")
+	QueueJobNotFound                        = New("job not found: %s")
+	QueueJobRegisterFailed                  = New("job register failed: %v")
+	QueueJobFailed                          = New("job failed: %v")
+	QueueJobAttemptedTooManyTimes           = New("job %s has been attempted too many times")
+	QueueJobReleasedOnShutdown              = New("job %s was released while the worker is shutting down")
+	QueueJobTimedOut                        = New("job %s has timed out after %s")
+	QueueProcessingJobs                     = New("Processing jobs from [%s] connection and [%s] queue")
+	QueuePushingFailedJob                   = New("Pushing failed queue jobs back onto the queue")
+	QueueNoFailedJobsFound                  = New("no failed jobs found")
 
 	RouteDefaultDriverNotSet = New("please set default driver")
 	RouteInvalidDriver       = New("init %s route driver fail: route must be implement route.Route or func() (route.Route, error)")
+	RouteNotDefined          = New("route %s is not defined").SetModule(ModuleRoute)
+	RouteParameterMissing    = New("missing parameter %s of route %s").SetModule(ModuleRoute)
 
 	SchemaConnectionNotFound   = New("connection %s not found")
 	SchemaDriverNotSupported   = New("driver %s is not supported")
@@ -312,12 +390,18 @@ var (
 	SchemaModelNotFound        = New("model %s not found in registered models")
 	SchemaTableNotFound        = New("table %s not found")
 
-	SessionDriverAlreadyExists        = New("session driver [%s] already exists")
-	SessionDriverExtensionFailed      = New("session failed to extend session [%s] driver [%v]")
-	SessionDriverIsNotSet             = New("session driver is not set")
-	SessionDriverNotSupported         = New("session driver [%s] not supported")
-	SessionDriverRegisterFailed       = New("failed to register session drivers: %v")
-	SessionDriverContractNotFulfilled = New("%s doesn't implement contracts/session/driver")
+	SessionBlockCacheNotSet             = New("the cache is required to block the session, the session is not blocked")
+	SessionCacheNotSet                  = New("the cache is required by the session driver [%s]")
+	SessionCryptNotSet                  = New("the crypt is required by the session driver [%s]")
+	SessionDatabaseNotSet               = New("the database is required by the session driver [%s]")
+	SessionDriverAlreadyExists          = New("session driver [%s] already exists")
+	SessionDriverExtensionFailed        = New("session failed to extend session [%s] driver [%v]")
+	SessionDriverIsNotSet               = New("session driver is not set")
+	SessionDriverNotSupported           = New("session driver [%s] not supported")
+	SessionDriverNotSupportUserSessions = New("session driver doesn't support listing the sessions of a user")
+	SessionDriverRegisterFailed         = New("failed to register session drivers: %v")
+	SessionDriverContractNotFulfilled   = New("%s doesn't implement contracts/session/driver")
+	SessionTableRequiresBootstrapSetup  = New("session:table auto-registration requires the bootstrap setup (see env.IsBootstrapSetup); register the migration manually").SetModule(ModuleSession)
 
 	TemplateFailedToExecute      = New("failed to execute template: %v")
 	TemplateFailedToFormatGoCode = New("failed to format go code: %v")
@@ -163,6 +163,10 @@ func Testing() testing.Testing {
 	return App().MakeTesting()
 }
 
+func URL() route.URL {
+	return App().MakeURL()
+}
+
 func Validation() validation.Validation {
 	return App().MakeValidation()
 }
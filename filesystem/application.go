@@ -2,6 +2,7 @@ package filesystem
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/goravel/framework/contracts/config"
 	"github.com/goravel/framework/contracts/filesystem"
@@ -12,6 +13,8 @@ type Driver string
 
 const (
 	DriverLocal  Driver = "local"
+	DriverMemory Driver = "memory"
+	DriverScoped Driver = "scoped"
 	DriverCustom Driver = "custom"
 )
 
@@ -27,25 +30,53 @@ func NewStorage(config config.Config) (*Storage, error) {
 		return nil, errors.FilesystemDefaultDiskNotSet.SetModule(errors.ModuleFilesystem)
 	}
 
-	driver, err := NewDriver(config, defaultDisk)
+	storage := &Storage{
+		config:  config,
+		drivers: make(map[string]filesystem.Driver),
+	}
+
+	driver, err := storage.resolve(defaultDisk, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	drivers := make(map[string]filesystem.Driver)
-	drivers[defaultDisk] = driver
-	return &Storage{
-		Driver:  driver,
-		config:  config,
-		drivers: drivers,
-	}, nil
+	storage.Driver = driver
+
+	return storage, nil
 }
 
 func NewDriver(config config.Config, disk string) (filesystem.Driver, error) {
+	var resolve func(disk string, resolving []string) (filesystem.Driver, error)
+	resolve = func(disk string, resolving []string) (filesystem.Driver, error) {
+		return newDriver(config, disk, resolving, resolve)
+	}
+
+	return resolve(disk, nil)
+}
+
+// newDriver creates the driver of the disk, the disks wrapped by a scoped disk are got via resolve.
+// resolving contains the scoped disks being created, it's used to detect the scoped disks wrapping
+// each other.
+func newDriver(config config.Config, disk string, resolving []string, resolve func(disk string, resolving []string) (filesystem.Driver, error)) (filesystem.Driver, error) {
+	if slices.Contains(resolving, disk) {
+		return nil, errors.FilesystemScopedDiskRecursive.Args(disk)
+	}
+
 	driver := Driver(config.GetString(fmt.Sprintf("filesystems.disks.%s.driver", disk)))
 	switch driver {
 	case DriverLocal:
 		return NewLocal(config, disk)
+	case DriverMemory:
+		return NewMemory(config, disk)
+	case DriverScoped:
+		scoped, err := NewScoped(config, disk, func(parent string) (filesystem.Driver, error) {
+			return resolve(parent, append(slices.Clone(resolving), disk))
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return scoped, nil
 	case DriverCustom:
 		via := config.Get(fmt.Sprintf("filesystems.disks.%s.via", disk))
 		driver, ok := via.(filesystem.Driver)
@@ -65,16 +96,27 @@ func NewDriver(config config.Config, disk string) (filesystem.Driver, error) {
 }
 
 func (r *Storage) Disk(disk string) filesystem.Driver {
+	driver, err := r.resolve(disk, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return driver
+}
+
+// resolve gets the driver of the disk from the created drivers, so a scoped disk shares the driver
+// (eg: the files of a memory disk) with the disk it wraps.
+func (r *Storage) resolve(disk string, resolving []string) (filesystem.Driver, error) {
 	if driver, exist := r.drivers[disk]; exist {
-		return driver
+		return driver, nil
 	}
 
-	driver, err := NewDriver(r.config, disk)
+	driver, err := newDriver(r.config, disk, resolving, r.resolve)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	r.drivers[disk] = driver
 
-	return driver
+	return driver, nil
 }
@@ -13,6 +13,7 @@ import (
 	mocksconfig "github.com/goravel/framework/mocks/config"
 	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	mocksroute "github.com/goravel/framework/mocks/route"
 )
 
 func TestNewStorage(t *testing.T) {
@@ -48,6 +49,23 @@ func TestNewStorage(t *testing.T) {
 		second := storage.Disk("backup")
 		assert.Same(t, first, second)
 	})
+
+	t.Run("scoped disk shares the wrapped disk", func(t *testing.T) {
+		config := mocksconfig.NewConfig(t)
+		config.EXPECT().GetString("filesystems.default").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.memory.driver").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.memory.url").Return("").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.driver").Return("scoped").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.disk").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.prefix").Return("tenants/1").Once()
+		config.EXPECT().GetBool("filesystems.disks.tenant.read_only").Return(false).Once()
+
+		storage, err := NewStorage(config)
+		assert.NoError(t, err)
+
+		assert.NoError(t, storage.Disk("tenant").Put("1.txt", "Goravel"))
+		assert.True(t, storage.Exists("tenants/1/1.txt"))
+	})
 }
 
 func TestNewDriver(t *testing.T) {
@@ -63,6 +81,56 @@ func TestNewDriver(t *testing.T) {
 		assert.IsType(t, &Local{}, driver)
 	})
 
+	t.Run("returns memory driver", func(t *testing.T) {
+		config := mocksconfig.NewConfig(t)
+		config.EXPECT().GetString("filesystems.disks.memory.driver").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.memory.url").Return("").Once()
+
+		driver, err := NewDriver(config, "memory")
+
+		assert.NoError(t, err)
+		assert.IsType(t, &Memory{}, driver)
+	})
+
+	t.Run("returns scoped driver", func(t *testing.T) {
+		config := mocksconfig.NewConfig(t)
+		config.EXPECT().GetString("filesystems.disks.tenant.driver").Return("scoped").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.disk").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.memory.driver").Return("memory").Once()
+		config.EXPECT().GetString("filesystems.disks.memory.url").Return("").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.prefix").Return("tenants/1").Once()
+		config.EXPECT().GetBool("filesystems.disks.tenant.read_only").Return(true).Once()
+
+		driver, err := NewDriver(config, "tenant")
+
+		assert.NoError(t, err)
+		assert.IsType(t, &Scoped{}, driver)
+	})
+
+	t.Run("returns error when scoped disk wraps itself", func(t *testing.T) {
+		config := mocksconfig.NewConfig(t)
+		config.EXPECT().GetString("filesystems.disks.tenant.driver").Return("scoped").Once()
+		config.EXPECT().GetString("filesystems.disks.tenant.disk").Return("tenant").Once()
+
+		driver, err := NewDriver(config, "tenant")
+
+		assert.Nil(t, driver)
+		assert.ErrorIs(t, err, errors.FilesystemScopedDiskRecursive)
+	})
+
+	t.Run("returns error when scoped disks wrap each other", func(t *testing.T) {
+		config := mocksconfig.NewConfig(t)
+		config.EXPECT().GetString("filesystems.disks.a.driver").Return("scoped").Once()
+		config.EXPECT().GetString("filesystems.disks.a.disk").Return("b").Once()
+		config.EXPECT().GetString("filesystems.disks.b.driver").Return("scoped").Once()
+		config.EXPECT().GetString("filesystems.disks.b.disk").Return("a").Once()
+
+		driver, err := NewDriver(config, "a")
+
+		assert.Nil(t, driver)
+		assert.ErrorIs(t, err, errors.FilesystemScopedDiskRecursive)
+	})
+
 	t.Run("returns custom driver instance", func(t *testing.T) {
 		config := mocksconfig.NewConfig(t)
 		custom := mocksfilesystem.NewDriver(t)
@@ -109,7 +177,7 @@ func TestNewDriver(t *testing.T) {
 
 		assert.Nil(t, driver)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "only support local, custom")
+		assert.Contains(t, err.Error(), "only support local, memory, scoped, custom")
 	})
 }
 
@@ -189,10 +257,40 @@ func TestServiceProvider(t *testing.T) {
 		storage := mocksfilesystem.NewStorage(t)
 		app.EXPECT().MakeConfig().Return(config).Once()
 		app.EXPECT().MakeStorage().Return(storage).Once()
+		app.EXPECT().MakeRoute().Return(nil).Once()
 
 		provider.Boot(app)
 
 		assert.Same(t, config, ConfigFacade)
 		assert.Same(t, storage, StorageFacade)
 	})
+
+	t.Run("boot registers temporary url route", func(t *testing.T) {
+		originConfigFacade := ConfigFacade
+		originStorageFacade := StorageFacade
+		t.Cleanup(func() {
+			ConfigFacade = originConfigFacade
+			StorageFacade = originStorageFacade
+		})
+
+		app := mocksfoundation.NewApplication(t)
+		config := mocksconfig.NewConfig(t)
+		storage := mocksfilesystem.NewStorage(t)
+		route := mocksroute.NewRoute(t)
+		router := mocksroute.NewRouter(t)
+		action := mocksroute.NewAction(t)
+		app.EXPECT().MakeConfig().Return(config).Once()
+		app.EXPECT().MakeStorage().Return(storage).Once()
+		app.EXPECT().MakeRoute().Return(route).Once()
+		config.EXPECT().Get("filesystems.disks").Return(map[string]any{
+			"local": map[string]any{"driver": "local", "serve": true},
+		}).Once()
+		config.EXPECT().GetBool("filesystems.disks.local.serve").Return(true).Once()
+		config.EXPECT().GetString("filesystems.temporary_url_path", "/_storage").Return("/_storage").Once()
+		route.EXPECT().Middleware(mock.Anything).Return(router).Once()
+		router.EXPECT().Get("/_storage/{disk}", mock.Anything).Return(action).Once()
+		action.EXPECT().Name(TemporaryUrlRouteName).Return(action).Once()
+
+		provider.Boot(app)
+	})
 }
@@ -3,6 +3,7 @@ package filesystem
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,8 +16,16 @@ import (
 	"github.com/goravel/framework/support/str"
 )
 
+const (
+	localPublicFileMode  os.FileMode = 0o644
+	localPrivateFileMode os.FileMode = 0o600
+)
+
+var _ filesystem.DriverWithStream = &Local{}
+
 type Local struct {
 	config config.Config
+	disk   string
 	root   string
 	url    string
 }
@@ -24,6 +33,7 @@ type Local struct {
 func NewLocal(config config.Config, disk string) (*Local, error) {
 	return &Local{
 		config: config,
+		disk:   disk,
 		root:   config.GetString(fmt.Sprintf("filesystems.disks.%s.root", disk)),
 		url:    config.GetString(fmt.Sprintf("filesystems.disks.%s.url", disk)),
 	}, nil
@@ -65,6 +75,25 @@ func (r *Local) AllFiles(path string) ([]string, error) {
 	return files, err
 }
 
+func (r *Local) Append(file, content string) error {
+	file = r.fullPath(file)
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, localPublicFileMode)
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(f.Close)
+
+	if _, err = f.WriteString(content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *Local) Copy(originFile, targetFile string) error {
 	content, err := r.Get(originFile)
 	if err != nil {
@@ -157,6 +186,30 @@ func (r *Local) MakeDirectory(directory string) error {
 	return os.MkdirAll(filepath.Dir(r.fullPath(directory)+string(filepath.Separator)), os.ModePerm)
 }
 
+func (r *Local) Metadata(file string) (filesystem.Metadata, error) {
+	info, err := os.Stat(r.fullPath(file))
+	if err != nil {
+		return filesystem.Metadata{}, err
+	}
+
+	mimeType, err := r.MimeType(file)
+	if err != nil {
+		return filesystem.Metadata{}, err
+	}
+
+	lastModified, err := r.LastModified(file)
+	if err != nil {
+		return filesystem.Metadata{}, err
+	}
+
+	return filesystem.Metadata{
+		ContentType:  mimeType,
+		LastModified: lastModified,
+		Size:         info.Size(),
+		Visibility:   visibilityOfMode(info.Mode()),
+	}, nil
+}
+
 func (r *Local) MimeType(file string) (string, error) {
 	return supportfile.MimeType(r.fullPath(file))
 }
@@ -182,6 +235,25 @@ func (r *Local) Path(file string) string {
 	return r.fullPath(file)
 }
 
+func (r *Local) Prepend(file, content string) error {
+	fullPath := r.fullPath(file)
+	original, err := os.Open(fullPath)
+	if os.IsNotExist(err) {
+		return r.Put(file, content)
+	}
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(original.Close)
+
+	info, err := original.Stat()
+	if err != nil {
+		return err
+	}
+
+	return r.writeAtomically(fullPath, io.MultiReader(strings.NewReader(content), original), info.Mode().Perm())
+}
+
 func (r *Local) Put(file, content string) error {
 	file = r.fullPath(file)
 	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
@@ -223,18 +295,60 @@ func (r *Local) PutFileAs(filePath string, source filesystem.File, name string)
 	return fullPath, nil
 }
 
+func (r *Local) ReadStream(file string) (io.ReadCloser, error) {
+	return os.Open(r.fullPath(file))
+}
+
+func (r *Local) SetVisibility(file string, visibility filesystem.Visibility) error {
+	return os.Chmod(r.fullPath(file), modeOfVisibility(visibility))
+}
+
 func (r *Local) Size(file string) (int64, error) {
 	return supportfile.Size(r.fullPath(file))
 }
 
+// TemporaryUrl generates a signed URL served by the framework if the "serve" option of the disk is enabled,
+// otherwise, the public URL of the file is returned.
 func (r *Local) TemporaryUrl(file string, time time.Time) (string, error) {
-	return r.Url(file), nil
+	if !r.config.GetBool(fmt.Sprintf("filesystems.disks.%s.serve", r.disk)) {
+		return r.Url(file), nil
+	}
+
+	return temporaryUrl(r.config, r.disk, file, time)
+}
+
+func (r *Local) Visibility(file string) (filesystem.Visibility, error) {
+	info, err := os.Stat(r.fullPath(file))
+	if err != nil {
+		return "", err
+	}
+
+	return visibilityOfMode(info.Mode()), nil
 }
 
 func (r *Local) WithContext(ctx context.Context) filesystem.Driver {
 	return r
 }
 
+// WriteStream writes the file, the content type and the metadata can't be stored by the local
+// driver, an error is returned if they are set.
+func (r *Local) WriteStream(file string, reader io.Reader, options ...filesystem.WriteOptions) error {
+	mode := localPublicFileMode
+	if len(options) > 0 {
+		if options[0].ContentType != "" {
+			return errors.FilesystemWriteOptionNotSupported.Args("ContentType", "local")
+		}
+		if len(options[0].Metadata) > 0 {
+			return errors.FilesystemWriteOptionNotSupported.Args("Metadata", "local")
+		}
+		if options[0].Visibility != "" {
+			mode = modeOfVisibility(options[0].Visibility)
+		}
+	}
+
+	return r.writeAtomically(r.fullPath(file), reader, mode)
+}
+
 func (r *Local) Url(file string) string {
 	return strings.TrimSuffix(r.url, "/") + "/" + strings.TrimPrefix(filepath.ToSlash(file), "/")
 }
@@ -252,3 +366,48 @@ func (r *Local) fullPath(path string) string {
 func (r *Local) rootPath() string {
 	return strings.TrimSuffix(r.root, string(filepath.Separator)) + string(filepath.Separator)
 }
+
+// writeAtomically copies the reader to a temporary file in the target directory and renames it to the target,
+// so readers never see a partially written file.
+func (r *Local) writeAtomically(file string, reader io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(func() error {
+		return os.Remove(tmp.Name())
+	})
+
+	if _, err = io.Copy(tmp, reader); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), file)
+}
+
+func modeOfVisibility(visibility filesystem.Visibility) os.FileMode {
+	if visibility == filesystem.VisibilityPrivate {
+		return localPrivateFileMode
+	}
+
+	return localPublicFileMode
+}
+
+func visibilityOfMode(mode os.FileMode) filesystem.Visibility {
+	if mode.Perm()&0o044 != 0 {
+		return filesystem.VisibilityPublic
+	}
+
+	return filesystem.VisibilityPrivate
+}
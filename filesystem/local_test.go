@@ -2,18 +2,25 @@ package filesystem
 
 import (
 	"context"
+	"io"
 	"mime"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/errors"
 	configmock "github.com/goravel/framework/mocks/config"
 	"github.com/goravel/framework/support/carbon"
 	"github.com/goravel/framework/support/env"
 	"github.com/goravel/framework/support/file"
+	supporthttp "github.com/goravel/framework/support/http"
 )
 
 type LocalTestSuite struct {
@@ -143,6 +150,15 @@ func (s *LocalTestSuite) TestAllFiles() {
 	s.Nil(s.local.DeleteDirectory("AllFiles"))
 }
 
+func (s *LocalTestSuite) TestAppend() {
+	s.Nil(s.local.Append("Append/1.txt", "Hello"))
+	s.Nil(s.local.Append("Append/1.txt", " Goravel"))
+	data, err := s.local.Get("Append/1.txt")
+	s.Nil(err)
+	s.Equal("Hello Goravel", data)
+	s.Nil(s.local.DeleteDirectory("Append"))
+}
+
 func (s *LocalTestSuite) TestCopy() {
 	s.Nil(s.local.Put("Copy/1.txt", "Goravel"))
 	s.True(s.local.Exists("Copy/1.txt"))
@@ -290,6 +306,21 @@ func (s *LocalTestSuite) TestMakeDirectory() {
 	s.Nil(s.local.DeleteDirectory("MakeDirectory4"))
 }
 
+func (s *LocalTestSuite) TestMetadata() {
+	s.mockConfig.On("GetString", "app.timezone").Return("UTC").Once()
+
+	s.Nil(s.local.Put("Metadata/1.txt", "Goravel"))
+	metadata, err := s.local.Metadata("Metadata/1.txt")
+	s.Nil(err)
+	s.Equal(int64(7), metadata.Size)
+	s.Equal("text/plain; charset=utf-8", metadata.ContentType)
+	s.False(metadata.LastModified.IsZero())
+	s.Nil(s.local.DeleteDirectory("Metadata"))
+
+	_, err = s.local.Metadata("Metadata/2.txt")
+	s.True(os.IsNotExist(err))
+}
+
 func (s *LocalTestSuite) TestMimeType_File() {
 	s.Nil(s.local.Put("MimeType/1.txt", "Goravel"))
 	s.True(s.local.Exists("MimeType/1.txt"))
@@ -336,6 +367,19 @@ func (s *LocalTestSuite) TestPath() {
 	s.Equal(filepath.Join(s.local.root, "test.txt"), path)
 }
 
+func (s *LocalTestSuite) TestPrepend() {
+	s.Nil(s.local.Prepend("Prepend/1.txt", "Goravel"))
+	s.Nil(s.local.Prepend("Prepend/1.txt", "Hello "))
+	data, err := s.local.Get("Prepend/1.txt")
+	s.Nil(err)
+	s.Equal("Hello Goravel", data)
+
+	files, err := s.local.Files("Prepend")
+	s.Nil(err)
+	s.Equal([]string{"1.txt"}, files)
+	s.Nil(s.local.DeleteDirectory("Prepend"))
+}
+
 func (s *LocalTestSuite) TestPut() {
 	s.Nil(s.local.Put("Put/1.txt", "Goravel"))
 	s.True(s.local.Exists("Put/1.txt"))
@@ -402,6 +446,20 @@ func (s *LocalTestSuite) TestPutFileAs_Image() {
 	s.Nil(s.local.DeleteDirectory("PutFileAs1"))
 }
 
+func (s *LocalTestSuite) TestReadStream() {
+	s.Nil(s.local.Put("ReadStream/1.txt", "Goravel"))
+	reader, err := s.local.ReadStream("ReadStream/1.txt")
+	s.Nil(err)
+	data, err := io.ReadAll(reader)
+	s.Nil(err)
+	s.Nil(reader.Close())
+	s.Equal("Goravel", string(data))
+	s.Nil(s.local.DeleteDirectory("ReadStream"))
+
+	_, err = s.local.ReadStream("ReadStream/2.txt")
+	s.True(os.IsNotExist(err))
+}
+
 func (s *LocalTestSuite) TestSize() {
 	s.Nil(s.local.Put("Size/1.txt", "Goravel"))
 	s.True(s.local.Exists("Size/1.txt"))
@@ -412,12 +470,55 @@ func (s *LocalTestSuite) TestSize() {
 }
 
 func (s *LocalTestSuite) TestTemporaryUrl() {
+	s.mockConfig.On("GetBool", "filesystems.disks.local.serve").Return(false).Once()
+
 	s.Nil(s.local.Put("TemporaryUrl/1.txt", "Goravel"))
 	s.True(s.local.Exists("TemporaryUrl/1.txt"))
 	url, err := s.local.TemporaryUrl("TemporaryUrl/1.txt", carbon.Now().AddSeconds(5).StdTime())
 	s.Nil(err)
-	s.NotEmpty(url)
+	s.Equal("https://goravel.dev/TemporaryUrl/1.txt", url)
 	s.Nil(s.local.DeleteDirectory("TemporaryUrl"))
+
+	s.mockConfig.AssertExpectations(s.T())
+}
+
+func (s *LocalTestSuite) TestTemporaryUrl_Serve() {
+	s.mockConfig.On("GetBool", "filesystems.disks.local.serve").Return(true).Once()
+	s.mockConfig.On("GetString", "app.url").Return("https://goravel.dev/").Once()
+	s.mockConfig.On("GetString", "filesystems.temporary_url_path", "/_storage").Return("/_storage").Once()
+	s.mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012").Once()
+
+	expiration := carbon.Now().AddMinutes(5).StdTime()
+	temporaryUrl, err := s.local.TemporaryUrl("TemporaryUrl/1.txt", expiration)
+	s.Nil(err)
+
+	u, err := url.Parse(temporaryUrl)
+	s.Nil(err)
+	s.Equal("goravel.dev", u.Host)
+	s.Equal("/_storage/local", u.Path)
+	s.Equal("TemporaryUrl/1.txt", u.Query().Get("path"))
+	s.Equal(strconv.FormatInt(expiration.Unix(), 10), u.Query().Get("expires"))
+	s.Nil(supporthttp.VerifyURL("12345678901234567890123456789012", u, carbon.Now().StdTime()))
+
+	s.mockConfig.AssertExpectations(s.T())
+}
+
+func (s *LocalTestSuite) TestVisibility() {
+	if env.IsWindows() {
+		s.T().Skip("Skip visibility test on Windows")
+	}
+
+	s.Nil(s.local.Put("Visibility/1.txt", "Goravel"))
+	s.Nil(s.local.SetVisibility("Visibility/1.txt", filesystem.VisibilityPrivate))
+	visibility, err := s.local.Visibility("Visibility/1.txt")
+	s.Nil(err)
+	s.Equal(filesystem.VisibilityPrivate, visibility)
+
+	s.Nil(s.local.SetVisibility("Visibility/1.txt", filesystem.VisibilityPublic))
+	visibility, err = s.local.Visibility("Visibility/1.txt")
+	s.Nil(err)
+	s.Equal(filesystem.VisibilityPublic, visibility)
+	s.Nil(s.local.DeleteDirectory("Visibility"))
 }
 
 func (s *LocalTestSuite) TestWithContext() {
@@ -425,6 +526,39 @@ func (s *LocalTestSuite) TestWithContext() {
 	s.Equal(s.local, driver)
 }
 
+func (s *LocalTestSuite) TestWriteStream() {
+	s.Nil(s.local.WriteStream("WriteStream/1.txt", strings.NewReader("Goravel")))
+	data, err := s.local.Get("WriteStream/1.txt")
+	s.Nil(err)
+	s.Equal("Goravel", data)
+
+	s.Nil(s.local.WriteStream("WriteStream/1.txt", strings.NewReader("Hello Goravel"), filesystem.WriteOptions{
+		Visibility: filesystem.VisibilityPrivate,
+	}))
+	data, err = s.local.Get("WriteStream/1.txt")
+	s.Nil(err)
+	s.Equal("Hello Goravel", data)
+	if !env.IsWindows() {
+		visibility, err := s.local.Visibility("WriteStream/1.txt")
+		s.Nil(err)
+		s.Equal(filesystem.VisibilityPrivate, visibility)
+	}
+
+	files, err := s.local.Files("WriteStream")
+	s.Nil(err)
+	s.Equal([]string{"1.txt"}, files)
+
+	// The content type and the metadata can't be stored by the local driver.
+	s.ErrorIs(s.local.WriteStream("WriteStream/2.txt", strings.NewReader("Goravel"), filesystem.WriteOptions{
+		ContentType: "text/plain",
+	}), errors.FilesystemWriteOptionNotSupported)
+	s.ErrorIs(s.local.WriteStream("WriteStream/2.txt", strings.NewReader("Goravel"), filesystem.WriteOptions{
+		Metadata: map[string]string{"author": "goravel"},
+	}), errors.FilesystemWriteOptionNotSupported)
+	s.False(s.local.Exists("WriteStream/2.txt"))
+	s.Nil(s.local.DeleteDirectory("WriteStream"))
+}
+
 func (s *LocalTestSuite) TestUrl() {
 	s.Equal("https://goravel.dev/Url/1.txt", s.local.Url("Url/1.txt"))
 
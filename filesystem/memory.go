@@ -0,0 +1,518 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/str"
+)
+
+type memoryFile struct {
+	content      []byte
+	contentType  string
+	lastModified time.Time
+	metadata     map[string]string
+	visibility   filesystem.Visibility
+}
+
+// memoryReader is a seekable reader of the file, so the range requests can be served.
+type memoryReader struct {
+	*bytes.Reader
+}
+
+func (r memoryReader) Close() error {
+	return nil
+}
+
+var _ filesystem.DriverWithStream = &Memory{}
+
+// Memory is a disk that keeps the files in memory, the files are lost when the process exits.
+type Memory struct {
+	directories map[string]struct{}
+	files       map[string]*memoryFile
+	mu          sync.RWMutex
+	url         string
+}
+
+func NewMemory(config config.Config, disk string) (*Memory, error) {
+	return &Memory{
+		directories: make(map[string]struct{}),
+		files:       make(map[string]*memoryFile),
+		url:         config.GetString(fmt.Sprintf("filesystems.disks.%s.url", disk)),
+	}, nil
+}
+
+func (r *Memory) AllDirectories(path string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.directoriesIn(memoryPath(path), true), nil
+}
+
+func (r *Memory) AllFiles(path string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.filesIn(memoryPath(path), true), nil
+}
+
+func (r *Memory) Append(file, content string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file = memoryPath(file)
+	var existing []byte
+	if f, exist := r.files[file]; exist {
+		existing = f.content
+	}
+
+	r.put(file, append(slices.Clone(existing), content...), filesystem.WriteOptions{})
+
+	return nil
+}
+
+func (r *Memory) Copy(originFile, targetFile string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, exist := r.files[memoryPath(originFile)]
+	if !exist {
+		return errors.FilesystemFileNotExist
+	}
+
+	r.put(memoryPath(targetFile), slices.Clone(f.content), filesystem.WriteOptions{
+		Visibility:  f.visibility,
+		ContentType: f.contentType,
+		Metadata:    maps.Clone(f.metadata),
+	})
+
+	return nil
+}
+
+func (r *Memory) Delete(files ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, file := range files {
+		file = memoryPath(file)
+		if _, exist := r.files[file]; exist {
+			continue
+		}
+		if r.isDirectory(file) {
+			return errors.FilesystemDeleteDirectory
+		}
+
+		return errors.FilesystemFileNotExist
+	}
+
+	for _, file := range files {
+		delete(r.files, memoryPath(file))
+	}
+
+	return nil
+}
+
+func (r *Memory) DeleteDirectory(directory string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	directory = memoryPath(directory)
+	for file := range r.files {
+		if isMemoryChild(directory, file) {
+			delete(r.files, file)
+		}
+	}
+	for dir := range r.directories {
+		if dir == directory || isMemoryChild(directory, dir) {
+			delete(r.directories, dir)
+		}
+	}
+
+	return nil
+}
+
+func (r *Memory) Directories(path string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.directoriesIn(memoryPath(path), false), nil
+}
+
+func (r *Memory) Exists(file string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	file = memoryPath(file)
+	if _, exist := r.files[file]; exist {
+		return true
+	}
+
+	return r.isDirectory(file)
+}
+
+func (r *Memory) Files(path string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.filesIn(memoryPath(path), false), nil
+}
+
+func (r *Memory) Get(file string) (string, error) {
+	data, err := r.GetBytes(file)
+
+	return string(data), err
+}
+
+func (r *Memory) GetBytes(file string) ([]byte, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(f.content), nil
+}
+
+func (r *Memory) LastModified(file string) (time.Time, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return f.lastModified, nil
+}
+
+func (r *Memory) MakeDirectory(directory string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	directory = memoryPath(directory)
+	for directory != "" {
+		r.directories[directory] = struct{}{}
+		directory = memoryParent(directory)
+	}
+
+	return nil
+}
+
+func (r *Memory) Metadata(file string) (filesystem.Metadata, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return filesystem.Metadata{}, err
+	}
+
+	return filesystem.Metadata{
+		ContentType:  f.contentType,
+		LastModified: f.lastModified,
+		Metadata:     maps.Clone(f.metadata),
+		Size:         int64(len(f.content)),
+		Visibility:   f.visibility,
+	}, nil
+}
+
+func (r *Memory) MimeType(file string) (string, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return "", err
+	}
+
+	return f.contentType, nil
+}
+
+func (r *Memory) Missing(file string) bool {
+	return !r.Exists(file)
+}
+
+func (r *Memory) Move(oldFile, newFile string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	oldFile = memoryPath(oldFile)
+	f, exist := r.files[oldFile]
+	if !exist {
+		return errors.FilesystemFileNotExist
+	}
+
+	delete(r.files, oldFile)
+	newFile = memoryPath(newFile)
+	r.files[newFile] = f
+	r.makeParents(newFile)
+
+	return nil
+}
+
+func (r *Memory) Path(file string) string {
+	return memoryPath(file)
+}
+
+func (r *Memory) Prepend(file, content string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file = memoryPath(file)
+	data := []byte(content)
+	if f, exist := r.files[file]; exist {
+		data = append(data, f.content...)
+	}
+
+	r.put(file, data, filesystem.WriteOptions{})
+
+	return nil
+}
+
+func (r *Memory) Put(file, content string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(memoryPath(file), []byte(content), filesystem.WriteOptions{})
+
+	return nil
+}
+
+func (r *Memory) PutFile(filePath string, source filesystem.File) (string, error) {
+	return r.PutFileAs(filePath, source, str.Random(40))
+}
+
+func (r *Memory) PutFileAs(filePath string, source filesystem.File, name string) (string, error) {
+	data, err := os.ReadFile(source.File())
+	if err != nil {
+		return "", err
+	}
+
+	fullPath, err := fullPathOfFile(filePath, source, name)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.Put(fullPath, string(data)); err != nil {
+		return "", err
+	}
+
+	return fullPath, nil
+}
+
+func (r *Memory) ReadStream(file string) (io.ReadCloser, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// The content is never modified in place, so the reader is safe even if the file is overwritten.
+	return memoryReader{Reader: bytes.NewReader(f.content)}, nil
+}
+
+func (r *Memory) SetVisibility(file string, visibility filesystem.Visibility) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file = memoryPath(file)
+	f, exist := r.files[file]
+	if !exist {
+		return errors.FilesystemFileNotExist
+	}
+
+	// The files are never modified in place, since they are read without the lock after being got.
+	updated := *f
+	updated.visibility = visibility
+	r.files[file] = &updated
+
+	return nil
+}
+
+func (r *Memory) Size(file string) (int64, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(f.content)), nil
+}
+
+func (r *Memory) TemporaryUrl(file string, time time.Time) (string, error) {
+	return r.Url(file), nil
+}
+
+func (r *Memory) Visibility(file string) (filesystem.Visibility, error) {
+	f, err := r.file(file)
+	if err != nil {
+		return "", err
+	}
+
+	return f.visibility, nil
+}
+
+func (r *Memory) WithContext(ctx context.Context) filesystem.Driver {
+	return r
+}
+
+func (r *Memory) WriteStream(file string, reader io.Reader, options ...filesystem.WriteOptions) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	var option filesystem.WriteOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(memoryPath(file), data, option)
+
+	return nil
+}
+
+func (r *Memory) Url(file string) string {
+	return strings.TrimSuffix(r.url, "/") + "/" + memoryPath(file)
+}
+
+func (r *Memory) directoriesIn(directory string, recursive bool) []string {
+	found := make(map[string]struct{})
+	add := func(dir string) {
+		if !isMemoryChild(directory, dir) {
+			return
+		}
+
+		relative := strings.TrimPrefix(strings.TrimPrefix(dir, directory), "/")
+		if !recursive {
+			relative, _, _ = strings.Cut(relative, "/")
+			found[relative+string(filepath.Separator)] = struct{}{}
+			return
+		}
+
+		for relative != "" && relative != "." {
+			found[filepath.FromSlash(relative)+string(filepath.Separator)] = struct{}{}
+			relative = path.Dir(relative)
+		}
+	}
+
+	for dir := range r.directories {
+		add(dir)
+	}
+	for file := range r.files {
+		add(memoryParent(file))
+	}
+
+	directories := slices.Collect(maps.Keys(found))
+	slices.Sort(directories)
+
+	return directories
+}
+
+func (r *Memory) file(file string) (*memoryFile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, exist := r.files[memoryPath(file)]
+	if !exist {
+		return nil, errors.FilesystemFileNotExist
+	}
+
+	return f, nil
+}
+
+func (r *Memory) filesIn(directory string, recursive bool) []string {
+	var files []string
+	for file := range r.files {
+		if !isMemoryChild(directory, file) {
+			continue
+		}
+
+		relative := strings.TrimPrefix(strings.TrimPrefix(file, directory), "/")
+		if !recursive && strings.Contains(relative, "/") {
+			continue
+		}
+
+		files = append(files, filepath.FromSlash(relative))
+	}
+	slices.Sort(files)
+
+	return files
+}
+
+func (r *Memory) isDirectory(directory string) bool {
+	if directory == "" {
+		return true
+	}
+	if _, exist := r.directories[directory]; exist {
+		return true
+	}
+	for file := range r.files {
+		if isMemoryChild(directory, file) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Memory) makeParents(file string) {
+	for dir := memoryParent(file); dir != ""; dir = memoryParent(dir) {
+		r.directories[dir] = struct{}{}
+	}
+}
+
+// put stores the file, the caller must hold the write lock.
+func (r *Memory) put(file string, content []byte, options filesystem.WriteOptions) {
+	visibility := options.Visibility
+	if visibility == "" {
+		visibility = filesystem.VisibilityPublic
+		if f, exist := r.files[file]; exist {
+			visibility = f.visibility
+		}
+	}
+
+	contentType := options.ContentType
+	if contentType == "" {
+		contentType = mimetype.Detect(content).String()
+	}
+
+	r.files[file] = &memoryFile{
+		content:      content,
+		contentType:  contentType,
+		lastModified: time.Now(),
+		metadata:     options.Metadata,
+		visibility:   visibility,
+	}
+	r.makeParents(file)
+}
+
+// memoryPath normalizes the path to the key used by the memory disk, eg: "/a/./b/" -> "a/b".
+func memoryPath(file string) string {
+	file = path.Clean("/" + filepath.ToSlash(file))
+
+	return strings.TrimPrefix(file, "/")
+}
+
+func memoryParent(file string) string {
+	parent := path.Dir(file)
+	if parent == "." || parent == "/" {
+		return ""
+	}
+
+	return parent
+}
+
+func isMemoryChild(directory, file string) bool {
+	if directory == "" {
+		return file != ""
+	}
+
+	return strings.HasPrefix(file, directory+"/")
+}
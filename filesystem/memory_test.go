@@ -0,0 +1,202 @@
+package filesystem
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+)
+
+type MemoryTestSuite struct {
+	suite.Suite
+	memory *Memory
+}
+
+func TestMemoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryTestSuite))
+}
+
+func (s *MemoryTestSuite) SetupTest() {
+	mockConfig := mocksconfig.NewConfig(s.T())
+	mockConfig.EXPECT().GetString("filesystems.disks.memory.url").Return("https://goravel.dev").Once()
+
+	memory, err := NewMemory(mockConfig, "memory")
+	s.Nil(err)
+
+	s.memory = memory
+}
+
+func (s *MemoryTestSuite) TestAllDirectoriesAndAllFiles() {
+	s.Nil(s.memory.Put("AllFiles/1.txt", "Goravel"))
+	s.Nil(s.memory.Put("AllFiles/3/3.txt", "Goravel"))
+	s.Nil(s.memory.Put("AllFiles/3/5/6/6.txt", "Goravel"))
+	s.Nil(s.memory.MakeDirectory("AllFiles/3/4"))
+
+	directories, err := s.memory.AllDirectories("AllFiles")
+	s.Nil(err)
+	s.Equal([]string{
+		"3" + string(filepath.Separator),
+		filepath.Join("3", "4") + string(filepath.Separator),
+		filepath.Join("3", "5") + string(filepath.Separator),
+		filepath.Join("3", "5", "6") + string(filepath.Separator),
+	}, directories)
+
+	files, err := s.memory.AllFiles("AllFiles")
+	s.Nil(err)
+	s.Equal([]string{"1.txt", filepath.Join("3", "3.txt"), filepath.Join("3", "5", "6", "6.txt")}, files)
+}
+
+func (s *MemoryTestSuite) TestAppendAndPrepend() {
+	s.Nil(s.memory.Append("1.txt", "Goravel"))
+	s.Nil(s.memory.Append("1.txt", "!"))
+	s.Nil(s.memory.Prepend("1.txt", "Hello "))
+
+	data, err := s.memory.Get("1.txt")
+	s.Nil(err)
+	s.Equal("Hello Goravel!", data)
+}
+
+func (s *MemoryTestSuite) TestCopyAndMove() {
+	s.Nil(s.memory.Put("Copy/1.txt", "Goravel"))
+	s.Nil(s.memory.Copy("Copy/1.txt", "Copy1/1.txt"))
+	s.True(s.memory.Exists("Copy/1.txt"))
+	s.True(s.memory.Exists("Copy1/1.txt"))
+
+	s.Nil(s.memory.Move("Copy/1.txt", "Move/1.txt"))
+	s.True(s.memory.Missing("Copy/1.txt"))
+	data, err := s.memory.Get("Move/1.txt")
+	s.Nil(err)
+	s.Equal("Goravel", data)
+
+	s.ErrorIs(s.memory.Copy("Copy/2.txt", "Copy1/2.txt"), errors.FilesystemFileNotExist)
+	s.ErrorIs(s.memory.Move("Copy/2.txt", "Move/2.txt"), errors.FilesystemFileNotExist)
+}
+
+func (s *MemoryTestSuite) TestDelete() {
+	s.Nil(s.memory.Put("Delete/1.txt", "Goravel"))
+	s.ErrorIs(s.memory.Delete("Delete"), errors.FilesystemDeleteDirectory)
+	s.ErrorIs(s.memory.Delete("Delete/2.txt"), errors.FilesystemFileNotExist)
+	s.Nil(s.memory.Delete("Delete/1.txt"))
+	s.True(s.memory.Missing("Delete/1.txt"))
+}
+
+func (s *MemoryTestSuite) TestDeleteDirectory() {
+	s.Nil(s.memory.Put("DeleteDirectory/1.txt", "Goravel"))
+	s.Nil(s.memory.Put("DeleteDirectory/2/2.txt", "Goravel"))
+	s.Nil(s.memory.Put("DeleteDirectory1/1.txt", "Goravel"))
+	s.Nil(s.memory.DeleteDirectory("DeleteDirectory"))
+	s.True(s.memory.Missing("DeleteDirectory"))
+	s.True(s.memory.Missing("DeleteDirectory/2/2.txt"))
+	s.True(s.memory.Exists("DeleteDirectory1/1.txt"))
+}
+
+func (s *MemoryTestSuite) TestDirectoriesAndFiles() {
+	s.Nil(s.memory.Put("Files/1.txt", "Goravel"))
+	s.Nil(s.memory.Put("Files/2/2.txt", "Goravel"))
+	s.Nil(s.memory.MakeDirectory("Files/3"))
+
+	directories, err := s.memory.Directories("Files")
+	s.Nil(err)
+	s.Equal([]string{"2" + string(filepath.Separator), "3" + string(filepath.Separator)}, directories)
+
+	files, err := s.memory.Files("/Files/")
+	s.Nil(err)
+	s.Equal([]string{"1.txt"}, files)
+}
+
+func (s *MemoryTestSuite) TestGet() {
+	s.Nil(s.memory.Put("Get/1.txt", "Goravel"))
+	s.True(s.memory.Exists("./Get/../Get/1.txt"))
+
+	data, err := s.memory.GetBytes("Get/1.txt")
+	s.Nil(err)
+	s.Equal([]byte("Goravel"), data)
+
+	_, err = s.memory.Get("Get/2.txt")
+	s.ErrorIs(err, errors.FilesystemFileNotExist)
+}
+
+func (s *MemoryTestSuite) TestMetadata() {
+	s.Nil(s.memory.WriteStream("Metadata/1.json", strings.NewReader(`{"name":"Goravel"}`), filesystem.WriteOptions{
+		Visibility: filesystem.VisibilityPrivate,
+		Metadata:   map[string]string{"owner": "1"},
+	}))
+
+	metadata, err := s.memory.Metadata("Metadata/1.json")
+	s.Nil(err)
+	s.Equal("application/json", metadata.ContentType)
+	s.Equal(int64(18), metadata.Size)
+	s.Equal(filesystem.VisibilityPrivate, metadata.Visibility)
+	s.Equal(map[string]string{"owner": "1"}, metadata.Metadata)
+	s.False(metadata.LastModified.IsZero())
+
+	mimeType, err := s.memory.MimeType("Metadata/1.json")
+	s.Nil(err)
+	s.Equal("application/json", mimeType)
+
+	size, err := s.memory.Size("Metadata/1.json")
+	s.Nil(err)
+	s.Equal(int64(18), size)
+}
+
+func (s *MemoryTestSuite) TestPutFileAs() {
+	mockFile := mocksfilesystem.NewFile(s.T())
+	mockFile.EXPECT().File().Return("./file.go").Once()
+
+	path, err := s.memory.PutFileAs("PutFileAs", mockFile, "file.go")
+	s.Nil(err)
+	s.Equal(filepath.Join("PutFileAs", "file.go"), path)
+
+	data, err := s.memory.Get(path)
+	s.Nil(err)
+	s.Contains(data, "package filesystem")
+}
+
+func (s *MemoryTestSuite) TestReadStream() {
+	s.Nil(s.memory.Put("ReadStream/1.txt", "Goravel"))
+	reader, err := s.memory.ReadStream("ReadStream/1.txt")
+	s.Nil(err)
+
+	s.Nil(s.memory.Put("ReadStream/1.txt", "Overwritten"))
+
+	data, err := io.ReadAll(reader)
+	s.Nil(err)
+	s.Nil(reader.Close())
+	s.Equal("Goravel", string(data))
+}
+
+func (s *MemoryTestSuite) TestUrl() {
+	s.Equal("https://goravel.dev/Url/1.txt", s.memory.Url("/Url/1.txt"))
+
+	url, err := s.memory.TemporaryUrl("Url/1.txt", time.Now())
+	s.Nil(err)
+	s.Equal("https://goravel.dev/Url/1.txt", url)
+}
+
+func (s *MemoryTestSuite) TestVisibility() {
+	s.Nil(s.memory.Put("Visibility/1.txt", "Goravel"))
+	visibility, err := s.memory.Visibility("Visibility/1.txt")
+	s.Nil(err)
+	s.Equal(filesystem.VisibilityPublic, visibility)
+
+	s.Nil(s.memory.SetVisibility("Visibility/1.txt", filesystem.VisibilityPrivate))
+	s.Nil(s.memory.Put("Visibility/1.txt", "Hello Goravel"))
+	visibility, err = s.memory.Visibility("Visibility/1.txt")
+	s.Nil(err)
+	s.Equal(filesystem.VisibilityPrivate, visibility)
+
+	s.ErrorIs(s.memory.SetVisibility("Visibility/2.txt", filesystem.VisibilityPrivate), errors.FilesystemFileNotExist)
+}
+
+func (s *MemoryTestSuite) TestWithContext() {
+	s.Same(s.memory, s.memory.WithContext(context.Background()))
+}
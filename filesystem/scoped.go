@@ -0,0 +1,303 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/errors"
+)
+
+var _ filesystem.DriverWithStream = &Scoped{}
+
+// Scoped is a disk that wraps another disk, all the paths are prefixed with the given prefix,
+// and the paths can't escape the prefix. The writes are rejected if the disk is read-only.
+type Scoped struct {
+	disk     string
+	driver   filesystem.Driver
+	prefix   string
+	readOnly bool
+}
+
+func NewScoped(config config.Config, disk string, resolve func(disk string) (filesystem.Driver, error)) (*Scoped, error) {
+	parent := config.GetString(fmt.Sprintf("filesystems.disks.%s.disk", disk))
+	if parent == "" {
+		return nil, errors.FilesystemScopedDiskNotSet.Args(disk)
+	}
+	if parent == disk {
+		return nil, errors.FilesystemScopedDiskRecursive.Args(disk)
+	}
+
+	driver, err := resolve(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Scoped{
+		disk:     disk,
+		driver:   driver,
+		prefix:   strings.Trim(filepath.ToSlash(config.GetString(fmt.Sprintf("filesystems.disks.%s.prefix", disk))), "/"),
+		readOnly: config.GetBool(fmt.Sprintf("filesystems.disks.%s.read_only", disk)),
+	}, nil
+}
+
+func (r *Scoped) AllDirectories(path string) ([]string, error) {
+	return r.driver.AllDirectories(r.path(path))
+}
+
+func (r *Scoped) AllFiles(path string) ([]string, error) {
+	return r.driver.AllFiles(r.path(path))
+}
+
+func (r *Scoped) Append(file, content string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	driver, err := r.stream()
+	if err != nil {
+		return err
+	}
+
+	return driver.Append(r.path(file), content)
+}
+
+func (r *Scoped) Copy(originFile, targetFile string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	return r.driver.Copy(r.path(originFile), r.path(targetFile))
+}
+
+func (r *Scoped) Delete(files ...string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = r.path(file)
+	}
+
+	return r.driver.Delete(paths...)
+}
+
+func (r *Scoped) DeleteDirectory(directory string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	return r.driver.DeleteDirectory(r.path(directory))
+}
+
+func (r *Scoped) Directories(path string) ([]string, error) {
+	return r.driver.Directories(r.path(path))
+}
+
+func (r *Scoped) Exists(file string) bool {
+	return r.driver.Exists(r.path(file))
+}
+
+func (r *Scoped) Files(path string) ([]string, error) {
+	return r.driver.Files(r.path(path))
+}
+
+func (r *Scoped) Get(file string) (string, error) {
+	return r.driver.Get(r.path(file))
+}
+
+func (r *Scoped) GetBytes(file string) ([]byte, error) {
+	return r.driver.GetBytes(r.path(file))
+}
+
+func (r *Scoped) LastModified(file string) (time.Time, error) {
+	return r.driver.LastModified(r.path(file))
+}
+
+func (r *Scoped) MakeDirectory(directory string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	return r.driver.MakeDirectory(r.path(directory))
+}
+
+func (r *Scoped) Metadata(file string) (filesystem.Metadata, error) {
+	driver, err := r.stream()
+	if err != nil {
+		return filesystem.Metadata{}, err
+	}
+
+	return driver.Metadata(r.path(file))
+}
+
+func (r *Scoped) MimeType(file string) (string, error) {
+	return r.driver.MimeType(r.path(file))
+}
+
+func (r *Scoped) Missing(file string) bool {
+	return r.driver.Missing(r.path(file))
+}
+
+func (r *Scoped) Move(oldFile, newFile string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	return r.driver.Move(r.path(oldFile), r.path(newFile))
+}
+
+func (r *Scoped) Path(file string) string {
+	return r.driver.Path(r.path(file))
+}
+
+func (r *Scoped) Prepend(file, content string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	driver, err := r.stream()
+	if err != nil {
+		return err
+	}
+
+	return driver.Prepend(r.path(file), content)
+}
+
+func (r *Scoped) Put(file, content string) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	return r.driver.Put(r.path(file), content)
+}
+
+func (r *Scoped) PutFile(filePath string, source filesystem.File) (string, error) {
+	if err := r.writable(); err != nil {
+		return "", err
+	}
+
+	file, err := r.driver.PutFile(r.path(filePath), source)
+	if err != nil {
+		return "", err
+	}
+
+	return r.unprefix(file), nil
+}
+
+func (r *Scoped) PutFileAs(filePath string, source filesystem.File, name string) (string, error) {
+	if err := r.writable(); err != nil {
+		return "", err
+	}
+
+	file, err := r.driver.PutFileAs(r.path(filePath), source, name)
+	if err != nil {
+		return "", err
+	}
+
+	return r.unprefix(file), nil
+}
+
+func (r *Scoped) ReadStream(file string) (io.ReadCloser, error) {
+	driver, err := r.stream()
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.ReadStream(r.path(file))
+}
+
+func (r *Scoped) SetVisibility(file string, visibility filesystem.Visibility) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	driver, err := r.stream()
+	if err != nil {
+		return err
+	}
+
+	return driver.SetVisibility(r.path(file), visibility)
+}
+
+func (r *Scoped) Size(file string) (int64, error) {
+	return r.driver.Size(r.path(file))
+}
+
+func (r *Scoped) TemporaryUrl(file string, time time.Time) (string, error) {
+	return r.driver.TemporaryUrl(r.path(file), time)
+}
+
+func (r *Scoped) Visibility(file string) (filesystem.Visibility, error) {
+	driver, err := r.stream()
+	if err != nil {
+		return "", err
+	}
+
+	return driver.Visibility(r.path(file))
+}
+
+func (r *Scoped) WithContext(ctx context.Context) filesystem.Driver {
+	return &Scoped{
+		disk:     r.disk,
+		driver:   r.driver.WithContext(ctx),
+		prefix:   r.prefix,
+		readOnly: r.readOnly,
+	}
+}
+
+func (r *Scoped) WriteStream(file string, reader io.Reader, options ...filesystem.WriteOptions) error {
+	if err := r.writable(); err != nil {
+		return err
+	}
+
+	driver, err := r.stream()
+	if err != nil {
+		return err
+	}
+
+	return driver.WriteStream(r.path(file), reader, options...)
+}
+
+func (r *Scoped) Url(file string) string {
+	return r.driver.Url(r.path(file))
+}
+
+// path prefixes the file, the file is cleaned first so "../" can't escape the prefix.
+func (r *Scoped) path(file string) string {
+	return strings.TrimPrefix(path.Join(r.prefix, path.Clean("/"+filepath.ToSlash(file))), "/")
+}
+
+func (r *Scoped) unprefix(file string) string {
+	relative := strings.TrimPrefix(filepath.ToSlash(file), "/")
+	if r.prefix != "" {
+		relative = strings.TrimPrefix(relative, r.prefix+"/")
+	}
+
+	return filepath.FromSlash(relative)
+}
+
+// stream gets the wrapped driver if it supports the streams.
+func (r *Scoped) stream() (filesystem.DriverWithStream, error) {
+	driver, ok := r.driver.(filesystem.DriverWithStream)
+	if !ok {
+		return nil, errors.FilesystemStreamNotSupported
+	}
+
+	return driver, nil
+}
+
+func (r *Scoped) writable() error {
+	if r.readOnly {
+		return errors.FilesystemDiskReadOnly.Args(r.disk)
+	}
+
+	return nil
+}
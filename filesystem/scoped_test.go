@@ -0,0 +1,166 @@
+package filesystem
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+)
+
+type ScopedTestSuite struct {
+	suite.Suite
+	memory     *Memory
+	mockConfig *mocksconfig.Config
+}
+
+func TestScopedTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopedTestSuite))
+}
+
+func (s *ScopedTestSuite) SetupTest() {
+	s.mockConfig = mocksconfig.NewConfig(s.T())
+	s.mockConfig.EXPECT().GetString("filesystems.disks.memory.url").Return("https://goravel.dev").Once()
+
+	memory, err := NewMemory(s.mockConfig, "memory")
+	s.Nil(err)
+
+	s.memory = memory
+}
+
+func (s *ScopedTestSuite) TestNewScoped() {
+	s.Run("the wrapped disk is not set", func() {
+		s.mockConfig.EXPECT().GetString("filesystems.disks.tenant.disk").Return("").Once()
+
+		scoped, err := NewScoped(s.mockConfig, "tenant", s.resolve)
+		s.Nil(scoped)
+		s.ErrorIs(err, errors.FilesystemScopedDiskNotSet)
+	})
+
+	s.Run("the wrapped disk can't be resolved", func() {
+		s.mockConfig.EXPECT().GetString("filesystems.disks.tenant.disk").Return("s3").Once()
+
+		scoped, err := NewScoped(s.mockConfig, "tenant", func(disk string) (contractsfilesystem.Driver, error) {
+			return nil, errors.FilesystemDriverNotSupported.Args(disk)
+		})
+		s.Nil(scoped)
+		s.ErrorIs(err, errors.FilesystemDriverNotSupported)
+	})
+}
+
+func (s *ScopedTestSuite) TestPrefix() {
+	scoped := s.scoped("/tenants/1/", false)
+
+	s.Nil(scoped.Put("avatars/1.txt", "Goravel"))
+	s.True(s.memory.Exists("tenants/1/avatars/1.txt"))
+	s.True(scoped.Exists("avatars/1.txt"))
+
+	s.Nil(scoped.Put("../../2/avatars/1.txt", "Escape"))
+	s.True(s.memory.Exists("tenants/1/2/avatars/1.txt"))
+	s.True(s.memory.Missing("tenants/2/avatars/1.txt"))
+
+	s.Nil(scoped.Append("avatars/1.txt", "!"))
+	s.Nil(scoped.Prepend("avatars/1.txt", "Hello "))
+	data, err := scoped.Get("avatars/1.txt")
+	s.Nil(err)
+	s.Equal("Hello Goravel!", data)
+
+	s.Nil(scoped.WriteStream("avatars/2.txt", strings.NewReader("Goravel")))
+	reader, err := scoped.ReadStream("avatars/2.txt")
+	s.Nil(err)
+	data2, err := io.ReadAll(reader)
+	s.Nil(err)
+	s.Nil(reader.Close())
+	s.Equal("Goravel", string(data2))
+
+	files, err := scoped.Files("avatars")
+	s.Nil(err)
+	s.Equal([]string{"1.txt", "2.txt"}, files)
+
+	directories, err := scoped.Directories("")
+	s.Nil(err)
+	s.Equal([]string{"2" + string(filepath.Separator), "avatars" + string(filepath.Separator)}, directories)
+
+	s.Equal("https://goravel.dev/tenants/1/avatars/1.txt", scoped.Url("avatars/1.txt"))
+	s.Equal("tenants/1/avatars/1.txt", scoped.Path("avatars/1.txt"))
+
+	s.Nil(scoped.DeleteDirectory(""))
+	s.True(s.memory.Missing("tenants/1"))
+}
+
+func (s *ScopedTestSuite) TestPutFileAs() {
+	scoped := s.scoped("tenants/1", false)
+	mockFile := mocksfilesystem.NewFile(s.T())
+	mockFile.EXPECT().File().Return("./file.go").Once()
+
+	path, err := scoped.PutFileAs("files", mockFile, "file.go")
+	s.Nil(err)
+	s.Equal(filepath.Join("files", "file.go"), path)
+	s.True(scoped.Exists(path))
+	s.True(s.memory.Exists("tenants/1/files/file.go"))
+}
+
+func (s *ScopedTestSuite) TestReadOnly() {
+	s.Nil(s.memory.Put("tenants/1/1.txt", "Goravel"))
+	scoped := s.scoped("tenants/1", true)
+
+	data, err := scoped.Get("1.txt")
+	s.Nil(err)
+	s.Equal("Goravel", data)
+
+	s.ErrorIs(scoped.Put("2.txt", "Goravel"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.Append("1.txt", "Goravel"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.Prepend("1.txt", "Goravel"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.Copy("1.txt", "2.txt"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.Move("1.txt", "2.txt"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.Delete("1.txt"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.DeleteDirectory(""), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.MakeDirectory("2"), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.SetVisibility("1.txt", contractsfilesystem.VisibilityPrivate), errors.FilesystemDiskReadOnly)
+	s.ErrorIs(scoped.WriteStream("2.txt", strings.NewReader("Goravel")), errors.FilesystemDiskReadOnly)
+	s.EqualError(scoped.Put("2.txt", "Goravel"), "disk tenant is read-only")
+
+	s.True(scoped.Exists("1.txt"))
+	s.True(scoped.Missing("2.txt"))
+}
+
+func (s *ScopedTestSuite) TestWithContext() {
+	scoped := s.scoped("tenants/1", true)
+
+	driver := scoped.WithContext(context.Background())
+	s.NotSame(scoped, driver)
+	s.ErrorIs(driver.Put("1.txt", "Goravel"), errors.FilesystemDiskReadOnly)
+}
+
+func (s *ScopedTestSuite) TestUnprefix() {
+	scoped := s.scoped("tenant", false)
+
+	s.Equal(filepath.FromSlash("avatars/1.txt"), scoped.unprefix("tenant/avatars/1.txt"))
+	s.Equal(filepath.FromSlash("avatars/1.txt"), scoped.unprefix("/tenant/avatars/1.txt"))
+	// The sibling directories sharing the prefix aren't trimmed.
+	s.Equal(filepath.FromSlash("tenant2/avatars/1.txt"), scoped.unprefix("tenant2/avatars/1.txt"))
+}
+
+func (s *ScopedTestSuite) resolve(disk string) (contractsfilesystem.Driver, error) {
+	s.Equal("memory", disk)
+
+	return s.memory, nil
+}
+
+func (s *ScopedTestSuite) scoped(prefix string, readOnly bool) *Scoped {
+	s.mockConfig.EXPECT().GetString("filesystems.disks.tenant.disk").Return("memory").Once()
+	s.mockConfig.EXPECT().GetString("filesystems.disks.tenant.prefix").Return(prefix).Once()
+	s.mockConfig.EXPECT().GetBool("filesystems.disks.tenant.read_only").Return(readOnly).Once()
+
+	scoped, err := NewScoped(s.mockConfig, "tenant", s.resolve)
+	s.Require().NoError(err)
+
+	return scoped
+}
@@ -40,4 +40,19 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 func (r *ServiceProvider) Boot(app foundation.Application) {
 	ConfigFacade = app.MakeConfig()
 	StorageFacade = app.MakeStorage()
+
+	r.registerRoutes(app)
+}
+
+func (r *ServiceProvider) registerRoutes(app foundation.Application) {
+	if ConfigFacade == nil || StorageFacade == nil {
+		return
+	}
+
+	routeFacade := app.MakeRoute()
+	if routeFacade == nil {
+		return
+	}
+
+	RegisterTemporaryUrlRoute(ConfigFacade, routeFacade, StorageFacade)
 }
@@ -31,11 +31,17 @@ func init() {
 		// may even configure multiple disks of the same driver. Defaults have
 		// been set up for each driver as an example of the required values.
 		//
-		// Supported Drivers: "local", "custom"
+		// Supported Drivers: "local", "memory", "scoped", "custom"
+		//
+		// The "scoped" driver wraps another disk with a path prefix, eg:
+		// "tenant": map[string]any{"driver": "scoped", "disk": "local", "prefix": "tenants/1", "read_only": false}
 		"disks": map[string]any{
 			"local": map[string]any{
 				"driver": "local",
 				"root":   path.Storage("app"),
+				// Serve the signed temporary URLs of the disk via the framework router,
+				// the URLs are generated by TemporaryUrl and signed with APP_KEY.
+				"serve": false,
 			},
 			"public": map[string]any{
 				"driver": "local",
@@ -0,0 +1,127 @@
+package filesystem
+
+import (
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/support/carbon"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+const (
+	TemporaryUrlRouteName = "goravel.storage.temporary"
+
+	defaultTemporaryUrlPath = "/_storage"
+)
+
+// RegisterTemporaryUrlRoute registers the route serving the temporary URLs if any disk enables the "serve" option.
+func RegisterTemporaryUrlRoute(config config.Config, route contractsroute.Route, storage filesystem.Storage) {
+	serve := false
+	for disk := range cast.ToStringMap(config.Get("filesystems.disks")) {
+		if config.GetBool(fmt.Sprintf("filesystems.disks.%s.serve", disk)) {
+			serve = true
+			break
+		}
+	}
+	if !serve {
+		return
+	}
+
+	route.Middleware(ValidateTemporaryUrl(config)).
+		Get(temporaryUrlPath(config)+"/{disk}", ServeTemporaryUrl(storage)).
+		Name(TemporaryUrlRouteName)
+}
+
+// ServeTemporaryUrl streams the file of a temporary URL, the range requests are supported if the stream is seekable.
+// The signature should be validated by the ValidateTemporaryUrl middleware.
+func ServeTemporaryUrl(storage filesystem.Storage) contractshttp.HandlerFunc {
+	return func(ctx contractshttp.Context) contractshttp.Response {
+		file := ctx.Request().Query("path")
+		driver, ok := storage.Disk(ctx.Request().Route("disk")).(filesystem.DriverWithStream)
+		if !ok {
+			return ctx.Response().String(contractshttp.StatusNotFound, nethttp.StatusText(contractshttp.StatusNotFound))
+		}
+
+		metadata, err := driver.Metadata(file)
+		if err != nil {
+			return ctx.Response().String(contractshttp.StatusNotFound, nethttp.StatusText(contractshttp.StatusNotFound))
+		}
+
+		reader, err := driver.ReadStream(file)
+		if err != nil {
+			return ctx.Response().String(contractshttp.StatusNotFound, nethttp.StatusText(contractshttp.StatusNotFound))
+		}
+		defer func() {
+			_ = reader.Close()
+		}()
+
+		writer := ctx.Response().Writer()
+		if metadata.ContentType != "" {
+			writer.Header().Set("Content-Type", metadata.ContentType)
+		}
+
+		if seeker, ok := reader.(io.ReadSeeker); ok {
+			nethttp.ServeContent(writer, ctx.Request().Origin(), path.Base(filepath.ToSlash(file)), metadata.LastModified, seeker)
+
+			return nil
+		}
+
+		writer.Header().Set("Content-Length", strconv.FormatInt(metadata.Size, 10))
+		writer.WriteHeader(contractshttp.StatusOK)
+		_, _ = io.Copy(writer, reader)
+
+		return nil
+	}
+}
+
+type validateTemporaryUrl struct {
+	config config.Config
+}
+
+// ValidateTemporaryUrl rejects the requests of which the disk doesn't enable the "serve" option,
+// or the signature is invalid or expired.
+func ValidateTemporaryUrl(config config.Config) contractshttp.Middleware {
+	return &validateTemporaryUrl{config: config}
+}
+
+func (r *validateTemporaryUrl) Signature() string {
+	return "goravel:validate_temporary_url"
+}
+
+func (r *validateTemporaryUrl) Handle(ctx contractshttp.Context) {
+	if !r.config.GetBool(fmt.Sprintf("filesystems.disks.%s.serve", ctx.Request().Route("disk"))) {
+		ctx.Request().Abort(contractshttp.StatusNotFound)
+		return
+	}
+
+	if err := supporthttp.VerifyURL(r.config.GetString("app.key"), ctx.Request().Origin().URL, carbon.Now().StdTime()); err != nil {
+		ctx.Request().Abort(contractshttp.StatusForbidden)
+		return
+	}
+
+	ctx.Request().Next()
+}
+
+func temporaryUrl(config config.Config, disk, file string, expiration time.Time) (string, error) {
+	query := url.Values{"path": []string{strings.TrimPrefix(filepath.ToSlash(file), "/")}}
+	rawURL := strings.TrimSuffix(config.GetString("app.url"), "/") + temporaryUrlPath(config) + "/" + url.PathEscape(disk) + "?" + query.Encode()
+
+	return supporthttp.SignURL(config.GetString("app.key"), rawURL, expiration)
+}
+
+func temporaryUrlPath(config config.Config) string {
+	return "/" + strings.Trim(config.GetString("filesystems.temporary_url_path", defaultTemporaryUrlPath), "/")
+}
@@ -0,0 +1,174 @@
+package filesystem
+
+import (
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+	mockshttp "github.com/goravel/framework/mocks/http"
+	"github.com/goravel/framework/support/carbon"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+type streamDriver struct {
+	*mocksfilesystem.Driver
+	*mocksfilesystem.DriverWithStream
+}
+
+const testAppKey = "12345678901234567890123456789012"
+
+func TestValidateTemporaryUrl(t *testing.T) {
+	var (
+		mockConfig  *mocksconfig.Config
+		mockContext *mockshttp.Context
+		mockRequest *mockshttp.ContextRequest
+	)
+
+	beforeEach := func(rawURL string) {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockContext = mockshttp.NewContext(t)
+		mockRequest = mockshttp.NewContextRequest(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		mockRequest.EXPECT().Route("disk").Return("local").Once()
+		mockRequest.EXPECT().Origin().Return(httptest.NewRequest(nethttp.MethodGet, rawURL, nil)).Maybe()
+	}
+
+	signed, err := supporthttp.SignURL(testAppKey, "https://goravel.dev/_storage/local?path=1.txt", carbon.Now().AddMinute().StdTime())
+	assert.NoError(t, err)
+
+	t.Run("the disk doesn't serve", func(t *testing.T) {
+		beforeEach(signed)
+		mockConfig.EXPECT().GetBool("filesystems.disks.local.serve").Return(false).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusNotFound).Once()
+
+		ValidateTemporaryUrl(mockConfig).Handle(mockContext)
+	})
+
+	t.Run("the signature is valid", func(t *testing.T) {
+		beforeEach(signed)
+		mockConfig.EXPECT().GetBool("filesystems.disks.local.serve").Return(true).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(testAppKey).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		ValidateTemporaryUrl(mockConfig).Handle(mockContext)
+	})
+
+	t.Run("the signature is invalid", func(t *testing.T) {
+		beforeEach(strings.Replace(signed, "1.txt", "2.txt", 1))
+		mockConfig.EXPECT().GetBool("filesystems.disks.local.serve").Return(true).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(testAppKey).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateTemporaryUrl(mockConfig).Handle(mockContext)
+	})
+
+	t.Run("the signature has expired", func(t *testing.T) {
+		carbon.SetTestNow(carbon.Now().AddMinutes(2))
+		defer carbon.ClearTestNow()
+
+		beforeEach(signed)
+		mockConfig.EXPECT().GetBool("filesystems.disks.local.serve").Return(true).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(testAppKey).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateTemporaryUrl(mockConfig).Handle(mockContext)
+	})
+}
+
+func TestServeTemporaryUrl(t *testing.T) {
+	var (
+		mockContext  *mockshttp.Context
+		mockRequest  *mockshttp.ContextRequest
+		mockResponse *mockshttp.ContextResponse
+		mockStorage  *mocksfilesystem.Storage
+		memory       *Memory
+		recorder     *httptest.ResponseRecorder
+	)
+
+	beforeEach := func(file string, header map[string]string, driver contractsfilesystem.Driver) {
+		mockConfig := mocksconfig.NewConfig(t)
+		mockConfig.EXPECT().GetString("filesystems.disks.memory.url").Return("").Once()
+
+		var err error
+		memory, err = NewMemory(mockConfig, "memory")
+		assert.NoError(t, err)
+		assert.NoError(t, memory.Put("avatars/1.txt", "Hello Goravel"))
+
+		request := httptest.NewRequest(nethttp.MethodGet, "/_storage/memory?path="+file, nil)
+		for key, value := range header {
+			request.Header.Set(key, value)
+		}
+
+		recorder = httptest.NewRecorder()
+		mockContext = mockshttp.NewContext(t)
+		mockRequest = mockshttp.NewContextRequest(t)
+		mockResponse = mockshttp.NewContextResponse(t)
+		mockStorage = mocksfilesystem.NewStorage(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		mockContext.EXPECT().Response().Return(mockResponse)
+		mockRequest.EXPECT().Query("path").Return(file).Once()
+		mockRequest.EXPECT().Route("disk").Return("memory").Once()
+		mockRequest.EXPECT().Origin().Return(request).Maybe()
+		mockResponse.EXPECT().Writer().Return(recorder).Maybe()
+		if driver == nil {
+			driver = memory
+		}
+		mockStorage.EXPECT().Disk("memory").Return(driver).Once()
+	}
+
+	t.Run("serves the file", func(t *testing.T) {
+		beforeEach("avatars/1.txt", nil, nil)
+
+		assert.Nil(t, ServeTemporaryUrl(mockStorage)(mockContext))
+		assert.Equal(t, contractshttp.StatusOK, recorder.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "bytes", recorder.Header().Get("Accept-Ranges"))
+		assert.Equal(t, "Hello Goravel", recorder.Body.String())
+	})
+
+	t.Run("serves the range of the file", func(t *testing.T) {
+		beforeEach("avatars/1.txt", map[string]string{"Range": "bytes=6-"}, nil)
+
+		assert.Nil(t, ServeTemporaryUrl(mockStorage)(mockContext))
+		assert.Equal(t, contractshttp.StatusPartialContent, recorder.Code)
+		assert.Equal(t, "bytes 6-12/13", recorder.Header().Get("Content-Range"))
+		assert.Equal(t, "Goravel", recorder.Body.String())
+	})
+
+	t.Run("the file doesn't exist", func(t *testing.T) {
+		beforeEach("avatars/2.txt", nil, nil)
+		response := mockshttp.NewAbortableResponse(t)
+		mockResponse.EXPECT().String(contractshttp.StatusNotFound, "Not Found").Return(response).Once()
+
+		assert.Equal(t, response, ServeTemporaryUrl(mockStorage)(mockContext))
+	})
+
+	t.Run("serves the file from a stream that isn't seekable", func(t *testing.T) {
+		driver := mocksfilesystem.NewDriverWithStream(t)
+		beforeEach("avatars/1.txt", map[string]string{"Range": "bytes=6-"}, &streamDriver{Driver: mocksfilesystem.NewDriver(t), DriverWithStream: driver})
+		driver.EXPECT().Metadata("avatars/1.txt").Return(contractsfilesystem.Metadata{ContentType: "text/plain", Size: 13}, nil).Once()
+		driver.EXPECT().ReadStream("avatars/1.txt").Return(io.NopCloser(strings.NewReader("Hello Goravel")), nil).Once()
+
+		assert.Nil(t, ServeTemporaryUrl(mockStorage)(mockContext))
+		assert.Equal(t, contractshttp.StatusOK, recorder.Code)
+		assert.Equal(t, "text/plain", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "13", recorder.Header().Get("Content-Length"))
+		assert.Equal(t, "Hello Goravel", recorder.Body.String())
+	})
+
+	t.Run("the driver doesn't support streams", func(t *testing.T) {
+		beforeEach("avatars/1.txt", nil, mocksfilesystem.NewDriver(t))
+		response := mockshttp.NewAbortableResponse(t)
+		mockResponse.EXPECT().String(contractshttp.StatusNotFound, "Not Found").Return(response).Once()
+
+		assert.Equal(t, response, ServeTemporaryUrl(mockStorage)(mockContext))
+	})
+}
@@ -422,6 +422,16 @@ func (r *Container) MakeTesting() contractstesting.Testing {
 	return instance.(contractstesting.Testing)
 }
 
+func (r *Container) MakeURL() contractsroute.URL {
+	instance, err := r.Make(facades.FacadeToBinding[facades.URL])
+	if err != nil {
+		logMakeErrorIfNeeded(err)
+		return nil
+	}
+
+	return instance.(contractsroute.URL)
+}
+
 func (r *Container) MakeValidation() contractsvalidation.Validation {
 	instance, err := r.Make(facades.FacadeToBinding[facades.Validation])
 	if err != nil {
@@ -244,6 +244,7 @@ func (s *ContainerTestSuite) TestMakeWrappers_SuppressBindingNotFoundError() {
 		{name: "storage", run: func(container *Container) any { return container.MakeStorage() }},
 		{name: "telemetry", run: func(container *Container) any { return container.MakeTelemetry() }},
 		{name: "testing", run: func(container *Container) any { return container.MakeTesting() }},
+		{name: "url", run: func(container *Container) any { return container.MakeURL() }},
 		{name: "validation", run: func(container *Container) any { return container.MakeValidation() }},
 		{name: "view", run: func(container *Container) any { return container.MakeView() }},
 	}
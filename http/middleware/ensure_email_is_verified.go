@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+type ensureEmailIsVerified struct {
+	guard []string
+	user  func() contractsauth.MustVerifyEmail
+}
+
+func (m *ensureEmailIsVerified) Signature() string {
+	return "goravel:verified"
+}
+
+// Handle rejects the request with 403 if the user isn't authenticated by the guard,
+// or hasn't verified the email address.
+func (m *ensureEmailIsVerified) Handle(ctx contractshttp.Context) {
+	auth := http.App.MakeAuth(ctx)
+	if auth == nil {
+		ctx.Request().Abort(contractshttp.StatusForbidden)
+		return
+	}
+
+	guard := contractsauth.GuardDriver(auth)
+	if len(m.guard) > 0 {
+		guard = auth.Guard(m.guard[0])
+	}
+
+	user := m.user()
+	if err := guard.User(user); err != nil || !user.HasVerifiedEmail() {
+		ctx.Request().Abort(contractshttp.StatusForbidden)
+		return
+	}
+
+	ctx.Request().Next()
+}
+
+// EnsureEmailIsVerified only allows the users that have verified the email address, user returns
+// the empty model that the authenticated user is retrieved into, eg:
+//
+//	middleware.EnsureEmailIsVerified(func() auth.MustVerifyEmail { return &models.User{} })
+func EnsureEmailIsVerified(user func() contractsauth.MustVerifyEmail, guard ...string) contractshttp.Middleware {
+	return &ensureEmailIsVerified{
+		guard: guard,
+		user:  user,
+	}
+}
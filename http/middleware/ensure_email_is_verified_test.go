@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	mocksauth "github.com/goravel/framework/mocks/auth"
+	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	mockshttp "github.com/goravel/framework/mocks/http"
+)
+
+type verifiableUser struct {
+	verified bool
+}
+
+func (r *verifiableUser) GetEmailForVerification() string {
+	return "goravel@example.com"
+}
+
+func (r *verifiableUser) HasVerifiedEmail() bool {
+	return r.verified
+}
+
+func (r *verifiableUser) MarkEmailAsVerified() error {
+	r.verified = true
+
+	return nil
+}
+
+func (r *verifiableUser) RouteNotificationFor(string) any {
+	return "goravel@example.com"
+}
+
+func TestEnsureEmailIsVerified(t *testing.T) {
+	var (
+		mockApp     *mocksfoundation.Application
+		mockAuth    *mocksauth.Auth
+		mockContext *mockshttp.Context
+		mockRequest *mockshttp.ContextRequest
+	)
+
+	beforeEach := func() {
+		mockApp = mocksfoundation.NewApplication(t)
+		mockAuth = mocksauth.NewAuth(t)
+		mockContext = mockshttp.NewContext(t)
+		mockRequest = mockshttp.NewContextRequest(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		http.App = mockApp
+	}
+
+	newUser := func() contractsauth.MustVerifyEmail {
+		return &verifiableUser{}
+	}
+
+	t.Run("the email has been verified", func(t *testing.T) {
+		beforeEach()
+		mockApp.EXPECT().MakeAuth(mockContext).Return(mockAuth).Once()
+		mockAuth.EXPECT().User(mock.Anything).Run(func(user any) {
+			user.(*verifiableUser).verified = true
+		}).Return(nil).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		EnsureEmailIsVerified(newUser).Handle(mockContext)
+	})
+
+	t.Run("the email hasn't been verified", func(t *testing.T) {
+		beforeEach()
+		mockApp.EXPECT().MakeAuth(mockContext).Return(mockAuth).Once()
+		mockAuth.EXPECT().User(mock.Anything).Return(nil).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		EnsureEmailIsVerified(newUser).Handle(mockContext)
+	})
+
+	t.Run("the user isn't authenticated", func(t *testing.T) {
+		beforeEach()
+		mockApp.EXPECT().MakeAuth(mockContext).Return(mockAuth).Once()
+		mockAuth.EXPECT().User(mock.Anything).Return(assert.AnError).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		EnsureEmailIsVerified(newUser).Handle(mockContext)
+	})
+
+	t.Run("the guard is specified", func(t *testing.T) {
+		beforeEach()
+		mockGuard := mocksauth.NewGuardDriver(t)
+		mockApp.EXPECT().MakeAuth(mockContext).Return(mockAuth).Once()
+		mockAuth.EXPECT().Guard("admin").Return(mockGuard).Once()
+		mockGuard.EXPECT().User(mock.Anything).Run(func(user any) {
+			user.(*verifiableUser).verified = true
+		}).Return(nil).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		EnsureEmailIsVerified(newUser, "admin").Handle(mockContext)
+	})
+
+	t.Run("the auth facade is not set", func(t *testing.T) {
+		beforeEach()
+		mockApp.EXPECT().MakeAuth(mockContext).Return(nil).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		EnsureEmailIsVerified(newUser).Handle(mockContext)
+	})
+}
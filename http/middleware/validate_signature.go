@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/support/carbon"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+type validateSignature struct{}
+
+func (m *validateSignature) Signature() string {
+	return "goravel:validate_signature"
+}
+
+// Handle rejects the request with 403 if the URL isn't signed by URL().SignedRoute or URL().TemporarySignedRoute,
+// or the signature has expired.
+func (m *validateSignature) Handle(ctx contractshttp.Context) {
+	config := http.App.MakeConfig()
+	if config == nil {
+		ctx.Request().Abort(contractshttp.StatusForbidden)
+		return
+	}
+
+	if err := supporthttp.VerifyURL(config.GetString("app.key"), ctx.Request().Origin().URL, carbon.Now().StdTime()); err != nil {
+		ctx.Request().Abort(contractshttp.StatusForbidden)
+		return
+	}
+
+	ctx.Request().Next()
+}
+
+func ValidateSignature() contractshttp.Middleware {
+	return &validateSignature{}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	mockshttp "github.com/goravel/framework/mocks/http"
+	"github.com/goravel/framework/support/carbon"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+func TestValidateSignature(t *testing.T) {
+	var (
+		mockApp     *mocksfoundation.Application
+		mockConfig  *mocksconfig.Config
+		mockContext *mockshttp.Context
+		mockRequest *mockshttp.ContextRequest
+	)
+
+	key := "12345678901234567890123456789012"
+
+	beforeEach := func(rawURL string) {
+		mockApp = mocksfoundation.NewApplication(t)
+		mockConfig = mocksconfig.NewConfig(t)
+		mockContext = mockshttp.NewContext(t)
+		mockRequest = mockshttp.NewContextRequest(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		mockRequest.EXPECT().Origin().Return(httptest.NewRequest(nethttp.MethodGet, rawURL, nil)).Maybe()
+		http.App = mockApp
+	}
+
+	signed, err := supporthttp.SignURL(key, "https://goravel.dev/unsubscribe/1?list=news", carbon.Now().AddMinute().StdTime())
+	assert.NoError(t, err)
+
+	t.Run("the signature is valid", func(t *testing.T) {
+		beforeEach(signed)
+		mockApp.EXPECT().MakeConfig().Return(mockConfig).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		ValidateSignature().Handle(mockContext)
+	})
+
+	t.Run("the signature is invalid", func(t *testing.T) {
+		beforeEach(strings.Replace(signed, "unsubscribe/1", "unsubscribe/2", 1))
+		mockApp.EXPECT().MakeConfig().Return(mockConfig).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateSignature().Handle(mockContext)
+	})
+
+	t.Run("the signature is missing", func(t *testing.T) {
+		beforeEach("https://goravel.dev/unsubscribe/1?list=news")
+		mockApp.EXPECT().MakeConfig().Return(mockConfig).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateSignature().Handle(mockContext)
+	})
+
+	t.Run("the signature has expired", func(t *testing.T) {
+		carbon.SetTestNow(carbon.Now().AddMinutes(2))
+		defer carbon.ClearTestNow()
+
+		beforeEach(signed)
+		mockApp.EXPECT().MakeConfig().Return(mockConfig).Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateSignature().Handle(mockContext)
+	})
+
+	t.Run("config is not set", func(t *testing.T) {
+		beforeEach(signed)
+		mockApp.EXPECT().MakeConfig().Return(nil).Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusForbidden).Once()
+
+		ValidateSignature().Handle(mockContext)
+	})
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/errors"
+)
+
+// StorageResponse streams the file of the given disk to the response inline, the file is never loaded into memory.
+func StorageResponse(ctx contractshttp.Context, driver contractsfilesystem.Driver, file string) (contractshttp.Response, error) {
+	return storageStream(ctx, driver, file, "inline", filepath.Base(file))
+}
+
+// StorageDownload streams the file of the given disk to the response as an attachment with the given name,
+// the base name of the file is used when the name is empty.
+func StorageDownload(ctx contractshttp.Context, driver contractsfilesystem.Driver, file, name string) (contractshttp.Response, error) {
+	if name == "" {
+		name = filepath.Base(file)
+	}
+
+	return storageStream(ctx, driver, file, "attachment", name)
+}
+
+func storageStream(ctx contractshttp.Context, disk contractsfilesystem.Driver, file, disposition, name string) (contractshttp.Response, error) {
+	driver, ok := disk.(contractsfilesystem.DriverWithStream)
+	if !ok {
+		return nil, errors.FilesystemStreamNotSupported
+	}
+
+	metadata, err := driver.Metadata(file)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := driver.ReadStream(file)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ctx.Response()
+	if metadata.ContentType != "" {
+		response.Header("Content-Type", metadata.ContentType)
+	}
+	if metadata.Size > 0 {
+		response.Header("Content-Length", strconv.FormatInt(metadata.Size, 10))
+	}
+	response.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": name}))
+
+	return response.Stream(http.StatusOK, func(w contractshttp.StreamWriter) error {
+		defer func() {
+			_ = reader.Close()
+		}()
+
+		if _, err := io.Copy(w, reader); err != nil {
+			return err
+		}
+
+		return w.Flush()
+	}), nil
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/errors"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+	mockshttp "github.com/goravel/framework/mocks/http"
+)
+
+type streamDriver struct {
+	*mocksfilesystem.Driver
+	*mocksfilesystem.DriverWithStream
+}
+
+type bufferStreamWriter struct {
+	bytes.Buffer
+}
+
+func (r *bufferStreamWriter) Flush() error {
+	return nil
+}
+
+func TestStorageResponse(t *testing.T) {
+	var (
+		mockContext  *mockshttp.Context
+		mockResponse *mockshttp.ContextResponse
+		mockDriver   *mocksfilesystem.DriverWithStream
+		disk         *streamDriver
+		writer       *bufferStreamWriter
+	)
+
+	beforeEach := func() {
+		mockContext = mockshttp.NewContext(t)
+		mockResponse = mockshttp.NewContextResponse(t)
+		mockDriver = mocksfilesystem.NewDriverWithStream(t)
+		disk = &streamDriver{Driver: mocksfilesystem.NewDriver(t), DriverWithStream: mockDriver}
+		writer = &bufferStreamWriter{}
+	}
+
+	expectStream := func(disposition string) {
+		mockDriver.EXPECT().Metadata("avatars/1.txt").Return(contractsfilesystem.Metadata{
+			ContentType: "text/plain; charset=utf-8",
+			Size:        7,
+		}, nil).Once()
+		mockDriver.EXPECT().ReadStream("avatars/1.txt").Return(io.NopCloser(strings.NewReader("Goravel")), nil).Once()
+		mockContext.EXPECT().Response().Return(mockResponse).Once()
+		mockResponse.EXPECT().Header("Content-Type", "text/plain; charset=utf-8").Return(mockResponse).Once()
+		mockResponse.EXPECT().Header("Content-Length", "7").Return(mockResponse).Once()
+		mockResponse.EXPECT().Header("Content-Disposition", disposition).Return(mockResponse).Once()
+		mockResponse.EXPECT().Stream(http.StatusOK, mock.Anything).RunAndReturn(func(_ int, step func(contractshttp.StreamWriter) error) contractshttp.Response {
+			assert.NoError(t, step(writer))
+
+			return nil
+		}).Once()
+	}
+
+	t.Run("inline", func(t *testing.T) {
+		beforeEach()
+		expectStream(`inline; filename=1.txt`)
+
+		_, err := StorageResponse(mockContext, disk, "avatars/1.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, "Goravel", writer.String())
+	})
+
+	t.Run("download", func(t *testing.T) {
+		beforeEach()
+		expectStream(`attachment; filename=avatar.txt`)
+
+		_, err := StorageDownload(mockContext, disk, "avatars/1.txt", "avatar.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, "Goravel", writer.String())
+	})
+
+	t.Run("file does not exist", func(t *testing.T) {
+		beforeEach()
+		mockDriver.EXPECT().Metadata("avatars/2.txt").Return(contractsfilesystem.Metadata{}, io.ErrUnexpectedEOF).Once()
+
+		response, err := StorageResponse(mockContext, disk, "avatars/2.txt")
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("driver doesn't support streams", func(t *testing.T) {
+		beforeEach()
+
+		response, err := StorageResponse(mockContext, mocksfilesystem.NewDriver(t), "avatars/1.txt")
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, errors.FilesystemStreamNotSupported)
+	})
+}
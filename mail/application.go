@@ -1,13 +1,18 @@
 package mail
 
 import (
-	"crypto/tls"
-	"fmt"
+	"encoding/json"
 	"net/smtp"
+	"strings"
+	"sync/atomic"
 
 	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/contracts/process"
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/mail/markdown"
 	"github.com/goravel/framework/mail/template"
 )
 
@@ -22,22 +27,32 @@ type Params struct {
 	CC          []string          `json:"cc"`
 	BCC         []string          `json:"bcc"`
 	Attachments []string          `json:"attachments"`
+	Attachables []mail.Attachment `json:"attachables"`
 	Headers     map[string]string `json:"headers"`
 }
 
 type Application struct {
 	config   config.Config
+	log      log.Log
+	process  process.Process
 	queue    contractsqueue.Queue
+	storage  filesystem.Storage
 	template mail.Template
 	params   Params
 	clone    int
+	mailer   string
 
-	htmlView string
-	textView string
-	with     map[string]any
+	// fake records the mails instead of sending them while it's set, it's shared with the
+	// instances cloned from the application.
+	fake *atomic.Pointer[Fake]
+
+	htmlView     string
+	markdownView string
+	textView     string
+	with         map[string]any
 }
 
-func NewApplication(config config.Config, queue contractsqueue.Queue) (*Application, error) {
+func NewApplication(config config.Config, queue contractsqueue.Queue, log log.Log, process process.Process, storage filesystem.Storage) (*Application, error) {
 	templateEngine, err := template.Get(config)
 	if err != nil {
 		return nil, err
@@ -45,8 +60,12 @@ func NewApplication(config config.Config, queue contractsqueue.Queue) (*Applicat
 
 	return &Application{
 		config:   config,
+		log:      log,
+		process:  process,
 		queue:    queue,
+		storage:  storage,
 		template: templateEngine,
+		fake:     &atomic.Pointer[Fake]{},
 	}, nil
 }
 
@@ -57,6 +76,13 @@ func (r *Application) Attach(attachments []string) mail.Mail {
 	return instance
 }
 
+func (r *Application) AttachWith(attachments ...mail.Attachment) mail.Mail {
+	instance := r.instance()
+	instance.params.Attachables = attachments
+
+	return instance
+}
+
 func (r *Application) Bcc(bcc []string) mail.Mail {
 	instance := r.instance()
 	instance.params.BCC = bcc
@@ -76,12 +102,32 @@ func (r *Application) Content(content mail.Content) mail.Mail {
 	instance.params.HTML = content.Html
 	instance.params.Text = content.Text
 	instance.htmlView = content.HtmlView
+	instance.markdownView = content.Markdown
 	instance.textView = content.TextView
 	instance.with = content.With
 
 	return instance
 }
 
+func (r *Application) Fake() mail.Fake {
+	if r.fake == nil {
+		r.fake = &atomic.Pointer[Fake]{}
+	}
+
+	mailFake := NewFake(&Application{
+		config:   r.config,
+		log:      r.log,
+		process:  r.process,
+		queue:    r.queue,
+		storage:  r.storage,
+		template: r.template,
+		fake:     r.fake,
+	})
+	r.fake.Store(mailFake)
+
+	return mailFake
+}
+
 func (r *Application) From(address mail.Address) mail.Mail {
 	instance := r.instance()
 	instance.params.FromAddress = address.Address
@@ -97,7 +143,18 @@ func (r *Application) Headers(headers map[string]string) mail.Mail {
 	return instance
 }
 
+func (r *Application) Mailer(name string) mail.Mail {
+	instance := r.instance()
+	instance.mailer = name
+
+	return instance
+}
+
 func (r *Application) Queue(mailable ...mail.Mailable) error {
+	if r.clone == 0 {
+		return r.instance().Queue(mailable...)
+	}
+
 	if len(mailable) > 0 {
 		r.setUsingMailable(mailable[0])
 	}
@@ -106,7 +163,22 @@ func (r *Application) Queue(mailable ...mail.Mailable) error {
 		return err
 	}
 
-	job := r.queue.Job(NewSendMailJob(r.config), []contractsqueue.Arg{
+	if mailFake := r.loadFake(); mailFake != nil {
+		captured := r.capture(mailable...)
+		if len(mailable) > 0 {
+			captured.queue = mailable[0].Queue()
+		}
+		mailFake.queue(captured)
+
+		return nil
+	}
+
+	attachables, err := json.Marshal(r.params.Attachables)
+	if err != nil {
+		return err
+	}
+
+	job := r.queue.Job(NewSendMailJob(r.config, r.log, r.process, r.storage), []contractsqueue.Arg{
 		{
 			Type:  "string",
 			Value: r.params.Subject,
@@ -147,6 +219,14 @@ func (r *Application) Queue(mailable ...mail.Mailable) error {
 			Type:  "[]string",
 			Value: convertMapHeadersToSlice(r.params.Headers),
 		},
+		{
+			Type:  "string",
+			Value: r.mailer,
+		},
+		{
+			Type:  "string",
+			Value: string(attachables),
+		},
 	})
 
 	if len(mailable) > 0 {
@@ -164,6 +244,10 @@ func (r *Application) Queue(mailable ...mail.Mailable) error {
 }
 
 func (r *Application) Send(mailable ...mail.Mailable) error {
+	if r.clone == 0 {
+		return r.instance().Send(mailable...)
+	}
+
 	if len(mailable) > 0 {
 		r.setUsingMailable(mailable[0])
 	}
@@ -172,7 +256,23 @@ func (r *Application) Send(mailable ...mail.Mailable) error {
 		return err
 	}
 
-	return SendMail(r.config, r.params)
+	if mailFake := r.loadFake(); mailFake != nil {
+		mailFake.send(r.capture(mailable...))
+
+		return nil
+	}
+
+	transport, err := GetTransport(r.config, r.log, r.process, r.mailer)
+	if err != nil {
+		return err
+	}
+
+	message, err := loadAttachments(r.storage, newMessage(r.config, r.params))
+	if err != nil {
+		return err
+	}
+
+	return transport.Send(message)
 }
 
 func (r *Application) Subject(subject string) mail.Mail {
@@ -194,8 +294,13 @@ func (r *Application) instance() *Application {
 		return &Application{
 			clone:    1,
 			config:   r.config,
+			log:      r.log,
+			process:  r.process,
 			queue:    r.queue,
+			storage:  r.storage,
 			template: r.template,
+			mailer:   r.mailer,
+			fake:     r.fake,
 		}
 	}
 
@@ -211,6 +316,7 @@ func (r *Application) setUsingMailable(mailable mail.Mailable) {
 			r.params.Text = content.Text
 		}
 		r.htmlView = content.HtmlView
+		r.markdownView = content.Markdown
 		r.textView = content.TextView
 		r.with = content.With
 	}
@@ -219,6 +325,12 @@ func (r *Application) setUsingMailable(mailable mail.Mailable) {
 		r.params.Attachments = attachments
 	}
 
+	if mailable, ok := mailable.(mail.MailableWithAttachments); ok {
+		if attachables := mailable.Attachables(); len(attachables) > 0 {
+			r.params.Attachables = attachables
+		}
+	}
+
 	if headers := mailable.Headers(); len(headers) > 0 {
 		r.params.Headers = headers
 	}
@@ -243,8 +355,37 @@ func (r *Application) setUsingMailable(mailable mail.Mailable) {
 	}
 }
 
+func (r *Application) capture(mailable ...mail.Mailable) *CapturedMail {
+	captured := &CapturedMail{
+		Mailer:  r.mailer,
+		message: newMessage(r.config, r.params),
+	}
+	if len(mailable) > 0 {
+		captured.Mailable = mailable[0]
+	}
+
+	return captured
+}
+
+func (r *Application) loadFake() *Fake {
+	if r.fake == nil {
+		return nil
+	}
+
+	return r.fake.Load()
+}
+
 func (r *Application) renderViewTemplate() error {
-	if r.htmlView != "" && r.template != nil {
+	if r.markdownView != "" {
+		html, text, err := markdown.Get(r.config).Render(r.markdownView, r.with)
+		if err != nil {
+			return err
+		}
+		r.params.HTML = html
+		if r.params.Text == "" && r.textView == "" {
+			r.params.Text = text
+		}
+	} else if r.htmlView != "" && r.template != nil {
 		html, err := r.template.Render(r.htmlView, r.with)
 		if err != nil {
 			return err
@@ -260,57 +401,59 @@ func (r *Application) renderViewTemplate() error {
 		r.params.Text = text
 	}
 
+	// The markdown views have been inlined with the theme, the stylesheets of the other
+	// HTML are only inlined when it's enabled, since the HTML is reformatted by the inliner.
+	if r.markdownView == "" && strings.Contains(r.params.HTML, "<style") && r.config.GetBool("mail.inline_css") {
+		html, err := markdown.InlineCss(r.params.HTML)
+		if err != nil {
+			return err
+		}
+		r.params.HTML = html
+	}
+
 	return nil
 }
 
+// SendMail sends the mail with the default mailer, the log and sendmail transports and the
+// attachments of the filesystem disks aren't available since the facades aren't passed.
 func SendMail(config config.Config, params Params) error {
-	e := NewEmail()
-	fromAddress, fromName := params.FromAddress, params.FromName
-	if fromAddress == "" {
-		fromAddress, fromName = config.GetString("mail.from.address"), config.GetString("mail.from.name")
-	}
-
-	e.From = fmt.Sprintf("%s <%s>", fromName, fromAddress)
-	e.To = params.To
-	if len(params.BCC) > 0 {
-		e.Bcc = params.BCC
-	}
-	if len(params.CC) > 0 {
-		e.Cc = params.CC
+	transport, err := GetTransport(config, nil, nil, "")
+	if err != nil {
+		return err
 	}
-	e.Subject = params.Subject
 
-	if len(params.HTML) > 0 {
-		e.HTML = []byte(params.HTML)
+	message, err := loadAttachments(nil, newMessage(config, params))
+	if err != nil {
+		return err
 	}
 
-	if len(params.Text) > 0 {
-		e.Text = []byte(params.Text)
-	}
+	return transport.Send(message)
+}
 
-	for _, attach := range params.Attachments {
-		if _, err := e.AttachFile(attach); err != nil {
-			return err
-		}
+// newMessage converts the params to the message of the transports, the global sender is
+// used if the params don't contain one.
+func newMessage(config config.Config, params Params) *mail.Message {
+	from := mail.Address{Address: params.FromAddress, Name: params.FromName}
+	if from.Address == "" {
+		from = mail.Address{Address: config.GetString("mail.from.address"), Name: config.GetString("mail.from.name")}
 	}
 
-	for key, val := range params.Headers {
-		e.Headers.Add(key, val)
+	var attachments []mail.Attachment
+	for _, path := range params.Attachments {
+		attachments = append(attachments, mail.Attachment{Path: path})
 	}
-
-	port := config.GetInt("mail.port")
-	switch port {
-	case 465:
-		return e.SendWithTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
-			&tls.Config{ServerName: config.GetString("mail.host")})
-	case 587:
-		return e.SendWithStartTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
-			&tls.Config{ServerName: config.GetString("mail.host")})
-	default:
-		return e.Send(fmt.Sprintf("%s:%d", config.GetString("mail.host"), port),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")))
+	attachments = append(attachments, params.Attachables...)
+
+	return &mail.Message{
+		From:        from,
+		To:          params.To,
+		Cc:          params.CC,
+		Bcc:         params.BCC,
+		Subject:     params.Subject,
+		Html:        params.HTML,
+		Text:        params.Text,
+		Attachments: attachments,
+		Headers:     params.Headers,
 	}
 }
 
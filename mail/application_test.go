@@ -3,6 +3,7 @@ package mail
 import (
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -16,8 +17,10 @@ import (
 	contractsfoundation "github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/contracts/mail"
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	frameworkerrors "github.com/goravel/framework/errors"
 	"github.com/goravel/framework/foundation/json"
 	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
 	mocksmail "github.com/goravel/framework/mocks/mail"
 	mocksqueue "github.com/goravel/framework/mocks/queue"
@@ -49,7 +52,7 @@ func (s *ApplicationTestSuite) SetupTest() {
 func (s *ApplicationTestSuite) TestSendMail() {
 	s.mockConfig = mockConfig(465)
 
-	app, err := NewApplication(s.mockConfig, nil)
+	app, err := NewApplication(s.mockConfig, nil, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.To([]string{testTo}).
 		Cc([]string{testCc}).
@@ -64,7 +67,7 @@ func (s *ApplicationTestSuite) TestSendMail() {
 func (s *ApplicationTestSuite) TestSendMailWithText() {
 	s.mockConfig = mockConfig(465)
 
-	app, err := NewApplication(s.mockConfig, nil)
+	app, err := NewApplication(s.mockConfig, nil, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.To([]string{testTo}).
 		Cc([]string{testCc}).
@@ -79,7 +82,7 @@ func (s *ApplicationTestSuite) TestSendMailWithText() {
 func (s *ApplicationTestSuite) TestSendMailViaTemplate() {
 	s.mockConfig = mockConfig(465)
 
-	app, err := NewApplication(s.mockConfig, nil)
+	app, err := NewApplication(s.mockConfig, nil, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.To([]string{testTo}).
 		Cc([]string{testCc}).
@@ -99,7 +102,7 @@ func (s *ApplicationTestSuite) TestSendMailViaTemplate() {
 func (s *ApplicationTestSuite) TestSendMailWithFromBy587Port() {
 	s.mockConfig = mockConfig(587)
 
-	app, err := NewApplication(s.mockConfig, nil)
+	app, err := NewApplication(s.mockConfig, nil, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.From(Address(testFromAddress, testFromName)).
 		To([]string{testTo}).
@@ -115,7 +118,7 @@ func (s *ApplicationTestSuite) TestSendMailWithFromBy587Port() {
 func (s *ApplicationTestSuite) TestSendMailWithMailable() {
 	s.mockConfig = mockConfig(465)
 
-	app, err := NewApplication(s.mockConfig, nil)
+	app, err := NewApplication(s.mockConfig, nil, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.Send(NewTestMailable()))
 }
@@ -132,10 +135,10 @@ func (s *ApplicationTestSuite) TestQueueMail() {
 
 	queueFacade := queue.NewApplication(queue.NewConfig(s.mockConfig), nil, nil, queue.NewJobStorer(), json.New(), nil)
 	queueFacade.Register([]contractsqueue.Job{
-		NewSendMailJob(s.mockConfig),
+		NewSendMailJob(s.mockConfig, nil, nil, nil),
 	})
 
-	app, err := NewApplication(s.mockConfig, queueFacade)
+	app, err := NewApplication(s.mockConfig, queueFacade, nil, nil, nil)
 	s.Nil(err)
 
 	s.Nil(app.To([]string{testTo}).
@@ -161,10 +164,10 @@ func (s *ApplicationTestSuite) TestQueueMailWithMailable() {
 
 	queueFacade := queue.NewApplication(queue.NewConfig(s.mockConfig), nil, nil, queue.NewJobStorer(), json.New(), nil)
 	queueFacade.Register([]contractsqueue.Job{
-		NewSendMailJob(s.mockConfig),
+		NewSendMailJob(s.mockConfig, nil, nil, nil),
 	})
 
-	app, err := NewApplication(s.mockConfig, queueFacade)
+	app, err := NewApplication(s.mockConfig, queueFacade, nil, nil, nil)
 	s.Nil(err)
 	s.Nil(app.Queue(NewTestMailable()))
 }
@@ -178,6 +181,10 @@ func mockConfig(mailPort int) *mocksconfig.Config {
 	config.EXPECT().GetInt("queue.connections.sync.concurrent", 1).Return(1)
 	config.EXPECT().GetString("queue.failed.database").Return("database")
 	config.EXPECT().GetString("queue.failed.table").Return("failed_jobs")
+	// The SMTP options are at the top level of the config, the transport is cached by the
+	// config, so it's recreated with the port of each test.
+	config.EXPECT().GetString("mail.default", "smtp").Return("smtp")
+	config.EXPECT().GetString("mail.mailers.smtp.transport").Return("")
 	if file.Exists(support.EnvFilePath) {
 		vip := viper.New()
 		vip.SetConfigName(support.EnvFilePath)
@@ -268,6 +275,7 @@ func (m *TestMailable) Queue() *mail.Queue {
 }
 
 type stubMailable struct {
+	attachables []mail.Attachment
 	attachments []string
 	content     *mail.Content
 	envelope    *mail.Envelope
@@ -275,11 +283,12 @@ type stubMailable struct {
 	queue       *mail.Queue
 }
 
-func (s *stubMailable) Attachments() []string      { return s.attachments }
-func (s *stubMailable) Content() *mail.Content     { return s.content }
-func (s *stubMailable) Envelope() *mail.Envelope   { return s.envelope }
-func (s *stubMailable) Headers() map[string]string { return s.headers }
-func (s *stubMailable) Queue() *mail.Queue         { return s.queue }
+func (s *stubMailable) Attachables() []mail.Attachment { return s.attachables }
+func (s *stubMailable) Attachments() []string          { return s.attachments }
+func (s *stubMailable) Content() *mail.Content         { return s.content }
+func (s *stubMailable) Envelope() *mail.Envelope       { return s.envelope }
+func (s *stubMailable) Headers() map[string]string     { return s.headers }
+func (s *stubMailable) Queue() *mail.Queue             { return s.queue }
 
 func matchWithID(data any) bool {
 	with, ok := data.(map[string]any)
@@ -383,7 +392,7 @@ func TestApplicationRenderViewTemplate(t *testing.T) {
 		template := mocksmail.NewTemplate(t)
 		template.EXPECT().Render("mail.tmpl", mock.MatchedBy(matchWithID)).Return("", errors.New("render failed")).Once()
 
-		app := &Application{template: template, htmlView: "mail.tmpl", with: map[string]any{"id": 1}}
+		app := &Application{clone: 1, template: template, htmlView: "mail.tmpl", with: map[string]any{"id": 1}}
 		err := app.renderViewTemplate()
 		assert.ErrorContains(t, err, "render failed")
 	})
@@ -405,6 +414,49 @@ func TestApplicationRenderViewTemplate(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "Hello", app.params.Text)
 	})
+
+	t.Run("markdown", func(t *testing.T) {
+		viewsPath := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(viewsPath, "welcome.tmpl"), []byte("# Hello {{ .name }}\n\n{{ button \"https://goravel.dev\" \"Start\" }}"), 0644))
+
+		mockConfig := mocksconfig.NewConfig(t)
+		mockConfig.EXPECT().GetString("mail.markdown.path", "resources/views/mail").Return(viewsPath).Twice()
+		mockConfig.EXPECT().GetString("mail.markdown.components", "resources/views/vendor/mail").Return("resources/views/vendor/mail").Twice()
+		mockConfig.EXPECT().GetString("mail.markdown.theme", "default").Return("default").Twice()
+
+		app := &Application{config: mockConfig, markdownView: "welcome.tmpl", with: map[string]any{"name": "Goravel"}}
+		assert.NoError(t, app.renderViewTemplate())
+		assert.Contains(t, app.params.HTML, `<h1 style="color: #18181b;`)
+		assert.Equal(t, "Hello Goravel\n\nStart (https://goravel.dev)", app.params.Text)
+
+		// The text view takes precedence over the generated plain text.
+		template := mocksmail.NewTemplate(t)
+		template.EXPECT().Render("welcome.txt", map[string]any{"name": "Goravel"}).Return("Welcome", nil).Once()
+
+		app = &Application{config: mockConfig, template: template, markdownView: "welcome.tmpl", textView: "welcome.txt", with: map[string]any{"name": "Goravel"}}
+		assert.NoError(t, app.renderViewTemplate())
+		assert.Equal(t, "Welcome", app.params.Text)
+	})
+
+	t.Run("stylesheets are inlined", func(t *testing.T) {
+		mockConfig := mocksconfig.NewConfig(t)
+		mockConfig.EXPECT().GetBool("mail.inline_css").Return(true).Once()
+
+		app := &Application{config: mockConfig, params: Params{HTML: "<style>h1 { color: red; }</style><h1>Hello</h1>"}}
+
+		assert.NoError(t, app.renderViewTemplate())
+		assert.Equal(t, `<html><head></head><body><h1 style="color: red;">Hello</h1></body></html>`, app.params.HTML)
+	})
+
+	t.Run("stylesheets are not inlined by default", func(t *testing.T) {
+		mockConfig := mocksconfig.NewConfig(t)
+		mockConfig.EXPECT().GetBool("mail.inline_css").Return(false).Once()
+
+		app := &Application{config: mockConfig, params: Params{HTML: "<style>h1 { color: red; }</style><h1>Hello</h1>"}}
+
+		assert.NoError(t, app.renderViewTemplate())
+		assert.Equal(t, "<style>h1 { color: red; }</style><h1>Hello</h1>", app.params.HTML)
+	})
 }
 
 func TestApplicationQueue(t *testing.T) {
@@ -413,6 +465,7 @@ func TestApplicationQueue(t *testing.T) {
 	mockConfig := mocksconfig.NewConfig(t)
 
 	mailable := &stubMailable{
+		attachables: []mail.Attachment{{Disk: "s3", Path: "invoices/1.pdf"}},
 		attachments: []string{"/tmp/logo.png"},
 		content:     &mail.Content{Html: "<h1>Queue</h1>"},
 		envelope: &mail.Envelope{
@@ -428,12 +481,12 @@ func TestApplicationQueue(t *testing.T) {
 
 	mockQueue.EXPECT().Job(
 		mock.MatchedBy(func(job contractsqueue.Job) bool { return job != nil && job.Signature() == "goravel_send_mail_job" }),
-		mock.MatchedBy(func(args []contractsqueue.Arg) bool { return len(args) == 10 }),
+		mock.MatchedBy(func(args []contractsqueue.Arg) bool { return len(args) == 12 }),
 	).
 		Run(func(job contractsqueue.Job, args ...[]contractsqueue.Arg) {
 			assert.Equal(t, "goravel_send_mail_job", job.Signature())
 			assert.Len(t, args, 1)
-			assert.Len(t, args[0], 10)
+			assert.Len(t, args[0], 12)
 			assert.Equal(t, "queue-subject", args[0][0].Value)
 			assert.Equal(t, "<h1>Queue</h1>", args[0][1].Value)
 			assert.Equal(t, "", args[0][2].Value)
@@ -444,6 +497,8 @@ func TestApplicationQueue(t *testing.T) {
 			assert.Equal(t, []string{"bcc@example.com"}, args[0][7].Value)
 			assert.Equal(t, []string{"/tmp/logo.png"}, args[0][8].Value)
 			assert.Equal(t, []string{"X-Test: queue"}, args[0][9].Value)
+			assert.Equal(t, "backup", args[0][10].Value)
+			assert.JSONEq(t, `[{"disk":"s3","path":"invoices/1.pdf"}]`, args[0][11].Value.(string))
 		}).
 		Return(pendingJob).Once()
 	pendingJob.EXPECT().OnConnection("redis").Return(pendingJob).Once()
@@ -452,7 +507,7 @@ func TestApplicationQueue(t *testing.T) {
 
 	app := &Application{config: mockConfig, queue: mockQueue}
 
-	err := app.Queue(mailable)
+	err := app.Mailer("backup").Queue(mailable)
 	assert.NoError(t, err)
 }
 
@@ -460,7 +515,7 @@ func TestApplicationQueueRenderError(t *testing.T) {
 	template := mocksmail.NewTemplate(t)
 	template.EXPECT().Render("mail.tmpl", mock.MatchedBy(matchWithID)).Return("", errors.New("render failed")).Once()
 
-	app := &Application{template: template, htmlView: "mail.tmpl", with: map[string]any{"id": 1}}
+	app := &Application{clone: 1, template: template, htmlView: "mail.tmpl", with: map[string]any{"id": 1}}
 
 	err := app.Queue()
 	assert.ErrorContains(t, err, "render failed")
@@ -476,6 +531,89 @@ func TestApplicationSendRenderError(t *testing.T) {
 	assert.ErrorContains(t, err, "render failed")
 }
 
+func TestApplicationSend(t *testing.T) {
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("mail.default", "smtp").Return("application_default").Once()
+	mockConfig.EXPECT().GetString("mail.mailers.application_default.transport").Return("array").Once()
+	mockConfig.EXPECT().GetString("mail.mailers.application_array.transport").Return("array").Once()
+	mockConfig.EXPECT().GetString("mail.from.address").Return("from@example.com").Twice()
+	mockConfig.EXPECT().GetString("mail.from.name").Return("From").Twice()
+
+	app := &Application{config: mockConfig}
+
+	assert.NoError(t, app.To([]string{"to@example.com"}).Subject("default").Send())
+	assert.NoError(t, app.Mailer("application_array").To([]string{"to@example.com"}).Subject("array").Send())
+
+	defaultTransport, err := GetTransport(mockConfig, nil, nil, "application_default")
+	assert.NoError(t, err)
+	assert.Equal(t, []mail.Message{
+		{
+			From:    mail.Address{Address: "from@example.com", Name: "From"},
+			To:      []string{"to@example.com"},
+			Subject: "default",
+		},
+	}, defaultTransport.(*ArrayTransport).Messages())
+
+	arrayTransport, err := GetTransport(mockConfig, nil, nil, "application_array")
+	assert.NoError(t, err)
+	assert.Len(t, arrayTransport.(*ArrayTransport).Messages(), 1)
+	assert.Equal(t, "array", arrayTransport.(*ArrayTransport).Messages()[0].Subject)
+
+	mockConfig.EXPECT().GetString("mail.mailers.application_unknown.transport").Return("").Once()
+	err = app.Mailer("application_unknown").Send()
+	assert.ErrorIs(t, err, frameworkerrors.MailMailerNotFound)
+}
+
+func TestApplicationSendWithAttachables(t *testing.T) {
+	mockConfig := mocksconfig.NewConfig(t)
+	mockStorage := mocksfilesystem.NewStorage(t)
+	mockDriver := mocksfilesystem.NewDriver(t)
+	mockConfig.EXPECT().GetString("mail.mailers.application_attachables.transport").Return("array").Once()
+	mockConfig.EXPECT().GetString("mail.from.address").Return("from@example.com").Times(6)
+	mockConfig.EXPECT().GetString("mail.from.name").Return("From").Times(6)
+
+	app := &Application{config: mockConfig, storage: mockStorage}
+	logo := AttachFromStorage("s3", "images/logo.png")
+	invoice := AttachData([]byte("invoice"), "invoice.pdf")
+
+	mockStorage.EXPECT().Disk("s3").Return(mockDriver).Once()
+	mockDriver.EXPECT().GetBytes("images/logo.png").Return([]byte("logo"), nil).Once()
+	assert.NoError(t, app.Mailer("application_attachables").
+		Attach([]string{"/tmp/terms.pdf"}).
+		AttachWith(Embed(logo), invoice).
+		Send(&stubMailable{}))
+
+	transport, err := GetTransport(mockConfig, nil, nil, "application_attachables")
+	assert.NoError(t, err)
+	assert.Equal(t, []mail.Attachment{
+		{Path: "/tmp/terms.pdf"},
+		{Data: []byte("logo"), Disk: "s3", Path: "images/logo.png", Name: "logo.png", Inline: true},
+		{Data: []byte("invoice"), Name: "invoice.pdf"},
+	}, transport.(*ArrayTransport).Messages()[0].Attachments)
+
+	// The attachables of the mailable replace the ones of the builder.
+	assert.NoError(t, app.Mailer("application_attachables").
+		AttachWith(logo).
+		Send(&stubMailable{attachables: []mail.Attachment{invoice}}))
+	assert.Equal(t, []mail.Attachment{invoice}, transport.(*ArrayTransport).Messages()[1].Attachments)
+
+	mockStorage.EXPECT().Disk("s3").Return(mockDriver).Once()
+	mockDriver.EXPECT().GetBytes("images/logo.png").Return(nil, assert.AnError).Once()
+	err = app.Mailer("application_attachables").AttachWith(logo).Send()
+	assert.ErrorIs(t, err, assert.AnError)
+
+	mockStorage.EXPECT().Disk("unknown").Panic("the unknown disk isn't configured").Once()
+	err = app.Mailer("application_attachables").AttachWith(AttachFromStorage("unknown", "images/logo.png")).Send()
+	assert.ErrorIs(t, err, frameworkerrors.MailAttachmentDiskInvalid)
+	assert.ErrorContains(t, err, "the unknown disk isn't configured")
+
+	err = app.Mailer("application_attachables").AttachWith(AttachData(nil, "empty.pdf")).Send()
+	assert.ErrorIs(t, err, frameworkerrors.MailAttachmentEmpty)
+
+	err = (&Application{config: mockConfig}).Mailer("application_attachables").AttachWith(logo).Send()
+	assert.ErrorIs(t, err, frameworkerrors.StorageFacadeNotSet)
+}
+
 func TestLoginAuth(t *testing.T) {
 	auth := LoginAuth("user", "pass")
 
@@ -508,7 +646,7 @@ func TestNewApplication(t *testing.T) {
 		mockConfig.EXPECT().GetString("mail.template.engines.mail_unit_success.driver", "html").Return("html").Once()
 		mockConfig.EXPECT().GetString("mail.template.engines.mail_unit_success.path", "resources/views/mail").Return(".").Once()
 
-		app, err := NewApplication(mockConfig, nil)
+		app, err := NewApplication(mockConfig, nil, nil, nil, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, app)
 		assert.Equal(t, mockConfig, app.config)
@@ -519,7 +657,7 @@ func TestNewApplication(t *testing.T) {
 		mockConfig.EXPECT().GetString("mail.template.default", "html").Return("mail_unit_fail").Once()
 		mockConfig.EXPECT().GetString("mail.template.engines.mail_unit_fail.driver", "html").Return("unsupported").Once()
 
-		app, err := NewApplication(mockConfig, nil)
+		app, err := NewApplication(mockConfig, nil, nil, nil, nil)
 		assert.Nil(t, app)
 		assert.ErrorContains(t, err, "not supported")
 	})
@@ -529,6 +667,12 @@ func TestSendMailAttachmentError(t *testing.T) {
 	mockConfig := mocksconfig.NewConfig(t)
 	mockConfig.EXPECT().GetString("mail.from.address").Return("from@example.com").Once()
 	mockConfig.EXPECT().GetString("mail.from.name").Return("From").Once()
+	mockConfig.EXPECT().GetString("mail.default", "smtp").Return("send_mail_smtp").Once()
+	mockConfig.EXPECT().GetString("mail.mailers.send_mail_smtp.transport").Return("smtp").Once()
+	mockConfig.EXPECT().GetString("mail.mailers.send_mail_smtp.host").Return("smtp.example.com").Once()
+	mockConfig.EXPECT().GetInt("mail.mailers.send_mail_smtp.port").Return(587).Once()
+	mockConfig.EXPECT().GetString("mail.mailers.send_mail_smtp.username").Return("user").Once()
+	mockConfig.EXPECT().GetString("mail.mailers.send_mail_smtp.password").Return("pass").Once()
 
 	err := SendMail(mockConfig, Params{
 		To:          []string{"to@example.com"},
@@ -551,7 +695,7 @@ func TestServiceProviderRegister(t *testing.T) {
 	provider := &ServiceProvider{}
 	app := mocksfoundation.NewApplication(t)
 
-	app.EXPECT().Bind(
+	app.EXPECT().Singleton(
 		binding.Mail,
 		mock.AnythingOfType("func(foundation.Application) (interface {}, error)"),
 	).
@@ -582,6 +726,9 @@ func TestServiceProviderRegister(t *testing.T) {
 				queue := mocksqueue.NewQueue(t)
 				withAll.EXPECT().MakeConfig().Return(configAndQueue).Once()
 				withAll.EXPECT().MakeQueue().Return(queue).Once()
+				withAll.EXPECT().MakeLog().Return(nil).Once()
+				withAll.EXPECT().MakeProcess().Return(nil).Once()
+				withAll.EXPECT().MakeStorage().Return(nil).Once()
 				configAndQueue.EXPECT().GetString("mail.template.default", "html").Return("mail_service_provider").Once()
 				configAndQueue.EXPECT().GetString("mail.template.engines.mail_service_provider.driver", "html").Return("html").Once()
 				configAndQueue.EXPECT().GetString("mail.template.engines.mail_service_provider.path", "resources/views/mail").Return(".").Once()
@@ -608,8 +755,15 @@ func TestServiceProviderBootAndRegisterJobs(t *testing.T) {
 				assert.Len(t, commands, 1)
 			}).
 			Once()
+		app.EXPECT().ResourcePath("views", "vendor", "mail").Return("resources/views/vendor/mail").Once()
+		app.EXPECT().Publishes("github.com/goravel/framework/mail", map[string]string{
+			"markdown/resources": "resources/views/vendor/mail",
+		}, "mail-markdown").Once()
 		app.EXPECT().MakeQueue().Return(queue).Once()
 		app.EXPECT().MakeConfig().Return(config).Once()
+		app.EXPECT().MakeLog().Return(nil).Once()
+		app.EXPECT().MakeProcess().Return(nil).Once()
+		app.EXPECT().MakeStorage().Return(nil).Once()
 		queue.EXPECT().Register(mock.AnythingOfType("[]queue.Job")).
 			Run(func(jobs []contractsqueue.Job) {
 				assert.Len(t, jobs, 1)
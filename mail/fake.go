@@ -0,0 +1,174 @@
+package mail
+
+import (
+	"sync"
+
+	contractsmail "github.com/goravel/framework/contracts/mail"
+)
+
+var _ contractsmail.Fake = (*Fake)(nil)
+
+type Fake struct {
+	contractsmail.Mail
+
+	app    *Application
+	queued []contractsmail.Mailable
+	sent   []contractsmail.Mailable
+	mu     sync.RWMutex
+}
+
+func NewFake(app *Application) *Fake {
+	return &Fake{
+		Mail: app,
+		app:  app,
+	}
+}
+
+func (r *Fake) AssertNotQueued(assertion func(mail contractsmail.Mailable) bool) bool {
+	return !r.AssertQueued(assertion)
+}
+
+func (r *Fake) AssertNotSent(assertion func(mail contractsmail.Mailable) bool) bool {
+	return !r.AssertSent(assertion)
+}
+
+func (r *Fake) AssertNothingQueued() bool {
+	return r.AssertQueuedCount(0)
+}
+
+func (r *Fake) AssertNothingSent() bool {
+	return r.AssertSentCount(0)
+}
+
+func (r *Fake) AssertQueued(assertion func(mail contractsmail.Mailable) bool) bool {
+	return matchAny(r.Queued(), assertion)
+}
+
+func (r *Fake) AssertQueuedCount(count int) bool {
+	return len(r.Queued()) == count
+}
+
+func (r *Fake) AssertSent(assertion func(mail contractsmail.Mailable) bool) bool {
+	return matchAny(r.Sent(), assertion)
+}
+
+func (r *Fake) AssertSentCount(count int) bool {
+	return len(r.Sent()) == count
+}
+
+// Queue queues the mail with a new instance, so the mailables don't affect each other.
+func (r *Fake) Queue(mailable ...contractsmail.Mailable) error {
+	return r.app.instance().Queue(mailable...)
+}
+
+func (r *Fake) Queued() []contractsmail.Mailable {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	queued := make([]contractsmail.Mailable, len(r.queued))
+	copy(queued, r.queued)
+
+	return queued
+}
+
+func (r *Fake) Reset() {
+	r.app.fake.CompareAndSwap(r, nil)
+}
+
+// Send sends the mail with a new instance, so the mailables don't affect each other.
+func (r *Fake) Send(mailable ...contractsmail.Mailable) error {
+	return r.app.instance().Send(mailable...)
+}
+
+func (r *Fake) Sent() []contractsmail.Mailable {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sent := make([]contractsmail.Mailable, len(r.sent))
+	copy(sent, r.sent)
+
+	return sent
+}
+
+func (r *Fake) queue(mail *CapturedMail) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.queued = append(r.queued, mail)
+}
+
+func (r *Fake) send(mail *CapturedMail) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sent = append(r.sent, mail)
+}
+
+// CapturedMail is the mail captured by the fake, the content has been rendered and the
+// default sender has been applied.
+type CapturedMail struct {
+	// Mailable is the mailable passed to Send or Queue, it's nil if the mail is built by
+	// the methods of Mail, e.g. To and Subject.
+	Mailable contractsmail.Mailable
+	// Mailer is the name of the mailer, it's empty if the default mailer is used.
+	Mailer string
+
+	message *contractsmail.Message
+	queue   *contractsmail.Queue
+}
+
+// Attachables returns all the attachments of the mail, including the local files.
+func (r *CapturedMail) Attachables() []contractsmail.Attachment {
+	return r.message.Attachments
+}
+
+// Attachments returns the local files attached to the mail.
+func (r *CapturedMail) Attachments() []string {
+	var attachments []string
+	for _, attachment := range r.message.Attachments {
+		if attachment.Disk == "" && len(attachment.Data) == 0 && attachment.Path != "" {
+			attachments = append(attachments, attachment.Path)
+		}
+	}
+
+	return attachments
+}
+
+// Content returns the rendered HTML and text of the mail.
+func (r *CapturedMail) Content() *contractsmail.Content {
+	return &contractsmail.Content{
+		Html: r.message.Html,
+		Text: r.message.Text,
+	}
+}
+
+// Envelope returns the sender, the recipients and the subject of the mail.
+func (r *CapturedMail) Envelope() *contractsmail.Envelope {
+	return &contractsmail.Envelope{
+		Bcc:     r.message.Bcc,
+		Cc:      r.message.Cc,
+		From:    r.message.From,
+		Subject: r.message.Subject,
+		To:      r.message.To,
+	}
+}
+
+// Headers returns the custom headers of the mail.
+func (r *CapturedMail) Headers() map[string]string {
+	return r.message.Headers
+}
+
+// Queue returns the queue of the queued mail, it's nil if the mail is sent.
+func (r *CapturedMail) Queue() *contractsmail.Queue {
+	return r.queue
+}
+
+func matchAny(mails []contractsmail.Mailable, assertion func(mail contractsmail.Mailable) bool) bool {
+	for _, mail := range mails {
+		if assertion(mail) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package mail
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksmail "github.com/goravel/framework/mocks/mail"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestFake(t *testing.T) {
+	mockConfig := mocksconfig.NewConfig(t)
+	mockTemplate := mocksmail.NewTemplate(t)
+	mockConfig.EXPECT().GetString("mail.from.address").Return("from@example.com")
+	mockConfig.EXPECT().GetString("mail.from.name").Return("From")
+
+	app := &Application{config: mockConfig, template: mockTemplate}
+	mailFake := app.Fake()
+	t.Cleanup(mailFake.Reset)
+
+	assert.True(t, mailFake.AssertNothingSent())
+	assert.True(t, mailFake.AssertNothingQueued())
+
+	// The mails sent by the instances cloned from the application are captured as well.
+	assert.NoError(t, app.Mailer("marketing").
+		To([]string{"to@example.com"}).
+		Cc([]string{"cc@example.com"}).
+		Subject("Welcome").
+		Content(contractsmail.Content{Html: "<h1>Welcome</h1>", Text: "Welcome"}).
+		Attach([]string{"logo.png"}).
+		AttachWith(AttachFromStorage("s3", "invoices/1.pdf")).
+		Send())
+
+	mockTemplate.EXPECT().Render("order.tmpl", map[string]any{"id": 1}).Return("<h1>Order 1</h1>", nil).Once()
+	order := &stubMailable{
+		content: &contractsmail.Content{HtmlView: "order.tmpl", With: map[string]any{"id": 1}},
+		envelope: &contractsmail.Envelope{
+			From:    contractsmail.Address{Address: "shop@example.com", Name: "Shop"},
+			To:      []string{"customer@example.com"},
+			Subject: "Order shipped",
+		},
+		headers: map[string]string{"X-Order": "1"},
+		queue:   &contractsmail.Queue{Connection: "redis", Queue: "mails"},
+	}
+	assert.NoError(t, mailFake.Queue(order))
+
+	assert.True(t, mailFake.AssertSentCount(1))
+	assert.True(t, mailFake.AssertQueuedCount(1))
+	assert.False(t, mailFake.AssertNothingSent())
+	assert.False(t, mailFake.AssertNothingQueued())
+
+	assert.True(t, mailFake.AssertSent(func(mail contractsmail.Mailable) bool {
+		return mail.Envelope().Subject == "Welcome"
+	}))
+	assert.True(t, mailFake.AssertNotSent(func(mail contractsmail.Mailable) bool {
+		return mail.Envelope().Subject == "Order shipped"
+	}))
+	assert.True(t, mailFake.AssertQueued(func(mail contractsmail.Mailable) bool {
+		return mail.(*CapturedMail).Mailable == order
+	}))
+	assert.True(t, mailFake.AssertNotQueued(func(mail contractsmail.Mailable) bool {
+		return mail.Envelope().Subject == "Welcome"
+	}))
+
+	sent := mailFake.Sent()[0]
+	assert.Equal(t, &contractsmail.Envelope{
+		From:    contractsmail.Address{Address: "from@example.com", Name: "From"},
+		To:      []string{"to@example.com"},
+		Cc:      []string{"cc@example.com"},
+		Subject: "Welcome",
+	}, sent.Envelope())
+	assert.Equal(t, &contractsmail.Content{Html: "<h1>Welcome</h1>", Text: "Welcome"}, sent.Content())
+	assert.Equal(t, []string{"logo.png"}, sent.Attachments())
+	// The attachments of the filesystem disks aren't loaded.
+	assert.Equal(t, []contractsmail.Attachment{{Path: "logo.png"}, {Disk: "s3", Path: "invoices/1.pdf"}}, sent.(contractsmail.MailableWithAttachments).Attachables())
+	assert.Nil(t, sent.Queue())
+	assert.Nil(t, sent.(*CapturedMail).Mailable)
+	assert.Equal(t, "marketing", sent.(*CapturedMail).Mailer)
+
+	queued := mailFake.Queued()[0]
+	assert.Equal(t, "<h1>Order 1</h1>", queued.Content().Html)
+	assert.Equal(t, contractsmail.Address{Address: "shop@example.com", Name: "Shop"}, queued.Envelope().From)
+	assert.Equal(t, map[string]string{"X-Order": "1"}, queued.Headers())
+	assert.Equal(t, &contractsmail.Queue{Connection: "redis", Queue: "mails"}, queued.Queue())
+
+	// The builder methods of the fake are available as well.
+	assert.NoError(t, mailFake.To([]string{"other@example.com"}).Subject("Other").Send())
+	assert.True(t, mailFake.AssertSentCount(2))
+
+	// The mailables sent by the fake directly don't affect each other.
+	assert.NoError(t, mailFake.Send(&stubMailable{envelope: &contractsmail.Envelope{To: []string{"a@example.com"}, Cc: []string{"cc@example.com"}}}))
+	assert.NoError(t, mailFake.Send(&stubMailable{envelope: &contractsmail.Envelope{To: []string{"b@example.com"}}}))
+	assert.Empty(t, mailFake.Sent()[3].Envelope().Cc)
+
+	mailFake.Reset()
+	assert.Nil(t, app.loadFake())
+}
+
+func TestFakeIsolated(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	mockTask := mocksqueue.NewPendingJob(t)
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("mail.from.address").Return("from@example.com")
+	mockConfig.EXPECT().GetString("mail.from.name").Return("From")
+
+	app := &Application{config: mockConfig, queue: mockQueue, fake: &atomic.Pointer[Fake]{}}
+	mailFake := app.Fake()
+	t.Cleanup(mailFake.Reset)
+
+	// The other applications aren't faked, the mail is dispatched to the queue.
+	other := &Application{config: mockConfig, queue: mockQueue, fake: &atomic.Pointer[Fake]{}}
+	mockQueue.EXPECT().Job(mock.Anything, mock.Anything).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+	assert.NoError(t, other.To([]string{"to@example.com"}).Queue())
+
+	assert.NoError(t, app.To([]string{"to@example.com"}).Queue())
+	assert.True(t, mailFake.AssertQueuedCount(1))
+}
+
+func TestFakeRenderError(t *testing.T) {
+	mockTemplate := mocksmail.NewTemplate(t)
+	mockTemplate.EXPECT().Render("mail.tmpl", mock.MatchedBy(matchWithID)).Return("", assert.AnError).Once()
+
+	mailFake := (&Application{template: mockTemplate}).Fake()
+	t.Cleanup(mailFake.Reset)
+
+	err := mailFake.Send(&stubMailable{content: &contractsmail.Content{HtmlView: "mail.tmpl", With: map[string]any{"id": 1}}})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.True(t, mailFake.AssertNothingSent())
+}
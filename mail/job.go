@@ -1,18 +1,29 @@
 package mail
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/contracts/log"
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/contracts/process"
 )
 
 type SendMailJob struct {
-	config config.Config
+	config  config.Config
+	log     log.Log
+	process process.Process
+	storage filesystem.Storage
 }
 
-func NewSendMailJob(config config.Config) *SendMailJob {
+func NewSendMailJob(config config.Config, log log.Log, process process.Process, storage filesystem.Storage) *SendMailJob {
 	return &SendMailJob{
-		config: config,
+		config:  config,
+		log:     log,
+		process: process,
+		storage: storage,
 	}
 }
 
@@ -23,8 +34,10 @@ func (r *SendMailJob) Signature() string {
 
 // Handle Execute the job.
 func (r *SendMailJob) Handle(args ...any) error {
-	if len(args) != 10 {
-		return fmt.Errorf("expected 10 arguments, got %d", len(args))
+	// The jobs dispatched before the mailer and the attachables arguments were added have
+	// 10 or 11 arguments.
+	if len(args) < 10 || len(args) > 12 {
+		return fmt.Errorf("expected 12 arguments, got %d", len(args))
 	}
 
 	subject, ok := args[0].(string)
@@ -77,6 +90,25 @@ func (r *SendMailJob) Handle(args ...any) error {
 		return fmt.Errorf("HEADERS should be of type []string")
 	}
 
+	var mailer string
+	if len(args) >= 11 {
+		mailer, ok = args[10].(string)
+		if !ok {
+			return fmt.Errorf("MAILER should be of type string")
+		}
+	}
+
+	var attachables []contractsmail.Attachment
+	if len(args) == 12 {
+		attachablesJson, ok := args[11].(string)
+		if !ok {
+			return fmt.Errorf("ATTACHABLES should be of type string")
+		}
+		if err := json.Unmarshal([]byte(attachablesJson), &attachables); err != nil {
+			return fmt.Errorf("ATTACHABLES should be a JSON array: %w", err)
+		}
+	}
+
 	params := Params{
 		Subject:     subject,
 		HTML:        html,
@@ -87,8 +119,19 @@ func (r *SendMailJob) Handle(args ...any) error {
 		CC:          cc,
 		BCC:         bcc,
 		Attachments: attachments,
+		Attachables: attachables,
 		Headers:     convertSliceHeadersToMap(headerSlice),
 	}
 
-	return SendMail(r.config, params)
+	transport, err := GetTransport(r.config, r.log, r.process, mailer)
+	if err != nil {
+		return err
+	}
+
+	message, err := loadAttachments(r.storage, newMessage(r.config, params))
+	if err != nil {
+		return err
+	}
+
+	return transport.Send(message)
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	contractsmail "github.com/goravel/framework/contracts/mail"
 	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
 )
 
 type SendMailJobTestSuite struct {
@@ -20,7 +22,7 @@ func TestSendMailJobTestSuite(t *testing.T) {
 
 func (r *SendMailJobTestSuite) SetupTest() {
 	r.mockConfig = mocksconfig.NewConfig(r.T())
-	r.job = NewSendMailJob(r.mockConfig)
+	r.job = NewSendMailJob(r.mockConfig, nil, nil, nil)
 	r.NotNil(r.job)
 	r.Equal(r.mockConfig, r.job.config)
 }
@@ -43,7 +45,7 @@ func (r *SendMailJobTestSuite) TestHandle_WrongArgumentCount() {
 			args: []any{
 				"subject", "html", "text", "from", "name",
 				[]string{"to"}, []string{"cc"}, []string{"bcc"},
-				[]string{"attachments"}, []string{"headers"}, "extra",
+				[]string{"attachments"}, []string{"headers"}, "mailer", "[]", "extra",
 			},
 		},
 		{
@@ -55,7 +57,7 @@ func (r *SendMailJobTestSuite) TestHandle_WrongArgumentCount() {
 	for _, test := range tests {
 		r.Run(test.name, func() {
 			err := r.job.Handle(test.args...)
-			r.Contains(err.Error(), "expected 10 arguments")
+			r.Contains(err.Error(), "expected 12 arguments")
 		})
 	}
 }
@@ -156,6 +158,33 @@ func (r *SendMailJobTestSuite) TestHandle_WrongArgumentTypes() {
 			},
 			errorMsg: "should be of type []string",
 		},
+		{
+			name: "mailer not string",
+			args: []any{
+				"subject", "html", "text", "from", "name",
+				[]string{"to"}, []string{"cc"}, []string{"bcc"},
+				[]string{"attachments"}, []string{"headers"}, 123,
+			},
+			errorMsg: "MAILER should be of type string",
+		},
+		{
+			name: "attachables not string",
+			args: []any{
+				"subject", "html", "text", "from", "name",
+				[]string{"to"}, []string{"cc"}, []string{"bcc"},
+				[]string{"attachments"}, []string{"headers"}, "mailer", 123,
+			},
+			errorMsg: "ATTACHABLES should be of type string",
+		},
+		{
+			name: "attachables not JSON",
+			args: []any{
+				"subject", "html", "text", "from", "name",
+				[]string{"to"}, []string{"cc"}, []string{"bcc"},
+				[]string{"attachments"}, []string{"headers"}, "mailer", "{",
+			},
+			errorMsg: "ATTACHABLES should be a JSON array",
+		},
 	}
 
 	for _, test := range tests {
@@ -165,3 +194,51 @@ func (r *SendMailJobTestSuite) TestHandle_WrongArgumentTypes() {
 		})
 	}
 }
+
+func (r *SendMailJobTestSuite) TestHandle() {
+	r.mockConfig.EXPECT().GetString("mail.mailers.job_array.transport").Return("array").Once()
+
+	r.NoError(r.job.Handle(
+		"subject", "html", "text", "from@example.com", "From",
+		[]string{"to@example.com"}, []string{}, []string{},
+		[]string{}, []string{"X-Test: job"}, "job_array",
+	))
+
+	transport, err := GetTransport(r.mockConfig, nil, nil, "job_array")
+	r.NoError(err)
+	r.Equal([]contractsmail.Message{
+		{
+			From:    contractsmail.Address{Address: "from@example.com", Name: "From"},
+			To:      []string{"to@example.com"},
+			Cc:      []string{},
+			Bcc:     []string{},
+			Subject: "subject",
+			Html:    "html",
+			Text:    "text",
+			Headers: map[string]string{"X-Test": "job"},
+		},
+	}, transport.(*ArrayTransport).Messages())
+}
+
+func (r *SendMailJobTestSuite) TestHandle_WithAttachables() {
+	mockStorage := mocksfilesystem.NewStorage(r.T())
+	mockDriver := mocksfilesystem.NewDriver(r.T())
+	mockStorage.EXPECT().Disk("s3").Return(mockDriver).Once()
+	mockDriver.EXPECT().GetBytes("invoices/1.pdf").Return([]byte("invoice"), nil).Once()
+	r.mockConfig.EXPECT().GetString("mail.mailers.job_attachables.transport").Return("array").Once()
+
+	job := NewSendMailJob(r.mockConfig, nil, nil, mockStorage)
+	r.NoError(job.Handle(
+		"subject", "html", "text", "from@example.com", "From",
+		[]string{"to@example.com"}, []string{}, []string{},
+		[]string{}, []string{}, "job_attachables",
+		`[{"data":"cmVwb3J0","name":"report.csv","mime_type":"text/csv"},{"disk":"s3","path":"invoices/1.pdf","name":"invoice.pdf"}]`,
+	))
+
+	transport, err := GetTransport(r.mockConfig, nil, nil, "job_attachables")
+	r.NoError(err)
+	r.Equal([]contractsmail.Attachment{
+		{Data: []byte("report"), Name: "report.csv", MimeType: "text/csv"},
+		{Data: []byte("invoice"), Disk: "s3", Path: "invoices/1.pdf", Name: "invoice.pdf"},
+	}, transport.(*ArrayTransport).Messages()[0].Attachments)
+}
@@ -0,0 +1,296 @@
+package markdown
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/goravel/framework/errors"
+)
+
+type declaration struct {
+	property  string
+	value     string
+	important bool
+}
+
+type rule struct {
+	declarations []declaration
+	selector     cascadia.Sel
+}
+
+type matched struct {
+	declaration
+	order       int
+	specificity cascadia.Specificity
+}
+
+// InlineCss moves the CSS rules of the given stylesheets and the <style> elements of the HTML
+// to the style attributes of the matched elements, since many mail clients ignore stylesheets.
+// The rules that can't be inlined, e.g. @media and :hover, are kept in a <style> element.
+func InlineCss(content string, css ...string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", errors.MailInlineCssFailed.Args(err)
+	}
+
+	var (
+		rules     []rule
+		leftovers []string
+		styles    []*html.Node
+	)
+	for _, stylesheet := range css {
+		parsedRules, leftover := parseCss(stylesheet)
+		rules = append(rules, parsedRules...)
+		leftovers = append(leftovers, leftover...)
+	}
+	for node := range doc.Descendants() {
+		if node.Type == html.ElementNode && node.DataAtom == atom.Style && node.FirstChild != nil {
+			parsedRules, leftover := parseCss(node.FirstChild.Data)
+			rules = append(rules, parsedRules...)
+			leftovers = append(leftovers, leftover...)
+			styles = append(styles, node)
+		}
+	}
+
+	for _, style := range styles {
+		style.Parent.RemoveChild(style)
+	}
+
+	applyRules(doc, rules)
+
+	if len(leftovers) > 0 {
+		if head := findHead(doc); head != nil {
+			head.AppendChild(&html.Node{
+				Type:     html.ElementNode,
+				Data:     "style",
+				DataAtom: atom.Style,
+			})
+			head.LastChild.AppendChild(&html.Node{
+				Type: html.TextNode,
+				Data: strings.Join(leftovers, "\n"),
+			})
+		}
+	}
+
+	var buf strings.Builder
+	if err := html.Render(&buf, doc); err != nil {
+		return "", errors.MailInlineCssFailed.Args(err)
+	}
+
+	return buf.String(), nil
+}
+
+func applyRules(doc *html.Node, rules []rule) {
+	matches := make(map[*html.Node][]matched)
+	for order, rule := range rules {
+		for _, node := range cascadia.QueryAll(doc, rule.selector) {
+			for _, declaration := range rule.declarations {
+				matches[node] = append(matches[node], matched{
+					declaration: declaration,
+					order:       order,
+					specificity: rule.selector.Specificity(),
+				})
+			}
+		}
+	}
+
+	for node, declarations := range matches {
+		// The existing style attribute has the highest specificity.
+		for i, attr := range node.Attr {
+			if attr.Key != "style" {
+				continue
+			}
+
+			for _, declaration := range parseDeclarations(attr.Val) {
+				declarations = append(declarations, matched{
+					declaration: declaration,
+					order:       len(rules),
+					specificity: cascadia.Specificity{math.MaxInt, 0, 0},
+				})
+			}
+			node.Attr = slices.Delete(node.Attr, i, i+1)
+
+			break
+		}
+
+		slices.SortStableFunc(declarations, func(a, b matched) int {
+			if a.important != b.important {
+				if a.important {
+					return 1
+				}
+				return -1
+			}
+			if a.specificity.Less(b.specificity) {
+				return -1
+			}
+			if b.specificity.Less(a.specificity) {
+				return 1
+			}
+
+			return cmp.Compare(a.order, b.order)
+		})
+
+		var (
+			properties []string
+			values     = make(map[string]string)
+		)
+		for _, declaration := range declarations {
+			if _, exist := values[declaration.property]; !exist {
+				properties = append(properties, declaration.property)
+			}
+			values[declaration.property] = declaration.value
+		}
+
+		style := make([]string, 0, len(properties))
+		for _, property := range properties {
+			style = append(style, property+": "+values[property]+";")
+		}
+
+		node.Attr = append(node.Attr, html.Attribute{Key: "style", Val: strings.Join(style, " ")})
+	}
+}
+
+func findHead(doc *html.Node) *html.Node {
+	for node := range doc.Descendants() {
+		if node.Type == html.ElementNode && node.DataAtom == atom.Head {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// parseCss parses the rules of the stylesheet, the at-rules and the rules whose selectors
+// can't be matched against the elements are returned as leftovers.
+func parseCss(css string) ([]rule, []string) {
+	var (
+		rules     []rule
+		leftovers []string
+	)
+
+	css = stripComments(css)
+	for {
+		open := strings.IndexByte(css, '{')
+		if open < 0 {
+			break
+		}
+
+		prelude := strings.TrimSpace(css[:open])
+		end := matchingBrace(css, open)
+		block := css[open+1 : end]
+		if end < len(css) {
+			css = css[end+1:]
+		} else {
+			css = ""
+		}
+
+		if strings.HasPrefix(prelude, "@") {
+			leftovers = append(leftovers, prelude+" {"+block+"}")
+			continue
+		}
+
+		declarations := parseDeclarations(block)
+		for selector := range strings.SplitSeq(prelude, ",") {
+			selector = strings.TrimSpace(selector)
+			if selector == "" {
+				continue
+			}
+
+			sel, err := cascadia.Parse(selector)
+			if err != nil || isDynamic(selector) {
+				leftovers = append(leftovers, selector+" {"+block+"}")
+				continue
+			}
+
+			rules = append(rules, rule{
+				declarations: declarations,
+				selector:     sel,
+			})
+		}
+	}
+
+	return rules, leftovers
+}
+
+// isDynamic reports whether the selector depends on the user interaction, such selectors never
+// match a static document but still work in the mail clients that support stylesheets.
+func isDynamic(selector string) bool {
+	for _, pseudo := range []string{":active", ":focus", ":hover", ":target", ":visited"} {
+		if strings.Contains(selector, pseudo) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseDeclarations(block string) []declaration {
+	var declarations []declaration
+	for item := range strings.SplitSeq(block, ";") {
+		property, value, found := strings.Cut(item, ":")
+		if !found {
+			continue
+		}
+
+		property = strings.ToLower(strings.TrimSpace(property))
+		value = strings.TrimSpace(value)
+		if property == "" || value == "" {
+			continue
+		}
+
+		declaration := declaration{property: property, value: value}
+		if trimmed, found := strings.CutSuffix(value, "!important"); found {
+			declaration.important = true
+			declaration.value = strings.TrimSpace(trimmed) + " !important"
+		}
+
+		declarations = append(declarations, declaration)
+	}
+
+	return declarations
+}
+
+// matchingBrace returns the index of the brace that closes the one at the given index,
+// the length of the CSS is returned if the block isn't closed.
+func matchingBrace(css string, open int) int {
+	depth := 0
+	for i := open; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return len(css)
+}
+
+func stripComments(css string) string {
+	var buf strings.Builder
+	for {
+		start := strings.Index(css, "/*")
+		if start < 0 {
+			buf.WriteString(css)
+			break
+		}
+
+		buf.WriteString(css[:start])
+		end := strings.Index(css[start+2:], "*/")
+		if end < 0 {
+			break
+		}
+		css = css[start+2+end+2:]
+	}
+
+	return buf.String()
+}
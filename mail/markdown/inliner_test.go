@@ -0,0 +1,60 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineCss(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		css      []string
+		expected string
+	}{
+		{
+			name:     "stylesheet",
+			content:  `<p class="lead">Hello</p>`,
+			css:      []string{`/* base */ p { color: red; margin: 0 } .lead { color: blue; }`},
+			expected: `<html><head></head><body><p class="lead" style="color: blue; margin: 0;">Hello</p></body></html>`,
+		},
+		{
+			name:     "style element",
+			content:  `<html><head><style>a { color: red; }</style></head><body><a href="#">Link</a></body></html>`,
+			expected: `<html><head></head><body><a href="#" style="color: red;">Link</a></body></html>`,
+		},
+		{
+			name:     "specificity",
+			content:  `<div id="main" class="box">Hello</div>`,
+			css:      []string{`#main { color: red; } .box { color: blue; } div { color: green; }`},
+			expected: `<html><head></head><body><div id="main" class="box" style="color: red;">Hello</div></body></html>`,
+		},
+		{
+			name:     "existing style attribute",
+			content:  `<p class="lead" style="color: green">Hello</p>`,
+			css:      []string{`.lead { color: blue; font-size: 16px; }`},
+			expected: `<html><head></head><body><p class="lead" style="color: green; font-size: 16px;">Hello</p></body></html>`,
+		},
+		{
+			name:     "important",
+			content:  `<p style="color: green">Hello</p>`,
+			css:      []string{`p { color: blue !important; }`},
+			expected: `<html><head></head><body><p style="color: blue !important;">Hello</p></body></html>`,
+		},
+		{
+			name:     "rules that can't be inlined",
+			content:  `<a href="#">Link</a>`,
+			css:      []string{`a { color: red; } a:hover { color: blue; } @media (max-width: 600px) { a { color: green; } }`},
+			expected: `<html><head><style>a:hover { color: blue; }` + "\n" + `@media (max-width: 600px) { a { color: green; } }</style></head><body><a href="#" style="color: red;">Link</a></body></html>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			html, err := InlineCss(test.content, test.css...)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, html)
+		})
+	}
+}
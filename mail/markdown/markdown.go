@@ -0,0 +1,265 @@
+package markdown
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support"
+	supportfile "github.com/goravel/framework/support/file"
+)
+
+// DefaultTheme is the theme used when the mail.markdown.theme config isn't set.
+const DefaultTheme = "default"
+
+// resources contains the default components and themes, they can be published to the
+// components path via `vendor:publish --tag=mail-markdown` for customising.
+//
+//go:embed resources
+var resources embed.FS
+
+var components = []string{"button", "footer", "layout", "panel", "table"}
+
+var instances sync.Map
+
+type instanceKey struct {
+	viewsPath      string
+	componentsPath string
+	theme          string
+}
+
+// Get retrieves a cached markdown renderer according to the mail.markdown config.
+func Get(config config.Config) *Markdown {
+	key := instanceKey{
+		viewsPath:      config.GetString("mail.markdown.path", "resources/views/mail"),
+		componentsPath: config.GetString("mail.markdown.components", "resources/views/vendor/mail"),
+		theme:          config.GetString("mail.markdown.theme", DefaultTheme),
+	}
+
+	if cached, ok := instances.Load(key); ok {
+		return cached.(*Markdown)
+	}
+
+	actual, _ := instances.LoadOrStore(key, NewMarkdown(key.viewsPath, key.componentsPath, key.theme))
+
+	return actual.(*Markdown)
+}
+
+type Markdown struct {
+	componentsPath string
+	theme          string
+	viewsPath      string
+
+	// markdown converts the views, the HTML in the views and the output of the components is kept,
+	// but the dangerous URLs of the links are removed, since the markdown may come from the data.
+	markdown goldmark.Markdown
+	// slot converts the slots of the components, the HTML in the slots is omitted since the
+	// slots usually contain the data of the views.
+	slot goldmark.Markdown
+
+	cache      sync.Map
+	components *template.Template
+	css        string
+	loadErr    error
+	loadOnce   sync.Once
+}
+
+func NewMarkdown(viewsPath, componentsPath, theme string) *Markdown {
+	return &Markdown{
+		componentsPath: componentsPath,
+		theme:          theme,
+		viewsPath:      viewsPath,
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithParserOptions(parser.WithASTTransformers(util.Prioritized(safeLinks{}, 0))),
+			goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+		),
+		slot: goldmark.New(goldmark.WithExtensions(extension.GFM)),
+	}
+}
+
+// Render renders the markdown view with the given data. It returns the HTML that is wrapped by
+// the layout and has the theme CSS inlined, and the plain text alternative of the HTML.
+func (r *Markdown) Render(view string, data any) (string, string, error) {
+	if err := r.load(); err != nil {
+		return "", "", err
+	}
+
+	tmpl, err := r.getTemplate(filepath.Join(support.RelativePath, r.viewsPath, view))
+	if err != nil {
+		return "", "", err
+	}
+
+	var source bytes.Buffer
+	if err := tmpl.Execute(&source, data); err != nil {
+		return "", "", errors.MailTemplateExecutionFailed.Args(view, err)
+	}
+
+	var body bytes.Buffer
+	if err := r.markdown.Convert(source.Bytes(), &body); err != nil {
+		return "", "", errors.MailMarkdownRenderFailed.Args(view, err)
+	}
+
+	var layout strings.Builder
+	if err := r.components.ExecuteTemplate(&layout, "layout", map[string]any{
+		"Body": template.HTML(body.String()),
+	}); err != nil {
+		return "", "", errors.MailTemplateExecutionFailed.Args("layout", err)
+	}
+
+	html, err := InlineCss(layout.String(), r.css)
+	if err != nil {
+		return "", "", err
+	}
+
+	return html, ToText(body.String()), nil
+}
+
+func (r *Markdown) button(url, text string, color ...string) (template.HTML, error) {
+	data := map[string]any{
+		"Color": "primary",
+		"Text":  text,
+		"Url":   url,
+	}
+	if len(color) > 0 && color[0] != "" {
+		data["Color"] = color[0]
+	}
+
+	return r.component("button", data)
+}
+
+// component renders the component to a single HTML block, the blank lines and the indentation
+// are removed, otherwise the component will be treated as markdown paragraphs or code blocks.
+func (r *Markdown) component(name string, data map[string]any) (template.HTML, error) {
+	var buf strings.Builder
+	if err := r.components.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", errors.MailTemplateExecutionFailed.Args(name, err)
+	}
+
+	var lines []string
+	for line := range strings.Lines(buf.String()) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return template.HTML("\n\n" + strings.Join(lines, "\n") + "\n\n"), nil
+}
+
+func (r *Markdown) funcs() template.FuncMap {
+	slotComponent := func(name string) func(slot string) (template.HTML, error) {
+		return func(slot string) (template.HTML, error) {
+			var buf bytes.Buffer
+			if err := r.slot.Convert([]byte(slot), &buf); err != nil {
+				return "", errors.MailMarkdownRenderFailed.Args(name, err)
+			}
+
+			return r.component(name, map[string]any{
+				"Slot": template.HTML(buf.String()),
+			})
+		}
+	}
+
+	return template.FuncMap{
+		"button": r.button,
+		"footer": slotComponent("footer"),
+		"panel":  slotComponent("panel"),
+		"table":  slotComponent("table"),
+	}
+}
+
+func (r *Markdown) getTemplate(templatePath string) (*template.Template, error) {
+	if cached, ok := r.cache.Load(templatePath); ok {
+		return cached.(*template.Template), nil
+	}
+
+	tmpl, err := template.New(filepath.Base(templatePath)).Funcs(r.funcs()).ParseFiles(templatePath)
+	if err != nil {
+		return nil, errors.MailTemplateParseFailed.Args(templatePath, err)
+	}
+
+	actual, _ := r.cache.LoadOrStore(templatePath, tmpl)
+
+	return actual.(*template.Template), nil
+}
+
+// load parses the components and reads the theme once, the published ones take precedence
+// over the embedded ones.
+func (r *Markdown) load() error {
+	r.loadOnce.Do(func() {
+		tmpl := template.New("components")
+		for _, name := range components {
+			content, err := r.readResource("html/" + name + ".tmpl")
+			if err != nil {
+				r.loadErr = errors.MailMarkdownComponentNotFound.Args(name)
+				return
+			}
+			if _, err := tmpl.New(name).Parse(string(content)); err != nil {
+				r.loadErr = errors.MailTemplateParseFailed.Args(name, err)
+				return
+			}
+		}
+
+		css, err := r.readResource("themes/" + r.theme + ".css")
+		if err != nil {
+			r.loadErr = errors.MailMarkdownThemeNotFound.Args(r.theme)
+			return
+		}
+
+		r.components = tmpl
+		r.css = string(css)
+	})
+
+	return r.loadErr
+}
+
+func (r *Markdown) readResource(name string) ([]byte, error) {
+	published := filepath.Join(support.RelativePath, r.componentsPath, filepath.FromSlash(name))
+	if supportfile.Exists(published) {
+		return os.ReadFile(published)
+	}
+
+	return fs.ReadFile(resources, path.Join("resources", name))
+}
+
+// safeLinks removes the dangerous URLs, such as "javascript:", of the links and the images. The
+// renderer of the views allows the raw HTML for the components, which allows the dangerous URLs
+// as well. The HTML in the data is escaped by the template, but the markdown in it isn't.
+type safeLinks struct{}
+
+func (r safeLinks) Transform(node *ast.Document, _ text.Reader, _ parser.Context) {
+	_ = ast.Walk(node, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch link := node.(type) {
+		case *ast.Link:
+			if goldmarkhtml.IsDangerousURL(link.Destination) {
+				link.Destination = nil
+			}
+		case *ast.Image:
+			if goldmarkhtml.IsDangerousURL(link.Destination) {
+				link.Destination = nil
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
@@ -0,0 +1,130 @@
+package markdown
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+)
+
+const orderView = `# Order Shipped
+
+Hello {{ .Name }}, your order has shipped.
+
+{{ button .Url "View Order" "success" }}
+
+{{ panel "Delivery in *2* days <script>alert(1)</script>" }}
+
+{{ table ` + "`" + `
+| Item | Price |
+| :--- | ---: |
+| Book | $10 |
+` + "`" + ` }}
+
+{{ footer "Goravel" }}
+`
+
+func writeFile(t *testing.T, path, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestGet(t *testing.T) {
+	instances = sync.Map{}
+
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("mail.markdown.path", "resources/views/mail").Return("views").Twice()
+	mockConfig.EXPECT().GetString("mail.markdown.components", "resources/views/vendor/mail").Return("components").Twice()
+	mockConfig.EXPECT().GetString("mail.markdown.theme", DefaultTheme).Return("dark").Twice()
+
+	markdown := Get(mockConfig)
+	assert.Equal(t, "views", markdown.viewsPath)
+	assert.Equal(t, "components", markdown.componentsPath)
+	assert.Equal(t, "dark", markdown.theme)
+	assert.Same(t, markdown, Get(mockConfig))
+}
+
+func TestMarkdown_Render(t *testing.T) {
+	viewsPath := t.TempDir()
+	writeFile(t, filepath.Join(viewsPath, "order.tmpl"), orderView)
+
+	html, text, err := NewMarkdown(viewsPath, t.TempDir(), DefaultTheme).Render("order.tmpl", map[string]any{
+		"Name": "<b>Goravel</b>",
+		"Url":  "https://example.com/orders/1",
+	})
+	assert.NoError(t, err)
+
+	// The view is wrapped by the layout and the theme CSS is inlined.
+	assert.Contains(t, html, `<table class="wrapper"`)
+	assert.Contains(t, html, `<h1 style="color: #18181b;`)
+	assert.Contains(t, html, `<a class="button button-success" href="https://example.com/orders/1"`)
+	assert.Contains(t, html, "background-color: #16a34a;")
+	assert.Contains(t, html, `<td class="panel-content" style=`)
+	assert.Contains(t, html, `<td class="footer-cell" align="center" style=`)
+	assert.Contains(t, html, "@media only screen and (max-width: 600px)")
+
+	// The data and the slots are escaped.
+	assert.Contains(t, html, "Hello &lt;b&gt;Goravel&lt;/b&gt;")
+	assert.NotContains(t, html, "<script>")
+
+	assert.Equal(t, `Order Shipped
+
+Hello <b>Goravel</b>, your order has shipped.
+
+View Order (https://example.com/orders/1)
+
+Delivery in 2 days alert(1)
+
+Item  Price
+Book  $10
+
+Goravel`, text)
+}
+
+func TestMarkdown_RenderWithPublishedResources(t *testing.T) {
+	viewsPath := t.TempDir()
+	componentsPath := t.TempDir()
+	writeFile(t, filepath.Join(viewsPath, "welcome.tmpl"), `{{ button "https://example.com" "Start" }}`)
+	writeFile(t, filepath.Join(componentsPath, "html", "button.tmpl"), `<div class="cta"><a href="{{ .Url }}">{{ .Text }}</a></div>`)
+	writeFile(t, filepath.Join(componentsPath, "themes", "brand.css"), `.cta a { color: #ff2d20; }`)
+
+	html, text, err := NewMarkdown(viewsPath, componentsPath, "brand").Render("welcome.tmpl", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, html, `<div class="cta"><a href="https://example.com" style="color: #ff2d20;">Start</a></div>`)
+	assert.Equal(t, "Start (https://example.com)", text)
+}
+
+func TestMarkdown_RenderRemovesDangerousLinks(t *testing.T) {
+	viewsPath := t.TempDir()
+	writeFile(t, filepath.Join(viewsPath, "comment.tmpl"), "{{ .Comment }}\n\n[Docs](https://goravel.dev)")
+
+	html, _, err := NewMarkdown(viewsPath, t.TempDir(), DefaultTheme).Render("comment.tmpl", map[string]any{
+		"Comment": "[Click](javascript:alert(1)) ![Image](vbscript:msgbox)",
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, html, "javascript:")
+	assert.NotContains(t, html, "vbscript:")
+	assert.Contains(t, html, `href=""`)
+	assert.Contains(t, html, `href="https://goravel.dev"`)
+}
+
+func TestMarkdown_RenderErrors(t *testing.T) {
+	viewsPath := t.TempDir()
+	writeFile(t, filepath.Join(viewsPath, "bad.tmpl"), `{{ .User.Name }}`)
+
+	_, _, err := NewMarkdown(viewsPath, t.TempDir(), "missing").Render("bad.tmpl", nil)
+	assert.ErrorIs(t, err, errors.MailMarkdownThemeNotFound)
+
+	markdown := NewMarkdown(viewsPath, t.TempDir(), DefaultTheme)
+
+	_, _, err = markdown.Render("missing.tmpl", nil)
+	assert.ErrorIs(t, err, errors.MailTemplateParseFailed)
+
+	_, _, err = markdown.Render("bad.tmpl", map[string]any{"User": nil})
+	assert.ErrorIs(t, err, errors.MailTemplateExecutionFailed)
+}
@@ -0,0 +1,105 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	blankLines = regexp.MustCompile(`\n{3,}`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// ToText converts the HTML to the plain text alternative of the mail, the links are kept
+// after their text and the list items are prefixed with a dash.
+func ToText(content string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return ""
+	}
+
+	var buf strings.Builder
+	for _, node := range nodes {
+		writeText(&buf, node)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func writeText(buf *strings.Builder, node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		buf.WriteString(whitespace.ReplaceAllString(node.Data, " "))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.Head, atom.Script, atom.Style:
+		return
+	case atom.Br:
+		buf.WriteString("\n")
+		return
+	case atom.Hr:
+		buf.WriteString("\n\n---\n\n")
+		return
+	case atom.Li:
+		buf.WriteString("\n- ")
+	case atom.Td, atom.Th:
+		buf.WriteString(" ")
+	case atom.P, atom.Div, atom.Table, atom.Ul, atom.Ol, atom.Blockquote, atom.Pre,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		buf.WriteString("\n\n")
+	case atom.Tr:
+		buf.WriteString("\n")
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeText(buf, child)
+	}
+
+	switch node.DataAtom {
+	case atom.A:
+		if href := attribute(node, "href"); href != "" && !strings.HasPrefix(href, "#") && href != textContent(node) {
+			buf.WriteString(" (" + href + ")")
+		}
+	case atom.P, atom.Div, atom.Table, atom.Ul, atom.Ol, atom.Blockquote, atom.Pre,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		buf.WriteString("\n\n")
+	}
+}
+
+func attribute(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+
+	return ""
+}
+
+func textContent(node *html.Node) string {
+	var buf strings.Builder
+	for descendant := range node.Descendants() {
+		if descendant.Type == html.TextNode {
+			buf.WriteString(descendant.Data)
+		}
+	}
+
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,28 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToText(t *testing.T) {
+	html := `<h1>Welcome</h1>
+<p>Hello   <strong>Goravel</strong>,<br>thanks for joining.</p>
+<ul><li>First</li><li>Second</li></ul>
+<p><a href="https://goravel.dev">Docs</a> and <a href="https://goravel.dev">https://goravel.dev</a></p>
+<hr>
+<style>p { color: red; }</style>`
+
+	assert.Equal(t, `Welcome
+
+Hello Goravel,
+thanks for joining.
+
+- First
+- Second
+
+Docs (https://goravel.dev) and https://goravel.dev
+
+---`, ToText(html))
+}
@@ -1,6 +1,8 @@
 package mail
 
 import (
+	"path/filepath"
+
 	"github.com/goravel/framework/contracts/mail"
 )
 
@@ -11,6 +13,50 @@ func Address(address, name string) mail.Address {
 	}
 }
 
+// AttachData creates an attachment from the in-memory data.
+func AttachData(data []byte, name string, mimeType ...string) mail.Attachment {
+	attachment := mail.Attachment{
+		Data: data,
+		Name: name,
+	}
+	if len(mimeType) > 0 {
+		attachment.MimeType = mimeType[0]
+	}
+
+	return attachment
+}
+
+// AttachFromPath creates an attachment from the local file.
+func AttachFromPath(path string, name ...string) mail.Attachment {
+	attachment := mail.Attachment{
+		Path: path,
+	}
+	if len(name) > 0 {
+		attachment.Name = name[0]
+	}
+
+	return attachment
+}
+
+// AttachFromStorage creates an attachment from the file of the filesystem disk.
+func AttachFromStorage(disk, path string, name ...string) mail.Attachment {
+	attachment := AttachFromPath(path, name...)
+	attachment.Disk = disk
+
+	return attachment
+}
+
+// Embed makes the attachment inline, it can be referenced in the HTML via "cid:" + the name of
+// the attachment, e.g. <img src="cid:logo.png">.
+func Embed(attachment mail.Attachment) mail.Attachment {
+	attachment.Inline = true
+	if attachment.Name == "" {
+		attachment.Name = filepath.Base(attachment.Path)
+	}
+
+	return attachment
+}
+
 func Html(html string) mail.Content {
 	return mail.Content{
 		Html: html,
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	contractsmail "github.com/goravel/framework/contracts/mail"
 )
 
 func TestAddress(t *testing.T) {
@@ -12,6 +14,16 @@ func TestAddress(t *testing.T) {
 	assert.Equal(t, "Mailer", address.Name)
 }
 
+func TestAttachments(t *testing.T) {
+	assert.Equal(t, contractsmail.Attachment{Data: []byte("a,b"), Name: "report.csv"}, AttachData([]byte("a,b"), "report.csv"))
+	assert.Equal(t, contractsmail.Attachment{Data: []byte("{}"), Name: "data", MimeType: "application/json"}, AttachData([]byte("{}"), "data", "application/json"))
+	assert.Equal(t, contractsmail.Attachment{Path: "/tmp/logo.png"}, AttachFromPath("/tmp/logo.png"))
+	assert.Equal(t, contractsmail.Attachment{Path: "/tmp/logo.png", Name: "brand.png"}, AttachFromPath("/tmp/logo.png", "brand.png"))
+	assert.Equal(t, contractsmail.Attachment{Disk: "s3", Path: "invoices/1.pdf"}, AttachFromStorage("s3", "invoices/1.pdf"))
+	assert.Equal(t, contractsmail.Attachment{Path: "/tmp/logo.png", Name: "logo.png", Inline: true}, Embed(AttachFromPath("/tmp/logo.png")))
+	assert.Equal(t, contractsmail.Attachment{Data: []byte("png"), Name: "chart.png", Inline: true}, Embed(AttachData([]byte("png"), "chart.png")))
+}
+
 func TestHtml(t *testing.T) {
 	content := Html("<h1>Hello</h1>")
 	assert.Equal(t, "<h1>Hello</h1>", content.Html)
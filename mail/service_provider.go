@@ -24,7 +24,9 @@ func (r *ServiceProvider) Relationship() binding.Relationship {
 }
 
 func (r *ServiceProvider) Register(app foundation.Application) {
-	app.Bind(binding.Mail, func(app foundation.Application) (any, error) {
+	// The application is a singleton, so the fake is shared by the resolved instances, the
+	// builder methods clone it.
+	app.Singleton(binding.Mail, func(app foundation.Application) (any, error) {
 		config := app.MakeConfig()
 		if config == nil {
 			return nil, errors.ConfigFacadeNotSet.SetModule(errors.ModuleMail)
@@ -35,7 +37,7 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 			return nil, errors.QueueFacadeNotSet.SetModule(errors.ModuleMail)
 		}
 
-		return NewApplication(config, queue)
+		return NewApplication(config, queue, app.MakeLog(), app.MakeProcess(), app.MakeStorage())
 	})
 }
 
@@ -44,6 +46,10 @@ func (r *ServiceProvider) Boot(app foundation.Application) {
 		console.NewMailMakeCommand(),
 	})
 
+	app.Publishes("github.com/goravel/framework/mail", map[string]string{
+		"markdown/resources": app.ResourcePath("views", "vendor", "mail"),
+	}, "mail-markdown")
+
 	r.registerJobs(app)
 }
 
@@ -61,6 +67,6 @@ func (r *ServiceProvider) registerJobs(app foundation.Application) {
 	}
 
 	queueFacade.Register([]contractsqueue.Job{
-		NewSendMailJob(configFacade),
+		NewSendMailJob(configFacade, app.MakeLog(), app.MakeProcess(), app.MakeStorage()),
 	})
 }
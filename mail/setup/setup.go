@@ -17,6 +17,7 @@ func main() {
 	mailServiceProvider := "&mail.ServiceProvider{}"
 	facadesPackage := setup.Paths().Facades().Package()
 	env := `
+MAIL_MAILER=smtp
 MAIL_HOST=
 MAIL_PORT=
 MAIL_USERNAME=
@@ -16,19 +16,57 @@ import (
 func init() {
 	config := DummyFacadesPackage.Config()
 	config.Add("mail", map[string]any{
-		// SMTP Host Address
+		// Default Mailer
 		//
-		// Here you may provide the host address of the SMTP server used by your
-		// applications. A default option is provided that is compatible with
-		// the Mailgun mail service which will provide reliable deliveries.
-		"host": config.Env("MAIL_HOST", ""),
+		// This option controls the default mailer that is used to send all email
+		// messages unless another mailer is explicitly specified when sending
+		// the message.
+		"default": config.Env("MAIL_MAILER", "smtp"),
 
-		// SMTP Host Port
+		// Mailer Configurations
 		//
-		// This is the SMTP port used by your application to deliver e-mails to
-		// users of the application. Like the host we have set this value to
-		// stay compatible with the Mailgun e-mail application by default.
-		"port": config.Env("MAIL_PORT", 587),
+		// Here you may configure all of the mailers used by your application plus
+		// their respective settings. You are free to add additional mailers as
+		// required, the "failover" and "roundrobin" transports wrap the mailers
+		// listed in their "mailers" option.
+		//
+		// Available Transports: "smtp", "log", "array", "sendmail", "failover",
+		// "roundrobin", "custom"
+		"mailers": map[string]any{
+			"smtp": map[string]any{
+				"transport": "smtp",
+				"host":      config.Env("MAIL_HOST", ""),
+				"port":      config.Env("MAIL_PORT", 587),
+				"username":  config.Env("MAIL_USERNAME"),
+				"password":  config.Env("MAIL_PASSWORD"),
+			},
+			"log": map[string]any{
+				"transport": "log",
+				"channel":   config.Env("MAIL_LOG_CHANNEL"),
+			},
+			"array": map[string]any{
+				"transport": "array",
+			},
+			"sendmail": map[string]any{
+				"transport": "sendmail",
+				"path":      config.Env("MAIL_SENDMAIL_PATH", "/usr/sbin/sendmail -i"),
+			},
+			"failover": map[string]any{
+				"transport": "failover",
+				"mailers":   []string{"smtp", "log"},
+			},
+			"roundrobin": map[string]any{
+				"transport": "roundrobin",
+				"mailers":   []string{"smtp", "log"},
+			},
+			// Example custom transport:
+			// "ses": map[string]any{
+			//     "transport": "custom",
+			//     "via": func() (mail.Transport, error) {
+			//         return NewSesTransport(), nil
+			//     },
+			// },
+		},
 
 		// --------------------------------------------------------------------------
 		// Global "From" Address
@@ -42,15 +80,6 @@ func init() {
 			"name":    config.Env("MAIL_FROM_NAME", "Example"),
 		},
 
-		// SMTP Server Username
-		//
-		// If your SMTP server requires a username for authentication, you should
-		// set it here. This will get used to authenticate with your server on
-		// connection. You may also set the "password" value below this one.
-		"username": config.Env("MAIL_USERNAME"),
-
-		"password": config.Env("MAIL_PASSWORD"),
-
 		// Template Configuration
 		//
 		// This controls template rendering for email views. Template engines are cached
@@ -73,6 +102,24 @@ func init() {
 				// },
 			},
 		},
+
+		// Markdown Mail Settings
+		//
+		// The markdown views are rendered with the components (button, panel, table and
+		// footer) and the theme CSS is inlined. You may customise the components and the
+		// theme by publishing them via "go run . artisan vendor:publish --tag=mail-markdown".
+		"markdown": map[string]any{
+			"theme":      config.Env("MAIL_MARKDOWN_THEME", "default"),
+			"path":       "resources/views/mail",
+			"components": "resources/views/vendor/mail",
+		},
+
+		// Inline CSS
+		//
+		// The stylesheets of the HTML mails (not the markdown ones, which are always inlined)
+		// are inlined to the style attributes when it's enabled, since many mail clients
+		// ignore the <style> elements.
+		"inline_css": config.Env("MAIL_INLINE_CSS", false),
 	})
 }
 `
@@ -0,0 +1,156 @@
+package mail
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/log"
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/contracts/process"
+	"github.com/goravel/framework/errors"
+)
+
+const (
+	TransportArray      = "array"
+	TransportCustom     = "custom"
+	TransportFailover   = "failover"
+	TransportLog        = "log"
+	TransportRoundRobin = "roundrobin"
+	TransportSendmail   = "sendmail"
+	TransportSmtp       = "smtp"
+)
+
+// transports caches the transports by the config and the mailer, so the applications built
+// with different configs don't share the transports of the mailers with the same name.
+var transports sync.Map
+
+type transportKey struct {
+	config config.Config
+	mailer string
+}
+
+// GetTransport retrieves the cached transport of a mailer, creating it if it doesn't exist.
+// The transports are shared by the mail instances of the same config, so the messages of the
+// array transport and the position of the round-robin transport are kept between the sends.
+func GetTransport(config config.Config, log log.Log, process process.Process, mailer string) (contractsmail.Transport, error) {
+	if mailer == "" {
+		mailer = config.GetString("mail.default", TransportSmtp)
+	}
+
+	return resolveTransport(config, log, process, mailer, nil)
+}
+
+// resolveTransport resolves the cached transport of a mailer, resolving contains the failover
+// and round-robin mailers that are being created, to detect the circular references.
+func resolveTransport(config config.Config, log log.Log, process process.Process, mailer string, resolving []string) (contractsmail.Transport, error) {
+	key := transportKey{config: config, mailer: mailer}
+	if cached, ok := transports.Load(key); ok {
+		return cached.(contractsmail.Transport), nil
+	}
+
+	if slices.Contains(resolving, mailer) {
+		return nil, errors.MailMailerCircularReference.Args(mailer)
+	}
+
+	transport, err := createTransport(config, log, process, mailer, resolving)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := transports.LoadOrStore(key, transport)
+
+	return actual.(contractsmail.Transport), nil
+}
+
+// ForgetTransports removes the cached transports of the config, they are created again with
+// the current options of the mailers on the next send.
+func ForgetTransports(config config.Config) {
+	transports.Range(func(key, _ any) bool {
+		if key.(transportKey).config == config {
+			transports.Delete(key)
+		}
+
+		return true
+	})
+}
+
+func createTransport(config config.Config, log log.Log, process process.Process, mailer string, resolving []string) (contractsmail.Transport, error) {
+	key := "mail.mailers." + mailer
+	transport := config.GetString(key + ".transport")
+	if transport == "" {
+		if mailer != TransportSmtp {
+			return nil, errors.MailMailerNotFound.Args(mailer)
+		}
+
+		// The mailers are not configured, the SMTP options are at the top level of the mail config.
+		return NewSmtpTransport(config.GetString("mail.host"), config.GetInt("mail.port"),
+			config.GetString("mail.username"), config.GetString("mail.password")), nil
+	}
+
+	switch transport {
+	case TransportSmtp:
+		return NewSmtpTransport(config.GetString(key+".host"), config.GetInt(key+".port"),
+			config.GetString(key+".username"), config.GetString(key+".password")), nil
+	case TransportLog:
+		if log == nil {
+			return nil, errors.LogFacadeNotSet.SetModule(errors.ModuleMail)
+		}
+
+		return NewLogTransport(log, config.GetString(key+".channel")), nil
+	case TransportArray:
+		return NewArrayTransport(), nil
+	case TransportSendmail:
+		if process == nil {
+			return nil, errors.ProcessFacadeNotSet.SetModule(errors.ModuleMail)
+		}
+
+		return NewSendmailTransport(process, config.GetString(key+".path", "/usr/sbin/sendmail -i")), nil
+	case TransportFailover, TransportRoundRobin:
+		mailers := cast.ToStringSlice(config.Get(key + ".mailers"))
+		if len(mailers) == 0 {
+			return nil, errors.MailMailersRequired.Args(transport, mailer)
+		}
+
+		wrapped := make([]contractsmail.Transport, len(mailers))
+		for i, name := range mailers {
+			// The wrapped mailers are resolved from the cache as well, so they share the state
+			// with the mailers that are used directly.
+			instance, err := resolveTransport(config, log, process, name, append(resolving, mailer))
+			if err != nil {
+				return nil, err
+			}
+
+			wrapped[i] = instance
+		}
+
+		if transport == TransportFailover {
+			return NewFailoverTransport(wrapped), nil
+		}
+
+		return NewRoundRobinTransport(wrapped), nil
+	case TransportCustom:
+		via := config.Get(key+".via", "")
+		if via == "" {
+			return nil, errors.MailTransportViaRequired.Args(mailer)
+		}
+
+		switch v := via.(type) {
+		case contractsmail.Transport:
+			return v, nil
+		case func() (contractsmail.Transport, error):
+			instance, err := v()
+			if err != nil {
+				return nil, errors.MailTransportFactoryFailed.Args(mailer, err)
+			}
+
+			return instance, nil
+		default:
+			return nil, errors.MailTransportViaInvalid.Args(mailer)
+		}
+	default:
+		return nil, errors.MailTransportNotSupported.Args(transport)
+	}
+}
@@ -0,0 +1,238 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockslog "github.com/goravel/framework/mocks/log"
+	mocksmail "github.com/goravel/framework/mocks/mail"
+	mocksprocess "github.com/goravel/framework/mocks/process"
+)
+
+func TestGetTransport(t *testing.T) {
+	var (
+		mockConfig  *mocksconfig.Config
+		mockLog     *mockslog.Log
+		mockProcess *mocksprocess.Process
+	)
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockLog = mockslog.NewLog(t)
+		mockProcess = mocksprocess.NewProcess(t)
+	}
+
+	t.Run("default mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.default", "smtp").Return("transport_default").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_default.transport").Return("array").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "")
+		assert.NoError(t, err)
+		assert.IsType(t, &ArrayTransport{}, transport)
+
+		// The transport is cached.
+		cached, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_default")
+		assert.NoError(t, err)
+		assert.Same(t, transport, cached)
+	})
+
+	t.Run("the transports are cached by the config", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_config.transport").Return("array").Twice()
+		otherConfig := mocksconfig.NewConfig(t)
+		otherConfig.EXPECT().GetString("mail.mailers.transport_config.transport").Return("array").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_config")
+		assert.NoError(t, err)
+
+		other, err := GetTransport(otherConfig, mockLog, mockProcess, "transport_config")
+		assert.NoError(t, err)
+		assert.NotSame(t, transport, other)
+
+		ForgetTransports(mockConfig)
+
+		recreated, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_config")
+		assert.NoError(t, err)
+		assert.NotSame(t, transport, recreated)
+
+		cached, err := GetTransport(otherConfig, mockLog, mockProcess, "transport_config")
+		assert.NoError(t, err)
+		assert.Same(t, other, cached)
+	})
+
+	t.Run("smtp mailer without the mailers config", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.smtp.transport").Return("").Once()
+		mockConfig.EXPECT().GetString("mail.host").Return("smtp.example.com").Once()
+		mockConfig.EXPECT().GetInt("mail.port").Return(465).Once()
+		mockConfig.EXPECT().GetString("mail.username").Return("user").Once()
+		mockConfig.EXPECT().GetString("mail.password").Return("pass").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "smtp")
+		assert.NoError(t, err)
+		assert.Equal(t, NewSmtpTransport("smtp.example.com", 465, "user", "pass"), transport)
+	})
+
+	t.Run("smtp mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_smtp.transport").Return("smtp").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_smtp.host").Return("smtp.example.com").Once()
+		mockConfig.EXPECT().GetInt("mail.mailers.transport_smtp.port").Return(587).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_smtp.username").Return("user").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_smtp.password").Return("pass").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_smtp")
+		assert.NoError(t, err)
+		assert.Equal(t, NewSmtpTransport("smtp.example.com", 587, "user", "pass"), transport)
+	})
+
+	t.Run("log mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_log.transport").Return("log").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_log.channel").Return("mail").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_log")
+		assert.NoError(t, err)
+		assert.Equal(t, NewLogTransport(mockLog, "mail"), transport)
+	})
+
+	t.Run("log mailer without the log facade", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_log_nil.transport").Return("log").Once()
+
+		transport, err := GetTransport(mockConfig, nil, mockProcess, "transport_log_nil")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.LogFacadeNotSet)
+	})
+
+	t.Run("sendmail mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_sendmail.transport").Return("sendmail").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_sendmail.path", "/usr/sbin/sendmail -i").Return("/usr/bin/sendmail -i").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_sendmail")
+		assert.NoError(t, err)
+		assert.Equal(t, NewSendmailTransport(mockProcess, "/usr/bin/sendmail -i"), transport)
+	})
+
+	t.Run("sendmail mailer without the process facade", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_sendmail_nil.transport").Return("sendmail").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, nil, "transport_sendmail_nil")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.ProcessFacadeNotSet)
+	})
+
+	t.Run("failover mailer shares the wrapped mailers", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_failover.transport").Return("failover").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_failover.mailers").Return([]any{"transport_failover_array"}).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_failover_array.transport").Return("array").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_failover")
+		assert.NoError(t, err)
+		assert.IsType(t, &FailoverTransport{}, transport)
+		assert.NoError(t, transport.Send(newTestMessage()))
+
+		array, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_failover_array")
+		assert.NoError(t, err)
+		assert.Len(t, array.(*ArrayTransport).Messages(), 1)
+	})
+
+	t.Run("roundrobin mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_roundrobin.transport").Return("roundrobin").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_roundrobin.mailers").Return([]string{"transport_roundrobin_array"}).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_roundrobin_array.transport").Return("array").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_roundrobin")
+		assert.NoError(t, err)
+		assert.IsType(t, &RoundRobinTransport{}, transport)
+	})
+
+	t.Run("failover mailer without the mailers", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_failover_empty.transport").Return("failover").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_failover_empty.mailers").Return(nil).Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_failover_empty")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.MailMailersRequired)
+	})
+
+	t.Run("circular reference", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_circular_a.transport").Return("failover").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_circular_a.mailers").Return([]string{"transport_circular_b"}).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_circular_b.transport").Return("roundrobin").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_circular_b.mailers").Return([]string{"transport_circular_a"}).Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_circular_a")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.MailMailerCircularReference)
+	})
+
+	t.Run("custom mailer", func(t *testing.T) {
+		beforeEach()
+		custom := mocksmail.NewTransport(t)
+		mockConfig.EXPECT().GetString("mail.mailers.transport_custom.transport").Return("custom").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_custom.via", "").Return(custom).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_custom_factory.transport").Return("custom").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_custom_factory.via", "").Return(func() (contractsmail.Transport, error) {
+			return custom, nil
+		}).Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_custom")
+		assert.NoError(t, err)
+		assert.Same(t, custom, transport)
+
+		transport, err = GetTransport(mockConfig, mockLog, mockProcess, "transport_custom_factory")
+		assert.NoError(t, err)
+		assert.Same(t, custom, transport)
+	})
+
+	t.Run("custom mailer with invalid via", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_custom_missing.transport").Return("custom").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_custom_missing.via", "").Return("").Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_custom_invalid.transport").Return("custom").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_custom_invalid.via", "").Return(1).Once()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_custom_failed.transport").Return("custom").Once()
+		mockConfig.EXPECT().Get("mail.mailers.transport_custom_failed.via", "").Return(func() (contractsmail.Transport, error) {
+			return nil, assert.AnError
+		}).Once()
+
+		_, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_custom_missing")
+		assert.ErrorIs(t, err, errors.MailTransportViaRequired)
+
+		_, err = GetTransport(mockConfig, mockLog, mockProcess, "transport_custom_invalid")
+		assert.ErrorIs(t, err, errors.MailTransportViaInvalid)
+
+		_, err = GetTransport(mockConfig, mockLog, mockProcess, "transport_custom_failed")
+		assert.ErrorIs(t, err, errors.MailTransportFactoryFailed)
+	})
+
+	t.Run("undefined mailer", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_undefined.transport").Return("").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_undefined")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.MailMailerNotFound)
+	})
+
+	t.Run("unsupported transport", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("mail.mailers.transport_unsupported.transport").Return("ses").Once()
+
+		transport, err := GetTransport(mockConfig, mockLog, mockProcess, "transport_unsupported")
+		assert.Nil(t, transport)
+		assert.ErrorIs(t, err, errors.MailTransportNotSupported)
+	})
+}
@@ -0,0 +1,306 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/goravel/framework/contracts/log"
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/contracts/process"
+	"github.com/goravel/framework/errors"
+)
+
+type SmtpTransport struct {
+	host     string
+	username string
+	password string
+	port     int
+}
+
+func NewSmtpTransport(host string, port int, username, password string) *SmtpTransport {
+	return &SmtpTransport{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+	}
+}
+
+func (r *SmtpTransport) Send(message *contractsmail.Message) error {
+	e, err := newEmail(message)
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf("%s:%d", r.host, r.port)
+	auth := LoginAuth(r.username, r.password)
+
+	switch r.port {
+	case 465:
+		return e.SendWithTLS(addr, auth, &tls.Config{ServerName: r.host})
+	case 587:
+		return e.SendWithStartTLS(addr, auth, &tls.Config{ServerName: r.host})
+	default:
+		return e.Send(addr, auth)
+	}
+}
+
+// LogTransport writes the MIME encoded messages to the log instead of sending them, it's
+// useful for the local development.
+type LogTransport struct {
+	log     log.Log
+	channel string
+}
+
+func NewLogTransport(log log.Log, channel string) *LogTransport {
+	return &LogTransport{
+		log:     log,
+		channel: channel,
+	}
+}
+
+func (r *LogTransport) Send(message *contractsmail.Message) error {
+	raw, err := encodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	writer := r.log
+	if r.channel != "" {
+		writer = r.log.Channel(r.channel)
+	}
+	writer.Debug(string(raw))
+
+	return nil
+}
+
+// ArrayTransport keeps the messages in memory instead of sending them.
+type ArrayTransport struct {
+	messages []contractsmail.Message
+	mu       sync.RWMutex
+}
+
+func NewArrayTransport() *ArrayTransport {
+	return &ArrayTransport{}
+}
+
+func (r *ArrayTransport) Send(message *contractsmail.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, *message)
+
+	return nil
+}
+
+// Messages returns the messages that have been sent.
+func (r *ArrayTransport) Messages() []contractsmail.Message {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages := make([]contractsmail.Message, len(r.messages))
+	copy(messages, r.messages)
+
+	return messages
+}
+
+// Flush removes the messages that have been sent.
+func (r *ArrayTransport) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = nil
+}
+
+// SendmailTransport pipes the MIME encoded messages to the local sendmail binary, the
+// sender and the recipients are passed as the arguments, since the Bcc header isn't
+// contained in the message.
+type SendmailTransport struct {
+	process process.Process
+	path    string
+	// mu serializes the sends, the process instance can't be used concurrently.
+	mu sync.Mutex
+}
+
+func NewSendmailTransport(process process.Process, path string) *SendmailTransport {
+	return &SendmailTransport{
+		process: process,
+		path:    path,
+	}
+}
+
+func (r *SendmailTransport) Send(message *contractsmail.Message) error {
+	raw, err := encodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	recipients := make([]string, 0, len(message.To)+len(message.Cc)+len(message.Bcc))
+	for _, address := range append(append(append([]string{}, message.To...), message.Cc...), message.Bcc...) {
+		parsed, err := mail.ParseAddress(address)
+		if err != nil {
+			return err
+		}
+
+		recipients = append(recipients, parsed.Address)
+	}
+
+	fields := strings.Fields(r.path)
+	if len(fields) == 0 {
+		fields = []string{"/usr/sbin/sendmail", "-i"}
+	}
+	args := append(fields[1:], "-f", message.From.Address, "--")
+	args = append(args, recipients...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.process.Input(bytes.NewReader(raw)).Quietly().Run(fields[0], args...)
+	if result.Failed() {
+		output := strings.TrimSpace(result.ErrorOutput())
+		if output == "" && result.Error() != nil {
+			output = result.Error().Error()
+		}
+
+		return errors.MailSendmailFailed.Args(result.ExitCode(), output)
+	}
+
+	return nil
+}
+
+// FailoverTransport sends the messages with the first transport, the next transport is
+// tried if the previous one fails.
+type FailoverTransport struct {
+	transports []contractsmail.Transport
+}
+
+func NewFailoverTransport(transports []contractsmail.Transport) *FailoverTransport {
+	return &FailoverTransport{
+		transports: transports,
+	}
+}
+
+func (r *FailoverTransport) Send(message *contractsmail.Message) error {
+	return sendInTurn(r.transports, 0, message)
+}
+
+// RoundRobinTransport distributes the messages across the transports, the next transport is
+// tried if the selected one fails.
+type RoundRobinTransport struct {
+	transports []contractsmail.Transport
+	next       atomic.Uint64
+}
+
+func NewRoundRobinTransport(transports []contractsmail.Transport) *RoundRobinTransport {
+	return &RoundRobinTransport{
+		transports: transports,
+	}
+}
+
+func (r *RoundRobinTransport) Send(message *contractsmail.Message) error {
+	start := int((r.next.Add(1) - 1) % uint64(len(r.transports)))
+
+	return sendInTurn(r.transports, start, message)
+}
+
+// sendInTurn sends the message with the transports from the start index until one of them
+// succeeds, the errors of all the transports are returned if none of them succeeds.
+func sendInTurn(transports []contractsmail.Transport, start int, message *contractsmail.Message) error {
+	var errs []error
+	for i := range transports {
+		err := transports[(start+i)%len(transports)].Send(message)
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// encodeMessage returns the MIME encoded message.
+func encodeMessage(message *contractsmail.Message) ([]byte, error) {
+	e, err := newEmail(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Bytes()
+}
+
+func newEmail(message *contractsmail.Message) (*Email, error) {
+	e := NewEmail()
+	e.From = fmt.Sprintf("%s <%s>", message.From.Name, message.From.Address)
+	e.To = message.To
+	if len(message.Bcc) > 0 {
+		e.Bcc = message.Bcc
+	}
+	if len(message.Cc) > 0 {
+		e.Cc = message.Cc
+	}
+	e.Subject = message.Subject
+
+	if len(message.Html) > 0 {
+		e.HTML = []byte(message.Html)
+	}
+
+	if len(message.Text) > 0 {
+		e.Text = []byte(message.Text)
+	}
+
+	for _, attachment := range message.Attachments {
+		if err := attach(e, attachment); err != nil {
+			return nil, err
+		}
+	}
+
+	for key, val := range message.Headers {
+		e.Headers.Add(key, val)
+	}
+
+	return e, nil
+}
+
+// attach adds the attachment to the email, the content is read from the local Path if Data is
+// empty, the attachments of the filesystem disks should have been loaded by loadAttachments.
+func attach(e *Email, attachment contractsmail.Attachment) error {
+	name := attachment.Name
+	if name == "" {
+		name = filepath.Base(attachment.Path)
+	}
+
+	mimeType := attachment.MimeType
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(name))
+	}
+
+	data := attachment.Data
+	if len(data) == 0 {
+		if attachment.Disk != "" {
+			return errors.MailAttachmentNotLoaded.Args(attachment.Path, attachment.Disk)
+		}
+
+		content, err := os.ReadFile(attachment.Path)
+		if err != nil {
+			return err
+		}
+		data = content
+	}
+
+	created, err := e.Attach(bytes.NewReader(data), name, mimeType)
+	if err != nil {
+		return err
+	}
+	created.HTMLRelated = attachment.Inline
+
+	return nil
+}
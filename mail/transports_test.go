@@ -0,0 +1,214 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/errors"
+	mockslog "github.com/goravel/framework/mocks/log"
+	mocksmail "github.com/goravel/framework/mocks/mail"
+	mocksprocess "github.com/goravel/framework/mocks/process"
+)
+
+func newTestMessage() *contractsmail.Message {
+	return &contractsmail.Message{
+		From:    contractsmail.Address{Address: "from@example.com", Name: "From"},
+		To:      []string{"To <to@example.com>"},
+		Cc:      []string{"cc@example.com"},
+		Bcc:     []string{"bcc@example.com"},
+		Subject: "Goravel",
+		Html:    "<h1>Hello Goravel</h1>",
+		Headers: map[string]string{"X-Test": "transport"},
+	}
+}
+
+func TestSmtpTransport(t *testing.T) {
+	message := newTestMessage()
+	message.Attachments = []contractsmail.Attachment{{Path: "/tmp/does-not-exist.txt"}}
+
+	err := NewSmtpTransport("smtp.example.com", 587, "user", "pass").Send(message)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestNewEmailAttachments(t *testing.T) {
+	logo := filepath.Join(t.TempDir(), "logo.png")
+	assert.NoError(t, os.WriteFile(logo, []byte("logo"), 0644))
+
+	message := newTestMessage()
+	message.Html = `<img src="cid:logo.png">`
+	message.Attachments = []contractsmail.Attachment{
+		{Path: logo, Inline: true},
+		{Data: []byte("a,b"), Name: "report.csv"},
+		{Data: []byte("{}"), Name: "data", MimeType: "application/json"},
+	}
+
+	e, err := newEmail(message)
+	assert.NoError(t, err)
+	assert.Len(t, e.Attachments, 3)
+
+	assert.Equal(t, "logo.png", e.Attachments[0].Filename)
+	assert.Equal(t, "image/png", e.Attachments[0].ContentType)
+	assert.Equal(t, []byte("logo"), e.Attachments[0].Content)
+	assert.True(t, e.Attachments[0].HTMLRelated)
+
+	assert.Equal(t, "report.csv", e.Attachments[1].Filename)
+	assert.Equal(t, "text/csv; charset=utf-8", e.Attachments[1].ContentType)
+	assert.False(t, e.Attachments[1].HTMLRelated)
+
+	assert.Equal(t, "application/json", e.Attachments[2].ContentType)
+
+	raw, err := e.Bytes()
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), "Content-Id: <logo.png>")
+	assert.Contains(t, string(raw), "multipart/related")
+
+	// The attachments of the filesystem disks should be loaded before sending.
+	message.Attachments = []contractsmail.Attachment{{Disk: "s3", Path: "logo.png"}}
+	_, err = newEmail(message)
+	assert.ErrorIs(t, err, errors.MailAttachmentNotLoaded)
+}
+
+func TestLogTransport(t *testing.T) {
+	// The message is MIME encoded, the Bcc header isn't contained.
+	isMessage := mock.MatchedBy(func(raw string) bool {
+		return strings.Contains(raw, "Subject: Goravel") &&
+			strings.Contains(raw, "X-Test: transport") &&
+			!strings.Contains(raw, "bcc@example.com")
+	})
+
+	t.Run("default channel", func(t *testing.T) {
+		mockLog := mockslog.NewLog(t)
+		mockLog.EXPECT().Debug(isMessage).Once()
+
+		assert.NoError(t, NewLogTransport(mockLog, "").Send(newTestMessage()))
+	})
+
+	t.Run("specified channel", func(t *testing.T) {
+		mockLog := mockslog.NewLog(t)
+		mockChannel := mockslog.NewLog(t)
+		mockLog.EXPECT().Channel("mail").Return(mockChannel).Once()
+		mockChannel.EXPECT().Debug(isMessage).Once()
+
+		assert.NoError(t, NewLogTransport(mockLog, "mail").Send(newTestMessage()))
+	})
+}
+
+func TestArrayTransport(t *testing.T) {
+	transport := NewArrayTransport()
+	assert.Empty(t, transport.Messages())
+
+	assert.NoError(t, transport.Send(newTestMessage()))
+	assert.Equal(t, []contractsmail.Message{*newTestMessage()}, transport.Messages())
+
+	transport.Flush()
+	assert.Empty(t, transport.Messages())
+}
+
+func TestSendmailTransport(t *testing.T) {
+	var (
+		mockProcess *mocksprocess.Process
+		mockResult  *mocksprocess.Result
+	)
+
+	beforeEach := func() {
+		mockProcess = mocksprocess.NewProcess(t)
+		mockResult = mocksprocess.NewResult(t)
+
+		mockProcess.EXPECT().Input(mock.MatchedBy(func(in io.Reader) bool {
+			raw, err := io.ReadAll(in)
+
+			return err == nil && bytes.Contains(raw, []byte("Subject: Goravel"))
+		})).Return(mockProcess).Once()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		beforeEach()
+		mockProcess.EXPECT().Run("/usr/sbin/sendmail", "-i", "-f", "from@example.com", "--",
+			"to@example.com", "cc@example.com", "bcc@example.com").Return(mockResult).Once()
+		mockResult.EXPECT().Failed().Return(false).Once()
+
+		assert.NoError(t, NewSendmailTransport(mockProcess, "/usr/sbin/sendmail -i").Send(newTestMessage()))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		beforeEach()
+		mockProcess.EXPECT().Run("/usr/bin/sendmail", "-f", "from@example.com", "--",
+			"to@example.com", "cc@example.com", "bcc@example.com").Return(mockResult).Once()
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().ErrorOutput().Return("recipient rejected\n").Once()
+		mockResult.EXPECT().ExitCode().Return(75).Once()
+
+		err := NewSendmailTransport(mockProcess, "/usr/bin/sendmail").Send(newTestMessage())
+		assert.EqualError(t, err, errors.MailSendmailFailed.Args(75, "recipient rejected").Error())
+	})
+
+	t.Run("invalid recipient", func(t *testing.T) {
+		message := newTestMessage()
+		message.To = []string{"invalid"}
+
+		assert.Error(t, NewSendmailTransport(mocksprocess.NewProcess(t), "/usr/sbin/sendmail -i").Send(message))
+	})
+}
+
+func TestFailoverTransport(t *testing.T) {
+	message := newTestMessage()
+
+	t.Run("the first transport succeeds", func(t *testing.T) {
+		first := mocksmail.NewTransport(t)
+		second := mocksmail.NewTransport(t)
+		first.EXPECT().Send(message).Return(nil).Once()
+
+		assert.NoError(t, NewFailoverTransport([]contractsmail.Transport{first, second}).Send(message))
+	})
+
+	t.Run("fail over to the next transport", func(t *testing.T) {
+		first := mocksmail.NewTransport(t)
+		second := mocksmail.NewTransport(t)
+		first.EXPECT().Send(message).Return(assert.AnError).Once()
+		second.EXPECT().Send(message).Return(nil).Once()
+
+		assert.NoError(t, NewFailoverTransport([]contractsmail.Transport{first, second}).Send(message))
+	})
+
+	t.Run("all transports fail", func(t *testing.T) {
+		first := mocksmail.NewTransport(t)
+		second := mocksmail.NewTransport(t)
+		secondErr := errors.New("second failed")
+		first.EXPECT().Send(message).Return(assert.AnError).Once()
+		second.EXPECT().Send(message).Return(secondErr).Once()
+
+		err := NewFailoverTransport([]contractsmail.Transport{first, second}).Send(message)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorIs(t, err, secondErr)
+	})
+}
+
+func TestRoundRobinTransport(t *testing.T) {
+	message := newTestMessage()
+	first := mocksmail.NewTransport(t)
+	second := mocksmail.NewTransport(t)
+	transport := NewRoundRobinTransport([]contractsmail.Transport{first, second})
+
+	first.EXPECT().Send(message).Return(nil).Once()
+	assert.NoError(t, transport.Send(message))
+
+	second.EXPECT().Send(message).Return(nil).Once()
+	assert.NoError(t, transport.Send(message))
+
+	// The next transport is tried if the selected one fails.
+	first.EXPECT().Send(message).Return(assert.AnError).Once()
+	second.EXPECT().Send(message).Return(nil).Once()
+	assert.NoError(t, transport.Send(message))
+
+	second.EXPECT().Send(message).Return(nil).Once()
+	assert.NoError(t, transport.Send(message))
+}
@@ -1,6 +1,12 @@
 package mail
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/errors"
+)
 
 func convertMapHeadersToSlice(headers map[string]string) []string {
 	var slice []string
@@ -20,3 +26,59 @@ func convertSliceHeadersToMap(headers []string) map[string]string {
 	}
 	return mapHeaders
 }
+
+// loadAttachments loads the attachments of the filesystem disks to Data, the message is copied
+// so the captured and queued attachments keep referencing the disks. The attachments without
+// data and path (e.g. AttachData with empty data) are rejected.
+func loadAttachments(storage filesystem.Storage, message *mail.Message) (*mail.Message, error) {
+	var loaded *mail.Message
+	for i, attachment := range message.Attachments {
+		if len(attachment.Data) > 0 {
+			continue
+		}
+		if attachment.Path == "" {
+			return nil, errors.MailAttachmentEmpty.Args(attachment.Name)
+		}
+		if attachment.Disk == "" {
+			continue
+		}
+		if storage == nil {
+			return nil, errors.StorageFacadeNotSet.SetModule(errors.ModuleMail)
+		}
+
+		driver, err := resolveDisk(storage, attachment.Disk)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := driver.GetBytes(attachment.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if loaded == nil {
+			copied := *message
+			copied.Attachments = append([]mail.Attachment(nil), message.Attachments...)
+			loaded = &copied
+		}
+		loaded.Attachments[i].Data = data
+	}
+
+	if loaded == nil {
+		return message, nil
+	}
+
+	return loaded, nil
+}
+
+// resolveDisk gets the driver of the disk, Storage.Disk panics if the disk can't be created (e.g.
+// it isn't configured), the panic is returned as an error instead.
+func resolveDisk(storage filesystem.Storage, disk string) (driver filesystem.Driver, err error) {
+	defer func() {
+		if re := recover(); re != nil {
+			err = errors.MailAttachmentDiskInvalid.Args(disk, re)
+		}
+	}()
+
+	return storage.Disk(disk), nil
+}
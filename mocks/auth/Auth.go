@@ -462,6 +462,67 @@ func (_c *Auth_Parse_Call) RunAndReturn(run func(string) (*auth.Payload, error))
 	return _c
 }
 
+// Password provides a mock function with given fields: name
+func (_m *Auth) Password(name ...string) auth.PasswordBroker {
+	_va := make([]interface{}, len(name))
+	for _i := range name {
+		_va[_i] = name[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Password")
+	}
+
+	var r0 auth.PasswordBroker
+	if rf, ok := ret.Get(0).(func(...string) auth.PasswordBroker); ok {
+		r0 = rf(name...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(auth.PasswordBroker)
+		}
+	}
+
+	return r0
+}
+
+// Auth_Password_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Password'
+type Auth_Password_Call struct {
+	*mock.Call
+}
+
+// Password is a helper method to define mock.On call
+//   - name ...string
+func (_e *Auth_Expecter) Password(name ...interface{}) *Auth_Password_Call {
+	return &Auth_Password_Call{Call: _e.mock.On("Password",
+		append([]interface{}{}, name...)...)}
+}
+
+func (_c *Auth_Password_Call) Run(run func(name ...string)) *Auth_Password_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Auth_Password_Call) Return(_a0 auth.PasswordBroker) *Auth_Password_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_Password_Call) RunAndReturn(run func(...string) auth.PasswordBroker) *Auth_Password_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Provider provides a mock function with given fields: name, fn
 func (_m *Auth) Provider(name string, fn auth.UserProviderFunc) {
 	_m.Called(name, fn)
@@ -551,6 +612,116 @@ func (_c *Auth_Refresh_Call) RunAndReturn(run func() (string, error)) *Auth_Refr
 	return _c
 }
 
+// Tokens provides a mock function with given fields: user, guard
+func (_m *Auth) Tokens(user interface{}, guard ...string) auth.UserTokens {
+	_va := make([]interface{}, len(guard))
+	for _i := range guard {
+		_va[_i] = guard[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, user)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tokens")
+	}
+
+	var r0 auth.UserTokens
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) auth.UserTokens); ok {
+		r0 = rf(user, guard...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(auth.UserTokens)
+		}
+	}
+
+	return r0
+}
+
+// Auth_Tokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tokens'
+type Auth_Tokens_Call struct {
+	*mock.Call
+}
+
+// Tokens is a helper method to define mock.On call
+//   - user interface{}
+//   - guard ...string
+func (_e *Auth_Expecter) Tokens(user interface{}, guard ...interface{}) *Auth_Tokens_Call {
+	return &Auth_Tokens_Call{Call: _e.mock.On("Tokens",
+		append([]interface{}{user}, guard...)...)}
+}
+
+func (_c *Auth_Tokens_Call) Run(run func(user interface{}, guard ...string)) *Auth_Tokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Auth_Tokens_Call) Return(_a0 auth.UserTokens) *Auth_Tokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_Tokens_Call) RunAndReturn(run func(interface{}, ...string) auth.UserTokens) *Auth_Tokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TwoFactor provides a mock function with no fields
+func (_m *Auth) TwoFactor() auth.TwoFactor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TwoFactor")
+	}
+
+	var r0 auth.TwoFactor
+	if rf, ok := ret.Get(0).(func() auth.TwoFactor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(auth.TwoFactor)
+		}
+	}
+
+	return r0
+}
+
+// Auth_TwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TwoFactor'
+type Auth_TwoFactor_Call struct {
+	*mock.Call
+}
+
+// TwoFactor is a helper method to define mock.On call
+func (_e *Auth_Expecter) TwoFactor() *Auth_TwoFactor_Call {
+	return &Auth_TwoFactor_Call{Call: _e.mock.On("TwoFactor")}
+}
+
+func (_c *Auth_TwoFactor_Call) Run(run func()) *Auth_TwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Auth_TwoFactor_Call) Return(_a0 auth.TwoFactor) *Auth_TwoFactor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_TwoFactor_Call) RunAndReturn(run func() auth.TwoFactor) *Auth_TwoFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // User provides a mock function with given fields: user
 func (_m *Auth) User(user interface{}) error {
 	ret := _m.Called(user)
@@ -597,6 +768,53 @@ func (_c *Auth_User_Call) RunAndReturn(run func(interface{}) error) *Auth_User_C
 	return _c
 }
 
+// Verification provides a mock function with no fields
+func (_m *Auth) Verification() auth.EmailVerifier {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verification")
+	}
+
+	var r0 auth.EmailVerifier
+	if rf, ok := ret.Get(0).(func() auth.EmailVerifier); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(auth.EmailVerifier)
+		}
+	}
+
+	return r0
+}
+
+// Auth_Verification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verification'
+type Auth_Verification_Call struct {
+	*mock.Call
+}
+
+// Verification is a helper method to define mock.On call
+func (_e *Auth_Expecter) Verification() *Auth_Verification_Call {
+	return &Auth_Verification_Call{Call: _e.mock.On("Verification")}
+}
+
+func (_c *Auth_Verification_Call) Run(run func()) *Auth_Verification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Auth_Verification_Call) Return(_a0 auth.EmailVerifier) *Auth_Verification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_Verification_Call) RunAndReturn(run func() auth.EmailVerifier) *Auth_Verification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuth creates a new instance of Auth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuth(t interface {
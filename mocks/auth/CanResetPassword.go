@@ -0,0 +1,125 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import mock "github.com/stretchr/testify/mock"
+
+// CanResetPassword is an autogenerated mock type for the CanResetPassword type
+type CanResetPassword struct {
+	mock.Mock
+}
+
+type CanResetPassword_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CanResetPassword) EXPECT() *CanResetPassword_Expecter {
+	return &CanResetPassword_Expecter{mock: &_m.Mock}
+}
+
+// GetEmailForPasswordReset provides a mock function with no fields
+func (_m *CanResetPassword) GetEmailForPasswordReset() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailForPasswordReset")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CanResetPassword_GetEmailForPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmailForPasswordReset'
+type CanResetPassword_GetEmailForPasswordReset_Call struct {
+	*mock.Call
+}
+
+// GetEmailForPasswordReset is a helper method to define mock.On call
+func (_e *CanResetPassword_Expecter) GetEmailForPasswordReset() *CanResetPassword_GetEmailForPasswordReset_Call {
+	return &CanResetPassword_GetEmailForPasswordReset_Call{Call: _e.mock.On("GetEmailForPasswordReset")}
+}
+
+func (_c *CanResetPassword_GetEmailForPasswordReset_Call) Run(run func()) *CanResetPassword_GetEmailForPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CanResetPassword_GetEmailForPasswordReset_Call) Return(_a0 string) *CanResetPassword_GetEmailForPasswordReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CanResetPassword_GetEmailForPasswordReset_Call) RunAndReturn(run func() string) *CanResetPassword_GetEmailForPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RouteNotificationFor provides a mock function with given fields: channel
+func (_m *CanResetPassword) RouteNotificationFor(channel string) interface{} {
+	ret := _m.Called(channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteNotificationFor")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// CanResetPassword_RouteNotificationFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteNotificationFor'
+type CanResetPassword_RouteNotificationFor_Call struct {
+	*mock.Call
+}
+
+// RouteNotificationFor is a helper method to define mock.On call
+//   - channel string
+func (_e *CanResetPassword_Expecter) RouteNotificationFor(channel interface{}) *CanResetPassword_RouteNotificationFor_Call {
+	return &CanResetPassword_RouteNotificationFor_Call{Call: _e.mock.On("RouteNotificationFor", channel)}
+}
+
+func (_c *CanResetPassword_RouteNotificationFor_Call) Run(run func(channel string)) *CanResetPassword_RouteNotificationFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CanResetPassword_RouteNotificationFor_Call) Return(_a0 interface{}) *CanResetPassword_RouteNotificationFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CanResetPassword_RouteNotificationFor_Call) RunAndReturn(run func(string) interface{}) *CanResetPassword_RouteNotificationFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCanResetPassword creates a new instance of CanResetPassword. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCanResetPassword(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CanResetPassword {
+	mock := &CanResetPassword{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,171 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import mock "github.com/stretchr/testify/mock"
+
+// CanResetPasswordWithNotification is an autogenerated mock type for the CanResetPasswordWithNotification type
+type CanResetPasswordWithNotification struct {
+	mock.Mock
+}
+
+type CanResetPasswordWithNotification_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CanResetPasswordWithNotification) EXPECT() *CanResetPasswordWithNotification_Expecter {
+	return &CanResetPasswordWithNotification_Expecter{mock: &_m.Mock}
+}
+
+// GetEmailForPasswordReset provides a mock function with no fields
+func (_m *CanResetPasswordWithNotification) GetEmailForPasswordReset() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailForPasswordReset")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CanResetPasswordWithNotification_GetEmailForPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmailForPasswordReset'
+type CanResetPasswordWithNotification_GetEmailForPasswordReset_Call struct {
+	*mock.Call
+}
+
+// GetEmailForPasswordReset is a helper method to define mock.On call
+func (_e *CanResetPasswordWithNotification_Expecter) GetEmailForPasswordReset() *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call {
+	return &CanResetPasswordWithNotification_GetEmailForPasswordReset_Call{Call: _e.mock.On("GetEmailForPasswordReset")}
+}
+
+func (_c *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call) Run(run func()) *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call) Return(_a0 string) *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call) RunAndReturn(run func() string) *CanResetPasswordWithNotification_GetEmailForPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RouteNotificationFor provides a mock function with given fields: channel
+func (_m *CanResetPasswordWithNotification) RouteNotificationFor(channel string) interface{} {
+	ret := _m.Called(channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteNotificationFor")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// CanResetPasswordWithNotification_RouteNotificationFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteNotificationFor'
+type CanResetPasswordWithNotification_RouteNotificationFor_Call struct {
+	*mock.Call
+}
+
+// RouteNotificationFor is a helper method to define mock.On call
+//   - channel string
+func (_e *CanResetPasswordWithNotification_Expecter) RouteNotificationFor(channel interface{}) *CanResetPasswordWithNotification_RouteNotificationFor_Call {
+	return &CanResetPasswordWithNotification_RouteNotificationFor_Call{Call: _e.mock.On("RouteNotificationFor", channel)}
+}
+
+func (_c *CanResetPasswordWithNotification_RouteNotificationFor_Call) Run(run func(channel string)) *CanResetPasswordWithNotification_RouteNotificationFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_RouteNotificationFor_Call) Return(_a0 interface{}) *CanResetPasswordWithNotification_RouteNotificationFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_RouteNotificationFor_Call) RunAndReturn(run func(string) interface{}) *CanResetPasswordWithNotification_RouteNotificationFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendPasswordResetNotification provides a mock function with given fields: token
+func (_m *CanResetPasswordWithNotification) SendPasswordResetNotification(token string) error {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPasswordResetNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CanResetPasswordWithNotification_SendPasswordResetNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPasswordResetNotification'
+type CanResetPasswordWithNotification_SendPasswordResetNotification_Call struct {
+	*mock.Call
+}
+
+// SendPasswordResetNotification is a helper method to define mock.On call
+//   - token string
+func (_e *CanResetPasswordWithNotification_Expecter) SendPasswordResetNotification(token interface{}) *CanResetPasswordWithNotification_SendPasswordResetNotification_Call {
+	return &CanResetPasswordWithNotification_SendPasswordResetNotification_Call{Call: _e.mock.On("SendPasswordResetNotification", token)}
+}
+
+func (_c *CanResetPasswordWithNotification_SendPasswordResetNotification_Call) Run(run func(token string)) *CanResetPasswordWithNotification_SendPasswordResetNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_SendPasswordResetNotification_Call) Return(_a0 error) *CanResetPasswordWithNotification_SendPasswordResetNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CanResetPasswordWithNotification_SendPasswordResetNotification_Call) RunAndReturn(run func(string) error) *CanResetPasswordWithNotification_SendPasswordResetNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCanResetPasswordWithNotification creates a new instance of CanResetPasswordWithNotification. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCanResetPasswordWithNotification(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CanResetPasswordWithNotification {
+	mock := &CanResetPasswordWithNotification{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
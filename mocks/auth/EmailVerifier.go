@@ -0,0 +1,186 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	http "github.com/goravel/framework/contracts/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmailVerifier is an autogenerated mock type for the EmailVerifier type
+type EmailVerifier struct {
+	mock.Mock
+}
+
+type EmailVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailVerifier) EXPECT() *EmailVerifier_Expecter {
+	return &EmailVerifier_Expecter{mock: &_m.Mock}
+}
+
+// SendVerificationNotification provides a mock function with given fields: user
+func (_m *EmailVerifier) SendVerificationNotification(user auth.MustVerifyEmail) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendVerificationNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(auth.MustVerifyEmail) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailVerifier_SendVerificationNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendVerificationNotification'
+type EmailVerifier_SendVerificationNotification_Call struct {
+	*mock.Call
+}
+
+// SendVerificationNotification is a helper method to define mock.On call
+//   - user auth.MustVerifyEmail
+func (_e *EmailVerifier_Expecter) SendVerificationNotification(user interface{}) *EmailVerifier_SendVerificationNotification_Call {
+	return &EmailVerifier_SendVerificationNotification_Call{Call: _e.mock.On("SendVerificationNotification", user)}
+}
+
+func (_c *EmailVerifier_SendVerificationNotification_Call) Run(run func(user auth.MustVerifyEmail)) *EmailVerifier_SendVerificationNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.MustVerifyEmail))
+	})
+	return _c
+}
+
+func (_c *EmailVerifier_SendVerificationNotification_Call) Return(_a0 error) *EmailVerifier_SendVerificationNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailVerifier_SendVerificationNotification_Call) RunAndReturn(run func(auth.MustVerifyEmail) error) *EmailVerifier_SendVerificationNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerificationURL provides a mock function with given fields: user
+func (_m *EmailVerifier) VerificationURL(user auth.MustVerifyEmail) (string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerificationURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.MustVerifyEmail) (string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(auth.MustVerifyEmail) string); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.MustVerifyEmail) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailVerifier_VerificationURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerificationURL'
+type EmailVerifier_VerificationURL_Call struct {
+	*mock.Call
+}
+
+// VerificationURL is a helper method to define mock.On call
+//   - user auth.MustVerifyEmail
+func (_e *EmailVerifier_Expecter) VerificationURL(user interface{}) *EmailVerifier_VerificationURL_Call {
+	return &EmailVerifier_VerificationURL_Call{Call: _e.mock.On("VerificationURL", user)}
+}
+
+func (_c *EmailVerifier_VerificationURL_Call) Run(run func(user auth.MustVerifyEmail)) *EmailVerifier_VerificationURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.MustVerifyEmail))
+	})
+	return _c
+}
+
+func (_c *EmailVerifier_VerificationURL_Call) Return(_a0 string, _a1 error) *EmailVerifier_VerificationURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailVerifier_VerificationURL_Call) RunAndReturn(run func(auth.MustVerifyEmail) (string, error)) *EmailVerifier_VerificationURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx, user
+func (_m *EmailVerifier) Verify(ctx http.Context, user auth.MustVerifyEmail) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(http.Context, auth.MustVerifyEmail) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailVerifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type EmailVerifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx http.Context
+//   - user auth.MustVerifyEmail
+func (_e *EmailVerifier_Expecter) Verify(ctx interface{}, user interface{}) *EmailVerifier_Verify_Call {
+	return &EmailVerifier_Verify_Call{Call: _e.mock.On("Verify", ctx, user)}
+}
+
+func (_c *EmailVerifier_Verify_Call) Run(run func(ctx http.Context, user auth.MustVerifyEmail)) *EmailVerifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context), args[1].(auth.MustVerifyEmail))
+	})
+	return _c
+}
+
+func (_c *EmailVerifier_Verify_Call) Return(_a0 error) *EmailVerifier_Verify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailVerifier_Verify_Call) RunAndReturn(run func(http.Context, auth.MustVerifyEmail) error) *EmailVerifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmailVerifier creates a new instance of EmailVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailVerifier {
+	mock := &EmailVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import mock "github.com/stretchr/testify/mock"
+
+// MustVerifyEmail is an autogenerated mock type for the MustVerifyEmail type
+type MustVerifyEmail struct {
+	mock.Mock
+}
+
+type MustVerifyEmail_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MustVerifyEmail) EXPECT() *MustVerifyEmail_Expecter {
+	return &MustVerifyEmail_Expecter{mock: &_m.Mock}
+}
+
+// GetEmailForVerification provides a mock function with no fields
+func (_m *MustVerifyEmail) GetEmailForVerification() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailForVerification")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MustVerifyEmail_GetEmailForVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmailForVerification'
+type MustVerifyEmail_GetEmailForVerification_Call struct {
+	*mock.Call
+}
+
+// GetEmailForVerification is a helper method to define mock.On call
+func (_e *MustVerifyEmail_Expecter) GetEmailForVerification() *MustVerifyEmail_GetEmailForVerification_Call {
+	return &MustVerifyEmail_GetEmailForVerification_Call{Call: _e.mock.On("GetEmailForVerification")}
+}
+
+func (_c *MustVerifyEmail_GetEmailForVerification_Call) Run(run func()) *MustVerifyEmail_GetEmailForVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MustVerifyEmail_GetEmailForVerification_Call) Return(_a0 string) *MustVerifyEmail_GetEmailForVerification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MustVerifyEmail_GetEmailForVerification_Call) RunAndReturn(run func() string) *MustVerifyEmail_GetEmailForVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasVerifiedEmail provides a mock function with no fields
+func (_m *MustVerifyEmail) HasVerifiedEmail() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasVerifiedEmail")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MustVerifyEmail_HasVerifiedEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasVerifiedEmail'
+type MustVerifyEmail_HasVerifiedEmail_Call struct {
+	*mock.Call
+}
+
+// HasVerifiedEmail is a helper method to define mock.On call
+func (_e *MustVerifyEmail_Expecter) HasVerifiedEmail() *MustVerifyEmail_HasVerifiedEmail_Call {
+	return &MustVerifyEmail_HasVerifiedEmail_Call{Call: _e.mock.On("HasVerifiedEmail")}
+}
+
+func (_c *MustVerifyEmail_HasVerifiedEmail_Call) Run(run func()) *MustVerifyEmail_HasVerifiedEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MustVerifyEmail_HasVerifiedEmail_Call) Return(_a0 bool) *MustVerifyEmail_HasVerifiedEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MustVerifyEmail_HasVerifiedEmail_Call) RunAndReturn(run func() bool) *MustVerifyEmail_HasVerifiedEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailAsVerified provides a mock function with no fields
+func (_m *MustVerifyEmail) MarkEmailAsVerified() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailAsVerified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MustVerifyEmail_MarkEmailAsVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailAsVerified'
+type MustVerifyEmail_MarkEmailAsVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailAsVerified is a helper method to define mock.On call
+func (_e *MustVerifyEmail_Expecter) MarkEmailAsVerified() *MustVerifyEmail_MarkEmailAsVerified_Call {
+	return &MustVerifyEmail_MarkEmailAsVerified_Call{Call: _e.mock.On("MarkEmailAsVerified")}
+}
+
+func (_c *MustVerifyEmail_MarkEmailAsVerified_Call) Run(run func()) *MustVerifyEmail_MarkEmailAsVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MustVerifyEmail_MarkEmailAsVerified_Call) Return(_a0 error) *MustVerifyEmail_MarkEmailAsVerified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MustVerifyEmail_MarkEmailAsVerified_Call) RunAndReturn(run func() error) *MustVerifyEmail_MarkEmailAsVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RouteNotificationFor provides a mock function with given fields: channel
+func (_m *MustVerifyEmail) RouteNotificationFor(channel string) interface{} {
+	ret := _m.Called(channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteNotificationFor")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// MustVerifyEmail_RouteNotificationFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteNotificationFor'
+type MustVerifyEmail_RouteNotificationFor_Call struct {
+	*mock.Call
+}
+
+// RouteNotificationFor is a helper method to define mock.On call
+//   - channel string
+func (_e *MustVerifyEmail_Expecter) RouteNotificationFor(channel interface{}) *MustVerifyEmail_RouteNotificationFor_Call {
+	return &MustVerifyEmail_RouteNotificationFor_Call{Call: _e.mock.On("RouteNotificationFor", channel)}
+}
+
+func (_c *MustVerifyEmail_RouteNotificationFor_Call) Run(run func(channel string)) *MustVerifyEmail_RouteNotificationFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MustVerifyEmail_RouteNotificationFor_Call) Return(_a0 interface{}) *MustVerifyEmail_RouteNotificationFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MustVerifyEmail_RouteNotificationFor_Call) RunAndReturn(run func(string) interface{}) *MustVerifyEmail_RouteNotificationFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMustVerifyEmail creates a new instance of MustVerifyEmail. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMustVerifyEmail(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MustVerifyEmail {
+	mock := &MustVerifyEmail{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
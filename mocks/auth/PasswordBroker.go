@@ -0,0 +1,278 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PasswordBroker is an autogenerated mock type for the PasswordBroker type
+type PasswordBroker struct {
+	mock.Mock
+}
+
+type PasswordBroker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PasswordBroker) EXPECT() *PasswordBroker_Expecter {
+	return &PasswordBroker_Expecter{mock: &_m.Mock}
+}
+
+// CreateToken provides a mock function with given fields: user
+func (_m *PasswordBroker) CreateToken(user auth.CanResetPassword) (string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword) (string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword) string); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.CanResetPassword) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasswordBroker_CreateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateToken'
+type PasswordBroker_CreateToken_Call struct {
+	*mock.Call
+}
+
+// CreateToken is a helper method to define mock.On call
+//   - user auth.CanResetPassword
+func (_e *PasswordBroker_Expecter) CreateToken(user interface{}) *PasswordBroker_CreateToken_Call {
+	return &PasswordBroker_CreateToken_Call{Call: _e.mock.On("CreateToken", user)}
+}
+
+func (_c *PasswordBroker_CreateToken_Call) Run(run func(user auth.CanResetPassword)) *PasswordBroker_CreateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.CanResetPassword))
+	})
+	return _c
+}
+
+func (_c *PasswordBroker_CreateToken_Call) Return(_a0 string, _a1 error) *PasswordBroker_CreateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasswordBroker_CreateToken_Call) RunAndReturn(run func(auth.CanResetPassword) (string, error)) *PasswordBroker_CreateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteToken provides a mock function with given fields: user
+func (_m *PasswordBroker) DeleteToken(user auth.CanResetPassword) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordBroker_DeleteToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteToken'
+type PasswordBroker_DeleteToken_Call struct {
+	*mock.Call
+}
+
+// DeleteToken is a helper method to define mock.On call
+//   - user auth.CanResetPassword
+func (_e *PasswordBroker_Expecter) DeleteToken(user interface{}) *PasswordBroker_DeleteToken_Call {
+	return &PasswordBroker_DeleteToken_Call{Call: _e.mock.On("DeleteToken", user)}
+}
+
+func (_c *PasswordBroker_DeleteToken_Call) Run(run func(user auth.CanResetPassword)) *PasswordBroker_DeleteToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.CanResetPassword))
+	})
+	return _c
+}
+
+func (_c *PasswordBroker_DeleteToken_Call) Return(_a0 error) *PasswordBroker_DeleteToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordBroker_DeleteToken_Call) RunAndReturn(run func(auth.CanResetPassword) error) *PasswordBroker_DeleteToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: user, token, callback
+func (_m *PasswordBroker) Reset(user auth.CanResetPassword, token string, callback func() error) error {
+	ret := _m.Called(user, token, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword, string, func() error) error); ok {
+		r0 = rf(user, token, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordBroker_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type PasswordBroker_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - user auth.CanResetPassword
+//   - token string
+//   - callback func() error
+func (_e *PasswordBroker_Expecter) Reset(user interface{}, token interface{}, callback interface{}) *PasswordBroker_Reset_Call {
+	return &PasswordBroker_Reset_Call{Call: _e.mock.On("Reset", user, token, callback)}
+}
+
+func (_c *PasswordBroker_Reset_Call) Run(run func(user auth.CanResetPassword, token string, callback func() error)) *PasswordBroker_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.CanResetPassword), args[1].(string), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *PasswordBroker_Reset_Call) Return(_a0 error) *PasswordBroker_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordBroker_Reset_Call) RunAndReturn(run func(auth.CanResetPassword, string, func() error) error) *PasswordBroker_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendResetLink provides a mock function with given fields: user
+func (_m *PasswordBroker) SendResetLink(user auth.CanResetPassword) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendResetLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordBroker_SendResetLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendResetLink'
+type PasswordBroker_SendResetLink_Call struct {
+	*mock.Call
+}
+
+// SendResetLink is a helper method to define mock.On call
+//   - user auth.CanResetPassword
+func (_e *PasswordBroker_Expecter) SendResetLink(user interface{}) *PasswordBroker_SendResetLink_Call {
+	return &PasswordBroker_SendResetLink_Call{Call: _e.mock.On("SendResetLink", user)}
+}
+
+func (_c *PasswordBroker_SendResetLink_Call) Run(run func(user auth.CanResetPassword)) *PasswordBroker_SendResetLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.CanResetPassword))
+	})
+	return _c
+}
+
+func (_c *PasswordBroker_SendResetLink_Call) Return(_a0 error) *PasswordBroker_SendResetLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordBroker_SendResetLink_Call) RunAndReturn(run func(auth.CanResetPassword) error) *PasswordBroker_SendResetLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenExists provides a mock function with given fields: user, token
+func (_m *PasswordBroker) TokenExists(user auth.CanResetPassword, token string) bool {
+	ret := _m.Called(user, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenExists")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(auth.CanResetPassword, string) bool); ok {
+		r0 = rf(user, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// PasswordBroker_TokenExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenExists'
+type PasswordBroker_TokenExists_Call struct {
+	*mock.Call
+}
+
+// TokenExists is a helper method to define mock.On call
+//   - user auth.CanResetPassword
+//   - token string
+func (_e *PasswordBroker_Expecter) TokenExists(user interface{}, token interface{}) *PasswordBroker_TokenExists_Call {
+	return &PasswordBroker_TokenExists_Call{Call: _e.mock.On("TokenExists", user, token)}
+}
+
+func (_c *PasswordBroker_TokenExists_Call) Run(run func(user auth.CanResetPassword, token string)) *PasswordBroker_TokenExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.CanResetPassword), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PasswordBroker_TokenExists_Call) Return(_a0 bool) *PasswordBroker_TokenExists_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordBroker_TokenExists_Call) RunAndReturn(run func(auth.CanResetPassword, string) bool) *PasswordBroker_TokenExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPasswordBroker creates a new instance of PasswordBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordBroker {
+	mock := &PasswordBroker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,794 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	mock "github.com/stretchr/testify/mock"
+
+	session "github.com/goravel/framework/contracts/session"
+)
+
+// SessionGuard is an autogenerated mock type for the SessionGuard type
+type SessionGuard struct {
+	mock.Mock
+}
+
+type SessionGuard_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SessionGuard) EXPECT() *SessionGuard_Expecter {
+	return &SessionGuard_Expecter{mock: &_m.Mock}
+}
+
+// ChallengeTwoFactor provides a mock function with given fields: user, code
+func (_m *SessionGuard) ChallengeTwoFactor(user interface{}, code string) error {
+	ret := _m.Called(user, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChallengeTwoFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, string) error); ok {
+		r0 = rf(user, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionGuard_ChallengeTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChallengeTwoFactor'
+type SessionGuard_ChallengeTwoFactor_Call struct {
+	*mock.Call
+}
+
+// ChallengeTwoFactor is a helper method to define mock.On call
+//   - user interface{}
+//   - code string
+func (_e *SessionGuard_Expecter) ChallengeTwoFactor(user interface{}, code interface{}) *SessionGuard_ChallengeTwoFactor_Call {
+	return &SessionGuard_ChallengeTwoFactor_Call{Call: _e.mock.On("ChallengeTwoFactor", user, code)}
+}
+
+func (_c *SessionGuard_ChallengeTwoFactor_Call) Run(run func(user interface{}, code string)) *SessionGuard_ChallengeTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_ChallengeTwoFactor_Call) Return(_a0 error) *SessionGuard_ChallengeTwoFactor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_ChallengeTwoFactor_Call) RunAndReturn(run func(interface{}, string) error) *SessionGuard_ChallengeTwoFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with no fields
+func (_m *SessionGuard) Check() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SessionGuard_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type SessionGuard_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) Check() *SessionGuard_Check_Call {
+	return &SessionGuard_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *SessionGuard_Check_Call) Run(run func()) *SessionGuard_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Check_Call) Return(_a0 bool) *SessionGuard_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_Check_Call) RunAndReturn(run func() bool) *SessionGuard_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Guest provides a mock function with no fields
+func (_m *SessionGuard) Guest() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Guest")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SessionGuard_Guest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Guest'
+type SessionGuard_Guest_Call struct {
+	*mock.Call
+}
+
+// Guest is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) Guest() *SessionGuard_Guest_Call {
+	return &SessionGuard_Guest_Call{Call: _e.mock.On("Guest")}
+}
+
+func (_c *SessionGuard_Guest_Call) Run(run func()) *SessionGuard_Guest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Guest_Call) Return(_a0 bool) *SessionGuard_Guest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_Guest_Call) RunAndReturn(run func() bool) *SessionGuard_Guest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasTwoFactorChallenge provides a mock function with no fields
+func (_m *SessionGuard) HasTwoFactorChallenge() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasTwoFactorChallenge")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SessionGuard_HasTwoFactorChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasTwoFactorChallenge'
+type SessionGuard_HasTwoFactorChallenge_Call struct {
+	*mock.Call
+}
+
+// HasTwoFactorChallenge is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) HasTwoFactorChallenge() *SessionGuard_HasTwoFactorChallenge_Call {
+	return &SessionGuard_HasTwoFactorChallenge_Call{Call: _e.mock.On("HasTwoFactorChallenge")}
+}
+
+func (_c *SessionGuard_HasTwoFactorChallenge_Call) Run(run func()) *SessionGuard_HasTwoFactorChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_HasTwoFactorChallenge_Call) Return(_a0 bool) *SessionGuard_HasTwoFactorChallenge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_HasTwoFactorChallenge_Call) RunAndReturn(run func() bool) *SessionGuard_HasTwoFactorChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ID provides a mock function with no fields
+func (_m *SessionGuard) ID() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_ID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ID'
+type SessionGuard_ID_Call struct {
+	*mock.Call
+}
+
+// ID is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) ID() *SessionGuard_ID_Call {
+	return &SessionGuard_ID_Call{Call: _e.mock.On("ID")}
+}
+
+func (_c *SessionGuard_ID_Call) Run(run func()) *SessionGuard_ID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_ID_Call) Return(token string, err error) *SessionGuard_ID_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *SessionGuard_ID_Call) RunAndReturn(run func() (string, error)) *SessionGuard_ID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: user
+func (_m *SessionGuard) Login(user interface{}) (string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) string); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type SessionGuard_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - user interface{}
+func (_e *SessionGuard_Expecter) Login(user interface{}) *SessionGuard_Login_Call {
+	return &SessionGuard_Login_Call{Call: _e.mock.On("Login", user)}
+}
+
+func (_c *SessionGuard_Login_Call) Run(run func(user interface{})) *SessionGuard_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Login_Call) Return(token string, err error) *SessionGuard_Login_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *SessionGuard_Login_Call) RunAndReturn(run func(interface{}) (string, error)) *SessionGuard_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginRemember provides a mock function with given fields: user, remember
+func (_m *SessionGuard) LoginRemember(user interface{}, remember bool) (string, error) {
+	ret := _m.Called(user, remember)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginRemember")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, bool) (string, error)); ok {
+		return rf(user, remember)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, bool) string); ok {
+		r0 = rf(user, remember)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, bool) error); ok {
+		r1 = rf(user, remember)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_LoginRemember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginRemember'
+type SessionGuard_LoginRemember_Call struct {
+	*mock.Call
+}
+
+// LoginRemember is a helper method to define mock.On call
+//   - user interface{}
+//   - remember bool
+func (_e *SessionGuard_Expecter) LoginRemember(user interface{}, remember interface{}) *SessionGuard_LoginRemember_Call {
+	return &SessionGuard_LoginRemember_Call{Call: _e.mock.On("LoginRemember", user, remember)}
+}
+
+func (_c *SessionGuard_LoginRemember_Call) Run(run func(user interface{}, remember bool)) *SessionGuard_LoginRemember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_LoginRemember_Call) Return(token string, err error) *SessionGuard_LoginRemember_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *SessionGuard_LoginRemember_Call) RunAndReturn(run func(interface{}, bool) (string, error)) *SessionGuard_LoginRemember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUsingID provides a mock function with given fields: id
+func (_m *SessionGuard) LoginUsingID(id interface{}) (string, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginUsingID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (string, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_LoginUsingID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUsingID'
+type SessionGuard_LoginUsingID_Call struct {
+	*mock.Call
+}
+
+// LoginUsingID is a helper method to define mock.On call
+//   - id interface{}
+func (_e *SessionGuard_Expecter) LoginUsingID(id interface{}) *SessionGuard_LoginUsingID_Call {
+	return &SessionGuard_LoginUsingID_Call{Call: _e.mock.On("LoginUsingID", id)}
+}
+
+func (_c *SessionGuard_LoginUsingID_Call) Run(run func(id interface{})) *SessionGuard_LoginUsingID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_LoginUsingID_Call) Return(token string, err error) *SessionGuard_LoginUsingID_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *SessionGuard_LoginUsingID_Call) RunAndReturn(run func(interface{}) (string, error)) *SessionGuard_LoginUsingID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with no fields
+func (_m *SessionGuard) Logout() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionGuard_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type SessionGuard_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) Logout() *SessionGuard_Logout_Call {
+	return &SessionGuard_Logout_Call{Call: _e.mock.On("Logout")}
+}
+
+func (_c *SessionGuard_Logout_Call) Run(run func()) *SessionGuard_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Logout_Call) Return(_a0 error) *SessionGuard_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_Logout_Call) RunAndReturn(run func() error) *SessionGuard_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutOtherDevices provides a mock function with no fields
+func (_m *SessionGuard) LogoutOtherDevices() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutOtherDevices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionGuard_LogoutOtherDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutOtherDevices'
+type SessionGuard_LogoutOtherDevices_Call struct {
+	*mock.Call
+}
+
+// LogoutOtherDevices is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) LogoutOtherDevices() *SessionGuard_LogoutOtherDevices_Call {
+	return &SessionGuard_LogoutOtherDevices_Call{Call: _e.mock.On("LogoutOtherDevices")}
+}
+
+func (_c *SessionGuard_LogoutOtherDevices_Call) Run(run func()) *SessionGuard_LogoutOtherDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_LogoutOtherDevices_Call) Return(_a0 error) *SessionGuard_LogoutOtherDevices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_LogoutOtherDevices_Call) RunAndReturn(run func() error) *SessionGuard_LogoutOtherDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Parse provides a mock function with given fields: token
+func (_m *SessionGuard) Parse(token string) (*auth.Payload, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Parse")
+	}
+
+	var r0 *auth.Payload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*auth.Payload, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *auth.Payload); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Payload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_Parse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Parse'
+type SessionGuard_Parse_Call struct {
+	*mock.Call
+}
+
+// Parse is a helper method to define mock.On call
+//   - token string
+func (_e *SessionGuard_Expecter) Parse(token interface{}) *SessionGuard_Parse_Call {
+	return &SessionGuard_Parse_Call{Call: _e.mock.On("Parse", token)}
+}
+
+func (_c *SessionGuard_Parse_Call) Run(run func(token string)) *SessionGuard_Parse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Parse_Call) Return(_a0 *auth.Payload, _a1 error) *SessionGuard_Parse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionGuard_Parse_Call) RunAndReturn(run func(string) (*auth.Payload, error)) *SessionGuard_Parse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *SessionGuard) Refresh() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type SessionGuard_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) Refresh() *SessionGuard_Refresh_Call {
+	return &SessionGuard_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *SessionGuard_Refresh_Call) Run(run func()) *SessionGuard_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Refresh_Call) Return(token string, err error) *SessionGuard_Refresh_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *SessionGuard_Refresh_Call) RunAndReturn(run func() (string, error)) *SessionGuard_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sessions provides a mock function with no fields
+func (_m *SessionGuard) Sessions() ([]session.Activity, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sessions")
+	}
+
+	var r0 []session.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]session.Activity, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []session.Activity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]session.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionGuard_Sessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sessions'
+type SessionGuard_Sessions_Call struct {
+	*mock.Call
+}
+
+// Sessions is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) Sessions() *SessionGuard_Sessions_Call {
+	return &SessionGuard_Sessions_Call{Call: _e.mock.On("Sessions")}
+}
+
+func (_c *SessionGuard_Sessions_Call) Run(run func()) *SessionGuard_Sessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_Sessions_Call) Return(_a0 []session.Activity, _a1 error) *SessionGuard_Sessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionGuard_Sessions_Call) RunAndReturn(run func() ([]session.Activity, error)) *SessionGuard_Sessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// User provides a mock function with given fields: user
+func (_m *SessionGuard) User(user interface{}) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionGuard_User_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'User'
+type SessionGuard_User_Call struct {
+	*mock.Call
+}
+
+// User is a helper method to define mock.On call
+//   - user interface{}
+func (_e *SessionGuard_Expecter) User(user interface{}) *SessionGuard_User_Call {
+	return &SessionGuard_User_Call{Call: _e.mock.On("User", user)}
+}
+
+func (_c *SessionGuard_User_Call) Run(run func(user interface{})) *SessionGuard_User_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *SessionGuard_User_Call) Return(_a0 error) *SessionGuard_User_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_User_Call) RunAndReturn(run func(interface{}) error) *SessionGuard_User_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViaRemember provides a mock function with no fields
+func (_m *SessionGuard) ViaRemember() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViaRemember")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SessionGuard_ViaRemember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViaRemember'
+type SessionGuard_ViaRemember_Call struct {
+	*mock.Call
+}
+
+// ViaRemember is a helper method to define mock.On call
+func (_e *SessionGuard_Expecter) ViaRemember() *SessionGuard_ViaRemember_Call {
+	return &SessionGuard_ViaRemember_Call{Call: _e.mock.On("ViaRemember")}
+}
+
+func (_c *SessionGuard_ViaRemember_Call) Run(run func()) *SessionGuard_ViaRemember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionGuard_ViaRemember_Call) Return(_a0 bool) *SessionGuard_ViaRemember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionGuard_ViaRemember_Call) RunAndReturn(run func() bool) *SessionGuard_ViaRemember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSessionGuard creates a new instance of SessionGuard. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionGuard(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionGuard {
+	mock := &SessionGuard{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,873 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TokenGuard is an autogenerated mock type for the TokenGuard type
+type TokenGuard struct {
+	mock.Mock
+}
+
+type TokenGuard_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenGuard) EXPECT() *TokenGuard_Expecter {
+	return &TokenGuard_Expecter{mock: &_m.Mock}
+}
+
+// Can provides a mock function with given fields: ability
+func (_m *TokenGuard) Can(ability string) bool {
+	ret := _m.Called(ability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Can")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(ability)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TokenGuard_Can_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Can'
+type TokenGuard_Can_Call struct {
+	*mock.Call
+}
+
+// Can is a helper method to define mock.On call
+//   - ability string
+func (_e *TokenGuard_Expecter) Can(ability interface{}) *TokenGuard_Can_Call {
+	return &TokenGuard_Can_Call{Call: _e.mock.On("Can", ability)}
+}
+
+func (_c *TokenGuard_Can_Call) Run(run func(ability string)) *TokenGuard_Can_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Can_Call) Return(_a0 bool) *TokenGuard_Can_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_Can_Call) RunAndReturn(run func(string) bool) *TokenGuard_Can_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cant provides a mock function with given fields: ability
+func (_m *TokenGuard) Cant(ability string) bool {
+	ret := _m.Called(ability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cant")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(ability)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TokenGuard_Cant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cant'
+type TokenGuard_Cant_Call struct {
+	*mock.Call
+}
+
+// Cant is a helper method to define mock.On call
+//   - ability string
+func (_e *TokenGuard_Expecter) Cant(ability interface{}) *TokenGuard_Cant_Call {
+	return &TokenGuard_Cant_Call{Call: _e.mock.On("Cant", ability)}
+}
+
+func (_c *TokenGuard_Cant_Call) Run(run func(ability string)) *TokenGuard_Cant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Cant_Call) Return(_a0 bool) *TokenGuard_Cant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_Cant_Call) RunAndReturn(run func(string) bool) *TokenGuard_Cant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with no fields
+func (_m *TokenGuard) Check() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TokenGuard_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type TokenGuard_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) Check() *TokenGuard_Check_Call {
+	return &TokenGuard_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *TokenGuard_Check_Call) Run(run func()) *TokenGuard_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Check_Call) Return(_a0 bool) *TokenGuard_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_Check_Call) RunAndReturn(run func() bool) *TokenGuard_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateToken provides a mock function with given fields: user, name, abilities, expiresAt
+func (_m *TokenGuard) CreateToken(user interface{}, name string, abilities []string, expiresAt ...time.Time) (*auth.NewAccessToken, error) {
+	_va := make([]interface{}, len(expiresAt))
+	for _i := range expiresAt {
+		_va[_i] = expiresAt[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, user, name, abilities)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateToken")
+	}
+
+	var r0 *auth.NewAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, string, []string, ...time.Time) (*auth.NewAccessToken, error)); ok {
+		return rf(user, name, abilities, expiresAt...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, string, []string, ...time.Time) *auth.NewAccessToken); ok {
+		r0 = rf(user, name, abilities, expiresAt...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.NewAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, string, []string, ...time.Time) error); ok {
+		r1 = rf(user, name, abilities, expiresAt...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_CreateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateToken'
+type TokenGuard_CreateToken_Call struct {
+	*mock.Call
+}
+
+// CreateToken is a helper method to define mock.On call
+//   - user interface{}
+//   - name string
+//   - abilities []string
+//   - expiresAt ...time.Time
+func (_e *TokenGuard_Expecter) CreateToken(user interface{}, name interface{}, abilities interface{}, expiresAt ...interface{}) *TokenGuard_CreateToken_Call {
+	return &TokenGuard_CreateToken_Call{Call: _e.mock.On("CreateToken",
+		append([]interface{}{user, name, abilities}, expiresAt...)...)}
+}
+
+func (_c *TokenGuard_CreateToken_Call) Run(run func(user interface{}, name string, abilities []string, expiresAt ...time.Time)) *TokenGuard_CreateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]time.Time, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(time.Time)
+			}
+		}
+		run(args[0].(interface{}), args[1].(string), args[2].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TokenGuard_CreateToken_Call) Return(_a0 *auth.NewAccessToken, _a1 error) *TokenGuard_CreateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenGuard_CreateToken_Call) RunAndReturn(run func(interface{}, string, []string, ...time.Time) (*auth.NewAccessToken, error)) *TokenGuard_CreateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CurrentToken provides a mock function with no fields
+func (_m *TokenGuard) CurrentToken() (*auth.AccessToken, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentToken")
+	}
+
+	var r0 *auth.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*auth.AccessToken, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *auth.AccessToken); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.AccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_CurrentToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentToken'
+type TokenGuard_CurrentToken_Call struct {
+	*mock.Call
+}
+
+// CurrentToken is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) CurrentToken() *TokenGuard_CurrentToken_Call {
+	return &TokenGuard_CurrentToken_Call{Call: _e.mock.On("CurrentToken")}
+}
+
+func (_c *TokenGuard_CurrentToken_Call) Run(run func()) *TokenGuard_CurrentToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_CurrentToken_Call) Return(_a0 *auth.AccessToken, _a1 error) *TokenGuard_CurrentToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenGuard_CurrentToken_Call) RunAndReturn(run func() (*auth.AccessToken, error)) *TokenGuard_CurrentToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Guest provides a mock function with no fields
+func (_m *TokenGuard) Guest() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Guest")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TokenGuard_Guest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Guest'
+type TokenGuard_Guest_Call struct {
+	*mock.Call
+}
+
+// Guest is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) Guest() *TokenGuard_Guest_Call {
+	return &TokenGuard_Guest_Call{Call: _e.mock.On("Guest")}
+}
+
+func (_c *TokenGuard_Guest_Call) Run(run func()) *TokenGuard_Guest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Guest_Call) Return(_a0 bool) *TokenGuard_Guest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_Guest_Call) RunAndReturn(run func() bool) *TokenGuard_Guest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ID provides a mock function with no fields
+func (_m *TokenGuard) ID() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_ID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ID'
+type TokenGuard_ID_Call struct {
+	*mock.Call
+}
+
+// ID is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) ID() *TokenGuard_ID_Call {
+	return &TokenGuard_ID_Call{Call: _e.mock.On("ID")}
+}
+
+func (_c *TokenGuard_ID_Call) Run(run func()) *TokenGuard_ID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_ID_Call) Return(token string, err error) *TokenGuard_ID_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *TokenGuard_ID_Call) RunAndReturn(run func() (string, error)) *TokenGuard_ID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: user
+func (_m *TokenGuard) Login(user interface{}) (string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) string); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type TokenGuard_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - user interface{}
+func (_e *TokenGuard_Expecter) Login(user interface{}) *TokenGuard_Login_Call {
+	return &TokenGuard_Login_Call{Call: _e.mock.On("Login", user)}
+}
+
+func (_c *TokenGuard_Login_Call) Run(run func(user interface{})) *TokenGuard_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Login_Call) Return(token string, err error) *TokenGuard_Login_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *TokenGuard_Login_Call) RunAndReturn(run func(interface{}) (string, error)) *TokenGuard_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUsingID provides a mock function with given fields: id
+func (_m *TokenGuard) LoginUsingID(id interface{}) (string, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginUsingID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (string, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_LoginUsingID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUsingID'
+type TokenGuard_LoginUsingID_Call struct {
+	*mock.Call
+}
+
+// LoginUsingID is a helper method to define mock.On call
+//   - id interface{}
+func (_e *TokenGuard_Expecter) LoginUsingID(id interface{}) *TokenGuard_LoginUsingID_Call {
+	return &TokenGuard_LoginUsingID_Call{Call: _e.mock.On("LoginUsingID", id)}
+}
+
+func (_c *TokenGuard_LoginUsingID_Call) Run(run func(id interface{})) *TokenGuard_LoginUsingID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_LoginUsingID_Call) Return(token string, err error) *TokenGuard_LoginUsingID_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *TokenGuard_LoginUsingID_Call) RunAndReturn(run func(interface{}) (string, error)) *TokenGuard_LoginUsingID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with no fields
+func (_m *TokenGuard) Logout() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenGuard_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type TokenGuard_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) Logout() *TokenGuard_Logout_Call {
+	return &TokenGuard_Logout_Call{Call: _e.mock.On("Logout")}
+}
+
+func (_c *TokenGuard_Logout_Call) Run(run func()) *TokenGuard_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Logout_Call) Return(_a0 error) *TokenGuard_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_Logout_Call) RunAndReturn(run func() error) *TokenGuard_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Parse provides a mock function with given fields: token
+func (_m *TokenGuard) Parse(token string) (*auth.Payload, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Parse")
+	}
+
+	var r0 *auth.Payload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*auth.Payload, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *auth.Payload); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Payload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_Parse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Parse'
+type TokenGuard_Parse_Call struct {
+	*mock.Call
+}
+
+// Parse is a helper method to define mock.On call
+//   - token string
+func (_e *TokenGuard_Expecter) Parse(token interface{}) *TokenGuard_Parse_Call {
+	return &TokenGuard_Parse_Call{Call: _e.mock.On("Parse", token)}
+}
+
+func (_c *TokenGuard_Parse_Call) Run(run func(token string)) *TokenGuard_Parse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Parse_Call) Return(_a0 *auth.Payload, _a1 error) *TokenGuard_Parse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenGuard_Parse_Call) RunAndReturn(run func(string) (*auth.Payload, error)) *TokenGuard_Parse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *TokenGuard) Refresh() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type TokenGuard_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *TokenGuard_Expecter) Refresh() *TokenGuard_Refresh_Call {
+	return &TokenGuard_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *TokenGuard_Refresh_Call) Run(run func()) *TokenGuard_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Refresh_Call) Return(token string, err error) *TokenGuard_Refresh_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *TokenGuard_Refresh_Call) RunAndReturn(run func() (string, error)) *TokenGuard_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: user, id
+func (_m *TokenGuard) RevokeToken(user interface{}, id string) error {
+	ret := _m.Called(user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, string) error); ok {
+		r0 = rf(user, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenGuard_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type TokenGuard_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - user interface{}
+//   - id string
+func (_e *TokenGuard_Expecter) RevokeToken(user interface{}, id interface{}) *TokenGuard_RevokeToken_Call {
+	return &TokenGuard_RevokeToken_Call{Call: _e.mock.On("RevokeToken", user, id)}
+}
+
+func (_c *TokenGuard_RevokeToken_Call) Run(run func(user interface{}, id string)) *TokenGuard_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_RevokeToken_Call) Return(_a0 error) *TokenGuard_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_RevokeToken_Call) RunAndReturn(run func(interface{}, string) error) *TokenGuard_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeTokens provides a mock function with given fields: user
+func (_m *TokenGuard) RevokeTokens(user interface{}) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenGuard_RevokeTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeTokens'
+type TokenGuard_RevokeTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeTokens is a helper method to define mock.On call
+//   - user interface{}
+func (_e *TokenGuard_Expecter) RevokeTokens(user interface{}) *TokenGuard_RevokeTokens_Call {
+	return &TokenGuard_RevokeTokens_Call{Call: _e.mock.On("RevokeTokens", user)}
+}
+
+func (_c *TokenGuard_RevokeTokens_Call) Run(run func(user interface{})) *TokenGuard_RevokeTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_RevokeTokens_Call) Return(_a0 error) *TokenGuard_RevokeTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_RevokeTokens_Call) RunAndReturn(run func(interface{}) error) *TokenGuard_RevokeTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tokens provides a mock function with given fields: user
+func (_m *TokenGuard) Tokens(user interface{}) ([]auth.AccessToken, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tokens")
+	}
+
+	var r0 []auth.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) ([]auth.AccessToken, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) []auth.AccessToken); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.AccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenGuard_Tokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tokens'
+type TokenGuard_Tokens_Call struct {
+	*mock.Call
+}
+
+// Tokens is a helper method to define mock.On call
+//   - user interface{}
+func (_e *TokenGuard_Expecter) Tokens(user interface{}) *TokenGuard_Tokens_Call {
+	return &TokenGuard_Tokens_Call{Call: _e.mock.On("Tokens", user)}
+}
+
+func (_c *TokenGuard_Tokens_Call) Run(run func(user interface{})) *TokenGuard_Tokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_Tokens_Call) Return(_a0 []auth.AccessToken, _a1 error) *TokenGuard_Tokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenGuard_Tokens_Call) RunAndReturn(run func(interface{}) ([]auth.AccessToken, error)) *TokenGuard_Tokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// User provides a mock function with given fields: user
+func (_m *TokenGuard) User(user interface{}) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenGuard_User_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'User'
+type TokenGuard_User_Call struct {
+	*mock.Call
+}
+
+// User is a helper method to define mock.On call
+//   - user interface{}
+func (_e *TokenGuard_Expecter) User(user interface{}) *TokenGuard_User_Call {
+	return &TokenGuard_User_Call{Call: _e.mock.On("User", user)}
+}
+
+func (_c *TokenGuard_User_Call) Run(run func(user interface{})) *TokenGuard_User_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TokenGuard_User_Call) Return(_a0 error) *TokenGuard_User_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenGuard_User_Call) RunAndReturn(run func(interface{}) error) *TokenGuard_User_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenGuard creates a new instance of TokenGuard. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenGuard(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenGuard {
+	mock := &TokenGuard{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
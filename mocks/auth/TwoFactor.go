@@ -0,0 +1,406 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TwoFactor is an autogenerated mock type for the TwoFactor type
+type TwoFactor struct {
+	mock.Mock
+}
+
+type TwoFactor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TwoFactor) EXPECT() *TwoFactor_Expecter {
+	return &TwoFactor_Expecter{mock: &_m.Mock}
+}
+
+// Disable provides a mock function with given fields: user
+func (_m *TwoFactor) Disable(user auth.TwoFactorAuthenticatable) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactor_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type TwoFactor_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+func (_e *TwoFactor_Expecter) Disable(user interface{}) *TwoFactor_Disable_Call {
+	return &TwoFactor_Disable_Call{Call: _e.mock.On("Disable", user)}
+}
+
+func (_c *TwoFactor_Disable_Call) Run(run func(user auth.TwoFactorAuthenticatable)) *TwoFactor_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_Disable_Call) Return(_a0 error) *TwoFactor_Disable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactor_Disable_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable) error) *TwoFactor_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function with given fields: user, account
+func (_m *TwoFactor) Enable(user auth.TwoFactorAuthenticatable, account string) (*auth.TwoFactorSetup, error) {
+	ret := _m.Called(user, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 *auth.TwoFactorSetup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable, string) (*auth.TwoFactorSetup, error)); ok {
+		return rf(user, account)
+	}
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable, string) *auth.TwoFactorSetup); ok {
+		r0 = rf(user, account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.TwoFactorSetup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.TwoFactorAuthenticatable, string) error); ok {
+		r1 = rf(user, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactor_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type TwoFactor_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+//   - account string
+func (_e *TwoFactor_Expecter) Enable(user interface{}, account interface{}) *TwoFactor_Enable_Call {
+	return &TwoFactor_Enable_Call{Call: _e.mock.On("Enable", user, account)}
+}
+
+func (_c *TwoFactor_Enable_Call) Run(run func(user auth.TwoFactorAuthenticatable, account string)) *TwoFactor_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_Enable_Call) Return(_a0 *auth.TwoFactorSetup, _a1 error) *TwoFactor_Enable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactor_Enable_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable, string) (*auth.TwoFactorSetup, error)) *TwoFactor_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enabled provides a mock function with given fields: user
+func (_m *TwoFactor) Enabled(user auth.TwoFactorAuthenticatable) bool {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) bool); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TwoFactor_Enabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enabled'
+type TwoFactor_Enabled_Call struct {
+	*mock.Call
+}
+
+// Enabled is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+func (_e *TwoFactor_Expecter) Enabled(user interface{}) *TwoFactor_Enabled_Call {
+	return &TwoFactor_Enabled_Call{Call: _e.mock.On("Enabled", user)}
+}
+
+func (_c *TwoFactor_Enabled_Call) Run(run func(user auth.TwoFactorAuthenticatable)) *TwoFactor_Enabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_Enabled_Call) Return(_a0 bool) *TwoFactor_Enabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactor_Enabled_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable) bool) *TwoFactor_Enabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoveryCodes provides a mock function with given fields: user
+func (_m *TwoFactor) RecoveryCodes(user auth.TwoFactorAuthenticatable) ([]string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) ([]string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) []string); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.TwoFactorAuthenticatable) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactor_RecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoveryCodes'
+type TwoFactor_RecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// RecoveryCodes is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+func (_e *TwoFactor_Expecter) RecoveryCodes(user interface{}) *TwoFactor_RecoveryCodes_Call {
+	return &TwoFactor_RecoveryCodes_Call{Call: _e.mock.On("RecoveryCodes", user)}
+}
+
+func (_c *TwoFactor_RecoveryCodes_Call) Run(run func(user auth.TwoFactorAuthenticatable)) *TwoFactor_RecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_RecoveryCodes_Call) Return(_a0 []string, _a1 error) *TwoFactor_RecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactor_RecoveryCodes_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable) ([]string, error)) *TwoFactor_RecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateRecoveryCodes provides a mock function with given fields: user
+func (_m *TwoFactor) RegenerateRecoveryCodes(user auth.TwoFactorAuthenticatable) ([]string, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) ([]string, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable) []string); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.TwoFactorAuthenticatable) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactor_RegenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateRecoveryCodes'
+type TwoFactor_RegenerateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// RegenerateRecoveryCodes is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+func (_e *TwoFactor_Expecter) RegenerateRecoveryCodes(user interface{}) *TwoFactor_RegenerateRecoveryCodes_Call {
+	return &TwoFactor_RegenerateRecoveryCodes_Call{Call: _e.mock.On("RegenerateRecoveryCodes", user)}
+}
+
+func (_c *TwoFactor_RegenerateRecoveryCodes_Call) Run(run func(user auth.TwoFactorAuthenticatable)) *TwoFactor_RegenerateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_RegenerateRecoveryCodes_Call) Return(_a0 []string, _a1 error) *TwoFactor_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactor_RegenerateRecoveryCodes_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable) ([]string, error)) *TwoFactor_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseRecoveryCode provides a mock function with given fields: user, code
+func (_m *TwoFactor) UseRecoveryCode(user auth.TwoFactorAuthenticatable, code string) (bool, error) {
+	ret := _m.Called(user, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable, string) (bool, error)); ok {
+		return rf(user, code)
+	}
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable, string) bool); ok {
+		r0 = rf(user, code)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(auth.TwoFactorAuthenticatable, string) error); ok {
+		r1 = rf(user, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactor_UseRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseRecoveryCode'
+type TwoFactor_UseRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// UseRecoveryCode is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+//   - code string
+func (_e *TwoFactor_Expecter) UseRecoveryCode(user interface{}, code interface{}) *TwoFactor_UseRecoveryCode_Call {
+	return &TwoFactor_UseRecoveryCode_Call{Call: _e.mock.On("UseRecoveryCode", user, code)}
+}
+
+func (_c *TwoFactor_UseRecoveryCode_Call) Run(run func(user auth.TwoFactorAuthenticatable, code string)) *TwoFactor_UseRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_UseRecoveryCode_Call) Return(_a0 bool, _a1 error) *TwoFactor_UseRecoveryCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactor_UseRecoveryCode_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable, string) (bool, error)) *TwoFactor_UseRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: user, code
+func (_m *TwoFactor) Verify(user auth.TwoFactorAuthenticatable, code string) bool {
+	ret := _m.Called(user, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(auth.TwoFactorAuthenticatable, string) bool); ok {
+		r0 = rf(user, code)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TwoFactor_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type TwoFactor_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - user auth.TwoFactorAuthenticatable
+//   - code string
+func (_e *TwoFactor_Expecter) Verify(user interface{}, code interface{}) *TwoFactor_Verify_Call {
+	return &TwoFactor_Verify_Call{Call: _e.mock.On("Verify", user, code)}
+}
+
+func (_c *TwoFactor_Verify_Call) Run(run func(user auth.TwoFactorAuthenticatable, code string)) *TwoFactor_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(auth.TwoFactorAuthenticatable), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactor_Verify_Call) Return(_a0 bool) *TwoFactor_Verify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactor_Verify_Call) RunAndReturn(run func(auth.TwoFactorAuthenticatable, string) bool) *TwoFactor_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTwoFactor creates a new instance of TwoFactor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTwoFactor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TwoFactor {
+	mock := &TwoFactor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
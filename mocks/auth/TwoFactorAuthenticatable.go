@@ -0,0 +1,214 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import mock "github.com/stretchr/testify/mock"
+
+// TwoFactorAuthenticatable is an autogenerated mock type for the TwoFactorAuthenticatable type
+type TwoFactorAuthenticatable struct {
+	mock.Mock
+}
+
+type TwoFactorAuthenticatable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TwoFactorAuthenticatable) EXPECT() *TwoFactorAuthenticatable_Expecter {
+	return &TwoFactorAuthenticatable_Expecter{mock: &_m.Mock}
+}
+
+// GetTwoFactorRecoveryCodes provides a mock function with no fields
+func (_m *TwoFactorAuthenticatable) GetTwoFactorRecoveryCodes() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTwoFactorRecoveryCodes")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTwoFactorRecoveryCodes'
+type TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// GetTwoFactorRecoveryCodes is a helper method to define mock.On call
+func (_e *TwoFactorAuthenticatable_Expecter) GetTwoFactorRecoveryCodes() *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call {
+	return &TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call{Call: _e.mock.On("GetTwoFactorRecoveryCodes")}
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call) Run(run func()) *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call) Return(_a0 string) *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call) RunAndReturn(run func() string) *TwoFactorAuthenticatable_GetTwoFactorRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTwoFactorSecret provides a mock function with no fields
+func (_m *TwoFactorAuthenticatable) GetTwoFactorSecret() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTwoFactorSecret")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TwoFactorAuthenticatable_GetTwoFactorSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTwoFactorSecret'
+type TwoFactorAuthenticatable_GetTwoFactorSecret_Call struct {
+	*mock.Call
+}
+
+// GetTwoFactorSecret is a helper method to define mock.On call
+func (_e *TwoFactorAuthenticatable_Expecter) GetTwoFactorSecret() *TwoFactorAuthenticatable_GetTwoFactorSecret_Call {
+	return &TwoFactorAuthenticatable_GetTwoFactorSecret_Call{Call: _e.mock.On("GetTwoFactorSecret")}
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorSecret_Call) Run(run func()) *TwoFactorAuthenticatable_GetTwoFactorSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorSecret_Call) Return(_a0 string) *TwoFactorAuthenticatable_GetTwoFactorSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_GetTwoFactorSecret_Call) RunAndReturn(run func() string) *TwoFactorAuthenticatable_GetTwoFactorSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTwoFactorRecoveryCodes provides a mock function with given fields: codes
+func (_m *TwoFactorAuthenticatable) SetTwoFactorRecoveryCodes(codes string) error {
+	ret := _m.Called(codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTwoFactorRecoveryCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(codes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTwoFactorRecoveryCodes'
+type TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// SetTwoFactorRecoveryCodes is a helper method to define mock.On call
+//   - codes string
+func (_e *TwoFactorAuthenticatable_Expecter) SetTwoFactorRecoveryCodes(codes interface{}) *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call {
+	return &TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call{Call: _e.mock.On("SetTwoFactorRecoveryCodes", codes)}
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call) Run(run func(codes string)) *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call) Return(_a0 error) *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call) RunAndReturn(run func(string) error) *TwoFactorAuthenticatable_SetTwoFactorRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTwoFactorSecret provides a mock function with given fields: secret
+func (_m *TwoFactorAuthenticatable) SetTwoFactorSecret(secret string) error {
+	ret := _m.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTwoFactorSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactorAuthenticatable_SetTwoFactorSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTwoFactorSecret'
+type TwoFactorAuthenticatable_SetTwoFactorSecret_Call struct {
+	*mock.Call
+}
+
+// SetTwoFactorSecret is a helper method to define mock.On call
+//   - secret string
+func (_e *TwoFactorAuthenticatable_Expecter) SetTwoFactorSecret(secret interface{}) *TwoFactorAuthenticatable_SetTwoFactorSecret_Call {
+	return &TwoFactorAuthenticatable_SetTwoFactorSecret_Call{Call: _e.mock.On("SetTwoFactorSecret", secret)}
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorSecret_Call) Run(run func(secret string)) *TwoFactorAuthenticatable_SetTwoFactorSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorSecret_Call) Return(_a0 error) *TwoFactorAuthenticatable_SetTwoFactorSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorAuthenticatable_SetTwoFactorSecret_Call) RunAndReturn(run func(string) error) *TwoFactorAuthenticatable_SetTwoFactorSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTwoFactorAuthenticatable creates a new instance of TwoFactorAuthenticatable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTwoFactorAuthenticatable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TwoFactorAuthenticatable {
+	mock := &TwoFactorAuthenticatable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,259 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package auth
+
+import (
+	auth "github.com/goravel/framework/contracts/auth"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// UserTokens is an autogenerated mock type for the UserTokens type
+type UserTokens struct {
+	mock.Mock
+}
+
+type UserTokens_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserTokens) EXPECT() *UserTokens_Expecter {
+	return &UserTokens_Expecter{mock: &_m.Mock}
+}
+
+// All provides a mock function with no fields
+func (_m *UserTokens) All() ([]auth.AccessToken, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 []auth.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]auth.AccessToken, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []auth.AccessToken); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.AccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserTokens_All_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'All'
+type UserTokens_All_Call struct {
+	*mock.Call
+}
+
+// All is a helper method to define mock.On call
+func (_e *UserTokens_Expecter) All() *UserTokens_All_Call {
+	return &UserTokens_All_Call{Call: _e.mock.On("All")}
+}
+
+func (_c *UserTokens_All_Call) Run(run func()) *UserTokens_All_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UserTokens_All_Call) Return(_a0 []auth.AccessToken, _a1 error) *UserTokens_All_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserTokens_All_Call) RunAndReturn(run func() ([]auth.AccessToken, error)) *UserTokens_All_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: name, abilities, expiresAt
+func (_m *UserTokens) Create(name string, abilities []string, expiresAt ...time.Time) (*auth.NewAccessToken, error) {
+	_va := make([]interface{}, len(expiresAt))
+	for _i := range expiresAt {
+		_va[_i] = expiresAt[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, abilities)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *auth.NewAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...time.Time) (*auth.NewAccessToken, error)); ok {
+		return rf(name, abilities, expiresAt...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, ...time.Time) *auth.NewAccessToken); ok {
+		r0 = rf(name, abilities, expiresAt...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.NewAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, ...time.Time) error); ok {
+		r1 = rf(name, abilities, expiresAt...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserTokens_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UserTokens_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - name string
+//   - abilities []string
+//   - expiresAt ...time.Time
+func (_e *UserTokens_Expecter) Create(name interface{}, abilities interface{}, expiresAt ...interface{}) *UserTokens_Create_Call {
+	return &UserTokens_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{name, abilities}, expiresAt...)...)}
+}
+
+func (_c *UserTokens_Create_Call) Run(run func(name string, abilities []string, expiresAt ...time.Time)) *UserTokens_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]time.Time, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(time.Time)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserTokens_Create_Call) Return(_a0 *auth.NewAccessToken, _a1 error) *UserTokens_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserTokens_Create_Call) RunAndReturn(run func(string, []string, ...time.Time) (*auth.NewAccessToken, error)) *UserTokens_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: id
+func (_m *UserTokens) Revoke(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserTokens_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type UserTokens_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - id string
+func (_e *UserTokens_Expecter) Revoke(id interface{}) *UserTokens_Revoke_Call {
+	return &UserTokens_Revoke_Call{Call: _e.mock.On("Revoke", id)}
+}
+
+func (_c *UserTokens_Revoke_Call) Run(run func(id string)) *UserTokens_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserTokens_Revoke_Call) Return(_a0 error) *UserTokens_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserTokens_Revoke_Call) RunAndReturn(run func(string) error) *UserTokens_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAll provides a mock function with no fields
+func (_m *UserTokens) RevokeAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserTokens_RevokeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAll'
+type UserTokens_RevokeAll_Call struct {
+	*mock.Call
+}
+
+// RevokeAll is a helper method to define mock.On call
+func (_e *UserTokens_Expecter) RevokeAll() *UserTokens_RevokeAll_Call {
+	return &UserTokens_RevokeAll_Call{Call: _e.mock.On("RevokeAll")}
+}
+
+func (_c *UserTokens_RevokeAll_Call) Run(run func()) *UserTokens_RevokeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UserTokens_RevokeAll_Call) Return(_a0 error) *UserTokens_RevokeAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserTokens_RevokeAll_Call) RunAndReturn(run func() error) *UserTokens_RevokeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserTokens creates a new instance of UserTokens. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserTokens(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserTokens {
+	mock := &UserTokens{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
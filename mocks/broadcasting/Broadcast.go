@@ -104,6 +104,64 @@ func (_c *Broadcast_Dispatch_Call) RunAndReturn(run func(context.Context, broadc
 	return _c
 }
 
+// Server provides a mock function with given fields: args
+func (_m *Broadcast) Server(args broadcasting.ServerArgs) (broadcasting.Server, error) {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Server")
+	}
+
+	var r0 broadcasting.Server
+	var r1 error
+	if rf, ok := ret.Get(0).(func(broadcasting.ServerArgs) (broadcasting.Server, error)); ok {
+		return rf(args)
+	}
+	if rf, ok := ret.Get(0).(func(broadcasting.ServerArgs) broadcasting.Server); ok {
+		r0 = rf(args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(broadcasting.Server)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(broadcasting.ServerArgs) error); ok {
+		r1 = rf(args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Broadcast_Server_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Server'
+type Broadcast_Server_Call struct {
+	*mock.Call
+}
+
+// Server is a helper method to define mock.On call
+//   - args broadcasting.ServerArgs
+func (_e *Broadcast_Expecter) Server(args interface{}) *Broadcast_Server_Call {
+	return &Broadcast_Server_Call{Call: _e.mock.On("Server", args)}
+}
+
+func (_c *Broadcast_Server_Call) Run(run func(args broadcasting.ServerArgs)) *Broadcast_Server_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broadcasting.ServerArgs))
+	})
+	return _c
+}
+
+func (_c *Broadcast_Server_Call) Return(_a0 broadcasting.Server, _a1 error) *Broadcast_Server_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Broadcast_Server_Call) RunAndReturn(run func(broadcasting.ServerArgs) (broadcasting.Server, error)) *Broadcast_Server_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBroadcast creates a new instance of Broadcast. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBroadcast(t interface {
@@ -0,0 +1,172 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package broadcasting
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Server is an autogenerated mock type for the Server type
+type Server struct {
+	mock.Mock
+}
+
+type Server_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Server) EXPECT() *Server_Expecter {
+	return &Server_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function with no fields
+func (_m *Server) Address() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Server_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type Server_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *Server_Expecter) Address() *Server_Address_Call {
+	return &Server_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *Server_Address_Call) Run(run func()) *Server_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Server_Address_Call) Return(_a0 string) *Server_Address_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Address_Call) RunAndReturn(run func() string) *Server_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with no fields
+func (_m *Server) Run() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Server_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+func (_e *Server_Expecter) Run() *Server_Run_Call {
+	return &Server_Run_Call{Call: _e.mock.On("Run")}
+}
+
+func (_c *Server_Run_Call) Run(run func()) *Server_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Server_Run_Call) Return(_a0 error) *Server_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Run_Call) RunAndReturn(run func() error) *Server_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Shutdown provides a mock function with given fields: ctx
+func (_m *Server) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shutdown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type Server_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Server_Expecter) Shutdown(ctx interface{}) *Server_Shutdown_Call {
+	return &Server_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx)}
+}
+
+func (_c *Server_Shutdown_Call) Run(run func(ctx context.Context)) *Server_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Server_Shutdown_Call) Return(_a0 error) *Server_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Shutdown_Call) RunAndReturn(run func(context.Context) error) *Server_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServer creates a new instance of Server. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Server {
+	mock := &Server{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -203,6 +203,67 @@ func (_c *Driver_Docker_Call) RunAndReturn(run func() (docker.CacheDriver, error
 	return _c
 }
 
+// Flexible provides a mock function with given fields: key, fresh, stale, callback
+func (_m *Driver) Flexible(key string, fresh time.Duration, stale time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	ret := _m.Called(key, fresh, stale, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flexible")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Duration, time.Duration, func() (interface{}, error)) (interface{}, error)); ok {
+		return rf(key, fresh, stale, callback)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Duration, time.Duration, func() (interface{}, error)) interface{}); ok {
+		r0 = rf(key, fresh, stale, callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Duration, time.Duration, func() (interface{}, error)) error); ok {
+		r1 = rf(key, fresh, stale, callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Driver_Flexible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flexible'
+type Driver_Flexible_Call struct {
+	*mock.Call
+}
+
+// Flexible is a helper method to define mock.On call
+//   - key string
+//   - fresh time.Duration
+//   - stale time.Duration
+//   - callback func()(interface{} , error)
+func (_e *Driver_Expecter) Flexible(key interface{}, fresh interface{}, stale interface{}, callback interface{}) *Driver_Flexible_Call {
+	return &Driver_Flexible_Call{Call: _e.mock.On("Flexible", key, fresh, stale, callback)}
+}
+
+func (_c *Driver_Flexible_Call) Run(run func(key string, fresh time.Duration, stale time.Duration, callback func() (interface{}, error))) *Driver_Flexible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Duration), args[2].(time.Duration), args[3].(func() (interface{}, error)))
+	})
+	return _c
+}
+
+func (_c *Driver_Flexible_Call) Return(_a0 interface{}, _a1 error) *Driver_Flexible_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Driver_Flexible_Call) RunAndReturn(run func(string, time.Duration, time.Duration, func() (interface{}, error)) (interface{}, error)) *Driver_Flexible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with no fields
 func (_m *Driver) Flush() bool {
 	ret := _m.Called()
@@ -1050,6 +1111,67 @@ func (_c *Driver_RememberForever_Call) RunAndReturn(run func(string, func() (int
 	return _c
 }
 
+// Tags provides a mock function with given fields: names
+func (_m *Driver) Tags(names ...string) cache.Driver {
+	_va := make([]interface{}, len(names))
+	for _i := range names {
+		_va[_i] = names[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tags")
+	}
+
+	var r0 cache.Driver
+	if rf, ok := ret.Get(0).(func(...string) cache.Driver); ok {
+		r0 = rf(names...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cache.Driver)
+		}
+	}
+
+	return r0
+}
+
+// Driver_Tags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tags'
+type Driver_Tags_Call struct {
+	*mock.Call
+}
+
+// Tags is a helper method to define mock.On call
+//   - names ...string
+func (_e *Driver_Expecter) Tags(names ...interface{}) *Driver_Tags_Call {
+	return &Driver_Tags_Call{Call: _e.mock.On("Tags",
+		append([]interface{}{}, names...)...)}
+}
+
+func (_c *Driver_Tags_Call) Run(run func(names ...string)) *Driver_Tags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Driver_Tags_Call) Return(_a0 cache.Driver) *Driver_Tags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Driver_Tags_Call) RunAndReturn(run func(...string) cache.Driver) *Driver_Tags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithContext provides a mock function with given fields: ctx
 func (_m *Driver) WithContext(ctx context.Context) cache.Driver {
 	ret := _m.Called(ctx)
@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import (
+	cache "github.com/goravel/framework/contracts/cache"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DriverWithRestoreLock is an autogenerated mock type for the DriverWithRestoreLock type
+type DriverWithRestoreLock struct {
+	mock.Mock
+}
+
+type DriverWithRestoreLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithRestoreLock) EXPECT() *DriverWithRestoreLock_Expecter {
+	return &DriverWithRestoreLock_Expecter{mock: &_m.Mock}
+}
+
+// RestoreLock provides a mock function with given fields: key, owner
+func (_m *DriverWithRestoreLock) RestoreLock(key string, owner string) cache.Lock {
+	ret := _m.Called(key, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLock")
+	}
+
+	var r0 cache.Lock
+	if rf, ok := ret.Get(0).(func(string, string) cache.Lock); ok {
+		r0 = rf(key, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cache.Lock)
+		}
+	}
+
+	return r0
+}
+
+// DriverWithRestoreLock_RestoreLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLock'
+type DriverWithRestoreLock_RestoreLock_Call struct {
+	*mock.Call
+}
+
+// RestoreLock is a helper method to define mock.On call
+//   - key string
+//   - owner string
+func (_e *DriverWithRestoreLock_Expecter) RestoreLock(key interface{}, owner interface{}) *DriverWithRestoreLock_RestoreLock_Call {
+	return &DriverWithRestoreLock_RestoreLock_Call{Call: _e.mock.On("RestoreLock", key, owner)}
+}
+
+func (_c *DriverWithRestoreLock_RestoreLock_Call) Run(run func(key string, owner string)) *DriverWithRestoreLock_RestoreLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithRestoreLock_RestoreLock_Call) Return(_a0 cache.Lock) *DriverWithRestoreLock_RestoreLock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriverWithRestoreLock_RestoreLock_Call) RunAndReturn(run func(string, string) cache.Lock) *DriverWithRestoreLock_RestoreLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithRestoreLock creates a new instance of DriverWithRestoreLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithRestoreLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithRestoreLock {
+	mock := &DriverWithRestoreLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
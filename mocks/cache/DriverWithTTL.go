@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DriverWithTTL is an autogenerated mock type for the DriverWithTTL type
+type DriverWithTTL struct {
+	mock.Mock
+}
+
+type DriverWithTTL_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithTTL) EXPECT() *DriverWithTTL_Expecter {
+	return &DriverWithTTL_Expecter{mock: &_m.Mock}
+}
+
+// TTL provides a mock function with given fields: key
+func (_m *DriverWithTTL) TTL(key string) (time.Duration, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (time.Duration, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// DriverWithTTL_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type DriverWithTTL_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+//   - key string
+func (_e *DriverWithTTL_Expecter) TTL(key interface{}) *DriverWithTTL_TTL_Call {
+	return &DriverWithTTL_TTL_Call{Call: _e.mock.On("TTL", key)}
+}
+
+func (_c *DriverWithTTL_TTL_Call) Run(run func(key string)) *DriverWithTTL_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithTTL_TTL_Call) Return(_a0 time.Duration, _a1 bool) *DriverWithTTL_TTL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithTTL_TTL_Call) RunAndReturn(run func(string) (time.Duration, bool)) *DriverWithTTL_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithTTL creates a new instance of DriverWithTTL. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithTTL(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithTTL {
+	mock := &DriverWithTTL{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import mock "github.com/stretchr/testify/mock"
+
+// LockWithOwner is an autogenerated mock type for the LockWithOwner type
+type LockWithOwner struct {
+	mock.Mock
+}
+
+type LockWithOwner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LockWithOwner) EXPECT() *LockWithOwner_Expecter {
+	return &LockWithOwner_Expecter{mock: &_m.Mock}
+}
+
+// Owner provides a mock function with no fields
+func (_m *LockWithOwner) Owner() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Owner")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LockWithOwner_Owner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Owner'
+type LockWithOwner_Owner_Call struct {
+	*mock.Call
+}
+
+// Owner is a helper method to define mock.On call
+func (_e *LockWithOwner_Expecter) Owner() *LockWithOwner_Owner_Call {
+	return &LockWithOwner_Owner_Call{Call: _e.mock.On("Owner")}
+}
+
+func (_c *LockWithOwner_Owner_Call) Run(run func()) *LockWithOwner_Owner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LockWithOwner_Owner_Call) Return(_a0 string) *LockWithOwner_Owner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LockWithOwner_Owner_Call) RunAndReturn(run func() string) *LockWithOwner_Owner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLockWithOwner creates a new instance of LockWithOwner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLockWithOwner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LockWithOwner {
+	mock := &LockWithOwner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package filesystem
+
+import (
+	io "io"
+
+	filesystem "github.com/goravel/framework/contracts/filesystem"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DriverWithStream is an autogenerated mock type for the DriverWithStream type
+type DriverWithStream struct {
+	mock.Mock
+}
+
+type DriverWithStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithStream) EXPECT() *DriverWithStream_Expecter {
+	return &DriverWithStream_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function with given fields: file, content
+func (_m *DriverWithStream) Append(file string, content string) error {
+	ret := _m.Called(file, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(file, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriverWithStream_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type DriverWithStream_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - file string
+//   - content string
+func (_e *DriverWithStream_Expecter) Append(file interface{}, content interface{}) *DriverWithStream_Append_Call {
+	return &DriverWithStream_Append_Call{Call: _e.mock.On("Append", file, content)}
+}
+
+func (_c *DriverWithStream_Append_Call) Run(run func(file string, content string)) *DriverWithStream_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_Append_Call) Return(_a0 error) *DriverWithStream_Append_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriverWithStream_Append_Call) RunAndReturn(run func(string, string) error) *DriverWithStream_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Metadata provides a mock function with given fields: file
+func (_m *DriverWithStream) Metadata(file string) (filesystem.Metadata, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Metadata")
+	}
+
+	var r0 filesystem.Metadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (filesystem.Metadata, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) filesystem.Metadata); ok {
+		r0 = rf(file)
+	} else {
+		r0 = ret.Get(0).(filesystem.Metadata)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriverWithStream_Metadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Metadata'
+type DriverWithStream_Metadata_Call struct {
+	*mock.Call
+}
+
+// Metadata is a helper method to define mock.On call
+//   - file string
+func (_e *DriverWithStream_Expecter) Metadata(file interface{}) *DriverWithStream_Metadata_Call {
+	return &DriverWithStream_Metadata_Call{Call: _e.mock.On("Metadata", file)}
+}
+
+func (_c *DriverWithStream_Metadata_Call) Run(run func(file string)) *DriverWithStream_Metadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_Metadata_Call) Return(_a0 filesystem.Metadata, _a1 error) *DriverWithStream_Metadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithStream_Metadata_Call) RunAndReturn(run func(string) (filesystem.Metadata, error)) *DriverWithStream_Metadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Prepend provides a mock function with given fields: file, content
+func (_m *DriverWithStream) Prepend(file string, content string) error {
+	ret := _m.Called(file, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prepend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(file, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriverWithStream_Prepend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prepend'
+type DriverWithStream_Prepend_Call struct {
+	*mock.Call
+}
+
+// Prepend is a helper method to define mock.On call
+//   - file string
+//   - content string
+func (_e *DriverWithStream_Expecter) Prepend(file interface{}, content interface{}) *DriverWithStream_Prepend_Call {
+	return &DriverWithStream_Prepend_Call{Call: _e.mock.On("Prepend", file, content)}
+}
+
+func (_c *DriverWithStream_Prepend_Call) Run(run func(file string, content string)) *DriverWithStream_Prepend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_Prepend_Call) Return(_a0 error) *DriverWithStream_Prepend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriverWithStream_Prepend_Call) RunAndReturn(run func(string, string) error) *DriverWithStream_Prepend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadStream provides a mock function with given fields: file
+func (_m *DriverWithStream) ReadStream(file string) (io.ReadCloser, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadStream")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (io.ReadCloser, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriverWithStream_ReadStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadStream'
+type DriverWithStream_ReadStream_Call struct {
+	*mock.Call
+}
+
+// ReadStream is a helper method to define mock.On call
+//   - file string
+func (_e *DriverWithStream_Expecter) ReadStream(file interface{}) *DriverWithStream_ReadStream_Call {
+	return &DriverWithStream_ReadStream_Call{Call: _e.mock.On("ReadStream", file)}
+}
+
+func (_c *DriverWithStream_ReadStream_Call) Run(run func(file string)) *DriverWithStream_ReadStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_ReadStream_Call) Return(_a0 io.ReadCloser, _a1 error) *DriverWithStream_ReadStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithStream_ReadStream_Call) RunAndReturn(run func(string) (io.ReadCloser, error)) *DriverWithStream_ReadStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVisibility provides a mock function with given fields: file, visibility
+func (_m *DriverWithStream) SetVisibility(file string, visibility filesystem.Visibility) error {
+	ret := _m.Called(file, visibility)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVisibility")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, filesystem.Visibility) error); ok {
+		r0 = rf(file, visibility)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriverWithStream_SetVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVisibility'
+type DriverWithStream_SetVisibility_Call struct {
+	*mock.Call
+}
+
+// SetVisibility is a helper method to define mock.On call
+//   - file string
+//   - visibility filesystem.Visibility
+func (_e *DriverWithStream_Expecter) SetVisibility(file interface{}, visibility interface{}) *DriverWithStream_SetVisibility_Call {
+	return &DriverWithStream_SetVisibility_Call{Call: _e.mock.On("SetVisibility", file, visibility)}
+}
+
+func (_c *DriverWithStream_SetVisibility_Call) Run(run func(file string, visibility filesystem.Visibility)) *DriverWithStream_SetVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(filesystem.Visibility))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_SetVisibility_Call) Return(_a0 error) *DriverWithStream_SetVisibility_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriverWithStream_SetVisibility_Call) RunAndReturn(run func(string, filesystem.Visibility) error) *DriverWithStream_SetVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Visibility provides a mock function with given fields: file
+func (_m *DriverWithStream) Visibility(file string) (filesystem.Visibility, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Visibility")
+	}
+
+	var r0 filesystem.Visibility
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (filesystem.Visibility, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) filesystem.Visibility); ok {
+		r0 = rf(file)
+	} else {
+		r0 = ret.Get(0).(filesystem.Visibility)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriverWithStream_Visibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Visibility'
+type DriverWithStream_Visibility_Call struct {
+	*mock.Call
+}
+
+// Visibility is a helper method to define mock.On call
+//   - file string
+func (_e *DriverWithStream_Expecter) Visibility(file interface{}) *DriverWithStream_Visibility_Call {
+	return &DriverWithStream_Visibility_Call{Call: _e.mock.On("Visibility", file)}
+}
+
+func (_c *DriverWithStream_Visibility_Call) Run(run func(file string)) *DriverWithStream_Visibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_Visibility_Call) Return(_a0 filesystem.Visibility, _a1 error) *DriverWithStream_Visibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithStream_Visibility_Call) RunAndReturn(run func(string) (filesystem.Visibility, error)) *DriverWithStream_Visibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteStream provides a mock function with given fields: file, reader, options
+func (_m *DriverWithStream) WriteStream(file string, reader io.Reader, options ...filesystem.WriteOptions) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file, reader)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteStream")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, ...filesystem.WriteOptions) error); ok {
+		r0 = rf(file, reader, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriverWithStream_WriteStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteStream'
+type DriverWithStream_WriteStream_Call struct {
+	*mock.Call
+}
+
+// WriteStream is a helper method to define mock.On call
+//   - file string
+//   - reader io.Reader
+//   - options ...filesystem.WriteOptions
+func (_e *DriverWithStream_Expecter) WriteStream(file interface{}, reader interface{}, options ...interface{}) *DriverWithStream_WriteStream_Call {
+	return &DriverWithStream_WriteStream_Call{Call: _e.mock.On("WriteStream",
+		append([]interface{}{file, reader}, options...)...)}
+}
+
+func (_c *DriverWithStream_WriteStream_Call) Run(run func(file string, reader io.Reader, options ...filesystem.WriteOptions)) *DriverWithStream_WriteStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]filesystem.WriteOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(filesystem.WriteOptions)
+			}
+		}
+		run(args[0].(string), args[1].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DriverWithStream_WriteStream_Call) Return(_a0 error) *DriverWithStream_WriteStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriverWithStream_WriteStream_Call) RunAndReturn(run func(string, io.Reader, ...filesystem.WriteOptions) error) *DriverWithStream_WriteStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithStream creates a new instance of DriverWithStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithStream {
+	mock := &DriverWithStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2551,6 +2551,53 @@ func (_c *Application_MakeTesting_Call) RunAndReturn(run func() testing.Testing)
 	return _c
 }
 
+// MakeURL provides a mock function with no fields
+func (_m *Application) MakeURL() route.URL {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeURL")
+	}
+
+	var r0 route.URL
+	if rf, ok := ret.Get(0).(func() route.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.URL)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeURL'
+type Application_MakeURL_Call struct {
+	*mock.Call
+}
+
+// MakeURL is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeURL() *Application_MakeURL_Call {
+	return &Application_MakeURL_Call{Call: _e.mock.On("MakeURL")}
+}
+
+func (_c *Application_MakeURL_Call) Run(run func()) *Application_MakeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeURL_Call) Return(_a0 route.URL) *Application_MakeURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeURL_Call) RunAndReturn(run func() route.URL) *Application_MakeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeValidation provides a mock function with no fields
 func (_m *Application) MakeValidation() validation.Validation {
 	ret := _m.Called()
@@ -0,0 +1,1185 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mail
+
+import (
+	mail "github.com/goravel/framework/contracts/mail"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Fake is an autogenerated mock type for the Fake type
+type Fake struct {
+	mock.Mock
+}
+
+type Fake_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Fake) EXPECT() *Fake_Expecter {
+	return &Fake_Expecter{mock: &_m.Mock}
+}
+
+// AssertNotQueued provides a mock function with given fields: assertion
+func (_m *Fake) AssertNotQueued(assertion func(mail.Mailable) bool) bool {
+	ret := _m.Called(assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNotQueued")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(mail.Mailable) bool) bool); ok {
+		r0 = rf(assertion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNotQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNotQueued'
+type Fake_AssertNotQueued_Call struct {
+	*mock.Call
+}
+
+// AssertNotQueued is a helper method to define mock.On call
+//   - assertion func(mail.Mailable) bool
+func (_e *Fake_Expecter) AssertNotQueued(assertion interface{}) *Fake_AssertNotQueued_Call {
+	return &Fake_AssertNotQueued_Call{Call: _e.mock.On("AssertNotQueued", assertion)}
+}
+
+func (_c *Fake_AssertNotQueued_Call) Run(run func(assertion func(mail.Mailable) bool)) *Fake_AssertNotQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(mail.Mailable) bool))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNotQueued_Call) Return(_a0 bool) *Fake_AssertNotQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNotQueued_Call) RunAndReturn(run func(func(mail.Mailable) bool) bool) *Fake_AssertNotQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNotSent provides a mock function with given fields: assertion
+func (_m *Fake) AssertNotSent(assertion func(mail.Mailable) bool) bool {
+	ret := _m.Called(assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNotSent")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(mail.Mailable) bool) bool); ok {
+		r0 = rf(assertion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNotSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNotSent'
+type Fake_AssertNotSent_Call struct {
+	*mock.Call
+}
+
+// AssertNotSent is a helper method to define mock.On call
+//   - assertion func(mail.Mailable) bool
+func (_e *Fake_Expecter) AssertNotSent(assertion interface{}) *Fake_AssertNotSent_Call {
+	return &Fake_AssertNotSent_Call{Call: _e.mock.On("AssertNotSent", assertion)}
+}
+
+func (_c *Fake_AssertNotSent_Call) Run(run func(assertion func(mail.Mailable) bool)) *Fake_AssertNotSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(mail.Mailable) bool))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNotSent_Call) Return(_a0 bool) *Fake_AssertNotSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNotSent_Call) RunAndReturn(run func(func(mail.Mailable) bool) bool) *Fake_AssertNotSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNothingQueued provides a mock function with no fields
+func (_m *Fake) AssertNothingQueued() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNothingQueued")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNothingQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNothingQueued'
+type Fake_AssertNothingQueued_Call struct {
+	*mock.Call
+}
+
+// AssertNothingQueued is a helper method to define mock.On call
+func (_e *Fake_Expecter) AssertNothingQueued() *Fake_AssertNothingQueued_Call {
+	return &Fake_AssertNothingQueued_Call{Call: _e.mock.On("AssertNothingQueued")}
+}
+
+func (_c *Fake_AssertNothingQueued_Call) Run(run func()) *Fake_AssertNothingQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNothingQueued_Call) Return(_a0 bool) *Fake_AssertNothingQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNothingQueued_Call) RunAndReturn(run func() bool) *Fake_AssertNothingQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNothingSent provides a mock function with no fields
+func (_m *Fake) AssertNothingSent() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNothingSent")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNothingSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNothingSent'
+type Fake_AssertNothingSent_Call struct {
+	*mock.Call
+}
+
+// AssertNothingSent is a helper method to define mock.On call
+func (_e *Fake_Expecter) AssertNothingSent() *Fake_AssertNothingSent_Call {
+	return &Fake_AssertNothingSent_Call{Call: _e.mock.On("AssertNothingSent")}
+}
+
+func (_c *Fake_AssertNothingSent_Call) Run(run func()) *Fake_AssertNothingSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNothingSent_Call) Return(_a0 bool) *Fake_AssertNothingSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNothingSent_Call) RunAndReturn(run func() bool) *Fake_AssertNothingSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertQueued provides a mock function with given fields: assertion
+func (_m *Fake) AssertQueued(assertion func(mail.Mailable) bool) bool {
+	ret := _m.Called(assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertQueued")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(mail.Mailable) bool) bool); ok {
+		r0 = rf(assertion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertQueued'
+type Fake_AssertQueued_Call struct {
+	*mock.Call
+}
+
+// AssertQueued is a helper method to define mock.On call
+//   - assertion func(mail.Mailable) bool
+func (_e *Fake_Expecter) AssertQueued(assertion interface{}) *Fake_AssertQueued_Call {
+	return &Fake_AssertQueued_Call{Call: _e.mock.On("AssertQueued", assertion)}
+}
+
+func (_c *Fake_AssertQueued_Call) Run(run func(assertion func(mail.Mailable) bool)) *Fake_AssertQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(mail.Mailable) bool))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertQueued_Call) Return(_a0 bool) *Fake_AssertQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertQueued_Call) RunAndReturn(run func(func(mail.Mailable) bool) bool) *Fake_AssertQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertQueuedCount provides a mock function with given fields: count
+func (_m *Fake) AssertQueuedCount(count int) bool {
+	ret := _m.Called(count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertQueuedCount")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(count)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertQueuedCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertQueuedCount'
+type Fake_AssertQueuedCount_Call struct {
+	*mock.Call
+}
+
+// AssertQueuedCount is a helper method to define mock.On call
+//   - count int
+func (_e *Fake_Expecter) AssertQueuedCount(count interface{}) *Fake_AssertQueuedCount_Call {
+	return &Fake_AssertQueuedCount_Call{Call: _e.mock.On("AssertQueuedCount", count)}
+}
+
+func (_c *Fake_AssertQueuedCount_Call) Run(run func(count int)) *Fake_AssertQueuedCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertQueuedCount_Call) Return(_a0 bool) *Fake_AssertQueuedCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertQueuedCount_Call) RunAndReturn(run func(int) bool) *Fake_AssertQueuedCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertSent provides a mock function with given fields: assertion
+func (_m *Fake) AssertSent(assertion func(mail.Mailable) bool) bool {
+	ret := _m.Called(assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertSent")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(mail.Mailable) bool) bool); ok {
+		r0 = rf(assertion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertSent'
+type Fake_AssertSent_Call struct {
+	*mock.Call
+}
+
+// AssertSent is a helper method to define mock.On call
+//   - assertion func(mail.Mailable) bool
+func (_e *Fake_Expecter) AssertSent(assertion interface{}) *Fake_AssertSent_Call {
+	return &Fake_AssertSent_Call{Call: _e.mock.On("AssertSent", assertion)}
+}
+
+func (_c *Fake_AssertSent_Call) Run(run func(assertion func(mail.Mailable) bool)) *Fake_AssertSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(mail.Mailable) bool))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertSent_Call) Return(_a0 bool) *Fake_AssertSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertSent_Call) RunAndReturn(run func(func(mail.Mailable) bool) bool) *Fake_AssertSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertSentCount provides a mock function with given fields: count
+func (_m *Fake) AssertSentCount(count int) bool {
+	ret := _m.Called(count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertSentCount")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(count)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertSentCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertSentCount'
+type Fake_AssertSentCount_Call struct {
+	*mock.Call
+}
+
+// AssertSentCount is a helper method to define mock.On call
+//   - count int
+func (_e *Fake_Expecter) AssertSentCount(count interface{}) *Fake_AssertSentCount_Call {
+	return &Fake_AssertSentCount_Call{Call: _e.mock.On("AssertSentCount", count)}
+}
+
+func (_c *Fake_AssertSentCount_Call) Run(run func(count int)) *Fake_AssertSentCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertSentCount_Call) Return(_a0 bool) *Fake_AssertSentCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertSentCount_Call) RunAndReturn(run func(int) bool) *Fake_AssertSentCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attach provides a mock function with given fields: files
+func (_m *Fake) Attach(files []string) mail.Mail {
+	ret := _m.Called(files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func([]string) mail.Mail); ok {
+		r0 = rf(files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type Fake_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - files []string
+func (_e *Fake_Expecter) Attach(files interface{}) *Fake_Attach_Call {
+	return &Fake_Attach_Call{Call: _e.mock.On("Attach", files)}
+}
+
+func (_c *Fake_Attach_Call) Run(run func(files []string)) *Fake_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *Fake_Attach_Call) Return(_a0 mail.Mail) *Fake_Attach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Attach_Call) RunAndReturn(run func([]string) mail.Mail) *Fake_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachWith provides a mock function with given fields: attachments
+func (_m *Fake) AttachWith(attachments ...mail.Attachment) mail.Mail {
+	_va := make([]interface{}, len(attachments))
+	for _i := range attachments {
+		_va[_i] = attachments[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachWith")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(...mail.Attachment) mail.Mail); ok {
+		r0 = rf(attachments...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_AttachWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachWith'
+type Fake_AttachWith_Call struct {
+	*mock.Call
+}
+
+// AttachWith is a helper method to define mock.On call
+//   - attachments ...mail.Attachment
+func (_e *Fake_Expecter) AttachWith(attachments ...interface{}) *Fake_AttachWith_Call {
+	return &Fake_AttachWith_Call{Call: _e.mock.On("AttachWith",
+		append([]interface{}{}, attachments...)...)}
+}
+
+func (_c *Fake_AttachWith_Call) Run(run func(attachments ...mail.Attachment)) *Fake_AttachWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]mail.Attachment, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(mail.Attachment)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_AttachWith_Call) Return(_a0 mail.Mail) *Fake_AttachWith_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AttachWith_Call) RunAndReturn(run func(...mail.Attachment) mail.Mail) *Fake_AttachWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Bcc provides a mock function with given fields: addresses
+func (_m *Fake) Bcc(addresses []string) mail.Mail {
+	ret := _m.Called(addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bcc")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func([]string) mail.Mail); ok {
+		r0 = rf(addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Bcc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bcc'
+type Fake_Bcc_Call struct {
+	*mock.Call
+}
+
+// Bcc is a helper method to define mock.On call
+//   - addresses []string
+func (_e *Fake_Expecter) Bcc(addresses interface{}) *Fake_Bcc_Call {
+	return &Fake_Bcc_Call{Call: _e.mock.On("Bcc", addresses)}
+}
+
+func (_c *Fake_Bcc_Call) Run(run func(addresses []string)) *Fake_Bcc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *Fake_Bcc_Call) Return(_a0 mail.Mail) *Fake_Bcc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Bcc_Call) RunAndReturn(run func([]string) mail.Mail) *Fake_Bcc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cc provides a mock function with given fields: addresses
+func (_m *Fake) Cc(addresses []string) mail.Mail {
+	ret := _m.Called(addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cc")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func([]string) mail.Mail); ok {
+		r0 = rf(addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Cc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cc'
+type Fake_Cc_Call struct {
+	*mock.Call
+}
+
+// Cc is a helper method to define mock.On call
+//   - addresses []string
+func (_e *Fake_Expecter) Cc(addresses interface{}) *Fake_Cc_Call {
+	return &Fake_Cc_Call{Call: _e.mock.On("Cc", addresses)}
+}
+
+func (_c *Fake_Cc_Call) Run(run func(addresses []string)) *Fake_Cc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *Fake_Cc_Call) Return(_a0 mail.Mail) *Fake_Cc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Cc_Call) RunAndReturn(run func([]string) mail.Mail) *Fake_Cc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Content provides a mock function with given fields: content
+func (_m *Fake) Content(content mail.Content) mail.Mail {
+	ret := _m.Called(content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Content")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(mail.Content) mail.Mail); ok {
+		r0 = rf(content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Content_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Content'
+type Fake_Content_Call struct {
+	*mock.Call
+}
+
+// Content is a helper method to define mock.On call
+//   - content mail.Content
+func (_e *Fake_Expecter) Content(content interface{}) *Fake_Content_Call {
+	return &Fake_Content_Call{Call: _e.mock.On("Content", content)}
+}
+
+func (_c *Fake_Content_Call) Run(run func(content mail.Content)) *Fake_Content_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(mail.Content))
+	})
+	return _c
+}
+
+func (_c *Fake_Content_Call) Return(_a0 mail.Mail) *Fake_Content_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Content_Call) RunAndReturn(run func(mail.Content) mail.Mail) *Fake_Content_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fake provides a mock function with no fields
+func (_m *Fake) Fake() mail.Fake {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fake")
+	}
+
+	var r0 mail.Fake
+	if rf, ok := ret.Get(0).(func() mail.Fake); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Fake)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Fake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fake'
+type Fake_Fake_Call struct {
+	*mock.Call
+}
+
+// Fake is a helper method to define mock.On call
+func (_e *Fake_Expecter) Fake() *Fake_Fake_Call {
+	return &Fake_Fake_Call{Call: _e.mock.On("Fake")}
+}
+
+func (_c *Fake_Fake_Call) Run(run func()) *Fake_Fake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Fake_Call) Return(_a0 mail.Fake) *Fake_Fake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Fake_Call) RunAndReturn(run func() mail.Fake) *Fake_Fake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// From provides a mock function with given fields: address
+func (_m *Fake) From(address mail.Address) mail.Mail {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for From")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(mail.Address) mail.Mail); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_From_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'From'
+type Fake_From_Call struct {
+	*mock.Call
+}
+
+// From is a helper method to define mock.On call
+//   - address mail.Address
+func (_e *Fake_Expecter) From(address interface{}) *Fake_From_Call {
+	return &Fake_From_Call{Call: _e.mock.On("From", address)}
+}
+
+func (_c *Fake_From_Call) Run(run func(address mail.Address)) *Fake_From_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(mail.Address))
+	})
+	return _c
+}
+
+func (_c *Fake_From_Call) Return(_a0 mail.Mail) *Fake_From_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_From_Call) RunAndReturn(run func(mail.Address) mail.Mail) *Fake_From_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Headers provides a mock function with given fields: headers
+func (_m *Fake) Headers(headers map[string]string) mail.Mail {
+	ret := _m.Called(headers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Headers")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(map[string]string) mail.Mail); ok {
+		r0 = rf(headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Headers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Headers'
+type Fake_Headers_Call struct {
+	*mock.Call
+}
+
+// Headers is a helper method to define mock.On call
+//   - headers map[string]string
+func (_e *Fake_Expecter) Headers(headers interface{}) *Fake_Headers_Call {
+	return &Fake_Headers_Call{Call: _e.mock.On("Headers", headers)}
+}
+
+func (_c *Fake_Headers_Call) Run(run func(headers map[string]string)) *Fake_Headers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *Fake_Headers_Call) Return(_a0 mail.Mail) *Fake_Headers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Headers_Call) RunAndReturn(run func(map[string]string) mail.Mail) *Fake_Headers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mailer provides a mock function with given fields: name
+func (_m *Fake) Mailer(name string) mail.Mail {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mailer")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(string) mail.Mail); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Mailer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mailer'
+type Fake_Mailer_Call struct {
+	*mock.Call
+}
+
+// Mailer is a helper method to define mock.On call
+//   - name string
+func (_e *Fake_Expecter) Mailer(name interface{}) *Fake_Mailer_Call {
+	return &Fake_Mailer_Call{Call: _e.mock.On("Mailer", name)}
+}
+
+func (_c *Fake_Mailer_Call) Run(run func(name string)) *Fake_Mailer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_Mailer_Call) Return(_a0 mail.Mail) *Fake_Mailer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Mailer_Call) RunAndReturn(run func(string) mail.Mail) *Fake_Mailer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Queue provides a mock function with given fields: mailable
+func (_m *Fake) Queue(mailable ...mail.Mailable) error {
+	_va := make([]interface{}, len(mailable))
+	for _i := range mailable {
+		_va[_i] = mailable[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...mail.Mailable) error); ok {
+		r0 = rf(mailable...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Queue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queue'
+type Fake_Queue_Call struct {
+	*mock.Call
+}
+
+// Queue is a helper method to define mock.On call
+//   - mailable ...mail.Mailable
+func (_e *Fake_Expecter) Queue(mailable ...interface{}) *Fake_Queue_Call {
+	return &Fake_Queue_Call{Call: _e.mock.On("Queue",
+		append([]interface{}{}, mailable...)...)}
+}
+
+func (_c *Fake_Queue_Call) Run(run func(mailable ...mail.Mailable)) *Fake_Queue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]mail.Mailable, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(mail.Mailable)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Queue_Call) Return(_a0 error) *Fake_Queue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Queue_Call) RunAndReturn(run func(...mail.Mailable) error) *Fake_Queue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Queued provides a mock function with no fields
+func (_m *Fake) Queued() []mail.Mailable {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queued")
+	}
+
+	var r0 []mail.Mailable
+	if rf, ok := ret.Get(0).(func() []mail.Mailable); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mail.Mailable)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Queued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queued'
+type Fake_Queued_Call struct {
+	*mock.Call
+}
+
+// Queued is a helper method to define mock.On call
+func (_e *Fake_Expecter) Queued() *Fake_Queued_Call {
+	return &Fake_Queued_Call{Call: _e.mock.On("Queued")}
+}
+
+func (_c *Fake_Queued_Call) Run(run func()) *Fake_Queued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Queued_Call) Return(_a0 []mail.Mailable) *Fake_Queued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Queued_Call) RunAndReturn(run func() []mail.Mailable) *Fake_Queued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *Fake) Reset() {
+	_m.Called()
+}
+
+// Fake_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type Fake_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *Fake_Expecter) Reset() *Fake_Reset_Call {
+	return &Fake_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *Fake_Reset_Call) Run(run func()) *Fake_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Reset_Call) Return() *Fake_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Reset_Call) RunAndReturn(run func()) *Fake_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: mailable
+func (_m *Fake) Send(mailable ...mail.Mailable) error {
+	_va := make([]interface{}, len(mailable))
+	for _i := range mailable {
+		_va[_i] = mailable[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...mail.Mailable) error); ok {
+		r0 = rf(mailable...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Fake_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - mailable ...mail.Mailable
+func (_e *Fake_Expecter) Send(mailable ...interface{}) *Fake_Send_Call {
+	return &Fake_Send_Call{Call: _e.mock.On("Send",
+		append([]interface{}{}, mailable...)...)}
+}
+
+func (_c *Fake_Send_Call) Run(run func(mailable ...mail.Mailable)) *Fake_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]mail.Mailable, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(mail.Mailable)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Send_Call) Return(_a0 error) *Fake_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Send_Call) RunAndReturn(run func(...mail.Mailable) error) *Fake_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sent provides a mock function with no fields
+func (_m *Fake) Sent() []mail.Mailable {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sent")
+	}
+
+	var r0 []mail.Mailable
+	if rf, ok := ret.Get(0).(func() []mail.Mailable); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mail.Mailable)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Sent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sent'
+type Fake_Sent_Call struct {
+	*mock.Call
+}
+
+// Sent is a helper method to define mock.On call
+func (_e *Fake_Expecter) Sent() *Fake_Sent_Call {
+	return &Fake_Sent_Call{Call: _e.mock.On("Sent")}
+}
+
+func (_c *Fake_Sent_Call) Run(run func()) *Fake_Sent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Sent_Call) Return(_a0 []mail.Mailable) *Fake_Sent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Sent_Call) RunAndReturn(run func() []mail.Mailable) *Fake_Sent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subject provides a mock function with given fields: subject
+func (_m *Fake) Subject(subject string) mail.Mail {
+	ret := _m.Called(subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subject")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(string) mail.Mail); ok {
+		r0 = rf(subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Subject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subject'
+type Fake_Subject_Call struct {
+	*mock.Call
+}
+
+// Subject is a helper method to define mock.On call
+//   - subject string
+func (_e *Fake_Expecter) Subject(subject interface{}) *Fake_Subject_Call {
+	return &Fake_Subject_Call{Call: _e.mock.On("Subject", subject)}
+}
+
+func (_c *Fake_Subject_Call) Run(run func(subject string)) *Fake_Subject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_Subject_Call) Return(_a0 mail.Mail) *Fake_Subject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Subject_Call) RunAndReturn(run func(string) mail.Mail) *Fake_Subject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// To provides a mock function with given fields: addresses
+func (_m *Fake) To(addresses []string) mail.Mail {
+	ret := _m.Called(addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for To")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func([]string) mail.Mail); ok {
+		r0 = rf(addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Fake_To_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'To'
+type Fake_To_Call struct {
+	*mock.Call
+}
+
+// To is a helper method to define mock.On call
+//   - addresses []string
+func (_e *Fake_Expecter) To(addresses interface{}) *Fake_To_Call {
+	return &Fake_To_Call{Call: _e.mock.On("To", addresses)}
+}
+
+func (_c *Fake_To_Call) Run(run func(addresses []string)) *Fake_To_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *Fake_To_Call) Return(_a0 mail.Mail) *Fake_To_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_To_Call) RunAndReturn(run func([]string) mail.Mail) *Fake_To_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFake creates a new instance of Fake. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFake(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Fake {
+	mock := &Fake{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
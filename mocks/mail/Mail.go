@@ -68,6 +68,67 @@ func (_c *Mail_Attach_Call) RunAndReturn(run func([]string) mail.Mail) *Mail_Att
 	return _c
 }
 
+// AttachWith provides a mock function with given fields: attachments
+func (_m *Mail) AttachWith(attachments ...mail.Attachment) mail.Mail {
+	_va := make([]interface{}, len(attachments))
+	for _i := range attachments {
+		_va[_i] = attachments[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachWith")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(...mail.Attachment) mail.Mail); ok {
+		r0 = rf(attachments...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Mail_AttachWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachWith'
+type Mail_AttachWith_Call struct {
+	*mock.Call
+}
+
+// AttachWith is a helper method to define mock.On call
+//   - attachments ...mail.Attachment
+func (_e *Mail_Expecter) AttachWith(attachments ...interface{}) *Mail_AttachWith_Call {
+	return &Mail_AttachWith_Call{Call: _e.mock.On("AttachWith",
+		append([]interface{}{}, attachments...)...)}
+}
+
+func (_c *Mail_AttachWith_Call) Run(run func(attachments ...mail.Attachment)) *Mail_AttachWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]mail.Attachment, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(mail.Attachment)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Mail_AttachWith_Call) Return(_a0 mail.Mail) *Mail_AttachWith_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mail_AttachWith_Call) RunAndReturn(run func(...mail.Attachment) mail.Mail) *Mail_AttachWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Bcc provides a mock function with given fields: addresses
 func (_m *Mail) Bcc(addresses []string) mail.Mail {
 	ret := _m.Called(addresses)
@@ -212,6 +273,53 @@ func (_c *Mail_Content_Call) RunAndReturn(run func(mail.Content) mail.Mail) *Mai
 	return _c
 }
 
+// Fake provides a mock function with no fields
+func (_m *Mail) Fake() mail.Fake {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fake")
+	}
+
+	var r0 mail.Fake
+	if rf, ok := ret.Get(0).(func() mail.Fake); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Fake)
+		}
+	}
+
+	return r0
+}
+
+// Mail_Fake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fake'
+type Mail_Fake_Call struct {
+	*mock.Call
+}
+
+// Fake is a helper method to define mock.On call
+func (_e *Mail_Expecter) Fake() *Mail_Fake_Call {
+	return &Mail_Fake_Call{Call: _e.mock.On("Fake")}
+}
+
+func (_c *Mail_Fake_Call) Run(run func()) *Mail_Fake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Mail_Fake_Call) Return(_a0 mail.Fake) *Mail_Fake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mail_Fake_Call) RunAndReturn(run func() mail.Fake) *Mail_Fake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // From provides a mock function with given fields: address
 func (_m *Mail) From(address mail.Address) mail.Mail {
 	ret := _m.Called(address)
@@ -308,6 +416,54 @@ func (_c *Mail_Headers_Call) RunAndReturn(run func(map[string]string) mail.Mail)
 	return _c
 }
 
+// Mailer provides a mock function with given fields: name
+func (_m *Mail) Mailer(name string) mail.Mail {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mailer")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(string) mail.Mail); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Mail_Mailer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mailer'
+type Mail_Mailer_Call struct {
+	*mock.Call
+}
+
+// Mailer is a helper method to define mock.On call
+//   - name string
+func (_e *Mail_Expecter) Mailer(name interface{}) *Mail_Mailer_Call {
+	return &Mail_Mailer_Call{Call: _e.mock.On("Mailer", name)}
+}
+
+func (_c *Mail_Mailer_Call) Run(run func(name string)) *Mail_Mailer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Mail_Mailer_Call) Return(_a0 mail.Mail) *Mail_Mailer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mail_Mailer_Call) RunAndReturn(run func(string) mail.Mail) *Mail_Mailer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Queue provides a mock function with given fields: mailable
 func (_m *Mail) Queue(mailable ...mail.Mailable) error {
 	_va := make([]interface{}, len(mailable))
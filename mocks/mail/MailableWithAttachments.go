@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mail
+
+import (
+	mail "github.com/goravel/framework/contracts/mail"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MailableWithAttachments is an autogenerated mock type for the MailableWithAttachments type
+type MailableWithAttachments struct {
+	mock.Mock
+}
+
+type MailableWithAttachments_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MailableWithAttachments) EXPECT() *MailableWithAttachments_Expecter {
+	return &MailableWithAttachments_Expecter{mock: &_m.Mock}
+}
+
+// Attachables provides a mock function with no fields
+func (_m *MailableWithAttachments) Attachables() []mail.Attachment {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachables")
+	}
+
+	var r0 []mail.Attachment
+	if rf, ok := ret.Get(0).(func() []mail.Attachment); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mail.Attachment)
+		}
+	}
+
+	return r0
+}
+
+// MailableWithAttachments_Attachables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachables'
+type MailableWithAttachments_Attachables_Call struct {
+	*mock.Call
+}
+
+// Attachables is a helper method to define mock.On call
+func (_e *MailableWithAttachments_Expecter) Attachables() *MailableWithAttachments_Attachables_Call {
+	return &MailableWithAttachments_Attachables_Call{Call: _e.mock.On("Attachables")}
+}
+
+func (_c *MailableWithAttachments_Attachables_Call) Run(run func()) *MailableWithAttachments_Attachables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MailableWithAttachments_Attachables_Call) Return(_a0 []mail.Attachment) *MailableWithAttachments_Attachables_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MailableWithAttachments_Attachables_Call) RunAndReturn(run func() []mail.Attachment) *MailableWithAttachments_Attachables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMailableWithAttachments creates a new instance of MailableWithAttachments. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMailableWithAttachments(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MailableWithAttachments {
+	mock := &MailableWithAttachments{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
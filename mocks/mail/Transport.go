@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mail
+
+import (
+	mail "github.com/goravel/framework/contracts/mail"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Transport is an autogenerated mock type for the Transport type
+type Transport struct {
+	mock.Mock
+}
+
+type Transport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Transport) EXPECT() *Transport_Expecter {
+	return &Transport_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: message
+func (_m *Transport) Send(message *mail.Message) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mail.Message) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Transport_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Transport_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - message *mail.Message
+func (_e *Transport_Expecter) Send(message interface{}) *Transport_Send_Call {
+	return &Transport_Send_Call{Call: _e.mock.On("Send", message)}
+}
+
+func (_c *Transport_Send_Call) Run(run func(message *mail.Message)) *Transport_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mail.Message))
+	})
+	return _c
+}
+
+func (_c *Transport_Send_Call) Return(_a0 error) *Transport_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transport_Send_Call) RunAndReturn(run func(*mail.Message) error) *Transport_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransport creates a new instance of Transport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transport {
+	mock := &Transport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package notification
+
+import (
+	notification "github.com/goravel/framework/contracts/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BroadcastNotification is an autogenerated mock type for the BroadcastNotification type
+type BroadcastNotification struct {
+	mock.Mock
+}
+
+type BroadcastNotification_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BroadcastNotification) EXPECT() *BroadcastNotification_Expecter {
+	return &BroadcastNotification_Expecter{mock: &_m.Mock}
+}
+
+// ToBroadcast provides a mock function with given fields: notifiable
+func (_m *BroadcastNotification) ToBroadcast(notifiable notification.Notifiable) notification.BroadcastMessage {
+	ret := _m.Called(notifiable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToBroadcast")
+	}
+
+	var r0 notification.BroadcastMessage
+	if rf, ok := ret.Get(0).(func(notification.Notifiable) notification.BroadcastMessage); ok {
+		r0 = rf(notifiable)
+	} else {
+		r0 = ret.Get(0).(notification.BroadcastMessage)
+	}
+
+	return r0
+}
+
+// BroadcastNotification_ToBroadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToBroadcast'
+type BroadcastNotification_ToBroadcast_Call struct {
+	*mock.Call
+}
+
+// ToBroadcast is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+func (_e *BroadcastNotification_Expecter) ToBroadcast(notifiable interface{}) *BroadcastNotification_ToBroadcast_Call {
+	return &BroadcastNotification_ToBroadcast_Call{Call: _e.mock.On("ToBroadcast", notifiable)}
+}
+
+func (_c *BroadcastNotification_ToBroadcast_Call) Run(run func(notifiable notification.Notifiable)) *BroadcastNotification_ToBroadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable))
+	})
+	return _c
+}
+
+func (_c *BroadcastNotification_ToBroadcast_Call) Return(_a0 notification.BroadcastMessage) *BroadcastNotification_ToBroadcast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BroadcastNotification_ToBroadcast_Call) RunAndReturn(run func(notification.Notifiable) notification.BroadcastMessage) *BroadcastNotification_ToBroadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Via provides a mock function with given fields: notifiable
+func (_m *BroadcastNotification) Via(notifiable notification.Notifiable) []string {
+	ret := _m.Called(notifiable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Via")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(notification.Notifiable) []string); ok {
+		r0 = rf(notifiable)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// BroadcastNotification_Via_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Via'
+type BroadcastNotification_Via_Call struct {
+	*mock.Call
+}
+
+// Via is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+func (_e *BroadcastNotification_Expecter) Via(notifiable interface{}) *BroadcastNotification_Via_Call {
+	return &BroadcastNotification_Via_Call{Call: _e.mock.On("Via", notifiable)}
+}
+
+func (_c *BroadcastNotification_Via_Call) Run(run func(notifiable notification.Notifiable)) *BroadcastNotification_Via_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable))
+	})
+	return _c
+}
+
+func (_c *BroadcastNotification_Via_Call) Return(_a0 []string) *BroadcastNotification_Via_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BroadcastNotification_Via_Call) RunAndReturn(run func(notification.Notifiable) []string) *BroadcastNotification_Via_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBroadcastNotification creates a new instance of BroadcastNotification. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroadcastNotification(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BroadcastNotification {
+	mock := &BroadcastNotification{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package notification
+
+import (
+	notification "github.com/goravel/framework/contracts/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BroadcastRoutable is an autogenerated mock type for the BroadcastRoutable type
+type BroadcastRoutable struct {
+	mock.Mock
+}
+
+type BroadcastRoutable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BroadcastRoutable) EXPECT() *BroadcastRoutable_Expecter {
+	return &BroadcastRoutable_Expecter{mock: &_m.Mock}
+}
+
+// RouteNotificationForBroadcast provides a mock function with given fields: _a0
+func (_m *BroadcastRoutable) RouteNotificationForBroadcast(_a0 notification.Notification) []string {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteNotificationForBroadcast")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(notification.Notification) []string); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// BroadcastRoutable_RouteNotificationForBroadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteNotificationForBroadcast'
+type BroadcastRoutable_RouteNotificationForBroadcast_Call struct {
+	*mock.Call
+}
+
+// RouteNotificationForBroadcast is a helper method to define mock.On call
+//   - _a0 notification.Notification
+func (_e *BroadcastRoutable_Expecter) RouteNotificationForBroadcast(_a0 interface{}) *BroadcastRoutable_RouteNotificationForBroadcast_Call {
+	return &BroadcastRoutable_RouteNotificationForBroadcast_Call{Call: _e.mock.On("RouteNotificationForBroadcast", _a0)}
+}
+
+func (_c *BroadcastRoutable_RouteNotificationForBroadcast_Call) Run(run func(_a0 notification.Notification)) *BroadcastRoutable_RouteNotificationForBroadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notification))
+	})
+	return _c
+}
+
+func (_c *BroadcastRoutable_RouteNotificationForBroadcast_Call) Return(_a0 []string) *BroadcastRoutable_RouteNotificationForBroadcast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BroadcastRoutable_RouteNotificationForBroadcast_Call) RunAndReturn(run func(notification.Notification) []string) *BroadcastRoutable_RouteNotificationForBroadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBroadcastRoutable creates a new instance of BroadcastRoutable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroadcastRoutable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BroadcastRoutable {
+	mock := &BroadcastRoutable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
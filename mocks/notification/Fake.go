@@ -0,0 +1,602 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package notification
+
+import (
+	notification "github.com/goravel/framework/contracts/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Fake is an autogenerated mock type for the Fake type
+type Fake struct {
+	mock.Mock
+}
+
+type Fake_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Fake) EXPECT() *Fake_Expecter {
+	return &Fake_Expecter{mock: &_m.Mock}
+}
+
+// AssertCount provides a mock function with given fields: count
+func (_m *Fake) AssertCount(count int) bool {
+	ret := _m.Called(count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertCount")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(count)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertCount'
+type Fake_AssertCount_Call struct {
+	*mock.Call
+}
+
+// AssertCount is a helper method to define mock.On call
+//   - count int
+func (_e *Fake_Expecter) AssertCount(count interface{}) *Fake_AssertCount_Call {
+	return &Fake_AssertCount_Call{Call: _e.mock.On("AssertCount", count)}
+}
+
+func (_c *Fake_AssertCount_Call) Run(run func(count int)) *Fake_AssertCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertCount_Call) Return(_a0 bool) *Fake_AssertCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertCount_Call) RunAndReturn(run func(int) bool) *Fake_AssertCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNotSentTo provides a mock function with given fields: notifiable, _a1, assertion
+func (_m *Fake) AssertNotSentTo(notifiable notification.Notifiable, _a1 notification.Notification, assertion ...func(notification.Notification, []string) bool) bool {
+	_va := make([]interface{}, len(assertion))
+	for _i := range assertion {
+		_va[_i] = assertion[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, notifiable, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNotSentTo")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(notification.Notifiable, notification.Notification, ...func(notification.Notification, []string) bool) bool); ok {
+		r0 = rf(notifiable, _a1, assertion...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNotSentTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNotSentTo'
+type Fake_AssertNotSentTo_Call struct {
+	*mock.Call
+}
+
+// AssertNotSentTo is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+//   - _a1 notification.Notification
+//   - assertion ...func(notification.Notification , []string) bool
+func (_e *Fake_Expecter) AssertNotSentTo(notifiable interface{}, _a1 interface{}, assertion ...interface{}) *Fake_AssertNotSentTo_Call {
+	return &Fake_AssertNotSentTo_Call{Call: _e.mock.On("AssertNotSentTo",
+		append([]interface{}{notifiable, _a1}, assertion...)...)}
+}
+
+func (_c *Fake_AssertNotSentTo_Call) Run(run func(notifiable notification.Notifiable, _a1 notification.Notification, assertion ...func(notification.Notification, []string) bool)) *Fake_AssertNotSentTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(notification.Notification, []string) bool, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(notification.Notification, []string) bool)
+			}
+		}
+		run(args[0].(notification.Notifiable), args[1].(notification.Notification), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNotSentTo_Call) Return(_a0 bool) *Fake_AssertNotSentTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNotSentTo_Call) RunAndReturn(run func(notification.Notifiable, notification.Notification, ...func(notification.Notification, []string) bool) bool) *Fake_AssertNotSentTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNothingSent provides a mock function with no fields
+func (_m *Fake) AssertNothingSent() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNothingSent")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNothingSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNothingSent'
+type Fake_AssertNothingSent_Call struct {
+	*mock.Call
+}
+
+// AssertNothingSent is a helper method to define mock.On call
+func (_e *Fake_Expecter) AssertNothingSent() *Fake_AssertNothingSent_Call {
+	return &Fake_AssertNothingSent_Call{Call: _e.mock.On("AssertNothingSent")}
+}
+
+func (_c *Fake_AssertNothingSent_Call) Run(run func()) *Fake_AssertNothingSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNothingSent_Call) Return(_a0 bool) *Fake_AssertNothingSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNothingSent_Call) RunAndReturn(run func() bool) *Fake_AssertNothingSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertSentTo provides a mock function with given fields: notifiable, _a1, assertion
+func (_m *Fake) AssertSentTo(notifiable notification.Notifiable, _a1 notification.Notification, assertion ...func(notification.Notification, []string) bool) bool {
+	_va := make([]interface{}, len(assertion))
+	for _i := range assertion {
+		_va[_i] = assertion[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, notifiable, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertSentTo")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(notification.Notifiable, notification.Notification, ...func(notification.Notification, []string) bool) bool); ok {
+		r0 = rf(notifiable, _a1, assertion...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertSentTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertSentTo'
+type Fake_AssertSentTo_Call struct {
+	*mock.Call
+}
+
+// AssertSentTo is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+//   - _a1 notification.Notification
+//   - assertion ...func(notification.Notification , []string) bool
+func (_e *Fake_Expecter) AssertSentTo(notifiable interface{}, _a1 interface{}, assertion ...interface{}) *Fake_AssertSentTo_Call {
+	return &Fake_AssertSentTo_Call{Call: _e.mock.On("AssertSentTo",
+		append([]interface{}{notifiable, _a1}, assertion...)...)}
+}
+
+func (_c *Fake_AssertSentTo_Call) Run(run func(notifiable notification.Notifiable, _a1 notification.Notification, assertion ...func(notification.Notification, []string) bool)) *Fake_AssertSentTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(notification.Notification, []string) bool, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(notification.Notification, []string) bool)
+			}
+		}
+		run(args[0].(notification.Notifiable), args[1].(notification.Notification), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_AssertSentTo_Call) Return(_a0 bool) *Fake_AssertSentTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertSentTo_Call) RunAndReturn(run func(notification.Notifiable, notification.Notification, ...func(notification.Notification, []string) bool) bool) *Fake_AssertSentTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Channel provides a mock function with given fields: name
+func (_m *Fake) Channel(name string) notification.Channel {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Channel")
+	}
+
+	var r0 notification.Channel
+	if rf, ok := ret.Get(0).(func(string) notification.Channel); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.Channel)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Channel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Channel'
+type Fake_Channel_Call struct {
+	*mock.Call
+}
+
+// Channel is a helper method to define mock.On call
+//   - name string
+func (_e *Fake_Expecter) Channel(name interface{}) *Fake_Channel_Call {
+	return &Fake_Channel_Call{Call: _e.mock.On("Channel", name)}
+}
+
+func (_c *Fake_Channel_Call) Run(run func(name string)) *Fake_Channel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_Channel_Call) Return(_a0 notification.Channel) *Fake_Channel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Channel_Call) RunAndReturn(run func(string) notification.Channel) *Fake_Channel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Extend provides a mock function with given fields: channel
+func (_m *Fake) Extend(channel notification.Channel) {
+	_m.Called(channel)
+}
+
+// Fake_Extend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Extend'
+type Fake_Extend_Call struct {
+	*mock.Call
+}
+
+// Extend is a helper method to define mock.On call
+//   - channel notification.Channel
+func (_e *Fake_Expecter) Extend(channel interface{}) *Fake_Extend_Call {
+	return &Fake_Extend_Call{Call: _e.mock.On("Extend", channel)}
+}
+
+func (_c *Fake_Extend_Call) Run(run func(channel notification.Channel)) *Fake_Extend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Channel))
+	})
+	return _c
+}
+
+func (_c *Fake_Extend_Call) Return() *Fake_Extend_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Extend_Call) RunAndReturn(run func(notification.Channel)) *Fake_Extend_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Fake provides a mock function with no fields
+func (_m *Fake) Fake() notification.Fake {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fake")
+	}
+
+	var r0 notification.Fake
+	if rf, ok := ret.Get(0).(func() notification.Fake); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.Fake)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Fake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fake'
+type Fake_Fake_Call struct {
+	*mock.Call
+}
+
+// Fake is a helper method to define mock.On call
+func (_e *Fake_Expecter) Fake() *Fake_Fake_Call {
+	return &Fake_Fake_Call{Call: _e.mock.On("Fake")}
+}
+
+func (_c *Fake_Fake_Call) Run(run func()) *Fake_Fake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Fake_Call) Return(_a0 notification.Fake) *Fake_Fake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Fake_Call) RunAndReturn(run func() notification.Fake) *Fake_Fake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *Fake) Reset() {
+	_m.Called()
+}
+
+// Fake_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type Fake_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *Fake_Expecter) Reset() *Fake_Reset_Call {
+	return &Fake_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *Fake_Reset_Call) Run(run func()) *Fake_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_Reset_Call) Return() *Fake_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Reset_Call) RunAndReturn(run func()) *Fake_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Route provides a mock function with given fields: channel, route
+func (_m *Fake) Route(channel string, route interface{}) notification.OnDemandNotifiable {
+	ret := _m.Called(channel, route)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Route")
+	}
+
+	var r0 notification.OnDemandNotifiable
+	if rf, ok := ret.Get(0).(func(string, interface{}) notification.OnDemandNotifiable); ok {
+		r0 = rf(channel, route)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.OnDemandNotifiable)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Route_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Route'
+type Fake_Route_Call struct {
+	*mock.Call
+}
+
+// Route is a helper method to define mock.On call
+//   - channel string
+//   - route interface{}
+func (_e *Fake_Expecter) Route(channel interface{}, route interface{}) *Fake_Route_Call {
+	return &Fake_Route_Call{Call: _e.mock.On("Route", channel, route)}
+}
+
+func (_c *Fake_Route_Call) Run(run func(channel string, route interface{})) *Fake_Route_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Fake_Route_Call) Return(_a0 notification.OnDemandNotifiable) *Fake_Route_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Route_Call) RunAndReturn(run func(string, interface{}) notification.OnDemandNotifiable) *Fake_Route_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: notifiable, _a1
+func (_m *Fake) Send(notifiable notification.Notifiable, _a1 notification.Notification) error {
+	ret := _m.Called(notifiable, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notification.Notifiable, notification.Notification) error); ok {
+		r0 = rf(notifiable, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Fake_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+//   - _a1 notification.Notification
+func (_e *Fake_Expecter) Send(notifiable interface{}, _a1 interface{}) *Fake_Send_Call {
+	return &Fake_Send_Call{Call: _e.mock.On("Send", notifiable, _a1)}
+}
+
+func (_c *Fake_Send_Call) Run(run func(notifiable notification.Notifiable, _a1 notification.Notification)) *Fake_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable), args[1].(notification.Notification))
+	})
+	return _c
+}
+
+func (_c *Fake_Send_Call) Return(_a0 error) *Fake_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Send_Call) RunAndReturn(run func(notification.Notifiable, notification.Notification) error) *Fake_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendNow provides a mock function with given fields: notifiable, _a1
+func (_m *Fake) SendNow(notifiable notification.Notifiable, _a1 notification.Notification) error {
+	ret := _m.Called(notifiable, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendNow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notification.Notifiable, notification.Notification) error); ok {
+		r0 = rf(notifiable, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_SendNow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendNow'
+type Fake_SendNow_Call struct {
+	*mock.Call
+}
+
+// SendNow is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+//   - _a1 notification.Notification
+func (_e *Fake_Expecter) SendNow(notifiable interface{}, _a1 interface{}) *Fake_SendNow_Call {
+	return &Fake_SendNow_Call{Call: _e.mock.On("SendNow", notifiable, _a1)}
+}
+
+func (_c *Fake_SendNow_Call) Run(run func(notifiable notification.Notifiable, _a1 notification.Notification)) *Fake_SendNow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable), args[1].(notification.Notification))
+	})
+	return _c
+}
+
+func (_c *Fake_SendNow_Call) Return(_a0 error) *Fake_SendNow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_SendNow_Call) RunAndReturn(run func(notification.Notifiable, notification.Notification) error) *Fake_SendNow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sent provides a mock function with given fields: notifiable, _a1
+func (_m *Fake) Sent(notifiable notification.Notifiable, _a1 notification.Notification) []notification.Notification {
+	ret := _m.Called(notifiable, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sent")
+	}
+
+	var r0 []notification.Notification
+	if rf, ok := ret.Get(0).(func(notification.Notifiable, notification.Notification) []notification.Notification); ok {
+		r0 = rf(notifiable, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notification.Notification)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Sent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sent'
+type Fake_Sent_Call struct {
+	*mock.Call
+}
+
+// Sent is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+//   - _a1 notification.Notification
+func (_e *Fake_Expecter) Sent(notifiable interface{}, _a1 interface{}) *Fake_Sent_Call {
+	return &Fake_Sent_Call{Call: _e.mock.On("Sent", notifiable, _a1)}
+}
+
+func (_c *Fake_Sent_Call) Run(run func(notifiable notification.Notifiable, _a1 notification.Notification)) *Fake_Sent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable), args[1].(notification.Notification))
+	})
+	return _c
+}
+
+func (_c *Fake_Sent_Call) Return(_a0 []notification.Notification) *Fake_Sent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Sent_Call) RunAndReturn(run func(notification.Notifiable, notification.Notification) []notification.Notification) *Fake_Sent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFake creates a new instance of Fake. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFake(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Fake {
+	mock := &Fake{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
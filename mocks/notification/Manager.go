@@ -101,6 +101,53 @@ func (_c *Manager_Extend_Call) RunAndReturn(run func(notification.Channel)) *Man
 	return _c
 }
 
+// Fake provides a mock function with no fields
+func (_m *Manager) Fake() notification.Fake {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fake")
+	}
+
+	var r0 notification.Fake
+	if rf, ok := ret.Get(0).(func() notification.Fake); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.Fake)
+		}
+	}
+
+	return r0
+}
+
+// Manager_Fake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fake'
+type Manager_Fake_Call struct {
+	*mock.Call
+}
+
+// Fake is a helper method to define mock.On call
+func (_e *Manager_Expecter) Fake() *Manager_Fake_Call {
+	return &Manager_Fake_Call{Call: _e.mock.On("Fake")}
+}
+
+func (_c *Manager_Fake_Call) Run(run func()) *Manager_Fake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Manager_Fake_Call) Return(_a0 notification.Fake) *Manager_Fake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Manager_Fake_Call) RunAndReturn(run func() notification.Fake) *Manager_Fake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Route provides a mock function with given fields: channel, route
 func (_m *Manager) Route(channel string, route interface{}) notification.OnDemandNotifiable {
 	ret := _m.Called(channel, route)
@@ -0,0 +1,129 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package notification
+
+import (
+	notification "github.com/goravel/framework/contracts/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookNotification is an autogenerated mock type for the WebhookNotification type
+type WebhookNotification struct {
+	mock.Mock
+}
+
+type WebhookNotification_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookNotification) EXPECT() *WebhookNotification_Expecter {
+	return &WebhookNotification_Expecter{mock: &_m.Mock}
+}
+
+// ToWebhook provides a mock function with given fields: notifiable
+func (_m *WebhookNotification) ToWebhook(notifiable notification.Notifiable) notification.WebhookMessage {
+	ret := _m.Called(notifiable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToWebhook")
+	}
+
+	var r0 notification.WebhookMessage
+	if rf, ok := ret.Get(0).(func(notification.Notifiable) notification.WebhookMessage); ok {
+		r0 = rf(notifiable)
+	} else {
+		r0 = ret.Get(0).(notification.WebhookMessage)
+	}
+
+	return r0
+}
+
+// WebhookNotification_ToWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToWebhook'
+type WebhookNotification_ToWebhook_Call struct {
+	*mock.Call
+}
+
+// ToWebhook is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+func (_e *WebhookNotification_Expecter) ToWebhook(notifiable interface{}) *WebhookNotification_ToWebhook_Call {
+	return &WebhookNotification_ToWebhook_Call{Call: _e.mock.On("ToWebhook", notifiable)}
+}
+
+func (_c *WebhookNotification_ToWebhook_Call) Run(run func(notifiable notification.Notifiable)) *WebhookNotification_ToWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable))
+	})
+	return _c
+}
+
+func (_c *WebhookNotification_ToWebhook_Call) Return(_a0 notification.WebhookMessage) *WebhookNotification_ToWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookNotification_ToWebhook_Call) RunAndReturn(run func(notification.Notifiable) notification.WebhookMessage) *WebhookNotification_ToWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Via provides a mock function with given fields: notifiable
+func (_m *WebhookNotification) Via(notifiable notification.Notifiable) []string {
+	ret := _m.Called(notifiable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Via")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(notification.Notifiable) []string); ok {
+		r0 = rf(notifiable)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// WebhookNotification_Via_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Via'
+type WebhookNotification_Via_Call struct {
+	*mock.Call
+}
+
+// Via is a helper method to define mock.On call
+//   - notifiable notification.Notifiable
+func (_e *WebhookNotification_Expecter) Via(notifiable interface{}) *WebhookNotification_Via_Call {
+	return &WebhookNotification_Via_Call{Call: _e.mock.On("Via", notifiable)}
+}
+
+func (_c *WebhookNotification_Via_Call) Run(run func(notifiable notification.Notifiable)) *WebhookNotification_Via_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notifiable))
+	})
+	return _c
+}
+
+func (_c *WebhookNotification_Via_Call) Return(_a0 []string) *WebhookNotification_Via_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookNotification_Via_Call) RunAndReturn(run func(notification.Notifiable) []string) *WebhookNotification_Via_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookNotification creates a new instance of WebhookNotification. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookNotification(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookNotification {
+	mock := &WebhookNotification{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package notification
+
+import (
+	notification "github.com/goravel/framework/contracts/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookRoutable is an autogenerated mock type for the WebhookRoutable type
+type WebhookRoutable struct {
+	mock.Mock
+}
+
+type WebhookRoutable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRoutable) EXPECT() *WebhookRoutable_Expecter {
+	return &WebhookRoutable_Expecter{mock: &_m.Mock}
+}
+
+// RouteNotificationForWebhook provides a mock function with given fields: _a0
+func (_m *WebhookRoutable) RouteNotificationForWebhook(_a0 notification.Notification) string {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteNotificationForWebhook")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notification.Notification) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// WebhookRoutable_RouteNotificationForWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteNotificationForWebhook'
+type WebhookRoutable_RouteNotificationForWebhook_Call struct {
+	*mock.Call
+}
+
+// RouteNotificationForWebhook is a helper method to define mock.On call
+//   - _a0 notification.Notification
+func (_e *WebhookRoutable_Expecter) RouteNotificationForWebhook(_a0 interface{}) *WebhookRoutable_RouteNotificationForWebhook_Call {
+	return &WebhookRoutable_RouteNotificationForWebhook_Call{Call: _e.mock.On("RouteNotificationForWebhook", _a0)}
+}
+
+func (_c *WebhookRoutable_RouteNotificationForWebhook_Call) Run(run func(_a0 notification.Notification)) *WebhookRoutable_RouteNotificationForWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(notification.Notification))
+	})
+	return _c
+}
+
+func (_c *WebhookRoutable_RouteNotificationForWebhook_Call) Return(_a0 string) *WebhookRoutable_RouteNotificationForWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRoutable_RouteNotificationForWebhook_Call) RunAndReturn(run func(notification.Notification) string) *WebhookRoutable_RouteNotificationForWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRoutable creates a new instance of WebhookRoutable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRoutable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRoutable {
+	mock := &WebhookRoutable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
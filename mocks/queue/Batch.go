@@ -0,0 +1,914 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	carbon "github.com/dromara/carbon/v2"
+	mock "github.com/stretchr/testify/mock"
+
+	queue "github.com/goravel/framework/contracts/queue"
+
+	supportcarbon "github.com/goravel/framework/support/carbon"
+)
+
+// Batch is an autogenerated mock type for the Batch type
+type Batch struct {
+	mock.Mock
+}
+
+type Batch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Batch) EXPECT() *Batch_Expecter {
+	return &Batch_Expecter{mock: &_m.Mock}
+}
+
+// AllowsFailures provides a mock function with no fields
+func (_m *Batch) AllowsFailures() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowsFailures")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Batch_AllowsFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllowsFailures'
+type Batch_AllowsFailures_Call struct {
+	*mock.Call
+}
+
+// AllowsFailures is a helper method to define mock.On call
+func (_e *Batch_Expecter) AllowsFailures() *Batch_AllowsFailures_Call {
+	return &Batch_AllowsFailures_Call{Call: _e.mock.On("AllowsFailures")}
+}
+
+func (_c *Batch_AllowsFailures_Call) Run(run func()) *Batch_AllowsFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_AllowsFailures_Call) Return(_a0 bool) *Batch_AllowsFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_AllowsFailures_Call) RunAndReturn(run func() bool) *Batch_AllowsFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function with no fields
+func (_m *Batch) Cancel() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Batch_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type Batch_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+func (_e *Batch_Expecter) Cancel() *Batch_Cancel_Call {
+	return &Batch_Cancel_Call{Call: _e.mock.On("Cancel")}
+}
+
+func (_c *Batch_Cancel_Call) Run(run func()) *Batch_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Cancel_Call) Return(_a0 error) *Batch_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Cancel_Call) RunAndReturn(run func() error) *Batch_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancelled provides a mock function with no fields
+func (_m *Batch) Cancelled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancelled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Batch_Cancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancelled'
+type Batch_Cancelled_Call struct {
+	*mock.Call
+}
+
+// Cancelled is a helper method to define mock.On call
+func (_e *Batch_Expecter) Cancelled() *Batch_Cancelled_Call {
+	return &Batch_Cancelled_Call{Call: _e.mock.On("Cancelled")}
+}
+
+func (_c *Batch_Cancelled_Call) Run(run func()) *Batch_Cancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Cancelled_Call) Return(_a0 bool) *Batch_Cancelled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Cancelled_Call) RunAndReturn(run func() bool) *Batch_Cancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelledAt provides a mock function with no fields
+func (_m *Batch) CancelledAt() *carbon.LayoutType[supportcarbon.DateTimeType] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelledAt")
+	}
+
+	var r0 *carbon.LayoutType[supportcarbon.DateTimeType]
+	if rf, ok := ret.Get(0).(func() *carbon.LayoutType[supportcarbon.DateTimeType]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*carbon.LayoutType[supportcarbon.DateTimeType])
+		}
+	}
+
+	return r0
+}
+
+// Batch_CancelledAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelledAt'
+type Batch_CancelledAt_Call struct {
+	*mock.Call
+}
+
+// CancelledAt is a helper method to define mock.On call
+func (_e *Batch_Expecter) CancelledAt() *Batch_CancelledAt_Call {
+	return &Batch_CancelledAt_Call{Call: _e.mock.On("CancelledAt")}
+}
+
+func (_c *Batch_CancelledAt_Call) Run(run func()) *Batch_CancelledAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_CancelledAt_Call) Return(_a0 *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_CancelledAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_CancelledAt_Call) RunAndReturn(run func() *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_CancelledAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatedAt provides a mock function with no fields
+func (_m *Batch) CreatedAt() *carbon.LayoutType[supportcarbon.DateTimeType] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatedAt")
+	}
+
+	var r0 *carbon.LayoutType[supportcarbon.DateTimeType]
+	if rf, ok := ret.Get(0).(func() *carbon.LayoutType[supportcarbon.DateTimeType]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*carbon.LayoutType[supportcarbon.DateTimeType])
+		}
+	}
+
+	return r0
+}
+
+// Batch_CreatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatedAt'
+type Batch_CreatedAt_Call struct {
+	*mock.Call
+}
+
+// CreatedAt is a helper method to define mock.On call
+func (_e *Batch_Expecter) CreatedAt() *Batch_CreatedAt_Call {
+	return &Batch_CreatedAt_Call{Call: _e.mock.On("CreatedAt")}
+}
+
+func (_c *Batch_CreatedAt_Call) Run(run func()) *Batch_CreatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_CreatedAt_Call) Return(_a0 *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_CreatedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_CreatedAt_Call) RunAndReturn(run func() *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_CreatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with no fields
+func (_m *Batch) Delete() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Batch_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Batch_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+func (_e *Batch_Expecter) Delete() *Batch_Delete_Call {
+	return &Batch_Delete_Call{Call: _e.mock.On("Delete")}
+}
+
+func (_c *Batch_Delete_Call) Run(run func()) *Batch_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Delete_Call) Return(_a0 error) *Batch_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Delete_Call) RunAndReturn(run func() error) *Batch_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailedJobIDs provides a mock function with no fields
+func (_m *Batch) FailedJobIDs() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailedJobIDs")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Batch_FailedJobIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailedJobIDs'
+type Batch_FailedJobIDs_Call struct {
+	*mock.Call
+}
+
+// FailedJobIDs is a helper method to define mock.On call
+func (_e *Batch_Expecter) FailedJobIDs() *Batch_FailedJobIDs_Call {
+	return &Batch_FailedJobIDs_Call{Call: _e.mock.On("FailedJobIDs")}
+}
+
+func (_c *Batch_FailedJobIDs_Call) Run(run func()) *Batch_FailedJobIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_FailedJobIDs_Call) Return(_a0 []string) *Batch_FailedJobIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_FailedJobIDs_Call) RunAndReturn(run func() []string) *Batch_FailedJobIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailedJobs provides a mock function with no fields
+func (_m *Batch) FailedJobs() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailedJobs")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_FailedJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailedJobs'
+type Batch_FailedJobs_Call struct {
+	*mock.Call
+}
+
+// FailedJobs is a helper method to define mock.On call
+func (_e *Batch_Expecter) FailedJobs() *Batch_FailedJobs_Call {
+	return &Batch_FailedJobs_Call{Call: _e.mock.On("FailedJobs")}
+}
+
+func (_c *Batch_FailedJobs_Call) Run(run func()) *Batch_FailedJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_FailedJobs_Call) Return(_a0 int) *Batch_FailedJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_FailedJobs_Call) RunAndReturn(run func() int) *Batch_FailedJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finished provides a mock function with no fields
+func (_m *Batch) Finished() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finished")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Batch_Finished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finished'
+type Batch_Finished_Call struct {
+	*mock.Call
+}
+
+// Finished is a helper method to define mock.On call
+func (_e *Batch_Expecter) Finished() *Batch_Finished_Call {
+	return &Batch_Finished_Call{Call: _e.mock.On("Finished")}
+}
+
+func (_c *Batch_Finished_Call) Run(run func()) *Batch_Finished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Finished_Call) Return(_a0 bool) *Batch_Finished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Finished_Call) RunAndReturn(run func() bool) *Batch_Finished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishedAt provides a mock function with no fields
+func (_m *Batch) FinishedAt() *carbon.LayoutType[supportcarbon.DateTimeType] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishedAt")
+	}
+
+	var r0 *carbon.LayoutType[supportcarbon.DateTimeType]
+	if rf, ok := ret.Get(0).(func() *carbon.LayoutType[supportcarbon.DateTimeType]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*carbon.LayoutType[supportcarbon.DateTimeType])
+		}
+	}
+
+	return r0
+}
+
+// Batch_FinishedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishedAt'
+type Batch_FinishedAt_Call struct {
+	*mock.Call
+}
+
+// FinishedAt is a helper method to define mock.On call
+func (_e *Batch_Expecter) FinishedAt() *Batch_FinishedAt_Call {
+	return &Batch_FinishedAt_Call{Call: _e.mock.On("FinishedAt")}
+}
+
+func (_c *Batch_FinishedAt_Call) Run(run func()) *Batch_FinishedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_FinishedAt_Call) Return(_a0 *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_FinishedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_FinishedAt_Call) RunAndReturn(run func() *carbon.LayoutType[supportcarbon.DateTimeType]) *Batch_FinishedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fresh provides a mock function with no fields
+func (_m *Batch) Fresh() (queue.Batch, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fresh")
+	}
+
+	var r0 queue.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (queue.Batch, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() queue.Batch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Batch_Fresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fresh'
+type Batch_Fresh_Call struct {
+	*mock.Call
+}
+
+// Fresh is a helper method to define mock.On call
+func (_e *Batch_Expecter) Fresh() *Batch_Fresh_Call {
+	return &Batch_Fresh_Call{Call: _e.mock.On("Fresh")}
+}
+
+func (_c *Batch_Fresh_Call) Run(run func()) *Batch_Fresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Fresh_Call) Return(_a0 queue.Batch, _a1 error) *Batch_Fresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Batch_Fresh_Call) RunAndReturn(run func() (queue.Batch, error)) *Batch_Fresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasFailures provides a mock function with no fields
+func (_m *Batch) HasFailures() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasFailures")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Batch_HasFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasFailures'
+type Batch_HasFailures_Call struct {
+	*mock.Call
+}
+
+// HasFailures is a helper method to define mock.On call
+func (_e *Batch_Expecter) HasFailures() *Batch_HasFailures_Call {
+	return &Batch_HasFailures_Call{Call: _e.mock.On("HasFailures")}
+}
+
+func (_c *Batch_HasFailures_Call) Run(run func()) *Batch_HasFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_HasFailures_Call) Return(_a0 bool) *Batch_HasFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_HasFailures_Call) RunAndReturn(run func() bool) *Batch_HasFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ID provides a mock function with no fields
+func (_m *Batch) ID() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Batch_ID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ID'
+type Batch_ID_Call struct {
+	*mock.Call
+}
+
+// ID is a helper method to define mock.On call
+func (_e *Batch_Expecter) ID() *Batch_ID_Call {
+	return &Batch_ID_Call{Call: _e.mock.On("ID")}
+}
+
+func (_c *Batch_ID_Call) Run(run func()) *Batch_ID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_ID_Call) Return(_a0 string) *Batch_ID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_ID_Call) RunAndReturn(run func() string) *Batch_ID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with no fields
+func (_m *Batch) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Batch_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Batch_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Batch_Expecter) Name() *Batch_Name_Call {
+	return &Batch_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Batch_Name_Call) Run(run func()) *Batch_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Name_Call) Return(_a0 string) *Batch_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Name_Call) RunAndReturn(run func() string) *Batch_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingJobs provides a mock function with no fields
+func (_m *Batch) PendingJobs() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingJobs")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_PendingJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingJobs'
+type Batch_PendingJobs_Call struct {
+	*mock.Call
+}
+
+// PendingJobs is a helper method to define mock.On call
+func (_e *Batch_Expecter) PendingJobs() *Batch_PendingJobs_Call {
+	return &Batch_PendingJobs_Call{Call: _e.mock.On("PendingJobs")}
+}
+
+func (_c *Batch_PendingJobs_Call) Run(run func()) *Batch_PendingJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_PendingJobs_Call) Return(_a0 int) *Batch_PendingJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_PendingJobs_Call) RunAndReturn(run func() int) *Batch_PendingJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessedJobs provides a mock function with no fields
+func (_m *Batch) ProcessedJobs() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessedJobs")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_ProcessedJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessedJobs'
+type Batch_ProcessedJobs_Call struct {
+	*mock.Call
+}
+
+// ProcessedJobs is a helper method to define mock.On call
+func (_e *Batch_Expecter) ProcessedJobs() *Batch_ProcessedJobs_Call {
+	return &Batch_ProcessedJobs_Call{Call: _e.mock.On("ProcessedJobs")}
+}
+
+func (_c *Batch_ProcessedJobs_Call) Run(run func()) *Batch_ProcessedJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_ProcessedJobs_Call) Return(_a0 int) *Batch_ProcessedJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_ProcessedJobs_Call) RunAndReturn(run func() int) *Batch_ProcessedJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Progress provides a mock function with no fields
+func (_m *Batch) Progress() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Progress")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_Progress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Progress'
+type Batch_Progress_Call struct {
+	*mock.Call
+}
+
+// Progress is a helper method to define mock.On call
+func (_e *Batch_Expecter) Progress() *Batch_Progress_Call {
+	return &Batch_Progress_Call{Call: _e.mock.On("Progress")}
+}
+
+func (_c *Batch_Progress_Call) Run(run func()) *Batch_Progress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_Progress_Call) Return(_a0 int) *Batch_Progress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_Progress_Call) RunAndReturn(run func() int) *Batch_Progress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SkippedJobs provides a mock function with no fields
+func (_m *Batch) SkippedJobs() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkippedJobs")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_SkippedJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkippedJobs'
+type Batch_SkippedJobs_Call struct {
+	*mock.Call
+}
+
+// SkippedJobs is a helper method to define mock.On call
+func (_e *Batch_Expecter) SkippedJobs() *Batch_SkippedJobs_Call {
+	return &Batch_SkippedJobs_Call{Call: _e.mock.On("SkippedJobs")}
+}
+
+func (_c *Batch_SkippedJobs_Call) Run(run func()) *Batch_SkippedJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_SkippedJobs_Call) Return(_a0 int) *Batch_SkippedJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_SkippedJobs_Call) RunAndReturn(run func() int) *Batch_SkippedJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalJobs provides a mock function with no fields
+func (_m *Batch) TotalJobs() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalJobs")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Batch_TotalJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalJobs'
+type Batch_TotalJobs_Call struct {
+	*mock.Call
+}
+
+// TotalJobs is a helper method to define mock.On call
+func (_e *Batch_Expecter) TotalJobs() *Batch_TotalJobs_Call {
+	return &Batch_TotalJobs_Call{Call: _e.mock.On("TotalJobs")}
+}
+
+func (_c *Batch_TotalJobs_Call) Run(run func()) *Batch_TotalJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Batch_TotalJobs_Call) Return(_a0 int) *Batch_TotalJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Batch_TotalJobs_Call) RunAndReturn(run func() int) *Batch_TotalJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBatch creates a new instance of Batch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBatch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Batch {
+	mock := &Batch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
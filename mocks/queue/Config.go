@@ -55,6 +55,96 @@ func (_c *Config_Add_Call) RunAndReturn(run func(string, interface{})) *Config_A
 	return _c
 }
 
+// BatchingDatabase provides a mock function with no fields
+func (_m *Config) BatchingDatabase() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchingDatabase")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_BatchingDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchingDatabase'
+type Config_BatchingDatabase_Call struct {
+	*mock.Call
+}
+
+// BatchingDatabase is a helper method to define mock.On call
+func (_e *Config_Expecter) BatchingDatabase() *Config_BatchingDatabase_Call {
+	return &Config_BatchingDatabase_Call{Call: _e.mock.On("BatchingDatabase")}
+}
+
+func (_c *Config_BatchingDatabase_Call) Run(run func()) *Config_BatchingDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_BatchingDatabase_Call) Return(_a0 string) *Config_BatchingDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_BatchingDatabase_Call) RunAndReturn(run func() string) *Config_BatchingDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchingTable provides a mock function with no fields
+func (_m *Config) BatchingTable() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchingTable")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_BatchingTable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchingTable'
+type Config_BatchingTable_Call struct {
+	*mock.Call
+}
+
+// BatchingTable is a helper method to define mock.On call
+func (_e *Config_Expecter) BatchingTable() *Config_BatchingTable_Call {
+	return &Config_BatchingTable_Call{Call: _e.mock.On("BatchingTable")}
+}
+
+func (_c *Config_BatchingTable_Call) Run(run func()) *Config_BatchingTable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_BatchingTable_Call) Return(_a0 string) *Config_BatchingTable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_BatchingTable_Call) RunAndReturn(run func() string) *Config_BatchingTable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Debug provides a mock function with no fields
 func (_m *Config) Debug() bool {
 	ret := _m.Called()
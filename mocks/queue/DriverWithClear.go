@@ -0,0 +1,88 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// DriverWithClear is an autogenerated mock type for the DriverWithClear type
+type DriverWithClear struct {
+	mock.Mock
+}
+
+type DriverWithClear_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithClear) EXPECT() *DriverWithClear_Expecter {
+	return &DriverWithClear_Expecter{mock: &_m.Mock}
+}
+
+// Clear provides a mock function with given fields: _a0
+func (_m *DriverWithClear) Clear(_a0 string) (int64, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clear")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriverWithClear_Clear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clear'
+type DriverWithClear_Clear_Call struct {
+	*mock.Call
+}
+
+// Clear is a helper method to define mock.On call
+//   - _a0 string
+func (_e *DriverWithClear_Expecter) Clear(_a0 interface{}) *DriverWithClear_Clear_Call {
+	return &DriverWithClear_Clear_Call{Call: _e.mock.On("Clear", _a0)}
+}
+
+func (_c *DriverWithClear_Clear_Call) Run(run func(_a0 string)) *DriverWithClear_Clear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithClear_Clear_Call) Return(_a0 int64, _a1 error) *DriverWithClear_Clear_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithClear_Clear_Call) RunAndReturn(run func(string) (int64, error)) *DriverWithClear_Clear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithClear creates a new instance of DriverWithClear. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithClear(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithClear {
+	mock := &DriverWithClear{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DriverWithPopFrom is an autogenerated mock type for the DriverWithPopFrom type
+type DriverWithPopFrom struct {
+	mock.Mock
+}
+
+type DriverWithPopFrom_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithPopFrom) EXPECT() *DriverWithPopFrom_Expecter {
+	return &DriverWithPopFrom_Expecter{mock: &_m.Mock}
+}
+
+// PopFrom provides a mock function with given fields: queues
+func (_m *DriverWithPopFrom) PopFrom(queues []string) (string, queue.ReservedJob, error) {
+	ret := _m.Called(queues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PopFrom")
+	}
+
+	var r0 string
+	var r1 queue.ReservedJob
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]string) (string, queue.ReservedJob, error)); ok {
+		return rf(queues)
+	}
+	if rf, ok := ret.Get(0).(func([]string) string); ok {
+		r0 = rf(queues)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) queue.ReservedJob); ok {
+		r1 = rf(queues)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(queue.ReservedJob)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]string) error); ok {
+		r2 = rf(queues)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DriverWithPopFrom_PopFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PopFrom'
+type DriverWithPopFrom_PopFrom_Call struct {
+	*mock.Call
+}
+
+// PopFrom is a helper method to define mock.On call
+//   - queues []string
+func (_e *DriverWithPopFrom_Expecter) PopFrom(queues interface{}) *DriverWithPopFrom_PopFrom_Call {
+	return &DriverWithPopFrom_PopFrom_Call{Call: _e.mock.On("PopFrom", queues)}
+}
+
+func (_c *DriverWithPopFrom_PopFrom_Call) Run(run func(queues []string)) *DriverWithPopFrom_PopFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *DriverWithPopFrom_PopFrom_Call) Return(_a0 string, _a1 queue.ReservedJob, _a2 error) *DriverWithPopFrom_PopFrom_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *DriverWithPopFrom_PopFrom_Call) RunAndReturn(run func([]string) (string, queue.ReservedJob, error)) *DriverWithPopFrom_PopFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithPopFrom creates a new instance of DriverWithPopFrom. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithPopFrom(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithPopFrom {
+	mock := &DriverWithPopFrom{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// DriverWithSize is an autogenerated mock type for the DriverWithSize type
+type DriverWithSize struct {
+	mock.Mock
+}
+
+type DriverWithSize_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriverWithSize) EXPECT() *DriverWithSize_Expecter {
+	return &DriverWithSize_Expecter{mock: &_m.Mock}
+}
+
+// Size provides a mock function with given fields: _a0
+func (_m *DriverWithSize) Size(_a0 string) (int64, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Size")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriverWithSize_Size_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Size'
+type DriverWithSize_Size_Call struct {
+	*mock.Call
+}
+
+// Size is a helper method to define mock.On call
+//   - _a0 string
+func (_e *DriverWithSize_Expecter) Size(_a0 interface{}) *DriverWithSize_Size_Call {
+	return &DriverWithSize_Size_Call{Call: _e.mock.On("Size", _a0)}
+}
+
+func (_c *DriverWithSize_Size_Call) Run(run func(_a0 string)) *DriverWithSize_Size_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DriverWithSize_Size_Call) Return(_a0 int64, _a1 error) *DriverWithSize_Size_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriverWithSize_Size_Call) RunAndReturn(run func(string) (int64, error)) *DriverWithSize_Size_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriverWithSize creates a new instance of DriverWithSize. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriverWithSize(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriverWithSize {
+	mock := &DriverWithSize{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -77,6 +77,108 @@ func (_c *Failer_All_Call) RunAndReturn(run func() ([]queue.FailedJob, error)) *
 	return _c
 }
 
+// Flush provides a mock function with given fields: hours
+func (_m *Failer) Flush(hours int) (int64, error) {
+	ret := _m.Called(hours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int64, error)); ok {
+		return rf(hours)
+	}
+	if rf, ok := ret.Get(0).(func(int) int64); ok {
+		r0 = rf(hours)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(hours)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Failer_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type Failer_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+//   - hours int
+func (_e *Failer_Expecter) Flush(hours interface{}) *Failer_Flush_Call {
+	return &Failer_Flush_Call{Call: _e.mock.On("Flush", hours)}
+}
+
+func (_c *Failer_Flush_Call) Run(run func(hours int)) *Failer_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Failer_Flush_Call) Return(_a0 int64, _a1 error) *Failer_Flush_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Failer_Flush_Call) RunAndReturn(run func(int) (int64, error)) *Failer_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Forget provides a mock function with given fields: uuid
+func (_m *Failer) Forget(uuid string) error {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Failer_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type Failer_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - uuid string
+func (_e *Failer_Expecter) Forget(uuid interface{}) *Failer_Forget_Call {
+	return &Failer_Forget_Call{Call: _e.mock.On("Forget", uuid)}
+}
+
+func (_c *Failer_Forget_Call) Run(run func(uuid string)) *Failer_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Failer_Forget_Call) Return(_a0 error) *Failer_Forget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Failer_Forget_Call) RunAndReturn(run func(string) error) *Failer_Forget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: connection, _a1, uuids
 func (_m *Failer) Get(connection string, _a1 string, uuids []string) ([]queue.FailedJob, error) {
 	ret := _m.Called(connection, _a1, uuids)
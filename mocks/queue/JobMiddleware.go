@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobMiddleware is an autogenerated mock type for the JobMiddleware type
+type JobMiddleware struct {
+	mock.Mock
+}
+
+type JobMiddleware_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobMiddleware) EXPECT() *JobMiddleware_Expecter {
+	return &JobMiddleware_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function with given fields: job, next
+func (_m *JobMiddleware) Handle(job queue.QueuedJob, next func() error) error {
+	ret := _m.Called(job, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(queue.QueuedJob, func() error) error); ok {
+		r0 = rf(job, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobMiddleware_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type JobMiddleware_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - job queue.QueuedJob
+//   - next func() error
+func (_e *JobMiddleware_Expecter) Handle(job interface{}, next interface{}) *JobMiddleware_Handle_Call {
+	return &JobMiddleware_Handle_Call{Call: _e.mock.On("Handle", job, next)}
+}
+
+func (_c *JobMiddleware_Handle_Call) Run(run func(job queue.QueuedJob, next func() error)) *JobMiddleware_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.QueuedJob), args[1].(func() error))
+	})
+	return _c
+}
+
+func (_c *JobMiddleware_Handle_Call) Return(_a0 error) *JobMiddleware_Handle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobMiddleware_Handle_Call) RunAndReturn(run func(queue.QueuedJob, func() error) error) *JobMiddleware_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobMiddleware creates a new instance of JobMiddleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobMiddleware(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobMiddleware {
+	mock := &JobMiddleware{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// JobWithBackoff is an autogenerated mock type for the JobWithBackoff type
+type JobWithBackoff struct {
+	mock.Mock
+}
+
+type JobWithBackoff_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithBackoff) EXPECT() *JobWithBackoff_Expecter {
+	return &JobWithBackoff_Expecter{mock: &_m.Mock}
+}
+
+// Backoff provides a mock function with no fields
+func (_m *JobWithBackoff) Backoff() []time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backoff")
+	}
+
+	var r0 []time.Duration
+	if rf, ok := ret.Get(0).(func() []time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// JobWithBackoff_Backoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backoff'
+type JobWithBackoff_Backoff_Call struct {
+	*mock.Call
+}
+
+// Backoff is a helper method to define mock.On call
+func (_e *JobWithBackoff_Expecter) Backoff() *JobWithBackoff_Backoff_Call {
+	return &JobWithBackoff_Backoff_Call{Call: _e.mock.On("Backoff")}
+}
+
+func (_c *JobWithBackoff_Backoff_Call) Run(run func()) *JobWithBackoff_Backoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobWithBackoff_Backoff_Call) Return(_a0 []time.Duration) *JobWithBackoff_Backoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithBackoff_Backoff_Call) RunAndReturn(run func() []time.Duration) *JobWithBackoff_Backoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithBackoff creates a new instance of JobWithBackoff. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithBackoff(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithBackoff {
+	mock := &JobWithBackoff{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
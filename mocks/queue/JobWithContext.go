@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobWithContext is an autogenerated mock type for the JobWithContext type
+type JobWithContext struct {
+	mock.Mock
+}
+
+type JobWithContext_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithContext) EXPECT() *JobWithContext_Expecter {
+	return &JobWithContext_Expecter{mock: &_m.Mock}
+}
+
+// HandleWithContext provides a mock function with given fields: ctx, args
+func (_m *JobWithContext) HandleWithContext(ctx context.Context, args ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleWithContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...interface{}) error); ok {
+		r0 = rf(ctx, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobWithContext_HandleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleWithContext'
+type JobWithContext_HandleWithContext_Call struct {
+	*mock.Call
+}
+
+// HandleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args ...interface{}
+func (_e *JobWithContext_Expecter) HandleWithContext(ctx interface{}, args ...interface{}) *JobWithContext_HandleWithContext_Call {
+	return &JobWithContext_HandleWithContext_Call{Call: _e.mock.On("HandleWithContext",
+		append([]interface{}{ctx}, args...)...)}
+}
+
+func (_c *JobWithContext_HandleWithContext_Call) Run(run func(ctx context.Context, args ...interface{})) *JobWithContext_HandleWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *JobWithContext_HandleWithContext_Call) Return(_a0 error) *JobWithContext_HandleWithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithContext_HandleWithContext_Call) RunAndReturn(run func(context.Context, ...interface{}) error) *JobWithContext_HandleWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithContext creates a new instance of JobWithContext. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithContext(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithContext {
+	mock := &JobWithContext{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobWithMiddleware is an autogenerated mock type for the JobWithMiddleware type
+type JobWithMiddleware struct {
+	mock.Mock
+}
+
+type JobWithMiddleware_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithMiddleware) EXPECT() *JobWithMiddleware_Expecter {
+	return &JobWithMiddleware_Expecter{mock: &_m.Mock}
+}
+
+// Middleware provides a mock function with given fields: args
+func (_m *JobWithMiddleware) Middleware(args ...interface{}) []queue.JobMiddleware {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Middleware")
+	}
+
+	var r0 []queue.JobMiddleware
+	if rf, ok := ret.Get(0).(func(...interface{}) []queue.JobMiddleware); ok {
+		r0 = rf(args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]queue.JobMiddleware)
+		}
+	}
+
+	return r0
+}
+
+// JobWithMiddleware_Middleware_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Middleware'
+type JobWithMiddleware_Middleware_Call struct {
+	*mock.Call
+}
+
+// Middleware is a helper method to define mock.On call
+//   - args ...interface{}
+func (_e *JobWithMiddleware_Expecter) Middleware(args ...interface{}) *JobWithMiddleware_Middleware_Call {
+	return &JobWithMiddleware_Middleware_Call{Call: _e.mock.On("Middleware",
+		append([]interface{}{}, args...)...)}
+}
+
+func (_c *JobWithMiddleware_Middleware_Call) Run(run func(args ...interface{})) *JobWithMiddleware_Middleware_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *JobWithMiddleware_Middleware_Call) Return(_a0 []queue.JobMiddleware) *JobWithMiddleware_Middleware_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithMiddleware_Middleware_Call) RunAndReturn(run func(...interface{}) []queue.JobMiddleware) *JobWithMiddleware_Middleware_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithMiddleware creates a new instance of JobWithMiddleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithMiddleware(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithMiddleware {
+	mock := &JobWithMiddleware{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
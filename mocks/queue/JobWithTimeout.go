@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// JobWithTimeout is an autogenerated mock type for the JobWithTimeout type
+type JobWithTimeout struct {
+	mock.Mock
+}
+
+type JobWithTimeout_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithTimeout) EXPECT() *JobWithTimeout_Expecter {
+	return &JobWithTimeout_Expecter{mock: &_m.Mock}
+}
+
+// Timeout provides a mock function with no fields
+func (_m *JobWithTimeout) Timeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobWithTimeout_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type JobWithTimeout_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *JobWithTimeout_Expecter) Timeout() *JobWithTimeout_Timeout_Call {
+	return &JobWithTimeout_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *JobWithTimeout_Timeout_Call) Run(run func()) *JobWithTimeout_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobWithTimeout_Timeout_Call) Return(_a0 time.Duration) *JobWithTimeout_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithTimeout_Timeout_Call) RunAndReturn(run func() time.Duration) *JobWithTimeout_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithTimeout creates a new instance of JobWithTimeout. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithTimeout(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithTimeout {
+	mock := &JobWithTimeout{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// JobWithTries is an autogenerated mock type for the JobWithTries type
+type JobWithTries struct {
+	mock.Mock
+}
+
+type JobWithTries_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithTries) EXPECT() *JobWithTries_Expecter {
+	return &JobWithTries_Expecter{mock: &_m.Mock}
+}
+
+// Tries provides a mock function with no fields
+func (_m *JobWithTries) Tries() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tries")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// JobWithTries_Tries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tries'
+type JobWithTries_Tries_Call struct {
+	*mock.Call
+}
+
+// Tries is a helper method to define mock.On call
+func (_e *JobWithTries_Expecter) Tries() *JobWithTries_Tries_Call {
+	return &JobWithTries_Tries_Call{Call: _e.mock.On("Tries")}
+}
+
+func (_c *JobWithTries_Tries_Call) Run(run func()) *JobWithTries_Tries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobWithTries_Tries_Call) Return(_a0 int) *JobWithTries_Tries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithTries_Tries_Call) RunAndReturn(run func() int) *JobWithTries_Tries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithTries creates a new instance of JobWithTries. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithTries(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithTries {
+	mock := &JobWithTries{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
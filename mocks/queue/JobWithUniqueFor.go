@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// JobWithUniqueFor is an autogenerated mock type for the JobWithUniqueFor type
+type JobWithUniqueFor struct {
+	mock.Mock
+}
+
+type JobWithUniqueFor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithUniqueFor) EXPECT() *JobWithUniqueFor_Expecter {
+	return &JobWithUniqueFor_Expecter{mock: &_m.Mock}
+}
+
+// UniqueFor provides a mock function with no fields
+func (_m *JobWithUniqueFor) UniqueFor() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UniqueFor")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobWithUniqueFor_UniqueFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UniqueFor'
+type JobWithUniqueFor_UniqueFor_Call struct {
+	*mock.Call
+}
+
+// UniqueFor is a helper method to define mock.On call
+func (_e *JobWithUniqueFor_Expecter) UniqueFor() *JobWithUniqueFor_UniqueFor_Call {
+	return &JobWithUniqueFor_UniqueFor_Call{Call: _e.mock.On("UniqueFor")}
+}
+
+func (_c *JobWithUniqueFor_UniqueFor_Call) Run(run func()) *JobWithUniqueFor_UniqueFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobWithUniqueFor_UniqueFor_Call) Return(_a0 time.Duration) *JobWithUniqueFor_UniqueFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithUniqueFor_UniqueFor_Call) RunAndReturn(run func() time.Duration) *JobWithUniqueFor_UniqueFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithUniqueFor creates a new instance of JobWithUniqueFor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithUniqueFor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithUniqueFor {
+	mock := &JobWithUniqueFor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
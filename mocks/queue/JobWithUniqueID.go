@@ -0,0 +1,87 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// JobWithUniqueID is an autogenerated mock type for the JobWithUniqueID type
+type JobWithUniqueID struct {
+	mock.Mock
+}
+
+type JobWithUniqueID_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobWithUniqueID) EXPECT() *JobWithUniqueID_Expecter {
+	return &JobWithUniqueID_Expecter{mock: &_m.Mock}
+}
+
+// UniqueID provides a mock function with given fields: args
+func (_m *JobWithUniqueID) UniqueID(args ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UniqueID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(...interface{}) string); ok {
+		r0 = rf(args...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobWithUniqueID_UniqueID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UniqueID'
+type JobWithUniqueID_UniqueID_Call struct {
+	*mock.Call
+}
+
+// UniqueID is a helper method to define mock.On call
+//   - args ...interface{}
+func (_e *JobWithUniqueID_Expecter) UniqueID(args ...interface{}) *JobWithUniqueID_UniqueID_Call {
+	return &JobWithUniqueID_UniqueID_Call{Call: _e.mock.On("UniqueID",
+		append([]interface{}{}, args...)...)}
+}
+
+func (_c *JobWithUniqueID_UniqueID_Call) Run(run func(args ...interface{})) *JobWithUniqueID_UniqueID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *JobWithUniqueID_UniqueID_Call) Return(_a0 string) *JobWithUniqueID_UniqueID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobWithUniqueID_UniqueID_Call) RunAndReturn(run func(...interface{}) string) *JobWithUniqueID_UniqueID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobWithUniqueID creates a new instance of JobWithUniqueID. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobWithUniqueID(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobWithUniqueID {
+	mock := &JobWithUniqueID{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,427 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PendingBatch is an autogenerated mock type for the PendingBatch type
+type PendingBatch struct {
+	mock.Mock
+}
+
+type PendingBatch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PendingBatch) EXPECT() *PendingBatch_Expecter {
+	return &PendingBatch_Expecter{mock: &_m.Mock}
+}
+
+// AllowFailures provides a mock function with no fields
+func (_m *PendingBatch) AllowFailures() queue.PendingBatch {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowFailures")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func() queue.PendingBatch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_AllowFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllowFailures'
+type PendingBatch_AllowFailures_Call struct {
+	*mock.Call
+}
+
+// AllowFailures is a helper method to define mock.On call
+func (_e *PendingBatch_Expecter) AllowFailures() *PendingBatch_AllowFailures_Call {
+	return &PendingBatch_AllowFailures_Call{Call: _e.mock.On("AllowFailures")}
+}
+
+func (_c *PendingBatch_AllowFailures_Call) Run(run func()) *PendingBatch_AllowFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PendingBatch_AllowFailures_Call) Return(_a0 queue.PendingBatch) *PendingBatch_AllowFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_AllowFailures_Call) RunAndReturn(run func() queue.PendingBatch) *PendingBatch_AllowFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Catch provides a mock function with given fields: job
+func (_m *PendingBatch) Catch(job queue.Job) queue.PendingBatch {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Catch")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(queue.Job) queue.PendingBatch); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_Catch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Catch'
+type PendingBatch_Catch_Call struct {
+	*mock.Call
+}
+
+// Catch is a helper method to define mock.On call
+//   - job queue.Job
+func (_e *PendingBatch_Expecter) Catch(job interface{}) *PendingBatch_Catch_Call {
+	return &PendingBatch_Catch_Call{Call: _e.mock.On("Catch", job)}
+}
+
+func (_c *PendingBatch_Catch_Call) Run(run func(job queue.Job)) *PendingBatch_Catch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_Catch_Call) Return(_a0 queue.PendingBatch) *PendingBatch_Catch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_Catch_Call) RunAndReturn(run func(queue.Job) queue.PendingBatch) *PendingBatch_Catch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function with no fields
+func (_m *PendingBatch) Dispatch() (queue.Batch, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 queue.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (queue.Batch, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() queue.Batch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PendingBatch_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type PendingBatch_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+func (_e *PendingBatch_Expecter) Dispatch() *PendingBatch_Dispatch_Call {
+	return &PendingBatch_Dispatch_Call{Call: _e.mock.On("Dispatch")}
+}
+
+func (_c *PendingBatch_Dispatch_Call) Run(run func()) *PendingBatch_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PendingBatch_Dispatch_Call) Return(_a0 queue.Batch, _a1 error) *PendingBatch_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PendingBatch_Dispatch_Call) RunAndReturn(run func() (queue.Batch, error)) *PendingBatch_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finally provides a mock function with given fields: job
+func (_m *PendingBatch) Finally(job queue.Job) queue.PendingBatch {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finally")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(queue.Job) queue.PendingBatch); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_Finally_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finally'
+type PendingBatch_Finally_Call struct {
+	*mock.Call
+}
+
+// Finally is a helper method to define mock.On call
+//   - job queue.Job
+func (_e *PendingBatch_Expecter) Finally(job interface{}) *PendingBatch_Finally_Call {
+	return &PendingBatch_Finally_Call{Call: _e.mock.On("Finally", job)}
+}
+
+func (_c *PendingBatch_Finally_Call) Run(run func(job queue.Job)) *PendingBatch_Finally_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_Finally_Call) Return(_a0 queue.PendingBatch) *PendingBatch_Finally_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_Finally_Call) RunAndReturn(run func(queue.Job) queue.PendingBatch) *PendingBatch_Finally_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with given fields: name
+func (_m *PendingBatch) Name(name string) queue.PendingBatch {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(string) queue.PendingBatch); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type PendingBatch_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+//   - name string
+func (_e *PendingBatch_Expecter) Name(name interface{}) *PendingBatch_Name_Call {
+	return &PendingBatch_Name_Call{Call: _e.mock.On("Name", name)}
+}
+
+func (_c *PendingBatch_Name_Call) Run(run func(name string)) *PendingBatch_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_Name_Call) Return(_a0 queue.PendingBatch) *PendingBatch_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_Name_Call) RunAndReturn(run func(string) queue.PendingBatch) *PendingBatch_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnConnection provides a mock function with given fields: connection
+func (_m *PendingBatch) OnConnection(connection string) queue.PendingBatch {
+	ret := _m.Called(connection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnConnection")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(string) queue.PendingBatch); ok {
+		r0 = rf(connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_OnConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnConnection'
+type PendingBatch_OnConnection_Call struct {
+	*mock.Call
+}
+
+// OnConnection is a helper method to define mock.On call
+//   - connection string
+func (_e *PendingBatch_Expecter) OnConnection(connection interface{}) *PendingBatch_OnConnection_Call {
+	return &PendingBatch_OnConnection_Call{Call: _e.mock.On("OnConnection", connection)}
+}
+
+func (_c *PendingBatch_OnConnection_Call) Run(run func(connection string)) *PendingBatch_OnConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_OnConnection_Call) Return(_a0 queue.PendingBatch) *PendingBatch_OnConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_OnConnection_Call) RunAndReturn(run func(string) queue.PendingBatch) *PendingBatch_OnConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnQueue provides a mock function with given fields: _a0
+func (_m *PendingBatch) OnQueue(_a0 string) queue.PendingBatch {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnQueue")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(string) queue.PendingBatch); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_OnQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnQueue'
+type PendingBatch_OnQueue_Call struct {
+	*mock.Call
+}
+
+// OnQueue is a helper method to define mock.On call
+//   - _a0 string
+func (_e *PendingBatch_Expecter) OnQueue(_a0 interface{}) *PendingBatch_OnQueue_Call {
+	return &PendingBatch_OnQueue_Call{Call: _e.mock.On("OnQueue", _a0)}
+}
+
+func (_c *PendingBatch_OnQueue_Call) Run(run func(_a0 string)) *PendingBatch_OnQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_OnQueue_Call) Return(_a0 queue.PendingBatch) *PendingBatch_OnQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_OnQueue_Call) RunAndReturn(run func(string) queue.PendingBatch) *PendingBatch_OnQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Then provides a mock function with given fields: job
+func (_m *PendingBatch) Then(job queue.Job) queue.PendingBatch {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Then")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func(queue.Job) queue.PendingBatch); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// PendingBatch_Then_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Then'
+type PendingBatch_Then_Call struct {
+	*mock.Call
+}
+
+// Then is a helper method to define mock.On call
+//   - job queue.Job
+func (_e *PendingBatch_Expecter) Then(job interface{}) *PendingBatch_Then_Call {
+	return &PendingBatch_Then_Call{Call: _e.mock.On("Then", job)}
+}
+
+func (_c *PendingBatch_Then_Call) Run(run func(job queue.Job)) *PendingBatch_Then_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job))
+	})
+	return _c
+}
+
+func (_c *PendingBatch_Then_Call) Return(_a0 queue.PendingBatch) *PendingBatch_Then_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingBatch_Then_Call) RunAndReturn(run func(queue.Job) queue.PendingBatch) *PendingBatch_Then_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPendingBatch creates a new instance of PendingBatch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPendingBatch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PendingBatch {
+	mock := &PendingBatch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
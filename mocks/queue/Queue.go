@@ -20,6 +20,54 @@ func (_m *Queue) EXPECT() *Queue_Expecter {
 	return &Queue_Expecter{mock: &_m.Mock}
 }
 
+// Batch provides a mock function with given fields: jobs
+func (_m *Queue) Batch(jobs []queue.ChainJob) queue.PendingBatch {
+	ret := _m.Called(jobs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func([]queue.ChainJob) queue.PendingBatch); ok {
+		r0 = rf(jobs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// Queue_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type Queue_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - jobs []queue.ChainJob
+func (_e *Queue_Expecter) Batch(jobs interface{}) *Queue_Batch_Call {
+	return &Queue_Batch_Call{Call: _e.mock.On("Batch", jobs)}
+}
+
+func (_c *Queue_Batch_Call) Run(run func(jobs []queue.ChainJob)) *Queue_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]queue.ChainJob))
+	})
+	return _c
+}
+
+func (_c *Queue_Batch_Call) Return(_a0 queue.PendingBatch) *Queue_Batch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Queue_Batch_Call) RunAndReturn(run func([]queue.ChainJob) queue.PendingBatch) *Queue_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Chain provides a mock function with given fields: jobs
 func (_m *Queue) Chain(jobs []queue.ChainJob) queue.PendingJob {
 	ret := _m.Called(jobs)
@@ -173,6 +221,64 @@ func (_c *Queue_Failer_Call) RunAndReturn(run func() queue.Failer) *Queue_Failer
 	return _c
 }
 
+// FindBatch provides a mock function with given fields: id
+func (_m *Queue) FindBatch(id string) (queue.Batch, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBatch")
+	}
+
+	var r0 queue.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (queue.Batch, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) queue.Batch); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queue_FindBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatch'
+type Queue_FindBatch_Call struct {
+	*mock.Call
+}
+
+// FindBatch is a helper method to define mock.On call
+//   - id string
+func (_e *Queue_Expecter) FindBatch(id interface{}) *Queue_FindBatch_Call {
+	return &Queue_FindBatch_Call{Call: _e.mock.On("FindBatch", id)}
+}
+
+func (_c *Queue_FindBatch_Call) Run(run func(id string)) *Queue_FindBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Queue_FindBatch_Call) Return(_a0 queue.Batch, _a1 error) *Queue_FindBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Queue_FindBatch_Call) RunAndReturn(run func(string) (queue.Batch, error)) *Queue_FindBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJob provides a mock function with given fields: signature
 func (_m *Queue) GetJob(signature string) (queue.Job, error) {
 	ret := _m.Called(signature)
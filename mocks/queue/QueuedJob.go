@@ -0,0 +1,254 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// QueuedJob is an autogenerated mock type for the QueuedJob type
+type QueuedJob struct {
+	mock.Mock
+}
+
+type QueuedJob_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QueuedJob) EXPECT() *QueuedJob_Expecter {
+	return &QueuedJob_Expecter{mock: &_m.Mock}
+}
+
+// Args provides a mock function with no fields
+func (_m *QueuedJob) Args() []interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Args")
+	}
+
+	var r0 []interface{}
+	if rf, ok := ret.Get(0).(func() []interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	return r0
+}
+
+// QueuedJob_Args_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Args'
+type QueuedJob_Args_Call struct {
+	*mock.Call
+}
+
+// Args is a helper method to define mock.On call
+func (_e *QueuedJob_Expecter) Args() *QueuedJob_Args_Call {
+	return &QueuedJob_Args_Call{Call: _e.mock.On("Args")}
+}
+
+func (_c *QueuedJob_Args_Call) Run(run func()) *QueuedJob_Args_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueuedJob_Args_Call) Return(_a0 []interface{}) *QueuedJob_Args_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueuedJob_Args_Call) RunAndReturn(run func() []interface{}) *QueuedJob_Args_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attempts provides a mock function with no fields
+func (_m *QueuedJob) Attempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// QueuedJob_Attempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempts'
+type QueuedJob_Attempts_Call struct {
+	*mock.Call
+}
+
+// Attempts is a helper method to define mock.On call
+func (_e *QueuedJob_Expecter) Attempts() *QueuedJob_Attempts_Call {
+	return &QueuedJob_Attempts_Call{Call: _e.mock.On("Attempts")}
+}
+
+func (_c *QueuedJob_Attempts_Call) Run(run func()) *QueuedJob_Attempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueuedJob_Attempts_Call) Return(_a0 int) *QueuedJob_Attempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueuedJob_Attempts_Call) RunAndReturn(run func() int) *QueuedJob_Attempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Job provides a mock function with no fields
+func (_m *QueuedJob) Job() queue.Job {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Job")
+	}
+
+	var r0 queue.Job
+	if rf, ok := ret.Get(0).(func() queue.Job); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Job)
+		}
+	}
+
+	return r0
+}
+
+// QueuedJob_Job_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Job'
+type QueuedJob_Job_Call struct {
+	*mock.Call
+}
+
+// Job is a helper method to define mock.On call
+func (_e *QueuedJob_Expecter) Job() *QueuedJob_Job_Call {
+	return &QueuedJob_Job_Call{Call: _e.mock.On("Job")}
+}
+
+func (_c *QueuedJob_Job_Call) Run(run func()) *QueuedJob_Job_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueuedJob_Job_Call) Return(_a0 queue.Job) *QueuedJob_Job_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueuedJob_Job_Call) RunAndReturn(run func() queue.Job) *QueuedJob_Job_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxTries provides a mock function with no fields
+func (_m *QueuedJob) MaxTries() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxTries")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// QueuedJob_MaxTries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxTries'
+type QueuedJob_MaxTries_Call struct {
+	*mock.Call
+}
+
+// MaxTries is a helper method to define mock.On call
+func (_e *QueuedJob_Expecter) MaxTries() *QueuedJob_MaxTries_Call {
+	return &QueuedJob_MaxTries_Call{Call: _e.mock.On("MaxTries")}
+}
+
+func (_c *QueuedJob_MaxTries_Call) Run(run func()) *QueuedJob_MaxTries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueuedJob_MaxTries_Call) Return(_a0 int) *QueuedJob_MaxTries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueuedJob_MaxTries_Call) RunAndReturn(run func() int) *QueuedJob_MaxTries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: delay
+func (_m *QueuedJob) Release(delay time.Duration) {
+	_m.Called(delay)
+}
+
+// QueuedJob_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type QueuedJob_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - delay time.Duration
+func (_e *QueuedJob_Expecter) Release(delay interface{}) *QueuedJob_Release_Call {
+	return &QueuedJob_Release_Call{Call: _e.mock.On("Release", delay)}
+}
+
+func (_c *QueuedJob_Release_Call) Run(run func(delay time.Duration)) *QueuedJob_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *QueuedJob_Release_Call) Return() *QueuedJob_Release_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *QueuedJob_Release_Call) RunAndReturn(run func(time.Duration)) *QueuedJob_Release_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewQueuedJob creates a new instance of QueuedJob. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQueuedJob(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QueuedJob {
+	mock := &QueuedJob{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,311 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package route
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// URL is an autogenerated mock type for the URL type
+type URL struct {
+	mock.Mock
+}
+
+type URL_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *URL) EXPECT() *URL_Expecter {
+	return &URL_Expecter{mock: &_m.Mock}
+}
+
+// Route provides a mock function with given fields: name, parameters
+func (_m *URL) Route(name string, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Route")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) string); ok {
+		r0 = rf(name, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...map[string]interface{}) error); ok {
+		r1 = rf(name, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_Route_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Route'
+type URL_Route_Call struct {
+	*mock.Call
+}
+
+// Route is a helper method to define mock.On call
+//   - name string
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) Route(name interface{}, parameters ...interface{}) *URL_Route_Call {
+	return &URL_Route_Call{Call: _e.mock.On("Route",
+		append([]interface{}{name}, parameters...)...)}
+}
+
+func (_c *URL_Route_Call) Run(run func(name string, parameters ...map[string]interface{})) *URL_Route_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_Route_Call) Return(_a0 string, _a1 error) *URL_Route_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_Route_Call) RunAndReturn(run func(string, ...map[string]interface{}) (string, error)) *URL_Route_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignedRoute provides a mock function with given fields: name, parameters
+func (_m *URL) SignedRoute(name string, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignedRoute")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) string); ok {
+		r0 = rf(name, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...map[string]interface{}) error); ok {
+		r1 = rf(name, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_SignedRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignedRoute'
+type URL_SignedRoute_Call struct {
+	*mock.Call
+}
+
+// SignedRoute is a helper method to define mock.On call
+//   - name string
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) SignedRoute(name interface{}, parameters ...interface{}) *URL_SignedRoute_Call {
+	return &URL_SignedRoute_Call{Call: _e.mock.On("SignedRoute",
+		append([]interface{}{name}, parameters...)...)}
+}
+
+func (_c *URL_SignedRoute_Call) Run(run func(name string, parameters ...map[string]interface{})) *URL_SignedRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_SignedRoute_Call) Return(_a0 string, _a1 error) *URL_SignedRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_SignedRoute_Call) RunAndReturn(run func(string, ...map[string]interface{}) (string, error)) *URL_SignedRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TemporarySignedRoute provides a mock function with given fields: name, expiration, parameters
+func (_m *URL) TemporarySignedRoute(name string, expiration time.Time, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, expiration)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemporarySignedRoute")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, expiration, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, ...map[string]interface{}) string); ok {
+		r0 = rf(name, expiration, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, ...map[string]interface{}) error); ok {
+		r1 = rf(name, expiration, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_TemporarySignedRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TemporarySignedRoute'
+type URL_TemporarySignedRoute_Call struct {
+	*mock.Call
+}
+
+// TemporarySignedRoute is a helper method to define mock.On call
+//   - name string
+//   - expiration time.Time
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) TemporarySignedRoute(name interface{}, expiration interface{}, parameters ...interface{}) *URL_TemporarySignedRoute_Call {
+	return &URL_TemporarySignedRoute_Call{Call: _e.mock.On("TemporarySignedRoute",
+		append([]interface{}{name, expiration}, parameters...)...)}
+}
+
+func (_c *URL_TemporarySignedRoute_Call) Run(run func(name string, expiration time.Time, parameters ...map[string]interface{})) *URL_TemporarySignedRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), args[1].(time.Time), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_TemporarySignedRoute_Call) Return(_a0 string, _a1 error) *URL_TemporarySignedRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_TemporarySignedRoute_Call) RunAndReturn(run func(string, time.Time, ...map[string]interface{}) (string, error)) *URL_TemporarySignedRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// To provides a mock function with given fields: path, query
+func (_m *URL) To(path string, query ...map[string]interface{}) string {
+	_va := make([]interface{}, len(query))
+	for _i := range query {
+		_va[_i] = query[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for To")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) string); ok {
+		r0 = rf(path, query...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// URL_To_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'To'
+type URL_To_Call struct {
+	*mock.Call
+}
+
+// To is a helper method to define mock.On call
+//   - path string
+//   - query ...map[string]interface{}
+func (_e *URL_Expecter) To(path interface{}, query ...interface{}) *URL_To_Call {
+	return &URL_To_Call{Call: _e.mock.On("To",
+		append([]interface{}{path}, query...)...)}
+}
+
+func (_c *URL_To_Call) Run(run func(path string, query ...map[string]interface{})) *URL_To_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_To_Call) Return(_a0 string) *URL_To_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *URL_To_Call) RunAndReturn(run func(string, ...map[string]interface{}) string) *URL_To_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewURL creates a new instance of URL. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewURL(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *URL {
+	mock := &URL{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -329,6 +329,53 @@ func (_c *Session_Get_Call) RunAndReturn(run func(string, ...interface{}) interf
 	return _c
 }
 
+// GetDriver provides a mock function with no fields
+func (_m *Session) GetDriver() session.Driver {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriver")
+	}
+
+	var r0 session.Driver
+	if rf, ok := ret.Get(0).(func() session.Driver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(session.Driver)
+		}
+	}
+
+	return r0
+}
+
+// Session_GetDriver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriver'
+type Session_GetDriver_Call struct {
+	*mock.Call
+}
+
+// GetDriver is a helper method to define mock.On call
+func (_e *Session_Expecter) GetDriver() *Session_GetDriver_Call {
+	return &Session_GetDriver_Call{Call: _e.mock.On("GetDriver")}
+}
+
+func (_c *Session_GetDriver_Call) Run(run func()) *Session_GetDriver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Session_GetDriver_Call) Return(_a0 session.Driver) *Session_GetDriver_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Session_GetDriver_Call) RunAndReturn(run func() session.Driver) *Session_GetDriver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetID provides a mock function with no fields
 func (_m *Session) GetID() string {
 	ret := _m.Called()
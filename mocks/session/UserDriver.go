@@ -0,0 +1,427 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package session
+
+import (
+	session "github.com/goravel/framework/contracts/session"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserDriver is an autogenerated mock type for the UserDriver type
+type UserDriver struct {
+	mock.Mock
+}
+
+type UserDriver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserDriver) EXPECT() *UserDriver_Expecter {
+	return &UserDriver_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *UserDriver) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type UserDriver_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *UserDriver_Expecter) Close() *UserDriver_Close_Call {
+	return &UserDriver_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *UserDriver_Close_Call) Run(run func()) *UserDriver_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UserDriver_Close_Call) Return(_a0 error) *UserDriver_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_Close_Call) RunAndReturn(run func() error) *UserDriver_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Destroy provides a mock function with given fields: id
+func (_m *UserDriver) Destroy(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Destroy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_Destroy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Destroy'
+type UserDriver_Destroy_Call struct {
+	*mock.Call
+}
+
+// Destroy is a helper method to define mock.On call
+//   - id string
+func (_e *UserDriver_Expecter) Destroy(id interface{}) *UserDriver_Destroy_Call {
+	return &UserDriver_Destroy_Call{Call: _e.mock.On("Destroy", id)}
+}
+
+func (_c *UserDriver_Destroy_Call) Run(run func(id string)) *UserDriver_Destroy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Destroy_Call) Return(_a0 error) *UserDriver_Destroy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_Destroy_Call) RunAndReturn(run func(string) error) *UserDriver_Destroy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DestroyOthers provides a mock function with given fields: userID, exceptID
+func (_m *UserDriver) DestroyOthers(userID string, exceptID string) error {
+	ret := _m.Called(userID, exceptID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DestroyOthers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, exceptID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_DestroyOthers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyOthers'
+type UserDriver_DestroyOthers_Call struct {
+	*mock.Call
+}
+
+// DestroyOthers is a helper method to define mock.On call
+//   - userID string
+//   - exceptID string
+func (_e *UserDriver_Expecter) DestroyOthers(userID interface{}, exceptID interface{}) *UserDriver_DestroyOthers_Call {
+	return &UserDriver_DestroyOthers_Call{Call: _e.mock.On("DestroyOthers", userID, exceptID)}
+}
+
+func (_c *UserDriver_DestroyOthers_Call) Run(run func(userID string, exceptID string)) *UserDriver_DestroyOthers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_DestroyOthers_Call) Return(_a0 error) *UserDriver_DestroyOthers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_DestroyOthers_Call) RunAndReturn(run func(string, string) error) *UserDriver_DestroyOthers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Gc provides a mock function with given fields: maxLifetime
+func (_m *UserDriver) Gc(maxLifetime int) error {
+	ret := _m.Called(maxLifetime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Gc")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(maxLifetime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_Gc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Gc'
+type UserDriver_Gc_Call struct {
+	*mock.Call
+}
+
+// Gc is a helper method to define mock.On call
+//   - maxLifetime int
+func (_e *UserDriver_Expecter) Gc(maxLifetime interface{}) *UserDriver_Gc_Call {
+	return &UserDriver_Gc_Call{Call: _e.mock.On("Gc", maxLifetime)}
+}
+
+func (_c *UserDriver_Gc_Call) Run(run func(maxLifetime int)) *UserDriver_Gc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Gc_Call) Return(_a0 error) *UserDriver_Gc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_Gc_Call) RunAndReturn(run func(int) error) *UserDriver_Gc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: path, name
+func (_m *UserDriver) Open(path string, name string) error {
+	ret := _m.Called(path, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type UserDriver_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - path string
+//   - name string
+func (_e *UserDriver_Expecter) Open(path interface{}, name interface{}) *UserDriver_Open_Call {
+	return &UserDriver_Open_Call{Call: _e.mock.On("Open", path, name)}
+}
+
+func (_c *UserDriver_Open_Call) Run(run func(path string, name string)) *UserDriver_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Open_Call) Return(_a0 error) *UserDriver_Open_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_Open_Call) RunAndReturn(run func(string, string) error) *UserDriver_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: id
+func (_m *UserDriver) Read(id string) (string, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserDriver_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type UserDriver_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - id string
+func (_e *UserDriver_Expecter) Read(id interface{}) *UserDriver_Read_Call {
+	return &UserDriver_Read_Call{Call: _e.mock.On("Read", id)}
+}
+
+func (_c *UserDriver_Read_Call) Run(run func(id string)) *UserDriver_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Read_Call) Return(_a0 string, _a1 error) *UserDriver_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserDriver_Read_Call) RunAndReturn(run func(string) (string, error)) *UserDriver_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sessions provides a mock function with given fields: userID
+func (_m *UserDriver) Sessions(userID string) ([]session.Activity, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sessions")
+	}
+
+	var r0 []session.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]session.Activity, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []session.Activity); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]session.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserDriver_Sessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sessions'
+type UserDriver_Sessions_Call struct {
+	*mock.Call
+}
+
+// Sessions is a helper method to define mock.On call
+//   - userID string
+func (_e *UserDriver_Expecter) Sessions(userID interface{}) *UserDriver_Sessions_Call {
+	return &UserDriver_Sessions_Call{Call: _e.mock.On("Sessions", userID)}
+}
+
+func (_c *UserDriver_Sessions_Call) Run(run func(userID string)) *UserDriver_Sessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Sessions_Call) Return(_a0 []session.Activity, _a1 error) *UserDriver_Sessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserDriver_Sessions_Call) RunAndReturn(run func(string) ([]session.Activity, error)) *UserDriver_Sessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: id, data
+func (_m *UserDriver) Write(id string, data string) error {
+	ret := _m.Called(id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserDriver_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type UserDriver_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - id string
+//   - data string
+func (_e *UserDriver_Expecter) Write(id interface{}, data interface{}) *UserDriver_Write_Call {
+	return &UserDriver_Write_Call{Call: _e.mock.On("Write", id, data)}
+}
+
+func (_c *UserDriver_Write_Call) Run(run func(id string, data string)) *UserDriver_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserDriver_Write_Call) Return(_a0 error) *UserDriver_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserDriver_Write_Call) RunAndReturn(run func(string, string) error) *UserDriver_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserDriver creates a new instance of UserDriver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserDriver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserDriver {
+	mock := &UserDriver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
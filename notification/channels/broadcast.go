@@ -0,0 +1,156 @@
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	contractsbroadcasting "github.com/goravel/framework/contracts/broadcasting"
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/errors"
+)
+
+// BroadcastChannel pushes notifications through Goravel's broadcasting facade.
+type BroadcastChannel struct {
+	broadcast contractsbroadcasting.Broadcast
+}
+
+func NewBroadcastChannel(broadcast contractsbroadcasting.Broadcast) *BroadcastChannel {
+	return &BroadcastChannel{broadcast: broadcast}
+}
+
+func (c *BroadcastChannel) Name() string { return contractsnotification.ChannelBroadcast }
+
+func (c *BroadcastChannel) Send(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) error {
+	route, payload, err := c.Resolve(notifiable, n)
+	if err != nil {
+		return err
+	}
+	return c.Deliver(route, payload)
+}
+
+// Resolve builds the BroadcastMessage — via ToBroadcast() if the
+// notification implements BroadcastNotification, else a payload carrying
+// only the notification's id and type — and JSON-encodes it. The id and
+// type are added here, while the notification is still live, so listeners
+// can tell notifications apart the same way Laravel's
+// BroadcastNotificationCreated event lets them.
+func (c *BroadcastChannel) Resolve(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) (string, []byte, error) {
+	var msg contractsnotification.BroadcastMessage
+	if bn, ok := n.(contractsnotification.BroadcastNotification); ok {
+		msg = bn.ToBroadcast(notifiable)
+	}
+	if len(msg.Channels) == 0 {
+		msg.Channels = c.resolveChannels(notifiable, n)
+	}
+	if len(msg.Channels) == 0 {
+		return "", nil, errors.NotificationBroadcastEmptyRoute.Args(notifiable)
+	}
+	if msg.Event == "" {
+		msg.Event = reflect.Indirect(reflect.ValueOf(n)).Type().Name()
+	}
+
+	data := make(map[string]any, len(msg.Data)+2)
+	for key, value := range msg.Data {
+		data[key] = value
+	}
+	if _, ok := data["id"]; !ok {
+		if withID, ok := n.(contractsnotification.NotificationWithID); ok {
+			data["id"] = withID.ID()
+		}
+	}
+	if _, ok := data["type"]; !ok {
+		data["type"] = fmt.Sprintf("%T", n)
+	}
+	msg.Data = data
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return "", nil, errors.NotificationBroadcastMarshalPayloadFailed.Args(n, err)
+	}
+
+	return strings.Join(msg.Channels, ","), payload, nil
+}
+
+// resolveChannels prefers BroadcastRoutable when the notifiable implements
+// it, falling back to RouteNotificationFor(ChannelBroadcast), which
+// accepts a single channel (string) or multiple channels ([]string). Any
+// other type is treated as no route.
+func (c *BroadcastChannel) resolveChannels(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) []string {
+	if br, ok := notifiable.(contractsnotification.BroadcastRoutable); ok {
+		if channels := br.RouteNotificationForBroadcast(n); len(channels) > 0 {
+			return channels
+		}
+	}
+
+	switch channels := notifiable.RouteNotificationFor(contractsnotification.ChannelBroadcast).(type) {
+	case string:
+		if channels != "" {
+			return []string{channels}
+		}
+	case []string:
+		return channels
+	}
+
+	return nil
+}
+
+// Deliver unmarshals payload and dispatches it immediately: a queued
+// notification is already running on a worker at this point, so the
+// event must not be queued a second time by the broadcasting facade.
+func (c *BroadcastChannel) Deliver(route string, payload []byte) error {
+	if route == "" {
+		return nil
+	}
+
+	var msg contractsnotification.BroadcastMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return errors.NotificationBroadcastUnmarshalPayloadFailed.Args(err)
+	}
+
+	channels := msg.Channels
+	if len(channels) == 0 {
+		channels = strings.Split(route, ",")
+	}
+
+	event := &NotificationBroadcastEvent{
+		channels: channels,
+		event:    msg.Event,
+		data:     msg.Data,
+	}
+
+	if err := c.broadcast.Dispatch(context.Background(), event); err != nil {
+		return errors.NotificationBroadcastSendFailed.Args(err)
+	}
+	return nil
+}
+
+// NotificationBroadcastEvent adapts a BroadcastMessage into
+// contractsbroadcasting.ShouldBroadcast.
+type NotificationBroadcastEvent struct {
+	channels []string
+	event    string
+	data     map[string]any
+}
+
+func (e *NotificationBroadcastEvent) BroadcastOn() []string         { return e.channels }
+func (e *NotificationBroadcastEvent) BroadcastAs() string           { return e.event }
+func (e *NotificationBroadcastEvent) BroadcastWith() map[string]any { return e.data }
+func (e *NotificationBroadcastEvent) BroadcastWhen() bool           { return true }
+func (e *NotificationBroadcastEvent) BroadcastNow() bool            { return true }
+
+var (
+	_ contractsnotification.ResolvableChannel  = (*BroadcastChannel)(nil)
+	_ contractsbroadcasting.ShouldBroadcastNow = (*NotificationBroadcastEvent)(nil)
+)
@@ -0,0 +1,152 @@
+package channels_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsbroadcasting "github.com/goravel/framework/contracts/broadcasting"
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+	mocksbroadcasting "github.com/goravel/framework/mocks/broadcasting"
+	"github.com/goravel/framework/notification/channels"
+)
+
+// ---- Fakes ----
+
+type broadcastNotifiable struct{ route any }
+
+func (b broadcastNotifiable) RouteNotificationFor(channel string) any {
+	if channel == contractsnotification.ChannelBroadcast {
+		return b.route
+	}
+	return nil
+}
+
+// typedBroadcastNotifiable implements BroadcastRoutable, falling back to
+// broadcastNotifiable's route when typed is empty.
+type typedBroadcastNotifiable struct {
+	broadcastNotifiable
+	typed []string
+}
+
+func (t typedBroadcastNotifiable) RouteNotificationForBroadcast(_ contractsnotification.Notification) []string {
+	return t.typed
+}
+
+// InvoicePaid does NOT implement BroadcastNotification — tests the fallback payload.
+type InvoicePaid struct{}
+
+func (i *InvoicePaid) Via(_ contractsnotification.Notifiable) []string {
+	return []string{contractsnotification.ChannelBroadcast}
+}
+func (i *InvoicePaid) ID() string { return "invoice-1" }
+
+type richBroadcastNotification struct {
+	msg contractsnotification.BroadcastMessage
+}
+
+func (r *richBroadcastNotification) Via(_ contractsnotification.Notifiable) []string {
+	return []string{contractsnotification.ChannelBroadcast}
+}
+func (r *richBroadcastNotification) ToBroadcast(_ contractsnotification.Notifiable) contractsnotification.BroadcastMessage {
+	return r.msg
+}
+
+// ---- Tests ----
+
+func TestBroadcastChannel_Name(t *testing.T) {
+	ch := channels.NewBroadcastChannel(nil)
+	assert.Equal(t, contractsnotification.ChannelBroadcast, ch.Name())
+}
+
+func TestBroadcastChannel_Send_DispatchesDefaultPayload(t *testing.T) {
+	broadcast := mocksbroadcasting.NewBroadcast(t)
+	broadcast.EXPECT().Dispatch(mock.Anything, mock.MatchedBy(func(event contractsbroadcasting.ShouldBroadcast) bool {
+		now, ok := event.(contractsbroadcasting.ShouldBroadcastNow)
+		return ok && now.BroadcastNow() && event.BroadcastWhen() &&
+			assert.ObjectsAreEqual([]string{"private-users.1"}, event.BroadcastOn()) &&
+			event.BroadcastAs() == "InvoicePaid" &&
+			assert.ObjectsAreEqual(map[string]any{"id": "invoice-1", "type": "*channels_test.InvoicePaid"}, event.BroadcastWith())
+	})).Return(nil).Once()
+
+	ch := channels.NewBroadcastChannel(broadcast)
+
+	assert.NoError(t, ch.Send(broadcastNotifiable{route: "private-users.1"}, &InvoicePaid{}))
+}
+
+func TestBroadcastChannel_Send_DispatchesCustomMessage(t *testing.T) {
+	broadcast := mocksbroadcasting.NewBroadcast(t)
+	broadcast.EXPECT().Dispatch(mock.Anything, mock.MatchedBy(func(event contractsbroadcasting.ShouldBroadcast) bool {
+		return assert.ObjectsAreEqual([]string{"orders"}, event.BroadcastOn()) &&
+			event.BroadcastAs() == "order.shipped" &&
+			assert.ObjectsAreEqual(map[string]any{"order_id": float64(1), "type": "shipped"}, event.BroadcastWith())
+	})).Return(nil).Once()
+
+	ch := channels.NewBroadcastChannel(broadcast)
+	n := &richBroadcastNotification{msg: contractsnotification.BroadcastMessage{
+		Channels: []string{"orders"},
+		Event:    "order.shipped",
+		Data:     map[string]any{"order_id": 1, "type": "shipped"},
+	}}
+
+	// The message's channels take precedence over the notifiable's route.
+	assert.NoError(t, ch.Send(broadcastNotifiable{route: "private-users.1"}, n))
+}
+
+func TestBroadcastChannel_Resolve_Routes(t *testing.T) {
+	ch := channels.NewBroadcastChannel(nil)
+
+	tests := []struct {
+		name       string
+		notifiable contractsnotification.Notifiable
+		expected   string
+	}{
+		{name: "string", notifiable: broadcastNotifiable{route: "users.1"}, expected: "users.1"},
+		{name: "slice", notifiable: broadcastNotifiable{route: []string{"users.1", "admins"}}, expected: "users.1,admins"},
+		{name: "typed", notifiable: typedBroadcastNotifiable{broadcastNotifiable{route: "users.1"}, []string{"typed"}}, expected: "typed"},
+		{name: "typed empty falls back", notifiable: typedBroadcastNotifiable{broadcastNotifiable{route: "users.1"}, nil}, expected: "users.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, _, err := ch.Resolve(tt.notifiable, &InvoicePaid{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, route)
+		})
+	}
+}
+
+func TestBroadcastChannel_Send_ReturnsError_WhenNoChannels(t *testing.T) {
+	ch := channels.NewBroadcastChannel(nil) // no dispatch expected
+
+	err := ch.Send(broadcastNotifiable{route: 1}, &InvoicePaid{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "returned no channels")
+}
+
+func TestBroadcastChannel_Send_WrapsDispatchError(t *testing.T) {
+	broadcast := mocksbroadcasting.NewBroadcast(t)
+	broadcast.EXPECT().Dispatch(mock.Anything, mock.Anything).Return(errors.New("pusher down")).Once()
+
+	ch := channels.NewBroadcastChannel(broadcast)
+
+	err := ch.Send(broadcastNotifiable{route: "users.1"}, &InvoicePaid{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "pusher down")
+}
+
+func TestBroadcastChannel_Deliver_ReturnsError_WhenPayloadMalformed(t *testing.T) {
+	ch := channels.NewBroadcastChannel(nil)
+
+	err := ch.Deliver("users.1", []byte("not json"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal payload")
+}
+
+func TestBroadcastChannel_Deliver_NoOp_WhenRouteEmpty(t *testing.T) {
+	ch := channels.NewBroadcastChannel(nil)
+
+	assert.NoError(t, ch.Deliver("", nil))
+}
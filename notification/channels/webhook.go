@@ -0,0 +1,162 @@
+package channels
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/http/client"
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/carbon"
+)
+
+const (
+	// WebhookSignatureHeader carries "sha256=" + the hex HMAC of the request.
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookTimestampHeader carries the Unix seconds the signature covers,
+	// so receivers can reject replayed requests.
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+)
+
+// WebhookChannel POSTs notifications as JSON via Goravel's HTTP client,
+// e.g. to Slack incoming webhooks or any endpoint of another service.
+type WebhookChannel struct {
+	config config.Config
+	http   client.Factory
+}
+
+func NewWebhookChannel(http client.Factory, config config.Config) *WebhookChannel {
+	return &WebhookChannel{config: config, http: http}
+}
+
+func (c *WebhookChannel) Name() string { return contractsnotification.ChannelWebhook }
+
+func (c *WebhookChannel) Send(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) error {
+	route, payload, err := c.Resolve(notifiable, n)
+	if err != nil {
+		return err
+	}
+	return c.Deliver(route, payload)
+}
+
+// resolvedWebhook is the WebhookMessage with its Payload already encoded,
+// so Deliver POSTs exactly the bytes Resolve produced instead of whatever
+// a map[string]any round trip would make of the notification's types.
+// Only the config key of the secret is kept, since the payload of a queued
+// notification is stored in the jobs and failed_jobs tables.
+type resolvedWebhook struct {
+	Body      json.RawMessage   `json:"body"`
+	Headers   map[string]string `json:"headers"`
+	SecretKey string            `json:"secret_key,omitempty"`
+}
+
+// Resolve builds the WebhookMessage — via ToWebhook() if the notification
+// implements WebhookNotification, else a payload carrying only the
+// notification's type — and resolves the URL, preferring the message's
+// own Url, then WebhookRoutable, then RouteNotificationFor(ChannelWebhook).
+func (c *WebhookChannel) Resolve(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) (string, []byte, error) {
+	var msg contractsnotification.WebhookMessage
+	if wn, ok := n.(contractsnotification.WebhookNotification); ok {
+		msg = wn.ToWebhook(notifiable)
+	} else {
+		msg.Payload = map[string]any{"type": fmt.Sprintf("%T", n)}
+	}
+
+	url := msg.Url
+	if url == "" {
+		if wr, ok := notifiable.(contractsnotification.WebhookRoutable); ok {
+			url = wr.RouteNotificationForWebhook(n)
+		}
+	}
+	if url == "" {
+		url, _ = notifiable.RouteNotificationFor(contractsnotification.ChannelWebhook).(string)
+	}
+	if url == "" {
+		return "", nil, errors.NotificationWebhookEmptyRoute.Args(notifiable)
+	}
+
+	body, err := json.Marshal(msg.Payload)
+	if err != nil {
+		return "", nil, errors.NotificationWebhookMarshalPayloadFailed.Args(n, err)
+	}
+
+	payload, err := json.Marshal(resolvedWebhook{
+		Body:      body,
+		Headers:   msg.Headers,
+		SecretKey: msg.SecretKey,
+	})
+	if err != nil {
+		return "", nil, errors.NotificationWebhookMarshalPayloadFailed.Args(n, err)
+	}
+
+	return url, payload, nil
+}
+
+// Deliver unmarshals payload and POSTs its body to route. The secret is
+// read and the signature is computed here rather than in Resolve, so the
+// secret isn't queued and the timestamp of a queued notification reflects
+// when it's actually sent.
+func (c *WebhookChannel) Deliver(route string, payload []byte) error {
+	if route == "" {
+		return nil
+	}
+
+	var webhook resolvedWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return errors.NotificationWebhookUnmarshalPayloadFailed.Args(err)
+	}
+
+	headers := map[string]string{"Content-Type": "application/json"}
+	for key, value := range webhook.Headers {
+		headers[key] = value
+	}
+	if webhook.SecretKey != "" {
+		if c.config == nil {
+			return errors.ConfigFacadeNotSet.SetModule(errors.ModuleNotification)
+		}
+
+		secret := c.config.GetString(webhook.SecretKey)
+		if secret == "" {
+			return errors.NotificationWebhookSecretEmpty.Args(webhook.SecretKey)
+		}
+
+		timestamp := strconv.FormatInt(carbon.Now().Timestamp(), 10)
+		headers[WebhookTimestampHeader] = timestamp
+		headers[WebhookSignatureHeader] = "sha256=" + SignWebhook(secret, timestamp, webhook.Body)
+	}
+
+	response, err := c.http.Client().WithHeaders(headers).Post(route, bytes.NewReader(webhook.Body))
+	if err != nil {
+		return errors.NotificationWebhookSendFailed.Args(err)
+	}
+	if response.Failed() {
+		return errors.NotificationWebhookRequestFailed.Args(route, response.Status())
+	}
+
+	return nil
+}
+
+// SignWebhook returns the hex HMAC-SHA256 of timestamp + "." + body, the
+// receivers can recompute it with the shared secret to verify a request.
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ contractsnotification.ResolvableChannel = (*WebhookChannel)(nil)
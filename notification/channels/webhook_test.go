@@ -0,0 +1,227 @@
+package channels_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsclient "github.com/goravel/framework/contracts/http/client"
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksclient "github.com/goravel/framework/mocks/http/client"
+	"github.com/goravel/framework/notification/channels"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// ---- Fakes ----
+
+type webhookNotifiable struct{ url any }
+
+func (w webhookNotifiable) RouteNotificationFor(channel string) any {
+	if channel == contractsnotification.ChannelWebhook {
+		return w.url
+	}
+	return nil
+}
+
+// typedWebhookNotifiable implements WebhookRoutable, falling back to
+// webhookNotifiable's route when typed is empty.
+type typedWebhookNotifiable struct {
+	webhookNotifiable
+	typed string
+}
+
+func (t typedWebhookNotifiable) RouteNotificationForWebhook(_ contractsnotification.Notification) string {
+	return t.typed
+}
+
+// plainWebhookNotification does NOT implement WebhookNotification — tests the fallback payload.
+type plainWebhookNotification struct{}
+
+func (p *plainWebhookNotification) Via(_ contractsnotification.Notifiable) []string {
+	return []string{contractsnotification.ChannelWebhook}
+}
+
+type richWebhookNotification struct {
+	msg contractsnotification.WebhookMessage
+}
+
+func (r *richWebhookNotification) Via(_ contractsnotification.Notifiable) []string {
+	return []string{contractsnotification.ChannelWebhook}
+}
+func (r *richWebhookNotification) ToWebhook(_ contractsnotification.Notifiable) contractsnotification.WebhookMessage {
+	return r.msg
+}
+
+// expectPost wires factory → request → response and captures the body
+// and headers the channel POSTed.
+func expectPost(t *testing.T, url string, failed bool, status int) (*mocksclient.Factory, *string, *map[string]string) {
+	var (
+		body    string
+		headers map[string]string
+	)
+
+	response := mocksclient.NewResponse(t)
+	response.EXPECT().Failed().Return(failed).Once()
+	if failed {
+		response.EXPECT().Status().Return(status).Once()
+	}
+
+	request := mocksclient.NewRequest(t)
+	request.EXPECT().WithHeaders(mock.Anything).RunAndReturn(func(h map[string]string) contractsclient.Request {
+		headers = h
+		return request
+	}).Once()
+	request.EXPECT().Post(url, mock.Anything).RunAndReturn(func(_ string, reader io.Reader) (contractsclient.Response, error) {
+		content, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		body = string(content)
+		return response, nil
+	}).Once()
+
+	factory := mocksclient.NewFactory(t)
+	factory.EXPECT().Client().Return(request).Once()
+
+	return factory, &body, &headers
+}
+
+// ---- Tests ----
+
+func TestWebhookChannel_Name(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+	assert.Equal(t, contractsnotification.ChannelWebhook, ch.Name())
+}
+
+func TestWebhookChannel_Send_PostsDefaultPayload(t *testing.T) {
+	factory, body, headers := expectPost(t, "https://example.com/hook", false, 0)
+	ch := channels.NewWebhookChannel(factory, nil)
+
+	assert.NoError(t, ch.Send(webhookNotifiable{url: "https://example.com/hook"}, &plainWebhookNotification{}))
+	assert.JSONEq(t, `{"type":"*channels_test.plainWebhookNotification"}`, *body)
+	assert.Equal(t, map[string]string{"Content-Type": "application/json"}, *headers)
+}
+
+func TestWebhookChannel_Send_PostsSignedCustomMessage(t *testing.T) {
+	carbon.SetTestNow(carbon.FromStdTime(time.Unix(1700000000, 0)))
+	defer carbon.ClearTestNow()
+
+	factory, body, headers := expectPost(t, "https://hooks.slack.com/services/T000", false, 0)
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("services.slack.webhook_secret").Return("secret").Once()
+	ch := channels.NewWebhookChannel(factory, mockConfig)
+	n := &richWebhookNotification{msg: contractsnotification.WebhookMessage{
+		Url:       "https://hooks.slack.com/services/T000",
+		Payload:   map[string]any{"text": "Invoice 1 paid"},
+		Headers:   map[string]string{"X-Source": "goravel"},
+		SecretKey: "services.slack.webhook_secret",
+	}}
+
+	// The message's URL takes precedence over the notifiable's route.
+	assert.NoError(t, ch.Send(webhookNotifiable{url: "https://example.com/hook"}, n))
+	assert.JSONEq(t, `{"text":"Invoice 1 paid"}`, *body)
+
+	timestamp := "1700000000"
+	assert.Equal(t, map[string]string{
+		"Content-Type":                  "application/json",
+		"X-Source":                      "goravel",
+		channels.WebhookTimestampHeader: timestamp,
+		channels.WebhookSignatureHeader: "sha256=" + channels.SignWebhook("secret", timestamp, []byte(*body)),
+	}, *headers)
+}
+
+func TestWebhookChannel_Resolve_KeepsOnlyTheSecretKey(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+	n := &richWebhookNotification{msg: contractsnotification.WebhookMessage{
+		Payload:   map[string]any{"text": "Invoice 1 paid"},
+		SecretKey: "services.slack.webhook_secret",
+	}}
+
+	_, payload, err := ch.Resolve(webhookNotifiable{url: "https://example.com/hook"}, n)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"body":{"text":"Invoice 1 paid"},"headers":null,"secret_key":"services.slack.webhook_secret"}`, string(payload))
+}
+
+func TestWebhookChannel_Deliver_ReturnsError_WhenSecretEmpty(t *testing.T) {
+	payload := []byte(`{"body":{},"secret_key":"services.slack.webhook_secret"}`)
+
+	err := channels.NewWebhookChannel(nil, nil).Deliver("https://example.com/hook", payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "config facade is not initialized")
+
+	mockConfig := mocksconfig.NewConfig(t)
+	mockConfig.EXPECT().GetString("services.slack.webhook_secret").Return("").Once()
+
+	err = channels.NewWebhookChannel(nil, mockConfig).Deliver("https://example.com/hook", payload)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the secret of config key services.slack.webhook_secret is empty")
+}
+
+func TestWebhookChannel_Resolve_Routes(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+
+	route, _, err := ch.Resolve(typedWebhookNotifiable{webhookNotifiable{url: "https://fallback"}, "https://typed"}, &plainWebhookNotification{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://typed", route)
+
+	route, _, err = ch.Resolve(typedWebhookNotifiable{webhookNotifiable{url: "https://fallback"}, ""}, &plainWebhookNotification{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://fallback", route)
+}
+
+func TestWebhookChannel_Send_ReturnsError_WhenEmptyURL(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil) // no request expected
+
+	err := ch.Send(webhookNotifiable{url: 1}, &plainWebhookNotification{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "empty URL")
+}
+
+func TestWebhookChannel_Send_ReturnsError_WhenPayloadUnmarshalable(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+	n := &richWebhookNotification{msg: contractsnotification.WebhookMessage{Payload: make(chan int)}}
+
+	err := ch.Send(webhookNotifiable{url: "https://example.com/hook"}, n)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to marshal payload")
+}
+
+func TestWebhookChannel_Send_ReturnsError_WhenRequestFails(t *testing.T) {
+	request := mocksclient.NewRequest(t)
+	request.EXPECT().WithHeaders(mock.Anything).Return(request).Once()
+	request.EXPECT().Post("https://example.com/hook", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	factory := mocksclient.NewFactory(t)
+	factory.EXPECT().Client().Return(request).Once()
+
+	ch := channels.NewWebhookChannel(factory, nil)
+
+	err := ch.Send(webhookNotifiable{url: "https://example.com/hook"}, &plainWebhookNotification{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+}
+
+func TestWebhookChannel_Send_ReturnsError_WhenResponseFailed(t *testing.T) {
+	factory, _, _ := expectPost(t, "https://example.com/hook", true, 500)
+	ch := channels.NewWebhookChannel(factory, nil)
+
+	err := ch.Send(webhookNotifiable{url: "https://example.com/hook"}, &plainWebhookNotification{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed with status 500")
+}
+
+func TestWebhookChannel_Deliver_ReturnsError_WhenPayloadMalformed(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+
+	err := ch.Deliver("https://example.com/hook", []byte("not json"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal payload")
+}
+
+func TestWebhookChannel_Deliver_NoOp_WhenRouteEmpty(t *testing.T) {
+	ch := channels.NewWebhookChannel(nil, nil)
+
+	assert.NoError(t, ch.Deliver("", nil))
+}
@@ -0,0 +1,130 @@
+package notification
+
+import (
+	"reflect"
+	"sync"
+
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+)
+
+var _ contractsnotification.Fake = (*Fake)(nil)
+
+// Fake records the notifications sent via its Manager, including the ones
+// sent via the facade and the on-demand notifiables, instead of delivering them.
+type Fake struct {
+	contractsnotification.Manager
+
+	manager *Manager
+	sent    []sentNotification
+	mu      sync.RWMutex
+}
+
+type sentNotification struct {
+	notifiable   contractsnotification.Notifiable
+	notification contractsnotification.Notification
+	channels     []string
+}
+
+func NewFake(manager *Manager) *Fake {
+	return &Fake{
+		Manager: manager,
+		manager: manager,
+	}
+}
+
+func (r *Fake) AssertCount(count int) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.sent) == count
+}
+
+func (r *Fake) AssertNothingSent() bool {
+	return r.AssertCount(0)
+}
+
+func (r *Fake) AssertNotSentTo(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+	assertion ...func(notification contractsnotification.Notification, channels []string) bool,
+) bool {
+	return !r.AssertSentTo(notifiable, n, assertion...)
+}
+
+func (r *Fake) AssertSentTo(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+	assertion ...func(notification contractsnotification.Notification, channels []string) bool,
+) bool {
+	for _, sent := range r.sentTo(notifiable, n) {
+		if len(assertion) == 0 || assertion[0](sent.notification, sent.channels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Fake returns the fake itself, the recorded notifications are kept.
+func (r *Fake) Fake() contractsnotification.Fake {
+	return r
+}
+
+func (r *Fake) Reset() {
+	r.manager.fake.CompareAndSwap(r, nil)
+}
+
+func (r *Fake) Sent(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) []contractsnotification.Notification {
+	var notifications []contractsnotification.Notification
+	for _, sent := range r.sentTo(notifiable, n) {
+		notifications = append(notifications, sent.notification)
+	}
+
+	return notifications
+}
+
+// record keeps the notification with the channels it would be delivered
+// on, the channels skipped by ShouldSend are excluded like the dispatch does.
+func (r *Fake) record(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) {
+	shouldSend, _ := n.(contractsnotification.NotificationWithShouldSend)
+
+	var channels []string
+	for _, name := range n.Via(notifiable) {
+		if shouldSend != nil && !shouldSend.ShouldSend(notifiable, name) {
+			continue
+		}
+		channels = append(channels, name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sent = append(r.sent, sentNotification{
+		notifiable:   notifiable,
+		notification: n,
+		channels:     channels,
+	})
+}
+
+func (r *Fake) sentTo(
+	notifiable contractsnotification.Notifiable,
+	n contractsnotification.Notification,
+) []sentNotification {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []sentNotification
+	for _, sent := range r.sent {
+		if reflect.TypeOf(sent.notification) == reflect.TypeOf(n) && reflect.DeepEqual(sent.notifiable, notifiable) {
+			matched = append(matched, sent)
+		}
+	}
+
+	return matched
+}
@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsnotification "github.com/goravel/framework/contracts/notification"
+	mockslog "github.com/goravel/framework/mocks/log"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+type otherNotification struct{}
+
+func (o *otherNotification) Via(_ contractsnotification.Notifiable) []string {
+	return []string{contractsnotification.ChannelMail}
+}
+
+func TestFake(t *testing.T) {
+	// No channel is registered and the queue isn't called, so nothing can
+	// be delivered while the fake is set.
+	manager := NewManager(mockslog.NewLog(t), mocksqueue.NewQueue(t))
+	notificationFake := manager.Fake()
+	t.Cleanup(notificationFake.Reset)
+
+	alice := &fakeNotifiable{email: "alice@example.com"}
+	bob := &fakeNotifiable{email: "bob@example.com"}
+
+	assert.True(t, notificationFake.AssertNothingSent())
+
+	// The notifications sent via the manager are captured as well.
+	assert.NoError(t, manager.Send(alice, &shouldQueueNotification{channels: []string{contractsnotification.ChannelMail}}))
+	assert.NoError(t, notificationFake.SendNow(alice, &shouldSendNotification{
+		channels: []string{contractsnotification.ChannelMail, contractsnotification.ChannelDatabase},
+		skip:     map[string]bool{contractsnotification.ChannelMail: true},
+	}))
+	assert.NoError(t, manager.Route(contractsnotification.ChannelMail, "carol@example.com").Notify(&otherNotification{}))
+
+	assert.True(t, notificationFake.AssertCount(3))
+	assert.False(t, notificationFake.AssertNothingSent())
+
+	// The notifiables are compared by value, the notifications by type.
+	assert.True(t, notificationFake.AssertSentTo(&fakeNotifiable{email: "alice@example.com"}, &shouldQueueNotification{}))
+	assert.True(t, notificationFake.AssertNotSentTo(bob, &shouldQueueNotification{}))
+	assert.True(t, notificationFake.AssertNotSentTo(alice, &otherNotification{}))
+
+	// The channels skipped by ShouldSend are excluded.
+	assert.True(t, notificationFake.AssertSentTo(alice, &shouldSendNotification{}, func(_ contractsnotification.Notification, channels []string) bool {
+		return assert.ObjectsAreEqual([]string{contractsnotification.ChannelDatabase}, channels)
+	}))
+	assert.True(t, notificationFake.AssertNotSentTo(alice, &shouldQueueNotification{}, func(n contractsnotification.Notification, _ []string) bool {
+		return len(n.(*shouldQueueNotification).channels) == 0
+	}))
+
+	assert.Len(t, notificationFake.Sent(alice, &shouldQueueNotification{}), 1)
+	assert.Empty(t, notificationFake.Sent(bob, &shouldQueueNotification{}))
+	assert.Same(t, notificationFake, notificationFake.Fake())
+
+	notificationFake.Reset()
+	assert.Nil(t, manager.fake.Load())
+}
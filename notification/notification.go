@@ -2,6 +2,7 @@ package notification
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/goravel/framework/contracts/log"
 	contractsnotification "github.com/goravel/framework/contracts/notification"
@@ -14,6 +15,9 @@ type Manager struct {
 	channels map[string]contractsnotification.Channel
 	log      log.Log
 	queue    queue.Queue
+
+	// fake records the notifications instead of delivering them while it's set.
+	fake atomic.Pointer[Fake]
 }
 
 func NewManager(logger log.Log, q queue.Queue) *Manager {
@@ -42,6 +46,13 @@ func (m *Manager) Channel(name string) contractsnotification.Channel {
 	return ch
 }
 
+func (m *Manager) Fake() contractsnotification.Fake {
+	f := NewFake(m)
+	m.fake.Store(f)
+
+	return f
+}
+
 func (m *Manager) Route(channel string, route any) contractsnotification.OnDemandNotifiable {
 	return &onDemandNotifiable{
 		manager: m,
@@ -53,6 +64,11 @@ func (m *Manager) Send(
 	notifiable contractsnotification.Notifiable,
 	n contractsnotification.Notification,
 ) error {
+	if f := m.fake.Load(); f != nil {
+		f.record(notifiable, n)
+		return nil
+	}
+
 	if sq, ok := n.(contractsnotification.ShouldQueue); ok && m.queue != nil {
 		return m.dispatchQueued(notifiable, n, sq)
 	}
@@ -63,6 +79,11 @@ func (m *Manager) SendNow(
 	notifiable contractsnotification.Notifiable,
 	n contractsnotification.Notification,
 ) error {
+	if f := m.fake.Load(); f != nil {
+		f.record(notifiable, n)
+		return nil
+	}
+
 	return m.dispatchSync(notifiable, n)
 }
 
@@ -49,6 +49,15 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 		manager.Extend(channels.NewMailChannel(mail))
 		manager.Extend(channels.NewDatabaseChannel(orm))
 
+		// The broadcast and webhook channels are optional, they are only
+		// available when the broadcasting and http client facades are.
+		if broadcast := app.MakeBroadcast(); broadcast != nil {
+			manager.Extend(channels.NewBroadcastChannel(broadcast))
+		}
+		if http := app.MakeHttp(); http != nil {
+			manager.Extend(channels.NewWebhookChannel(http, app.MakeConfig()))
+		}
+
 		return manager, nil
 	})
 }
@@ -8,13 +8,18 @@ import (
 
 	"github.com/goravel/framework/contracts/binding"
 	contractsfoundation "github.com/goravel/framework/contracts/foundation"
+	contractsnotification "github.com/goravel/framework/contracts/notification"
 	"github.com/goravel/framework/errors"
+	mocksbroadcasting "github.com/goravel/framework/mocks/broadcasting"
+	mocksconfig "github.com/goravel/framework/mocks/config"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	mocksclient "github.com/goravel/framework/mocks/http/client"
 	mockslog "github.com/goravel/framework/mocks/log"
 	mocksmail "github.com/goravel/framework/mocks/mail"
 	mocksnotification "github.com/goravel/framework/mocks/notification"
 	mocksqueue "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/notification/channels"
 )
 
 func TestServiceProviderRelationship(t *testing.T) {
@@ -118,11 +123,42 @@ func TestServiceProviderRegister(t *testing.T) {
 			callbackApp.EXPECT().MakeMail().Return(mailer).Once()
 			callbackApp.EXPECT().MakeOrm().Return(o).Once()
 			callbackApp.EXPECT().MakeQueue().Return(q).Once()
+			callbackApp.EXPECT().MakeBroadcast().Return(nil).Once()
+			callbackApp.EXPECT().MakeHttp().Return(nil).Once()
 
 			instance, err := callback(callbackApp)
 
 			assert.NoError(t, err)
 			assert.IsType(t, &Manager{}, instance)
+
+			manager := instance.(*Manager)
+			assert.NotNil(t, manager.channels[contractsnotification.ChannelMail])
+			assert.NotNil(t, manager.channels[contractsnotification.ChannelDatabase])
+			assert.NotContains(t, manager.channels, contractsnotification.ChannelBroadcast)
+			assert.NotContains(t, manager.channels, contractsnotification.ChannelWebhook)
+		}).Once()
+
+		provider.Register(app)
+	})
+
+	t.Run("register the broadcast and webhook channels", func(t *testing.T) {
+		app := mocksfoundation.NewApplication(t)
+		app.EXPECT().Singleton(binding.Notification, mock.AnythingOfType("func(foundation.Application) (interface {}, error)")).Run(func(_ any, callback func(contractsfoundation.Application) (any, error)) {
+			callbackApp := mocksfoundation.NewApplication(t)
+			callbackApp.EXPECT().MakeLog().Return(mockslog.NewLog(t)).Once()
+			callbackApp.EXPECT().MakeMail().Return(mocksmail.NewMail(t)).Once()
+			callbackApp.EXPECT().MakeOrm().Return(mocksorm.NewOrm(t)).Once()
+			callbackApp.EXPECT().MakeQueue().Return(mocksqueue.NewQueue(t)).Once()
+			callbackApp.EXPECT().MakeBroadcast().Return(mocksbroadcasting.NewBroadcast(t)).Once()
+			callbackApp.EXPECT().MakeHttp().Return(mocksclient.NewFactory(t)).Once()
+			callbackApp.EXPECT().MakeConfig().Return(mocksconfig.NewConfig(t)).Once()
+
+			instance, err := callback(callbackApp)
+
+			assert.NoError(t, err)
+			manager := instance.(*Manager)
+			assert.IsType(t, &channels.BroadcastChannel{}, manager.channels[contractsnotification.ChannelBroadcast])
+			assert.IsType(t, &channels.WebhookChannel{}, manager.channels[contractsnotification.ChannelWebhook])
 		}).Once()
 
 		provider.Register(app)
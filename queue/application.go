@@ -30,6 +30,12 @@ func NewApplication(config queue.Config, cache cache.Cache, db db.DB, job queue.
 	}
 }
 
+func (r *Application) Batch(jobs []queue.ChainJob) queue.PendingBatch {
+	repository, err := NewBatchRepository(r.config, r.db, r.jobStorer, r.json)
+
+	return NewPendingBatch(r.config, NewDriverCreator(r.config, r.cache, r.db, r.jobStorer, r.json, r.log), repository, err, r.json, jobs)
+}
+
 func (r *Application) Connection(name string) (queue.Driver, error) {
 	return NewDriverCreator(r.config, r.cache, r.db, r.jobStorer, r.json, r.log).Create(name)
 }
@@ -38,6 +44,15 @@ func (r *Application) Chain(jobs []queue.ChainJob) queue.PendingJob {
 	return NewPendingChainJob(r.config, r.cache, r.db, r.jobStorer, r.json, jobs, r.log)
 }
 
+func (r *Application) FindBatch(id string) (queue.Batch, error) {
+	repository, err := NewBatchRepository(r.config, r.db, r.jobStorer, r.json)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.Find(id)
+}
+
 func (r *Application) GetJob(signature string) (queue.Job, error) {
 	return r.jobStorer.Get(signature)
 }
@@ -68,7 +83,12 @@ func (r *Application) Worker(payloads ...queue.Args) queue.Worker {
 	defaultConcurrent := r.config.DefaultConcurrent()
 
 	if len(payloads) == 0 {
-		worker, err := NewWorker(r.config, r.cache, r.db, r.jobStorer, r.json, r.log, defaultConnection, defaultQueue, defaultConcurrent, 1)
+		worker, err := NewWorker(r.config, r.cache, r.db, r.jobStorer, r.json, r.log, queue.Args{
+			Connection: defaultConnection,
+			Queue:      defaultQueue,
+			Concurrent: defaultConcurrent,
+			Tries:      1,
+		})
 		if err != nil {
 			panic(err)
 		}
@@ -84,7 +104,7 @@ func (r *Application) Worker(payloads ...queue.Args) queue.Worker {
 		payloads[0].Concurrent = r.config.GetInt(fmt.Sprintf("queue.connections.%s.concurrent", payloads[0].Connection), 1)
 	}
 
-	worker, err := NewWorker(r.config, r.cache, r.db, r.jobStorer, r.json, r.log, payloads[0].Connection, payloads[0].Queue, payloads[0].Concurrent, payloads[0].Tries)
+	worker, err := NewWorker(r.config, r.cache, r.db, r.jobStorer, r.json, r.log, payloads[0])
 	if err != nil {
 		panic(err)
 	}
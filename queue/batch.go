@@ -0,0 +1,374 @@
+package queue
+
+import (
+	"strings"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	contractsfoundation "github.com/goravel/framework/contracts/foundation"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/queue/models"
+	"github.com/goravel/framework/support/carbon"
+)
+
+var _ contractsqueue.Batch = &Batch{}
+
+// BatchOptions are persisted with the batch so that any worker, not only the
+// dispatching process, is able to honour them.
+type BatchOptions struct {
+	Catch         []string `json:"catch,omitempty"`
+	Finally       []string `json:"finally,omitempty"`
+	Then          []string `json:"then,omitempty"`
+	AllowFailures bool     `json:"allow_failures"`
+}
+
+type Batch struct {
+	repository *BatchRepository
+	batch      models.JobBatch
+	options    BatchOptions
+}
+
+func NewBatch(batch models.JobBatch, repository *BatchRepository) *Batch {
+	var options BatchOptions
+	if batch.Options != "" {
+		// A malformed options column only loses the callbacks, the counters stay usable.
+		_ = repository.json.UnmarshalString(batch.Options, &options)
+	}
+
+	return &Batch{
+		repository: repository,
+		batch:      batch,
+		options:    options,
+	}
+}
+
+func (r *Batch) AllowsFailures() bool {
+	return r.options.AllowFailures
+}
+
+func (r *Batch) Cancel() error {
+	return r.repository.Cancel(r.batch.ID)
+}
+
+func (r *Batch) Cancelled() bool {
+	return r.batch.CancelledAt != nil
+}
+
+func (r *Batch) CancelledAt() *carbon.DateTime {
+	return r.batch.CancelledAt
+}
+
+func (r *Batch) CreatedAt() *carbon.DateTime {
+	return r.batch.CreatedAt
+}
+
+func (r *Batch) Delete() error {
+	return r.repository.Delete(r.batch.ID)
+}
+
+func (r *Batch) FailedJobIDs() []string {
+	if r.batch.FailedJobIDs == "" {
+		return nil
+	}
+
+	return strings.Split(r.batch.FailedJobIDs, ",")
+}
+
+func (r *Batch) FailedJobs() int {
+	return r.batch.FailedJobs
+}
+
+func (r *Batch) Finished() bool {
+	return r.batch.FinishedAt != nil
+}
+
+func (r *Batch) FinishedAt() *carbon.DateTime {
+	return r.batch.FinishedAt
+}
+
+func (r *Batch) Fresh() (contractsqueue.Batch, error) {
+	return r.repository.Find(r.batch.ID)
+}
+
+func (r *Batch) HasFailures() bool {
+	return r.batch.FailedJobs > 0
+}
+
+func (r *Batch) ID() string {
+	return r.batch.ID
+}
+
+func (r *Batch) Name() string {
+	return r.batch.Name
+}
+
+func (r *Batch) PendingJobs() int {
+	return r.batch.PendingJobs
+}
+
+func (r *Batch) ProcessedJobs() int {
+	return r.batch.TotalJobs - r.batch.PendingJobs
+}
+
+func (r *Batch) Progress() int {
+	if r.batch.TotalJobs == 0 {
+		return 0
+	}
+
+	return r.ProcessedJobs() * 100 / r.batch.TotalJobs
+}
+
+func (r *Batch) SkippedJobs() int {
+	return r.batch.SkippedJobs
+}
+
+func (r *Batch) TotalJobs() int {
+	return r.batch.TotalJobs
+}
+
+// allJobsRan determines if every job of the batch has succeeded, failed or been skipped,
+// the failed and skipped jobs are still counted as pending.
+func (r *Batch) allJobsRan() bool {
+	return r.batch.PendingJobs == r.batch.FailedJobs+r.batch.SkippedJobs
+}
+
+type BatchRepository struct {
+	db        contractsdb.DB
+	jobStorer contractsqueue.JobStorer
+	json      contractsfoundation.Json
+	table     string
+}
+
+func NewBatchRepository(config contractsqueue.Config, db contractsdb.DB, jobStorer contractsqueue.JobStorer, json contractsfoundation.Json) (*BatchRepository, error) {
+	database := config.BatchingDatabase()
+	if db == nil || database == "" {
+		return nil, errors.QueueBatchingNotConfigured
+	}
+
+	return &BatchRepository{
+		db:        db.Connection(database),
+		jobStorer: jobStorer,
+		json:      json,
+		table:     config.BatchingTable(),
+	}, nil
+}
+
+func (r *BatchRepository) Cancel(id string) error {
+	now := carbon.NewDateTime(carbon.Now())
+	_, err := r.db.Table(r.table).Where("id", id).Update(map[string]any{
+		"cancelled_at": now,
+		"finished_at":  now,
+	})
+
+	return err
+}
+
+// Cancelled determines if the batch has been cancelled, a deleted batch is
+// treated as cancelled so that its remaining jobs are skipped. The batch is
+// treated as running if it can't be read, the job then runs and its result
+// fails to be recorded instead of being skipped silently.
+func (r *BatchRepository) Cancelled(id string) bool {
+	var batch models.JobBatch
+	if err := r.db.Table(r.table).Where("id", id).First(&batch); err != nil {
+		return false
+	}
+
+	return batch.ID == "" || batch.CancelledAt != nil
+}
+
+func (r *BatchRepository) Delete(id string) error {
+	_, err := r.db.Table(r.table).Where("id", id).Delete()
+
+	return err
+}
+
+func (r *BatchRepository) Find(id string) (contractsqueue.Batch, error) {
+	var batch models.JobBatch
+	if err := r.db.Table(r.table).Where("id", id).First(&batch); err != nil {
+		return nil, err
+	}
+	if batch.ID == "" {
+		return nil, errors.QueueBatchNotFound.Args(id)
+	}
+
+	return NewBatch(batch, r), nil
+}
+
+// Record records the result of a batch job and calls the batch callbacks
+// when the result completes the batch.
+func (r *BatchRepository) Record(id, jobID string, jobErr error) error {
+	if jobErr != nil {
+		return r.recordFailedJob(id, jobID, jobErr)
+	}
+
+	return r.recordSuccessfulJob(id)
+}
+
+// RecordSkipped records a job skipped because the batch was cancelled, it
+// doesn't count as processed but calls the Finally callbacks once it is the
+// last job of the batch.
+func (r *BatchRepository) RecordSkipped(id string) error {
+	batch, err := r.update(id, func(batch *models.JobBatch, _ BatchOptions) map[string]any {
+		batch.SkippedJobs++
+
+		return map[string]any{
+			"skipped_jobs": batch.SkippedJobs,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if batch.allJobsRan() {
+		return r.call(batch.options.Finally, []any{id})
+	}
+
+	return nil
+}
+
+// RecordUnqueued removes the jobs that failed to be pushed from the batch and
+// cancels it, so the queued jobs are skipped and the Finally callbacks are
+// called once they have run.
+func (r *BatchRepository) RecordUnqueued(id string, count int) error {
+	batch, err := r.update(id, func(batch *models.JobBatch, _ BatchOptions) map[string]any {
+		batch.TotalJobs -= count
+		batch.PendingJobs -= count
+		values := map[string]any{
+			"total_jobs":   batch.TotalJobs,
+			"pending_jobs": batch.PendingJobs,
+		}
+
+		if batch.CancelledAt == nil {
+			now := carbon.NewDateTime(carbon.Now())
+			batch.CancelledAt = now
+			batch.FinishedAt = now
+			values["cancelled_at"] = now
+			values["finished_at"] = now
+		}
+
+		return values
+	})
+	if err != nil {
+		return err
+	}
+
+	if batch.allJobsRan() {
+		return r.call(batch.options.Finally, []any{id})
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) Store(batch models.JobBatch) (*Batch, error) {
+	if _, err := r.db.Table(r.table).Insert(&batch); err != nil {
+		return nil, err
+	}
+
+	return NewBatch(batch, r), nil
+}
+
+func (r *BatchRepository) call(signatures []string, args []any) error {
+	var errs []error
+	for _, signature := range signatures {
+		if err := r.jobStorer.Call(signature, args); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *BatchRepository) recordFailedJob(id, jobID string, jobErr error) error {
+	batch, err := r.update(id, func(batch *models.JobBatch, options BatchOptions) map[string]any {
+		batch.FailedJobs++
+		if batch.FailedJobIDs == "" {
+			batch.FailedJobIDs = jobID
+		} else {
+			batch.FailedJobIDs += "," + jobID
+		}
+
+		values := map[string]any{
+			"failed_jobs":    batch.FailedJobs,
+			"failed_job_ids": batch.FailedJobIDs,
+		}
+
+		if !options.AllowFailures && batch.CancelledAt == nil {
+			now := carbon.NewDateTime(carbon.Now())
+			batch.CancelledAt = now
+			batch.FinishedAt = now
+			values["cancelled_at"] = now
+			values["finished_at"] = now
+		}
+
+		return values
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if batch.batch.FailedJobs == 1 {
+		errs = append(errs, r.call(batch.options.Catch, []any{id, jobErr.Error()}))
+	}
+	if batch.allJobsRan() {
+		errs = append(errs, r.call(batch.options.Finally, []any{id}))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *BatchRepository) recordSuccessfulJob(id string) error {
+	batch, err := r.update(id, func(batch *models.JobBatch, _ BatchOptions) map[string]any {
+		batch.PendingJobs--
+		values := map[string]any{
+			"pending_jobs": batch.PendingJobs,
+		}
+
+		if batch.PendingJobs == 0 && batch.FinishedAt == nil {
+			batch.FinishedAt = carbon.NewDateTime(carbon.Now())
+			values["finished_at"] = batch.FinishedAt
+		}
+
+		return values
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if batch.batch.PendingJobs == 0 && batch.batch.CancelledAt == nil {
+		errs = append(errs, r.call(batch.options.Then, []any{id}))
+	}
+	if batch.allJobsRan() {
+		errs = append(errs, r.call(batch.options.Finally, []any{id}))
+	}
+
+	return errors.Join(errs...)
+}
+
+// update locks the batch row, applies the changes returned by callback and
+// returns the updated batch, so that concurrent workers never lose a count.
+func (r *BatchRepository) update(id string, callback func(batch *models.JobBatch, options BatchOptions) map[string]any) (*Batch, error) {
+	var batch *Batch
+	if err := r.db.Transaction(func(tx contractsdb.Tx) error {
+		var model models.JobBatch
+		if err := tx.Table(r.table).LockForUpdate().Where("id", id).First(&model); err != nil {
+			return err
+		}
+		if model.ID == "" {
+			return errors.QueueBatchNotFound.Args(id)
+		}
+
+		batch = NewBatch(model, r)
+		values := callback(&batch.batch, batch.options)
+		if _, err := tx.Table(r.table).Where("id", id).Update(values); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, errors.QueueFailedToUpdateBatch.Args(id, err)
+	}
+
+	return batch, nil
+}
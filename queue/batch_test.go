@@ -0,0 +1,334 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/foundation/json"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/queue/models"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type BatchRepositoryTestSuite struct {
+	suite.Suite
+	mockDB        *mocksdb.DB
+	mockJobStorer *mocksqueue.JobStorer
+	repository    *BatchRepository
+}
+
+func TestBatchRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchRepositoryTestSuite))
+}
+
+func (s *BatchRepositoryTestSuite) SetupTest() {
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockJobStorer = mocksqueue.NewJobStorer(s.T())
+	s.repository = &BatchRepository{
+		db:        s.mockDB,
+		jobStorer: s.mockJobStorer,
+		json:      json.New(),
+		table:     "job_batches",
+	}
+}
+
+func (s *BatchRepositoryTestSuite) TestNewBatchRepository() {
+	s.Run("not configured", func() {
+		mockConfig := mocksqueue.NewConfig(s.T())
+		mockConfig.EXPECT().BatchingDatabase().Return("").Once()
+
+		repository, err := NewBatchRepository(mockConfig, s.mockDB, s.mockJobStorer, json.New())
+
+		s.Nil(repository)
+		s.Equal(errors.QueueBatchingNotConfigured, err)
+	})
+
+	s.Run("happy path", func() {
+		mockConfig := mocksqueue.NewConfig(s.T())
+		mockConfig.EXPECT().BatchingDatabase().Return("mysql").Once()
+		mockConfig.EXPECT().BatchingTable().Return("job_batches").Once()
+		s.mockDB.EXPECT().Connection("mysql").Return(s.mockDB).Once()
+
+		repository, err := NewBatchRepository(mockConfig, s.mockDB, s.mockJobStorer, json.New())
+
+		s.NoError(err)
+		s.Equal("job_batches", repository.table)
+	})
+}
+
+func (s *BatchRepositoryTestSuite) TestFind() {
+	s.Run("not found", func() {
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+
+		batch, err := s.repository.Find("1")
+
+		s.Nil(batch)
+		s.Equal(errors.QueueBatchNotFound.Args("1"), err)
+	})
+
+	s.Run("happy path", func() {
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{
+				ID:           "1",
+				Name:         "import",
+				TotalJobs:    4,
+				PendingJobs:  3,
+				FailedJobs:   1,
+				FailedJobIDs: "a",
+				Options:      `{"allow_failures":true}`,
+			}
+		}).Return(nil).Once()
+
+		batch, err := s.repository.Find("1")
+
+		s.NoError(err)
+		s.Equal("1", batch.ID())
+		s.Equal("import", batch.Name())
+		s.Equal(4, batch.TotalJobs())
+		s.Equal(3, batch.PendingJobs())
+		s.Equal(1, batch.ProcessedJobs())
+		s.Equal(25, batch.Progress())
+		s.Equal(1, batch.FailedJobs())
+		s.Equal([]string{"a"}, batch.FailedJobIDs())
+		s.True(batch.AllowsFailures())
+		s.True(batch.HasFailures())
+		s.False(batch.Cancelled())
+		s.False(batch.Finished())
+	})
+}
+
+func (s *BatchRepositoryTestSuite) TestRecord() {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	now := carbon.NewDateTime(carbon.Now())
+
+	tests := []struct {
+		name      string
+		batch     models.JobBatch
+		jobErr    error
+		setup     func()
+		wantValue map[string]any
+	}{
+		{
+			name:  "success, batch is still pending",
+			batch: models.JobBatch{ID: "1", TotalJobs: 2, PendingJobs: 2, Options: `{"then":["then"],"finally":["finally"]}`},
+			setup: func() {},
+			wantValue: map[string]any{
+				"pending_jobs": 1,
+			},
+		},
+		{
+			name:  "success, last job calls then and finally",
+			batch: models.JobBatch{ID: "1", TotalJobs: 2, PendingJobs: 1, Options: `{"then":["then"],"finally":["finally"]}`},
+			setup: func() {
+				s.mockJobStorer.EXPECT().Call("then", []any{"1"}).Return(nil).Once()
+				s.mockJobStorer.EXPECT().Call("finally", []any{"1"}).Return(nil).Once()
+			},
+			wantValue: map[string]any{
+				"pending_jobs": 0,
+				"finished_at":  now,
+			},
+		},
+		{
+			name:   "first failure cancels the batch and calls catch",
+			batch:  models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 3, Options: `{"catch":["catch"],"finally":["finally"]}`},
+			jobErr: assert.AnError,
+			setup: func() {
+				s.mockJobStorer.EXPECT().Call("catch", []any{"1", assert.AnError.Error()}).Return(nil).Once()
+			},
+			wantValue: map[string]any{
+				"failed_jobs":    1,
+				"failed_job_ids": "job",
+				"cancelled_at":   now,
+				"finished_at":    now,
+			},
+		},
+		{
+			name:   "failure with allowed failures calls finally when every job has run",
+			batch:  models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 2, FailedJobs: 1, FailedJobIDs: "a", Options: `{"catch":["catch"],"finally":["finally"],"allow_failures":true}`},
+			jobErr: assert.AnError,
+			setup: func() {
+				s.mockJobStorer.EXPECT().Call("finally", []any{"1"}).Return(nil).Once()
+			},
+			wantValue: map[string]any{
+				"failed_jobs":    2,
+				"failed_job_ids": "a,job",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			mockTx := mocksdb.NewTx(s.T())
+			mockQuery := mocksdb.NewQuery(s.T())
+			s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(tx contractsdb.Tx) error) error {
+				return txFunc(mockTx)
+			}).Once()
+			mockTx.EXPECT().Table("job_batches").Return(mockQuery).Twice()
+			mockQuery.EXPECT().LockForUpdate().Return(mockQuery).Once()
+			mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Twice()
+			mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+				*dest.(*models.JobBatch) = test.batch
+			}).Return(nil).Once()
+			mockQuery.EXPECT().Update(test.wantValue).Return(nil, nil).Once()
+			test.setup()
+
+			s.NoError(s.repository.Record("1", "job", test.jobErr))
+		})
+	}
+}
+
+func (s *BatchRepositoryTestSuite) TestRecordSkipped() {
+	tests := []struct {
+		name      string
+		batch     models.JobBatch
+		setup     func()
+		wantValue map[string]any
+	}{
+		{
+			name:      "jobs are still running",
+			batch:     models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 3, FailedJobs: 1, Options: `{"finally":["finally"]}`},
+			setup:     func() {},
+			wantValue: map[string]any{"skipped_jobs": 1},
+		},
+		{
+			name:  "last skipped job calls finally",
+			batch: models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 3, FailedJobs: 1, SkippedJobs: 1, Options: `{"then":["then"],"finally":["finally"]}`},
+			setup: func() {
+				s.mockJobStorer.EXPECT().Call("finally", []any{"1"}).Return(nil).Once()
+			},
+			wantValue: map[string]any{"skipped_jobs": 2},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			mockTx := mocksdb.NewTx(s.T())
+			mockQuery := mocksdb.NewQuery(s.T())
+			s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(tx contractsdb.Tx) error) error {
+				return txFunc(mockTx)
+			}).Once()
+			mockTx.EXPECT().Table("job_batches").Return(mockQuery).Twice()
+			mockQuery.EXPECT().LockForUpdate().Return(mockQuery).Once()
+			mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Twice()
+			mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+				*dest.(*models.JobBatch) = test.batch
+			}).Return(nil).Once()
+			mockQuery.EXPECT().Update(test.wantValue).Return(nil, nil).Once()
+			test.setup()
+
+			s.NoError(s.repository.RecordSkipped("1"))
+		})
+	}
+}
+
+func (s *BatchRepositoryTestSuite) TestRecordUnqueued() {
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	tests := []struct {
+		name      string
+		batch     models.JobBatch
+		count     int
+		setup     func()
+		wantValue map[string]any
+	}{
+		{
+			name:  "queued jobs are still running",
+			batch: models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 3, Options: `{"finally":["finally"]}`},
+			count: 2,
+			setup: func() {},
+			wantValue: map[string]any{
+				"total_jobs":   1,
+				"pending_jobs": 1,
+				"cancelled_at": carbon.NewDateTime(now),
+				"finished_at":  carbon.NewDateTime(now),
+			},
+		},
+		{
+			name:  "no job is queued",
+			batch: models.JobBatch{ID: "1", TotalJobs: 3, PendingJobs: 3, Options: `{"then":["then"],"finally":["finally"]}`},
+			count: 3,
+			setup: func() {
+				s.mockJobStorer.EXPECT().Call("finally", []any{"1"}).Return(nil).Once()
+			},
+			wantValue: map[string]any{
+				"total_jobs":   0,
+				"pending_jobs": 0,
+				"cancelled_at": carbon.NewDateTime(now),
+				"finished_at":  carbon.NewDateTime(now),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			mockTx := mocksdb.NewTx(s.T())
+			mockQuery := mocksdb.NewQuery(s.T())
+			s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(tx contractsdb.Tx) error) error {
+				return txFunc(mockTx)
+			}).Once()
+			mockTx.EXPECT().Table("job_batches").Return(mockQuery).Twice()
+			mockQuery.EXPECT().LockForUpdate().Return(mockQuery).Once()
+			mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Twice()
+			mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+				*dest.(*models.JobBatch) = test.batch
+			}).Return(nil).Once()
+			mockQuery.EXPECT().Update(test.wantValue).Return(nil, nil).Once()
+			test.setup()
+
+			s.NoError(s.repository.RecordUnqueued("1", test.count))
+		})
+	}
+}
+
+func (s *BatchRepositoryTestSuite) TestCancelled() {
+	s.Run("missing batch", func() {
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+
+		s.True(s.repository.Cancelled("1"))
+	})
+
+	s.Run("running batch", func() {
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{ID: "1"}
+		}).Return(nil).Once()
+
+		s.False(s.repository.Cancelled("1"))
+	})
+
+	s.Run("failed to read the batch", func() {
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "1").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Return(assert.AnError).Once()
+
+		s.False(s.repository.Cancelled("1"))
+	})
+}
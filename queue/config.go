@@ -10,6 +10,8 @@ type Config struct {
 	contractsconfig.Config
 
 	appName           string
+	batchingDatabase  string
+	batchingTable     string
 	defaultConnection string
 	defaultQueue      string
 	failedDatabase    string
@@ -27,6 +29,8 @@ func NewConfig(config contractsconfig.Config) *Config {
 		Config: config,
 
 		appName:           config.GetString("app.name", "goravel"),
+		batchingDatabase:  config.GetString("queue.batching.database"),
+		batchingTable:     config.GetString("queue.batching.table", "job_batches"),
 		debug:             config.GetBool("app.debug"),
 		defaultConnection: defaultConnection,
 		defaultQueue:      defaultQueue,
@@ -38,6 +42,14 @@ func NewConfig(config contractsconfig.Config) *Config {
 	return c
 }
 
+func (r *Config) BatchingDatabase() string {
+	return r.batchingDatabase
+}
+
+func (r *Config) BatchingTable() string {
+	return r.batchingTable
+}
+
 func (r *Config) Debug() bool {
 	return r.debug
 }
@@ -24,6 +24,8 @@ func (s *ConfigTestSuite) SetupTest() {
 	s.mockConfig.EXPECT().GetString("queue.connections.redis.queue", "default").Return("default").Once()
 	s.mockConfig.EXPECT().GetInt("queue.connections.redis.concurrent", 1).Return(2).Once()
 	s.mockConfig.EXPECT().GetString("app.name", "goravel").Return("goravel").Once()
+	s.mockConfig.EXPECT().GetString("queue.batching.database").Return("mysql").Once()
+	s.mockConfig.EXPECT().GetString("queue.batching.table", "job_batches").Return("job_batches").Once()
 	s.mockConfig.EXPECT().GetBool("app.debug").Return(true).Once()
 	s.mockConfig.EXPECT().GetString("queue.failed.database").Return("mysql").Once()
 	s.mockConfig.EXPECT().GetString("queue.failed.table").Return("failed_jobs").Once()
@@ -31,6 +33,14 @@ func (s *ConfigTestSuite) SetupTest() {
 	s.config = NewConfig(s.mockConfig)
 }
 
+func (s *ConfigTestSuite) TestBatchingDatabase() {
+	s.Equal("mysql", s.config.BatchingDatabase())
+}
+
+func (s *ConfigTestSuite) TestBatchingTable() {
+	s.Equal("job_batches", s.config.BatchingTable())
+}
+
 func (s *ConfigTestSuite) TestDebug() {
 	s.True(s.config.Debug())
 }
@@ -0,0 +1,137 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/packages/modify"
+	"github.com/goravel/framework/support/env"
+)
+
+// QueueBatchesTableCommand creates the migration of the job batches table, it's
+// for the applications whose jobs migration was created before job batching.
+type QueueBatchesTableCommand struct {
+	config config.Config
+}
+
+func NewQueueBatchesTableCommand(config config.Config) *QueueBatchesTableCommand {
+	return &QueueBatchesTableCommand{
+		config: config,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueBatchesTableCommand) Signature() string {
+	return "queue:batches-table"
+}
+
+// Description The console command description.
+func (r *QueueBatchesTableCommand) Description() string {
+	return "Create a migration for the batches database table"
+}
+
+// Extend The console command extend.
+func (r *QueueBatchesTableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueBatchesTableCommand) Handle(ctx console.Context) error {
+	timestamp := time.Now().Format("20060102150405")
+	dest := filepath.Join("database", "migrations", timestamp+"_create_job_batches_table.go")
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	table := r.config.GetString("queue.batching.table", "job_batches")
+	if err := os.WriteFile(dest, []byte(jobBatchesMigrationStub(timestamp, table)), 0o644); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	ctx.Info("Migration created successfully: " + dest)
+
+	structName := "M" + timestamp + "CreateJobBatchesTable"
+	if err := r.registerMigration(structName); err != nil {
+		ctx.Warning("Could not auto-register migration: " + err.Error())
+		ctx.Warning("Add manually to your migrations registration:")
+		ctx.Info("  &migrations." + structName + "{},")
+	} else {
+		ctx.Info("Migration registered successfully")
+	}
+
+	ctx.Info("Run `./artisan migrate` to apply it.")
+
+	return nil
+}
+
+func (r *QueueBatchesTableCommand) registerMigration(structName string) error {
+	if !env.IsBootstrapSetup() {
+		return errors.QueueBatchesTableRequiresBootstrapSetup
+	}
+
+	modulePath := "goravel"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		modulePath = info.Main.Path
+	}
+
+	return modify.AddMigration(modulePath+"/database/migrations", fmt.Sprintf("&migrations.%s{}", structName))
+}
+
+// jobBatchesMigrationStub returns the migration of the job batches table, the columns
+// MUST stay in sync with models.JobBatch.
+func jobBatchesMigrationStub(timestamp, table string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreateJobBatchesTable struct{}
+
+// Signature The unique signature for the migration.
+func (r *M` + timestamp + `CreateJobBatchesTable) Signature() string {
+	return "` + timestamp + `_create_job_batches_table"
+}
+
+// Up Run the migrations.
+func (r *M` + timestamp + `CreateJobBatchesTable) Up() error {
+	if !facades.Schema().HasTable("` + table + `") {
+		return facades.Schema().Create("` + table + `", func(table schema.Blueprint) {
+			table.String("id")
+			table.String("name")
+			table.Integer("total_jobs")
+			table.Integer("pending_jobs")
+			table.Integer("failed_jobs")
+			table.Integer("skipped_jobs").Default(0)
+			table.LongText("failed_job_ids")
+			table.MediumText("options").Nullable()
+			table.DateTimeTz("cancelled_at").Nullable()
+			table.DateTimeTz("created_at").UseCurrent()
+			table.DateTimeTz("finished_at").Nullable()
+			table.Primary("id")
+		})
+	}
+
+	return nil
+}
+
+// Down Reverse the migrations.
+func (r *M` + timestamp + `CreateJobBatchesTable) Down() error {
+	return facades.Schema().DropIfExists("` + table + `")
+}
+`
+}
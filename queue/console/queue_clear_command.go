@@ -0,0 +1,94 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+	supportconsole "github.com/goravel/framework/support/console"
+)
+
+type QueueClearCommand struct {
+	config config.Config
+	queue  contractsqueue.Queue
+}
+
+func NewQueueClearCommand(config config.Config, queue contractsqueue.Queue) *QueueClearCommand {
+	return &QueueClearCommand{
+		config: config,
+		queue:  queue,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueClearCommand) Signature() string {
+	return "queue:clear"
+}
+
+// Description The console command description.
+func (r *QueueClearCommand) Description() string {
+	return "Delete all of the jobs from the specified queue"
+}
+
+// Extend The console command extend.
+func (r *QueueClearCommand) Extend() command.Extend {
+	return command.Extend{
+		ArgsUsage: " [connection]",
+		Category:  "queue",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "queue",
+				Aliases: []string{"q"},
+				Usage:   "The name of the queue to clear",
+			},
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Force the operation to run when in production",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueClearCommand) Handle(ctx console.Context) error {
+	if !supportconsole.ConfirmToProceed(ctx, r.config.GetString("app.env")) {
+		ctx.Warning(errors.ConsoleRunInProduction.Error())
+		return nil
+	}
+
+	connection := ctx.Argument(0)
+	if connection == "" {
+		connection = r.config.GetString("queue.default")
+	}
+
+	queue := ctx.Option("queue")
+	if queue == "" {
+		queue = r.config.GetString(fmt.Sprintf("queue.connections.%s.queue", connection), "default")
+	}
+
+	driver, err := r.queue.Connection(connection)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	driverWithClear, ok := driver.(contractsqueue.DriverWithClear)
+	if !ok {
+		ctx.Error(errors.QueueDriverNotSupportClear.Args(connection).Error())
+		return nil
+	}
+
+	count, err := driverWithClear.Clear(queue)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Cleared %d jobs from the [%s] queue", count, queue))
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+// mockDriver avoids the conflict between the embedded field and the Driver method.
+type mockDriver = mocksqueue.Driver
+
+type driverWithClear struct {
+	*mockDriver
+	*mocksqueue.DriverWithClear
+}
+
+func TestQueueClearCommand(t *testing.T) {
+	var (
+		mockConfig *mocksconfig.Config
+		mockCtx    *mocksconsole.Context
+		mockQueue  *mocksqueue.Queue
+	)
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockCtx = mocksconsole.NewContext(t)
+		mockQueue = mocksqueue.NewQueue(t)
+	}
+
+	t.Run("not confirmed in production", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.env").Return("production").Once()
+		mockCtx.EXPECT().OptionBool("force").Return(false).Once()
+		mockCtx.EXPECT().Confirm("Are you sure you want to run this command?").Return(false).Once()
+		mockCtx.EXPECT().Warning(errors.ConsoleRunInProduction.Error()).Once()
+
+		assert.NoError(t, NewQueueClearCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("clears the default queue of the default connection", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+		mockCtx.EXPECT().Argument(0).Return("").Once()
+		mockConfig.EXPECT().GetString("queue.default").Return("database").Once()
+		mockCtx.EXPECT().Option("queue").Return("").Once()
+		mockConfig.EXPECT().GetString("queue.connections.database.queue", "default").Return("default").Once()
+
+		driver := &driverWithClear{DriverWithClear: mocksqueue.NewDriverWithClear(t)}
+		driver.DriverWithClear.EXPECT().Clear("default").Return(int64(2), nil).Once()
+		mockQueue.EXPECT().Connection("database").Return(driver, nil).Once()
+		mockCtx.EXPECT().Success("Cleared 2 jobs from the [default] queue").Once()
+
+		assert.NoError(t, NewQueueClearCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("driver doesn't support clear", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+		mockCtx.EXPECT().Argument(0).Return("sync").Once()
+		mockCtx.EXPECT().Option("queue").Return("high").Once()
+		mockQueue.EXPECT().Connection("sync").Return(mocksqueue.NewDriver(t), nil).Once()
+		mockCtx.EXPECT().Error(errors.QueueDriverNotSupportClear.Args("sync").Error()).Once()
+
+		assert.NoError(t, NewQueueClearCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("failed to clear", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+		mockCtx.EXPECT().Argument(0).Return("database").Once()
+		mockCtx.EXPECT().Option("queue").Return("high").Once()
+
+		driver := &driverWithClear{DriverWithClear: mocksqueue.NewDriverWithClear(t)}
+		driver.DriverWithClear.EXPECT().Clear("high").Return(int64(0), assert.AnError).Once()
+		mockQueue.EXPECT().Connection("database").Return(driver, nil).Once()
+		mockCtx.EXPECT().Error(assert.AnError.Error()).Once()
+
+		assert.NoError(t, NewQueueClearCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+}
@@ -0,0 +1,60 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+type QueueFlushCommand struct {
+	queue contractsqueue.Queue
+}
+
+func NewQueueFlushCommand(queue contractsqueue.Queue) *QueueFlushCommand {
+	return &QueueFlushCommand{
+		queue: queue,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueFlushCommand) Signature() string {
+	return "queue:flush"
+}
+
+// Description The console command description.
+func (r *QueueFlushCommand) Description() string {
+	return "Flush all of the failed queue jobs"
+}
+
+// Extend The console command extend.
+func (r *QueueFlushCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "hours",
+				Usage: "The number of hours to retain failed job data",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueFlushCommand) Handle(ctx console.Context) error {
+	hours := ctx.OptionInt("hours")
+
+	if _, err := r.queue.Failer().Flush(hours); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	if hours > 0 {
+		ctx.Success(fmt.Sprintf("All jobs that failed more than %d hours ago have been deleted successfully", hours))
+	} else {
+		ctx.Success("All failed jobs deleted successfully")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestQueueFlushCommand(t *testing.T) {
+	var (
+		mockCtx    *mocksconsole.Context
+		mockFailer *mocksqueue.Failer
+		mockQueue  *mocksqueue.Queue
+	)
+
+	beforeEach := func() {
+		mockCtx = mocksconsole.NewContext(t)
+		mockFailer = mocksqueue.NewFailer(t)
+		mockQueue = mocksqueue.NewQueue(t)
+		mockQueue.EXPECT().Failer().Return(mockFailer).Once()
+	}
+
+	t.Run("flushes all failed jobs", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().OptionInt("hours").Return(0).Once()
+		mockFailer.EXPECT().Flush(0).Return(int64(3), nil).Once()
+		mockCtx.EXPECT().Success("All failed jobs deleted successfully").Once()
+
+		assert.NoError(t, NewQueueFlushCommand(mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("flushes failed jobs older than the hours", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().OptionInt("hours").Return(48).Once()
+		mockFailer.EXPECT().Flush(48).Return(int64(1), nil).Once()
+		mockCtx.EXPECT().Success("All jobs that failed more than 48 hours ago have been deleted successfully").Once()
+
+		assert.NoError(t, NewQueueFlushCommand(mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("failed to flush", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().OptionInt("hours").Return(0).Once()
+		mockFailer.EXPECT().Flush(0).Return(int64(0), assert.AnError).Once()
+		mockCtx.EXPECT().Error(assert.AnError.Error()).Once()
+
+		assert.NoError(t, NewQueueFlushCommand(mockQueue).Handle(mockCtx))
+	})
+}
@@ -0,0 +1,54 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+)
+
+type QueueForgetCommand struct {
+	queue contractsqueue.Queue
+}
+
+func NewQueueForgetCommand(queue contractsqueue.Queue) *QueueForgetCommand {
+	return &QueueForgetCommand{
+		queue: queue,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueForgetCommand) Signature() string {
+	return "queue:forget"
+}
+
+// Description The console command description.
+func (r *QueueForgetCommand) Description() string {
+	return "Delete a failed queue job"
+}
+
+// Extend The console command extend.
+func (r *QueueForgetCommand) Extend() command.Extend {
+	return command.Extend{
+		ArgsUsage: " <uuid>",
+		Category:  "queue",
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueForgetCommand) Handle(ctx console.Context) error {
+	uuid := ctx.Argument(0)
+	if uuid == "" {
+		ctx.Error(errors.QueueFailedJobUUIDRequired.Error())
+		return nil
+	}
+
+	if err := r.queue.Failer().Forget(uuid); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	ctx.Success("Failed job deleted successfully")
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestQueueForgetCommand(t *testing.T) {
+	var (
+		mockCtx    *mocksconsole.Context
+		mockFailer *mocksqueue.Failer
+		mockQueue  *mocksqueue.Queue
+	)
+
+	beforeEach := func() {
+		mockCtx = mocksconsole.NewContext(t)
+		mockFailer = mocksqueue.NewFailer(t)
+		mockQueue = mocksqueue.NewQueue(t)
+	}
+
+	t.Run("uuid is required", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("").Once()
+		mockCtx.EXPECT().Error(errors.QueueFailedJobUUIDRequired.Error()).Once()
+
+		assert.NoError(t, NewQueueForgetCommand(mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("forgets the failed job", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("uuid").Once()
+		mockQueue.EXPECT().Failer().Return(mockFailer).Once()
+		mockFailer.EXPECT().Forget("uuid").Return(nil).Once()
+		mockCtx.EXPECT().Success("Failed job deleted successfully").Once()
+
+		assert.NoError(t, NewQueueForgetCommand(mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("failed job not found", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("uuid").Once()
+		mockQueue.EXPECT().Failer().Return(mockFailer).Once()
+		mockFailer.EXPECT().Forget("uuid").Return(errors.QueueFailedJobNotFound.Args("uuid")).Once()
+		mockCtx.EXPECT().Error(errors.QueueFailedJobNotFound.Args("uuid").Error()).Once()
+
+		assert.NoError(t, NewQueueForgetCommand(mockQueue).Handle(mockCtx))
+	})
+}
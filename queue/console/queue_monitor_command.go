@@ -0,0 +1,97 @@
+package console
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+)
+
+type QueueMonitorCommand struct {
+	config config.Config
+	queue  contractsqueue.Queue
+}
+
+func NewQueueMonitorCommand(config config.Config, queue contractsqueue.Queue) *QueueMonitorCommand {
+	return &QueueMonitorCommand{
+		config: config,
+		queue:  queue,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueMonitorCommand) Signature() string {
+	return "queue:monitor"
+}
+
+// Description The console command description.
+func (r *QueueMonitorCommand) Description() string {
+	return "Monitor the size of the specified queues"
+}
+
+// Extend The console command extend.
+func (r *QueueMonitorCommand) Extend() command.Extend {
+	return command.Extend{
+		ArgsUsage: " <connection:queue,...>",
+		Category:  "queue",
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "max",
+				Usage: "The maximum number of jobs that can be on the queue before an alert is shown",
+				Value: 1000,
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueMonitorCommand) Handle(ctx console.Context) error {
+	queues := ctx.Argument(0)
+	if queues == "" {
+		ctx.Error(errors.QueueMonitorQueuesRequired.Error())
+		return nil
+	}
+
+	maxSize := int64(ctx.OptionInt("max"))
+
+	for _, item := range strings.Split(queues, ",") {
+		connection, queue, found := strings.Cut(strings.TrimSpace(item), ":")
+		if !found {
+			queue = connection
+			connection = r.config.GetString("queue.default")
+		}
+
+		size, err := r.size(connection, queue)
+		if err != nil {
+			ctx.Error(err.Error())
+			continue
+		}
+
+		status := "<fg=green;op=bold>OK</>"
+		if size >= maxSize {
+			status = "<fg=red;op=bold>ALERT</>"
+		}
+
+		ctx.TwoColumnDetail(fmt.Sprintf("[%s] %s", connection, queue), fmt.Sprintf("[%d] %s", size, status))
+	}
+
+	return nil
+}
+
+func (r *QueueMonitorCommand) size(connection, queue string) (int64, error) {
+	driver, err := r.queue.Connection(connection)
+	if err != nil {
+		return 0, err
+	}
+
+	driverWithSize, ok := driver.(contractsqueue.DriverWithSize)
+	if !ok {
+		return 0, errors.QueueDriverNotSupportSize.Args(connection)
+	}
+
+	return driverWithSize.Size(queue)
+}
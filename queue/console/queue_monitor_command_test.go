@@ -0,0 +1,69 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+type driverWithSize struct {
+	*mockDriver
+	*mocksqueue.DriverWithSize
+}
+
+func TestQueueMonitorCommand(t *testing.T) {
+	var (
+		mockConfig *mocksconfig.Config
+		mockCtx    *mocksconsole.Context
+		mockQueue  *mocksqueue.Queue
+	)
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockCtx = mocksconsole.NewContext(t)
+		mockQueue = mocksqueue.NewQueue(t)
+	}
+
+	t.Run("queues are required", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("").Once()
+		mockCtx.EXPECT().Error(errors.QueueMonitorQueuesRequired.Error()).Once()
+
+		assert.NoError(t, NewQueueMonitorCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("prints the size of the queues", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("redis:high, default").Once()
+		mockCtx.EXPECT().OptionInt("max").Return(10).Once()
+		mockConfig.EXPECT().GetString("queue.default").Return("database").Once()
+
+		high := &driverWithSize{DriverWithSize: mocksqueue.NewDriverWithSize(t)}
+		high.DriverWithSize.EXPECT().Size("high").Return(int64(10), nil).Once()
+		mockQueue.EXPECT().Connection("redis").Return(high, nil).Once()
+
+		low := &driverWithSize{DriverWithSize: mocksqueue.NewDriverWithSize(t)}
+		low.DriverWithSize.EXPECT().Size("default").Return(int64(1), nil).Once()
+		mockQueue.EXPECT().Connection("database").Return(low, nil).Once()
+
+		mockCtx.EXPECT().TwoColumnDetail("[redis] high", "[10] <fg=red;op=bold>ALERT</>").Once()
+		mockCtx.EXPECT().TwoColumnDetail("[database] default", "[1] <fg=green;op=bold>OK</>").Once()
+
+		assert.NoError(t, NewQueueMonitorCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+
+	t.Run("driver doesn't support size", func(t *testing.T) {
+		beforeEach()
+		mockCtx.EXPECT().Argument(0).Return("sync:default").Once()
+		mockCtx.EXPECT().OptionInt("max").Return(1000).Once()
+		mockQueue.EXPECT().Connection("sync").Return(mocksqueue.NewDriver(t), nil).Once()
+		mockCtx.EXPECT().Error(errors.QueueDriverNotSupportSize.Args("sync").Error()).Once()
+
+		assert.NoError(t, NewQueueMonitorCommand(mockConfig, mockQueue).Handle(mockCtx))
+	})
+}
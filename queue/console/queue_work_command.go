@@ -0,0 +1,127 @@
+package console
+
+import (
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/queue/utils"
+)
+
+type QueueWorkCommand struct {
+	queue  contractsqueue.Queue
+	worker contractsqueue.Worker
+	mu     sync.Mutex
+}
+
+func NewQueueWorkCommand(queue contractsqueue.Queue) *QueueWorkCommand {
+	return &QueueWorkCommand{
+		queue: queue,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *QueueWorkCommand) Signature() string {
+	return "queue:work"
+}
+
+// Description The console command description.
+func (r *QueueWorkCommand) Description() string {
+	return "Start processing jobs on the queue as a daemon"
+}
+
+// Extend The console command extend.
+func (r *QueueWorkCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "connection",
+				Aliases: []string{"c"},
+				Usage:   "The name of the queue connection to work",
+			},
+			&command.StringFlag{
+				Name:    "queue",
+				Aliases: []string{"q"},
+				Usage:   "The names of the queues to work in priority order, e.g. high,default or high:3,default:1",
+			},
+			&command.IntFlag{
+				Name:  "concurrent",
+				Usage: "The number of jobs to process concurrently",
+			},
+			&command.IntFlag{
+				Name:  "tries",
+				Usage: "Number of times to attempt a job before logging it failed",
+				Value: 1,
+			},
+			&command.IntFlag{
+				Name:  "max-jobs",
+				Usage: "The number of jobs to process before stopping",
+			},
+			&command.IntFlag{
+				Name:  "max-time",
+				Usage: "The maximum number of seconds the worker should run",
+			},
+			&command.IntFlag{
+				Name:  "sleep",
+				Usage: "Number of seconds to sleep when no job is available",
+			},
+			&command.BoolFlag{
+				Name:  "stop-when-empty",
+				Usage: "Stop when the queue is empty",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *QueueWorkCommand) Handle(ctx console.Context) error {
+	queue := ctx.Option("queue")
+	if queue != "" {
+		if _, err := utils.NewPriority(queue); err != nil {
+			ctx.Error(err.Error())
+			return nil
+		}
+	}
+
+	worker := r.queue.Worker(contractsqueue.Args{
+		Connection:    ctx.Option("connection"),
+		Queue:         queue,
+		Concurrent:    ctx.OptionInt("concurrent"),
+		Tries:         ctx.OptionInt("tries"),
+		MaxJobs:       ctx.OptionInt("max-jobs"),
+		MaxTime:       time.Duration(ctx.OptionInt("max-time")) * time.Second,
+		Sleep:         time.Duration(ctx.OptionInt("sleep")) * time.Second,
+		StopWhenEmpty: ctx.OptionBool("stop-when-empty"),
+	})
+
+	r.mu.Lock()
+	r.worker = worker
+	r.mu.Unlock()
+
+	if err := worker.Run(); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	// The worker may stop by itself because of the limits, wait for the failed jobs to be saved.
+	if err := worker.Shutdown(); err != nil {
+		ctx.Error(err.Error())
+	}
+
+	return nil
+}
+
+// Shutdown stops the worker when the process receives SIGINT or SIGTERM.
+func (r *QueueWorkCommand) Shutdown(_ console.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.worker == nil {
+		return nil
+	}
+
+	return r.worker.Shutdown()
+}
@@ -0,0 +1,56 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestQueueWorkCommand(t *testing.T) {
+	mockCtx := mocksconsole.NewContext(t)
+	mockQueue := mocksqueue.NewQueue(t)
+	mockWorker := mocksqueue.NewWorker(t)
+
+	command := NewQueueWorkCommand(mockQueue)
+	assert.NoError(t, command.Shutdown(mockCtx))
+
+	mockCtx.EXPECT().Option("connection").Return("database").Once()
+	mockCtx.EXPECT().Option("queue").Return("high").Once()
+	mockCtx.EXPECT().OptionInt("concurrent").Return(2).Once()
+	mockCtx.EXPECT().OptionInt("tries").Return(3).Once()
+	mockCtx.EXPECT().OptionInt("max-jobs").Return(10).Once()
+	mockCtx.EXPECT().OptionInt("max-time").Return(60).Once()
+	mockCtx.EXPECT().OptionInt("sleep").Return(3).Once()
+	mockCtx.EXPECT().OptionBool("stop-when-empty").Return(true).Once()
+	mockQueue.EXPECT().Worker(contractsqueue.Args{
+		Connection:    "database",
+		Queue:         "high",
+		Concurrent:    2,
+		Tries:         3,
+		MaxJobs:       10,
+		MaxTime:       time.Minute,
+		Sleep:         3 * time.Second,
+		StopWhenEmpty: true,
+	}).Return(mockWorker).Once()
+	mockWorker.EXPECT().Run().Return(nil).Once()
+	mockWorker.EXPECT().Shutdown().Return(nil).Twice()
+
+	assert.NoError(t, command.Handle(mockCtx))
+	assert.NoError(t, command.Shutdown(mockCtx))
+}
+
+func TestQueueWorkCommandWithInvalidQueue(t *testing.T) {
+	mockCtx := mocksconsole.NewContext(t)
+	mockQueue := mocksqueue.NewQueue(t)
+
+	mockCtx.EXPECT().Option("queue").Return("high:0").Once()
+	mockCtx.EXPECT().Error(errors.QueueInvalidPriority.Args("high:0").Error()).Once()
+
+	assert.NoError(t, NewQueueWorkCommand(mockQueue).Handle(mockCtx))
+}
@@ -2,6 +2,8 @@ package queue
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	contractscache "github.com/goravel/framework/contracts/cache"
@@ -15,7 +17,10 @@ import (
 )
 
 var (
-	_ contractsqueue.Driver = &Database{}
+	_ contractsqueue.Driver            = &Database{}
+	_ contractsqueue.DriverWithClear   = &Database{}
+	_ contractsqueue.DriverWithPopFrom = &Database{}
+	_ contractsqueue.DriverWithSize    = &Database{}
 )
 
 type Database struct {
@@ -55,53 +60,42 @@ func NewDatabase(
 	}, nil
 }
 
+func (r *Database) Clear(queue string) (int64, error) {
+	result, err := r.db.Table(r.jobsTable).Where("queue", queue).Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (r *Database) Driver() string {
 	return contractsqueue.DriverDatabase
 }
 
 func (r *Database) Pop(queue string) (contractsqueue.ReservedJob, error) {
-	var job models.Job
-
-	cacheLock := fmt.Sprintf("goravel:queue-database-%s:lock", queue)
-	lock := r.cache.Lock(cacheLock, 1*time.Minute)
-	if !lock.Block(1 * time.Minute) {
-		return nil, errors.QueuePopIsLocked.Args(queue, cacheLock)
+	job, err := r.pop([]string{queue})
+	if err != nil {
+		return nil, err
 	}
 
-	defer lock.Release()
-
-	if err := r.db.Transaction(func(tx contractsdb.Tx) error {
-		if err := tx.Table(r.jobsTable).LockForUpdate().Where("queue", queue).Where(func(q contractsdb.Query) contractsdb.Query {
-			return q.Where(func(q1 contractsdb.Query) contractsdb.Query {
-				return r.isAvailable(q1)
-			}).OrWhere(func(q1 contractsdb.Query) contractsdb.Query {
-				return r.isReservedButExpired(q1)
-			})
-		}).OrderBy("id").First(&job); err != nil {
-			return err
-		}
-
-		if job.ID == 0 {
-			return errors.QueueDriverNoJobFound.Args(queue)
-		}
-
-		job.Increment()
-		job.Touch()
+	return NewDatabaseReservedJob(job, r.db, r.jobStorer, r.json, r.jobsTable)
+}
 
-		_, err := tx.Table(r.jobsTable).Where("id", job.ID).Update(map[string]any{
-			"attempts":    job.Attempts,
-			"reserved_at": job.ReservedAt,
-		})
-		if err != nil {
-			return errors.QueueFailedToReserveJob.Args(job, err)
-		}
+// PopFrom pops the next job off of the first non-empty queue, the queues are
+// locked together, so the jobs of a queue are popped before the jobs of the queues after it.
+func (r *Database) PopFrom(queues []string) (string, contractsqueue.ReservedJob, error) {
+	job, err := r.pop(queues)
+	if err != nil {
+		return "", nil, err
+	}
 
-		return nil
-	}); err != nil {
-		return nil, err
+	reservedJob, err := NewDatabaseReservedJob(job, r.db, r.jobStorer, r.json, r.jobsTable)
+	if err != nil {
+		return "", nil, err
 	}
 
-	return NewDatabaseReservedJob(&job, r.db, r.jobStorer, r.json, r.jobsTable)
+	return job.Queue, reservedJob, nil
 }
 
 func (r *Database) Push(task contractsqueue.Task, queue string) error {
@@ -135,6 +129,67 @@ func (r *Database) Push(task contractsqueue.Task, queue string) error {
 	return nil
 }
 
+func (r *Database) Size(queue string) (int64, error) {
+	return r.db.Table(r.jobsTable).Where("queue", queue).Count()
+}
+
+// pop reserves the first available job of the first non-empty queue, the queues
+// are locked in a stable order so that workers popping overlapping queues don't deadlock.
+func (r *Database) pop(queues []string) (*models.Job, error) {
+	sorted := slices.Clone(queues)
+	slices.Sort(sorted)
+
+	for _, queue := range slices.Compact(sorted) {
+		cacheLock := fmt.Sprintf("goravel:queue-database-%s:lock", queue)
+		lock := r.cache.Lock(cacheLock, 1*time.Minute)
+		if !lock.Block(1 * time.Minute) {
+			return nil, errors.QueuePopIsLocked.Args(queue, cacheLock)
+		}
+
+		defer lock.Release()
+	}
+
+	var job models.Job
+	if err := r.db.Transaction(func(tx contractsdb.Tx) error {
+		for _, queue := range queues {
+			if err := tx.Table(r.jobsTable).LockForUpdate().Where("queue", queue).Where(func(q contractsdb.Query) contractsdb.Query {
+				return q.Where(func(q1 contractsdb.Query) contractsdb.Query {
+					return r.isAvailable(q1)
+				}).OrWhere(func(q1 contractsdb.Query) contractsdb.Query {
+					return r.isReservedButExpired(q1)
+				})
+			}).OrderBy("id").First(&job); err != nil {
+				return err
+			}
+
+			if job.ID != 0 {
+				break
+			}
+		}
+
+		if job.ID == 0 {
+			return errors.QueueDriverNoJobFound.Args(strings.Join(queues, ","))
+		}
+
+		job.Increment()
+		job.Touch()
+
+		_, err := tx.Table(r.jobsTable).Where("id", job.ID).Update(map[string]any{
+			"attempts":    job.Attempts,
+			"reserved_at": job.ReservedAt,
+		})
+		if err != nil {
+			return errors.QueueFailedToReserveJob.Args(job, err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
 func (r *Database) isAvailable(query contractsdb.Query) contractsdb.Query {
 	return query.WhereNull("reserved_at").Where("available_at <= ?", carbon.Now())
 }
@@ -144,5 +199,5 @@ func (r *Database) isAvailable(query contractsdb.Query) contractsdb.Query {
 // retry_after must exceed the maximum job runtime to avoid double-processing
 // long-running jobs (same contract as Laravel).
 func (r *Database) isReservedButExpired(query contractsdb.Query) contractsdb.Query {
-	return query.Where("reserved_at", "<=", carbon.Now().SubSeconds(r.retryAfter))
+	return query.Where("reserved_at <= ?", carbon.Now().SubSeconds(r.retryAfter))
 }
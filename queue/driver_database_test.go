@@ -114,6 +114,30 @@ func (s *DatabaseTestSuite) TestNewDatabase() {
 	}
 }
 
+func (s *DatabaseTestSuite) TestClear() {
+	mockQuery := mocksdb.NewQuery(s.T())
+	s.mockDB.EXPECT().Table(s.jobsTable).Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("queue", "default").Return(mockQuery).Once()
+	mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 3}, nil).Once()
+
+	count, err := s.database.Clear("default")
+
+	s.NoError(err)
+	s.Equal(int64(3), count)
+}
+
+func (s *DatabaseTestSuite) TestSize() {
+	mockQuery := mocksdb.NewQuery(s.T())
+	s.mockDB.EXPECT().Table(s.jobsTable).Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("queue", "default").Return(mockQuery).Once()
+	mockQuery.EXPECT().Count().Return(int64(5), nil).Once()
+
+	size, err := s.database.Size("default")
+
+	s.NoError(err)
+	s.Equal(int64(5), size)
+}
+
 func (s *DatabaseTestSuite) TestDriver() {
 	s.Equal(contractsqueue.DriverDatabase, s.database.Driver())
 }
@@ -228,6 +252,57 @@ func (s *DatabaseTestSuite) TestPop() {
 	}
 }
 
+func (s *DatabaseTestSuite) TestPopFrom() {
+	payload := "{\"signature\":\"test_job_one\",\"args\":null,\"delay\":null,\"uuid\":\"test\",\"chain\":[]}"
+	testJobOne := &TestJobOne{}
+
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	for _, queue := range []string{"high", "low"} {
+		mockCacheLock := mockscache.NewLock(s.T())
+		s.mockCache.EXPECT().Lock("goravel:queue-database-"+queue+":lock", 1*time.Minute).Return(mockCacheLock).Once()
+		mockCacheLock.EXPECT().Block(1 * time.Minute).Return(true).Once()
+		mockCacheLock.EXPECT().Release().Return(true).Once()
+	}
+
+	mockTx := mocksdb.NewTx(s.T())
+	mockQuery := mocksdb.NewQuery(s.T())
+
+	s.mockDB.EXPECT().Transaction(mock.Anything).Run(func(txFunc func(tx contractsdb.Tx) error) {
+		s.NoError(txFunc(mockTx))
+	}).Return(nil).Once()
+
+	mockTx.EXPECT().Table(s.jobsTable).Return(mockQuery).Times(3)
+	mockQuery.EXPECT().LockForUpdate().Return(mockQuery).Twice()
+	mockQuery.EXPECT().Where(mock.Anything).Return(mockQuery).Twice()
+	mockQuery.EXPECT().OrderBy("id").Return(mockQuery).Twice()
+
+	// The low queue is empty, the job is popped from the high queue after it.
+	mockQuery.EXPECT().Where("queue", "low").Return(mockQuery).Once()
+	mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+	mockQuery.EXPECT().Where("queue", "high").Return(mockQuery).Once()
+	mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		*dest.(*models.Job) = models.Job{ID: 1, Queue: "high", Payload: payload}
+	}).Return(nil).Once()
+	mockQuery.EXPECT().Where("id", uint(1)).Return(mockQuery).Once()
+	mockQuery.EXPECT().Update(map[string]any{
+		"attempts":    1,
+		"reserved_at": carbon.NewDateTime(carbon.Now()),
+	}).Return(nil, nil).Once()
+
+	s.mockJson.EXPECT().UnmarshalString(payload, mock.Anything).Run(func(_ string, taskPtr any) {
+		*taskPtr.(*utils.Task) = utils.Task{UUID: "test", Job: utils.Job{Signature: testJobOne.Signature()}}
+	}).Return(nil).Once()
+	s.mockJobStorer.EXPECT().Get(testJobOne.Signature()).Return(testJobOne, nil).Once()
+
+	queue, reservedJob, err := s.database.PopFrom([]string{"low", "high"})
+
+	s.NoError(err)
+	s.Equal("high", queue)
+	s.Equal(1, reservedJob.Attempts())
+}
+
 func (s *DatabaseTestSuite) TestPush() {
 	testJobOne := &TestJobOne{}
 	payload := "{\"signature\":\"test_job_one\",\"args\":null,\"delay\":null,\"uuid\":\"test\",\"chain\":[]}"
@@ -335,7 +410,7 @@ func (s *DatabaseTestSuite) TestIsReservedButExpired() {
 
 	s.database.retryAfter = 60
 	mockQuery := mocksdb.NewQuery(s.T())
-	mockQuery.EXPECT().Where("reserved_at", "<=", carbon.Now().SubSeconds(60)).Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("reserved_at <= ?", carbon.Now().SubSeconds(60)).Return(mockQuery).Once()
 
 	result := s.database.isReservedButExpired(mockQuery)
 
@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	SyncDriverName              = "sync"
-	_              queue.Driver = &Sync{}
+	SyncDriverName                         = "sync"
+	_              queue.Driver            = &Sync{}
+	_              queue.DriverWithPopFrom = &Sync{}
 )
 
 type Sync struct {
@@ -28,6 +29,11 @@ func (r *Sync) Pop(_ string) (queue.ReservedJob, error) {
 	return nil, nil
 }
 
+func (r *Sync) PopFrom(_ []string) (string, queue.ReservedJob, error) {
+	// sync driver does not support pop, the jobs are handled when they are pushed
+	return "", nil, nil
+}
+
 func (r *Sync) Push(task queue.Task, _ string) error {
 	if err := push(task.ChainJob); err != nil {
 		return err
@@ -54,9 +60,8 @@ func push(job queue.ChainJob) error {
 		realArgs = append(realArgs, arg.Value)
 	}
 
-	if err := job.Job.Handle(realArgs...); err != nil {
-		return err
-	}
-
-	return nil
+	// A job released by a middleware is skipped, the sync driver has no queue to release it back onto.
+	return NewQueuedJob(job.Job, realArgs, nil, 1, 1).Handle(func() error {
+		return job.Job.Handle(realArgs...)
+	})
 }
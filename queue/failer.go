@@ -5,6 +5,7 @@ import (
 	"github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/foundation"
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
 	"github.com/goravel/framework/queue/models"
 	"github.com/goravel/framework/queue/utils"
 	"github.com/goravel/framework/support/carbon"
@@ -33,6 +34,33 @@ func (r *Failer) All() ([]contractsqueue.FailedJob, error) {
 	return r.modelFailedJobsToFailedJobs(modelFailedJobs), nil
 }
 
+func (r *Failer) Flush(hours int) (int64, error) {
+	query := r.query
+	if hours > 0 {
+		query = query.Where("failed_at <= ?", carbon.Now().SubHours(hours))
+	}
+
+	result, err := query.Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *Failer) Forget(uuid string) error {
+	result, err := r.query.Where("uuid", uuid).Delete()
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.QueueFailedJobNotFound.Args(uuid)
+	}
+
+	return nil
+}
+
 func (r *Failer) Get(connection, queue string, uuids []string) ([]contractsqueue.FailedJob, error) {
 	query := r.query
 
@@ -95,7 +123,8 @@ func (r *FailedJob) Retry() error {
 		return err
 	}
 
-	if err := connection.Push(task, r.failedJob.Queue); err != nil {
+	// The job gets a new RetryUntil, otherwise it can't be retried anymore once it fails again.
+	if err := connection.Push(utils.RefreshRetryUntil(task), r.failedJob.Queue); err != nil {
 		return err
 	}
 
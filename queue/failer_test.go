@@ -1,14 +1,21 @@
 package queue
 
 import (
+	"context"
+	sqldriver "database/sql/driver"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/goravel/framework/contracts/database/db"
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	databasedb "github.com/goravel/framework/database/db"
+	"github.com/goravel/framework/errors"
 	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksdriver "github.com/goravel/framework/mocks/database/driver"
+	mockslogger "github.com/goravel/framework/mocks/database/logger"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
 	mocksqueue "github.com/goravel/framework/mocks/queue"
 	"github.com/goravel/framework/queue/models"
@@ -114,6 +121,73 @@ func (s *FailerTestSuite) TestAll() {
 	}
 }
 
+func (s *FailerTestSuite) TestFlush() {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	s.Run("all failed jobs", func() {
+		s.mockQuery.EXPECT().Delete().Return(&db.Result{RowsAffected: 2}, nil).Once()
+
+		count, err := s.failer.Flush(0)
+
+		s.NoError(err)
+		s.Equal(int64(2), count)
+	})
+
+	s.Run("failed jobs older than the given hours", func() {
+		s.mockQuery.EXPECT().Where("failed_at <= ?", carbon.Now().SubHours(24)).Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Delete().Return(&db.Result{RowsAffected: 1}, nil).Once()
+
+		count, err := s.failer.Flush(24)
+
+		s.NoError(err)
+		s.Equal(int64(1), count)
+	})
+
+	s.Run("deletes the rows failed before the given hours", func() {
+		mockBuilder := mocksdb.NewBuilder(s.T())
+		mockGrammar := mocksdriver.NewGrammar(s.T())
+		mockLogger := mockslogger.NewLogger(s.T())
+		failedAt := carbon.Now().SubHours(24)
+
+		mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		mockBuilder.EXPECT().ExecContext(mock.Anything, "DELETE FROM failed_jobs WHERE failed_at <= ?", failedAt).Return(sqldriver.RowsAffected(1), nil).Once()
+		mockBuilder.EXPECT().Explain("DELETE FROM failed_jobs WHERE failed_at <= ?", failedAt).Return("").Once()
+		mockLogger.EXPECT().Trace(mock.Anything, mock.Anything, "", int64(1), nil).Return().Once()
+
+		failer := &Failer{query: databasedb.NewQuery(context.Background(), mockBuilder, mockBuilder, mockGrammar, mockLogger, "failed_jobs", nil)}
+		count, err := failer.Flush(24)
+
+		s.NoError(err)
+		s.Equal(int64(1), count)
+	})
+
+	s.Run("failed to delete", func() {
+		s.mockQuery.EXPECT().Delete().Return(nil, assert.AnError).Once()
+
+		count, err := s.failer.Flush(0)
+
+		s.Equal(assert.AnError, err)
+		s.Zero(count)
+	})
+}
+
+func (s *FailerTestSuite) TestForget() {
+	s.Run("happy path", func() {
+		s.mockQuery.EXPECT().Where("uuid", "test-uuid").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Delete().Return(&db.Result{RowsAffected: 1}, nil).Once()
+
+		s.NoError(s.failer.Forget("test-uuid"))
+	})
+
+	s.Run("failed job not found", func() {
+		s.mockQuery.EXPECT().Where("uuid", "test-uuid").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Delete().Return(&db.Result{RowsAffected: 0}, nil).Once()
+
+		s.Equal(errors.QueueFailedJobNotFound.Args("test-uuid"), s.failer.Forget("test-uuid"))
+	})
+}
+
 func (s *FailerTestSuite) TestGet() {
 	modelFailedJobs := []models.FailedJob{
 		{
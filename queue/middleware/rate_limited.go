@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type RateLimitedMiddleware struct {
+	limit       contractshttp.Limit
+	dontRelease bool
+}
+
+// RateLimited limits how often a job runs using the token bucket of the given
+// limit, e.g. limit.PerMinute(10).By("stripe"), a rate limited job is released
+// back onto the queue until a token is available.
+func RateLimited(limit contractshttp.Limit) *RateLimitedMiddleware {
+	return &RateLimitedMiddleware{
+		limit: limit,
+	}
+}
+
+// DontRelease drops a rate limited job instead of releasing it.
+func (r *RateLimitedMiddleware) DontRelease() *RateLimitedMiddleware {
+	r.dontRelease = true
+	return r
+}
+
+func (r *RateLimitedMiddleware) Handle(job contractsqueue.QueuedJob, next func() error) error {
+	key := "goravel:queue-rate-limited:" + job.Job().Signature() + ":" + r.limit.GetKey()
+
+	_, _, reset, ok, err := r.limit.GetStore().Take(context.Background(), key)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if !r.dontRelease {
+			job.Release(time.Duration(max(int64(reset)-carbon.Now().TimestampNano(), 0)))
+		}
+
+		return nil
+	}
+
+	return next()
+}
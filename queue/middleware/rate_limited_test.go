@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mockshttp "github.com/goravel/framework/mocks/http"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestRateLimited(t *testing.T) {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	var (
+		mockLimit     *mockshttp.Limit
+		mockStore     *mockshttp.Store
+		mockQueuedJob *mocksqueue.QueuedJob
+	)
+
+	beforeEach := func() {
+		mockLimit = mockshttp.NewLimit(t)
+		mockStore = mockshttp.NewStore(t)
+		mockQueuedJob = mocksqueue.NewQueuedJob(t)
+
+		mockJob := mocksqueue.NewJob(t)
+		mockJob.EXPECT().Signature().Return("job").Once()
+		mockQueuedJob.EXPECT().Job().Return(mockJob).Once()
+		mockLimit.EXPECT().GetKey().Return("stripe").Once()
+		mockLimit.EXPECT().GetStore().Return(mockStore).Once()
+	}
+
+	t.Run("runs the job when a token is available", func(t *testing.T) {
+		beforeEach()
+		mockStore.EXPECT().Take(context.Background(), "goravel:queue-rate-limited:job:stripe").Return(10, 9, 0, true, nil).Once()
+
+		called := false
+		err := RateLimited(mockLimit).Handle(mockQueuedJob, func() error {
+			called = true
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("releases the job until the bucket resets", func(t *testing.T) {
+		beforeEach()
+		reset := uint64(carbon.Now().AddSeconds(30).TimestampNano())
+		mockStore.EXPECT().Take(context.Background(), "goravel:queue-rate-limited:job:stripe").Return(10, 0, reset, false, nil).Once()
+		mockQueuedJob.EXPECT().Release(30 * time.Second).Once()
+
+		err := RateLimited(mockLimit).Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("drops the job", func(t *testing.T) {
+		beforeEach()
+		mockStore.EXPECT().Take(context.Background(), "goravel:queue-rate-limited:job:stripe").Return(10, 0, 0, false, nil).Once()
+
+		err := RateLimited(mockLimit).DontRelease().Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed to take a token", func(t *testing.T) {
+		beforeEach()
+		mockStore.EXPECT().Take(context.Background(), "goravel:queue-rate-limited:job:stripe").Return(0, 0, 0, false, assert.AnError).Once()
+
+		err := RateLimited(mockLimit).Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.Equal(t, assert.AnError, err)
+	})
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+type SkipMiddleware struct {
+	condition func(job contractsqueue.QueuedJob) bool
+}
+
+// SkipIf skips a job when the condition is true, a skipped job is deleted as if it succeeded.
+func SkipIf(condition func(job contractsqueue.QueuedJob) bool) *SkipMiddleware {
+	return &SkipMiddleware{
+		condition: condition,
+	}
+}
+
+// SkipUnless skips a job when the condition is false, a skipped job is deleted as if it succeeded.
+func SkipUnless(condition func(job contractsqueue.QueuedJob) bool) *SkipMiddleware {
+	return &SkipMiddleware{
+		condition: func(job contractsqueue.QueuedJob) bool {
+			return !condition(job)
+		},
+	}
+}
+
+func (r *SkipMiddleware) Handle(job contractsqueue.QueuedJob, next func() error) error {
+	if r.condition(job) {
+		return nil
+	}
+
+	return next()
+}
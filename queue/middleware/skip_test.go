@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name       string
+		middleware *SkipMiddleware
+		wantCalled bool
+	}{
+		{
+			name:       "skip if true",
+			middleware: SkipIf(func(contractsqueue.QueuedJob) bool { return true }),
+			wantCalled: false,
+		},
+		{
+			name:       "skip if false",
+			middleware: SkipIf(func(contractsqueue.QueuedJob) bool { return false }),
+			wantCalled: true,
+		},
+		{
+			name:       "skip unless true",
+			middleware: SkipUnless(func(contractsqueue.QueuedJob) bool { return true }),
+			wantCalled: true,
+		},
+		{
+			name:       "skip unless false",
+			middleware: SkipUnless(func(contractsqueue.QueuedJob) bool { return false }),
+			wantCalled: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := false
+			err := test.middleware.Handle(mocksqueue.NewQueuedJob(t), func() error {
+				called = true
+				return nil
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantCalled, called)
+		})
+	}
+}
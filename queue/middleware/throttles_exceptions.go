@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type ThrottlesExceptionsMiddleware struct {
+	cache         contractscache.Driver
+	when          func(err error) bool
+	key           string
+	backoff       time.Duration
+	decay         time.Duration
+	maxExceptions int
+}
+
+// ThrottlesExceptions releases a job instead of running it once it has failed
+// maxExceptions times in a row, until decay has passed since the last failure.
+// A failed job is released after the backoff instead of being failed, unless
+// it has no attempts left.
+func ThrottlesExceptions(cache contractscache.Driver, maxExceptions int, decay time.Duration) *ThrottlesExceptionsMiddleware {
+	return &ThrottlesExceptionsMiddleware{
+		cache:         cache,
+		decay:         decay,
+		maxExceptions: max(maxExceptions, 1),
+	}
+}
+
+// Backoff sets the delay before a failed job is available again.
+func (r *ThrottlesExceptionsMiddleware) Backoff(backoff time.Duration) *ThrottlesExceptionsMiddleware {
+	r.backoff = backoff
+	return r
+}
+
+// By sets the key of the throttle, jobs with the same signature share a throttle by default.
+func (r *ThrottlesExceptionsMiddleware) By(key string) *ThrottlesExceptionsMiddleware {
+	r.key = key
+	return r
+}
+
+// When sets the condition of the errors that should be throttled, the other
+// errors fail the job as usual.
+func (r *ThrottlesExceptionsMiddleware) When(when func(err error) bool) *ThrottlesExceptionsMiddleware {
+	r.when = when
+	return r
+}
+
+func (r *ThrottlesExceptionsMiddleware) Handle(job contractsqueue.QueuedJob, next func() error) error {
+	key := "goravel:queue-throttle-exceptions:" + job.Job().Signature() + ":" + r.key
+	untilKey := key + ":until"
+
+	exceptions := r.cache.GetInt(key)
+	if exceptions >= r.maxExceptions {
+		job.Release(time.Duration(max(r.cache.GetInt64(untilKey)-carbon.Now().TimestampNano(), 0)))
+		return nil
+	}
+
+	err := next()
+	if err == nil {
+		r.cache.Forget(key)
+		r.cache.Forget(untilKey)
+		return nil
+	}
+
+	if r.when != nil && !r.when(err) {
+		return err
+	}
+
+	if putErr := r.cache.Put(key, exceptions+1, r.decay); putErr != nil {
+		return putErr
+	}
+	if putErr := r.cache.Put(untilKey, carbon.Now().TimestampNano()+int64(r.decay), r.decay); putErr != nil {
+		return putErr
+	}
+
+	// The job fails as usual once it has no attempts left.
+	if job.Attempts() >= job.MaxTries() {
+		return err
+	}
+
+	job.Release(r.backoff)
+
+	return nil
+}
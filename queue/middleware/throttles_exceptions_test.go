@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestThrottlesExceptions(t *testing.T) {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	var (
+		mockCache     *mockscache.Cache
+		mockQueuedJob *mocksqueue.QueuedJob
+	)
+
+	key := "goravel:queue-throttle-exceptions:job:api"
+	untilKey := key + ":until"
+	until := carbon.Now().TimestampNano() + int64(time.Minute)
+
+	beforeEach := func() {
+		mockCache = mockscache.NewCache(t)
+		mockQueuedJob = mocksqueue.NewQueuedJob(t)
+
+		mockJob := mocksqueue.NewJob(t)
+		mockJob.EXPECT().Signature().Return("job").Once()
+		mockQueuedJob.EXPECT().Job().Return(mockJob).Once()
+	}
+
+	t.Run("clears the exceptions when the job succeeds", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().GetInt(key).Return(1).Once()
+		mockCache.EXPECT().Forget(key).Return(true).Once()
+		mockCache.EXPECT().Forget(untilKey).Return(true).Once()
+
+		err := ThrottlesExceptions(mockCache, 3, time.Minute).By("api").Handle(mockQueuedJob, func() error {
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("releases a failed job after the backoff", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().GetInt(key).Return(1).Once()
+		mockCache.EXPECT().Put(key, 2, time.Minute).Return(nil).Once()
+		mockCache.EXPECT().Put(untilKey, until, time.Minute).Return(nil).Once()
+		mockQueuedJob.EXPECT().Attempts().Return(1).Once()
+		mockQueuedJob.EXPECT().MaxTries().Return(3).Once()
+		mockQueuedJob.EXPECT().Release(5 * time.Second).Once()
+
+		err := ThrottlesExceptions(mockCache, 3, time.Minute).By("api").Backoff(5*time.Second).Handle(mockQueuedJob, func() error {
+			return assert.AnError
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns the error when the job has no attempts left", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().GetInt(key).Return(1).Once()
+		mockCache.EXPECT().Put(key, 2, time.Minute).Return(nil).Once()
+		mockCache.EXPECT().Put(untilKey, until, time.Minute).Return(nil).Once()
+		mockQueuedJob.EXPECT().Attempts().Return(3).Once()
+		mockQueuedJob.EXPECT().MaxTries().Return(3).Once()
+
+		err := ThrottlesExceptions(mockCache, 3, time.Minute).By("api").Handle(mockQueuedJob, func() error {
+			return assert.AnError
+		})
+
+		assert.Equal(t, assert.AnError, err)
+	})
+
+	t.Run("returns the errors that should not be throttled", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().GetInt(key).Return(0).Once()
+
+		err := ThrottlesExceptions(mockCache, 3, time.Minute).By("api").When(func(err error) bool {
+			return false
+		}).Handle(mockQueuedJob, func() error {
+			return assert.AnError
+		})
+
+		assert.Equal(t, assert.AnError, err)
+	})
+
+	t.Run("releases the job until the decay passes when there are too many exceptions", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().GetInt(key).Return(3).Once()
+		mockCache.EXPECT().GetInt64(untilKey).Return(carbon.Now().TimestampNano() + int64(20*time.Second)).Once()
+		mockQueuedJob.EXPECT().Release(20 * time.Second).Once()
+
+		err := ThrottlesExceptions(mockCache, 3, time.Minute).By("api").Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+type WithoutOverlappingMiddleware struct {
+	cache        contractscache.Driver
+	key          string
+	expiresAfter time.Duration
+	releaseAfter time.Duration
+	dontRelease  bool
+}
+
+// WithoutOverlapping prevents jobs with the same signature and key from running
+// at the same time, an overlapping job is released back onto the queue.
+func WithoutOverlapping(cache contractscache.Driver, key string) *WithoutOverlappingMiddleware {
+	return &WithoutOverlappingMiddleware{
+		cache: cache,
+		key:   key,
+	}
+}
+
+// DontRelease drops an overlapping job instead of releasing it.
+func (r *WithoutOverlappingMiddleware) DontRelease() *WithoutOverlappingMiddleware {
+	r.dontRelease = true
+	return r
+}
+
+// ExpireAfter sets the expiration of the lock, it avoids the lock being kept
+// forever if a worker crashes while running the job.
+func (r *WithoutOverlappingMiddleware) ExpireAfter(expiresAfter time.Duration) *WithoutOverlappingMiddleware {
+	r.expiresAfter = expiresAfter
+	return r
+}
+
+// ReleaseAfter sets the delay before an overlapping job is available again.
+func (r *WithoutOverlappingMiddleware) ReleaseAfter(releaseAfter time.Duration) *WithoutOverlappingMiddleware {
+	r.releaseAfter = releaseAfter
+	return r
+}
+
+func (r *WithoutOverlappingMiddleware) Handle(job contractsqueue.QueuedJob, next func() error) error {
+	key := "goravel:queue-overlap:" + job.Job().Signature() + ":" + r.key
+
+	var lock contractscache.Lock
+	if r.expiresAfter > 0 {
+		lock = r.cache.Lock(key, r.expiresAfter)
+	} else {
+		lock = r.cache.Lock(key)
+	}
+
+	if !lock.Get() {
+		if !r.dontRelease {
+			job.Release(r.releaseAfter)
+		}
+
+		return nil
+	}
+
+	defer lock.Release()
+
+	return next()
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+func TestWithoutOverlapping(t *testing.T) {
+	var (
+		mockCache     *mockscache.Cache
+		mockLock      *mockscache.Lock
+		mockQueuedJob *mocksqueue.QueuedJob
+	)
+
+	beforeEach := func() {
+		mockCache = mockscache.NewCache(t)
+		mockLock = mockscache.NewLock(t)
+		mockQueuedJob = mocksqueue.NewQueuedJob(t)
+
+		mockJob := mocksqueue.NewJob(t)
+		mockJob.EXPECT().Signature().Return("job").Once()
+		mockQueuedJob.EXPECT().Job().Return(mockJob).Once()
+	}
+
+	t.Run("runs the job when the lock is acquired", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().Lock("goravel:queue-overlap:job:1").Return(mockLock).Once()
+		mockLock.EXPECT().Get().Return(true).Once()
+		mockLock.EXPECT().Release().Return(true).Once()
+
+		called := false
+		err := WithoutOverlapping(mockCache, "1").Handle(mockQueuedJob, func() error {
+			called = true
+			return assert.AnError
+		})
+
+		assert.Equal(t, assert.AnError, err)
+		assert.True(t, called)
+	})
+
+	t.Run("releases an overlapping job", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().Lock("goravel:queue-overlap:job:1", time.Minute).Return(mockLock).Once()
+		mockLock.EXPECT().Get().Return(false).Once()
+		mockQueuedJob.EXPECT().Release(10 * time.Second).Once()
+
+		err := WithoutOverlapping(mockCache, "1").ExpireAfter(time.Minute).ReleaseAfter(10*time.Second).Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("drops an overlapping job", func(t *testing.T) {
+		beforeEach()
+		mockCache.EXPECT().Lock("goravel:queue-overlap:job:1").Return(mockLock).Once()
+		mockLock.EXPECT().Get().Return(false).Once()
+
+		err := WithoutOverlapping(mockCache, "1").DontRelease().Handle(mockQueuedJob, func() error {
+			t.Fatal("the job should not run")
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+}
@@ -33,3 +33,17 @@ type FailedJob struct {
 	Exception  string           `db:"exception"`
 	ID         uint             `db:"id"`
 }
+
+type JobBatch struct {
+	CancelledAt  *carbon.DateTime `db:"cancelled_at"`
+	CreatedAt    *carbon.DateTime `db:"created_at"`
+	FinishedAt   *carbon.DateTime `db:"finished_at"`
+	ID           string           `db:"id"`
+	Name         string           `db:"name"`
+	FailedJobIDs string           `db:"failed_job_ids"`
+	Options      string           `db:"options"`
+	TotalJobs    int              `db:"total_jobs"`
+	PendingJobs  int              `db:"pending_jobs"`
+	FailedJobs   int              `db:"failed_jobs"`
+	SkippedJobs  int              `db:"skipped_jobs"`
+}
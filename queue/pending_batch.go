@@ -0,0 +1,148 @@
+package queue
+
+import (
+	"github.com/google/uuid"
+
+	contractsfoundation "github.com/goravel/framework/contracts/foundation"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/queue/models"
+	"github.com/goravel/framework/queue/utils"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type PendingBatch struct {
+	connection    string
+	driverCreator contractsqueue.DriverCreator
+	json          contractsfoundation.Json
+	name          string
+	options       BatchOptions
+	queue         string
+	repository    *BatchRepository
+	repositoryErr error
+	jobs          []contractsqueue.ChainJob
+}
+
+func NewPendingBatch(
+	config contractsqueue.Config,
+	driverCreator contractsqueue.DriverCreator,
+	repository *BatchRepository,
+	repositoryErr error,
+	json contractsfoundation.Json,
+	jobs []contractsqueue.ChainJob,
+) *PendingBatch {
+	return &PendingBatch{
+		connection:    config.DefaultConnection(),
+		driverCreator: driverCreator,
+		json:          json,
+		queue:         config.DefaultQueue(),
+		repository:    repository,
+		repositoryErr: repositoryErr,
+		jobs:          jobs,
+	}
+}
+
+// AllowFailures keeps the batch running after a job fails
+func (r *PendingBatch) AllowFailures() contractsqueue.PendingBatch {
+	r.options.AllowFailures = true
+	return r
+}
+
+// Catch registers a job to be called when the first job of the batch fails
+func (r *PendingBatch) Catch(job contractsqueue.Job) contractsqueue.PendingBatch {
+	r.options.Catch = append(r.options.Catch, job.Signature())
+	return r
+}
+
+// Dispatch stores the batch and dispatches its jobs
+func (r *PendingBatch) Dispatch() (contractsqueue.Batch, error) {
+	if r.repositoryErr != nil {
+		return nil, r.repositoryErr
+	}
+	if len(r.jobs) == 0 {
+		return nil, errors.QueueBatchEmpty
+	}
+
+	driver, err := r.driverCreator.Create(r.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := r.json.MarshalString(r.options)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := r.repository.Store(models.JobBatch{
+		ID:          uuid.New().String(),
+		Name:        r.name,
+		TotalJobs:   len(r.jobs),
+		PendingJobs: len(r.jobs),
+		Options:     options,
+		CreatedAt:   carbon.NewDateTime(carbon.Now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, job := range r.jobs {
+		task := utils.RefreshRetryUntil(contractsqueue.Task{
+			UUID:     uuid.New().String(),
+			BatchID:  batch.ID(),
+			ChainJob: job,
+		})
+
+		// The sync driver runs the job while pushing, so the result has to be
+		// recorded here instead of by a worker.
+		if driver.Driver() == contractsqueue.DriverSync {
+			if r.repository.Cancelled(batch.ID()) {
+				err = r.repository.RecordSkipped(batch.ID())
+			} else {
+				err = r.repository.Record(batch.ID(), task.UUID, driver.Push(task, r.queue))
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		// The jobs that aren't queued are removed from the batch, otherwise it
+		// keeps waiting for them and never finishes.
+		if err := driver.Push(task, r.queue); err != nil {
+			return nil, errors.Join(err, r.repository.RecordUnqueued(batch.ID(), len(r.jobs)-i))
+		}
+	}
+
+	return batch.Fresh()
+}
+
+// Finally registers a job to be called when every job of the batch has run
+func (r *PendingBatch) Finally(job contractsqueue.Job) contractsqueue.PendingBatch {
+	r.options.Finally = append(r.options.Finally, job.Signature())
+	return r
+}
+
+// Name sets the name of the batch
+func (r *PendingBatch) Name(name string) contractsqueue.PendingBatch {
+	r.name = name
+	return r
+}
+
+// OnConnection sets the connection name
+func (r *PendingBatch) OnConnection(connection string) contractsqueue.PendingBatch {
+	r.connection = connection
+	return r
+}
+
+// OnQueue sets the queue name
+func (r *PendingBatch) OnQueue(queue string) contractsqueue.PendingBatch {
+	r.queue = queue
+	return r
+}
+
+// Then registers a job to be called when every job of the batch has succeeded
+func (r *PendingBatch) Then(job contractsqueue.Job) contractsqueue.PendingBatch {
+	r.options.Then = append(r.options.Then, job.Signature())
+	return r
+}
@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/foundation/json"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/queue/models"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type PendingBatchTestSuite struct {
+	suite.Suite
+	mockDB            *mocksdb.DB
+	mockDriverCreator *mocksqueue.DriverCreator
+	pendingBatch      *PendingBatch
+}
+
+func TestPendingBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(PendingBatchTestSuite))
+}
+
+func (s *PendingBatchTestSuite) SetupTest() {
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockDriverCreator = mocksqueue.NewDriverCreator(s.T())
+	s.pendingBatch = &PendingBatch{
+		connection:    "default",
+		driverCreator: s.mockDriverCreator,
+		json:          json.New(),
+		queue:         "default",
+		repository: &BatchRepository{
+			db:    s.mockDB,
+			json:  json.New(),
+			table: "job_batches",
+		},
+		jobs: []contractsqueue.ChainJob{
+			{Job: &TestJobOne{}},
+			{Job: &TestJobTwo{}},
+		},
+	}
+}
+
+func (s *PendingBatchTestSuite) TestOptions() {
+	s.pendingBatch.AllowFailures().
+		Name("import").
+		Then(&TestJobOne{}).
+		Catch(&TestJobTwo{}).
+		Finally(&TestJobOne{}).
+		OnConnection("database").
+		OnQueue("high")
+
+	s.Equal("import", s.pendingBatch.name)
+	s.Equal("database", s.pendingBatch.connection)
+	s.Equal("high", s.pendingBatch.queue)
+	s.Equal(BatchOptions{
+		AllowFailures: true,
+		Then:          []string{"test_job_one"},
+		Catch:         []string{"test_job_two"},
+		Finally:       []string{"test_job_one"},
+	}, s.pendingBatch.options)
+}
+
+func (s *PendingBatchTestSuite) TestDispatch() {
+	s.Run("batching is not configured", func() {
+		s.SetupTest()
+		s.pendingBatch.repositoryErr = errors.QueueBatchingNotConfigured
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.Nil(batch)
+		s.Equal(errors.QueueBatchingNotConfigured, err)
+	})
+
+	s.Run("empty batch", func() {
+		s.SetupTest()
+		s.pendingBatch.jobs = nil
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.Nil(batch)
+		s.Equal(errors.QueueBatchEmpty, err)
+	})
+
+	s.Run("failed to create driver", func() {
+		s.SetupTest()
+		s.mockDriverCreator.EXPECT().Create("default").Return(nil, assert.AnError).Once()
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.Nil(batch)
+		s.Equal(assert.AnError, err)
+	})
+
+	s.Run("happy path", func() {
+		s.SetupTest()
+
+		var batchID string
+		mockDriver := mocksqueue.NewDriver(s.T())
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDriverCreator.EXPECT().Create("default").Return(mockDriver, nil).Once()
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Twice()
+		mockQuery.EXPECT().Insert(mock.Anything).Run(func(data any) {
+			batch := data.(*models.JobBatch)
+			batchID = batch.ID
+
+			s.Equal(2, batch.TotalJobs)
+			s.Equal(2, batch.PendingJobs)
+			s.Equal(`{"allow_failures":false}`, batch.Options)
+		}).Return(nil, nil).Once()
+		mockDriver.EXPECT().Driver().Return(contractsqueue.DriverDatabase).Twice()
+		mockDriver.EXPECT().Push(mock.MatchedBy(func(task contractsqueue.Task) bool {
+			return task.BatchID == batchID && task.UUID != ""
+		}), "default").Return(nil).Twice()
+		mockQuery.EXPECT().Where("id", mock.Anything).Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{ID: batchID, TotalJobs: 2, PendingJobs: 2}
+		}).Return(nil).Once()
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.NoError(err)
+		s.Equal(batchID, batch.ID())
+		s.Equal(2, batch.TotalJobs())
+	})
+
+	s.Run("failed to push", func() {
+		s.SetupTest()
+
+		mockDriver := mocksqueue.NewDriver(s.T())
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDriverCreator.EXPECT().Create("default").Return(mockDriver, nil).Once()
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Insert(mock.Anything).Return(nil, nil).Once()
+		mockDriver.EXPECT().Driver().Return(contractsqueue.DriverDatabase).Twice()
+		mockDriver.EXPECT().Push(mock.Anything, "default").Return(nil).Once()
+		mockDriver.EXPECT().Push(mock.Anything, "default").Return(assert.AnError).Once()
+
+		// The job that failed to be pushed is removed from the batch.
+		mockTx := mocksdb.NewTx(s.T())
+		mockTxQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(tx contractsdb.Tx) error) error {
+			return txFunc(mockTx)
+		}).Once()
+		mockTx.EXPECT().Table("job_batches").Return(mockTxQuery).Twice()
+		mockTxQuery.EXPECT().LockForUpdate().Return(mockTxQuery).Once()
+		mockTxQuery.EXPECT().Where("id", mock.Anything).Return(mockTxQuery).Twice()
+		mockTxQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{ID: "1", TotalJobs: 2, PendingJobs: 2}
+		}).Return(nil).Once()
+		mockTxQuery.EXPECT().Update(mock.MatchedBy(func(values map[string]any) bool {
+			return values["total_jobs"] == 1 && values["pending_jobs"] == 1 && values["cancelled_at"] != nil
+		})).Return(nil, nil).Once()
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.Nil(batch)
+		s.ErrorIs(err, assert.AnError)
+	})
+
+	s.Run("skip the jobs of a cancelled batch with the sync driver", func() {
+		s.SetupTest()
+
+		mockDriver := mocksqueue.NewDriver(s.T())
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.mockDriverCreator.EXPECT().Create("default").Return(mockDriver, nil).Once()
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Times(4)
+		mockQuery.EXPECT().Insert(mock.Anything).Return(nil, nil).Once()
+		mockDriver.EXPECT().Driver().Return(contractsqueue.DriverSync).Twice()
+		mockQuery.EXPECT().Where("id", mock.Anything).Return(mockQuery).Times(3)
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			cancelledAt := carbon.NewDateTime(carbon.Now())
+			*dest.(*models.JobBatch) = models.JobBatch{ID: "1", TotalJobs: 2, PendingJobs: 2, CancelledAt: cancelledAt}
+		}).Return(nil).Times(3)
+
+		// The jobs are recorded as skipped rather than processed.
+		mockTx := mocksdb.NewTx(s.T())
+		mockTxQuery := mocksdb.NewQuery(s.T())
+		s.mockDB.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(tx contractsdb.Tx) error) error {
+			return txFunc(mockTx)
+		}).Twice()
+		mockTx.EXPECT().Table("job_batches").Return(mockTxQuery).Times(4)
+		mockTxQuery.EXPECT().LockForUpdate().Return(mockTxQuery).Twice()
+		mockTxQuery.EXPECT().Where("id", mock.Anything).Return(mockTxQuery).Times(4)
+		mockTxQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{ID: "1", TotalJobs: 2, PendingJobs: 2}
+		}).Return(nil).Twice()
+		mockTxQuery.EXPECT().Update(map[string]any{"skipped_jobs": 1}).Return(nil, nil).Twice()
+
+		batch, err := s.pendingBatch.Dispatch()
+
+		s.NoError(err)
+		s.Equal(2, batch.TotalJobs())
+		s.Equal(2, batch.PendingJobs())
+	})
+}
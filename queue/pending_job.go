@@ -9,10 +9,12 @@ import (
 	contractsfoundation "github.com/goravel/framework/contracts/foundation"
 	contractslog "github.com/goravel/framework/contracts/log"
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/queue/utils"
 	"github.com/goravel/framework/support/carbon"
 )
 
 type PendingJob struct {
+	cache         contractscache.Cache
 	connection    string
 	driverCreator contractsqueue.DriverCreator
 	delay         time.Time
@@ -38,6 +40,7 @@ func NewPendingJob(
 	queue := config.DefaultQueue()
 
 	return &PendingJob{
+		cache:         cache,
 		connection:    connection,
 		driverCreator: NewDriverCreator(config, cache, db, jobStorer, json, log),
 		queue:         queue,
@@ -83,6 +86,7 @@ func NewPendingChainJob(
 	queue := config.DefaultQueue()
 
 	return &PendingJob{
+		cache:         cache,
 		connection:    connection,
 		driverCreator: NewDriverCreator(config, cache, db, jobStorer, json, log),
 		queue:         queue,
@@ -108,8 +112,33 @@ func (r *PendingJob) Dispatch() error {
 	}
 
 	r.recalculateDelay()
+	r.task = utils.RefreshRetryUntil(r.task)
 
-	return driver.Push(r.task, r.queue)
+	lock := uniqueLock(r.cache, r.task.Job, utils.ConvertArgs(r.task.Args))
+	if lock == nil {
+		return driver.Push(r.task, r.queue)
+	}
+
+	// Another instance of the unique job is queued or running, skip it.
+	if !lock.Get() {
+		return nil
+	}
+
+	// The task carries the owner token, so that only the worker processing this
+	// dispatch releases the lock, the locks without an owner get a token of their own.
+	r.task.UniqueOwner = uuid.NewString()
+	if lockWithOwner, ok := lock.(contractscache.LockWithOwner); ok {
+		r.task.UniqueOwner = lockWithOwner.Owner()
+	}
+
+	// The lock is released by the worker once the job is processed, the sync
+	// driver has processed the job when Push returns.
+	if err := driver.Push(r.task, r.queue); err != nil || driver.Driver() == contractsqueue.DriverSync {
+		lock.Release()
+		return err
+	}
+
+	return nil
 }
 
 // DispatchSync dispatches the task synchronously
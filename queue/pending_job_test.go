@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	contractsqueue "github.com/goravel/framework/contracts/queue"
+	mockscache "github.com/goravel/framework/mocks/cache"
 	mocksqueue "github.com/goravel/framework/mocks/queue"
 )
 
@@ -80,6 +82,67 @@ func (s *PendingJobTestSuite) Test_Dispatch() {
 	})
 }
 
+func (s *PendingJobTestSuite) TestDispatchUniqueJob() {
+	var (
+		mockCache  *mockscache.Cache
+		mockLock   *mockscache.Lock
+		mockDriver *mocksqueue.Driver
+	)
+
+	beforeEach := func() {
+		s.SetupTest()
+
+		mockCache = mockscache.NewCache(s.T())
+		mockLock = mockscache.NewLock(s.T())
+		mockDriver = mocksqueue.NewDriver(s.T())
+		s.pendingJob.cache = mockCache
+		s.pendingJob.task.Job = &TestUniqueJob{}
+		s.pendingJob.task.Args = []contractsqueue.Arg{{Type: "int", Value: 1}}
+
+		s.mockDriverCreator.EXPECT().Create("default").Return(mockDriver, nil).Once()
+		mockCache.EXPECT().Lock("goravel:queue-unique:test_unique_job:1").Return(mockLock).Once()
+	}
+
+	withOwner := mock.MatchedBy(func(task contractsqueue.Task) bool {
+		return task.UniqueOwner != ""
+	})
+
+	s.Run("keeps the lock until the worker processes the job", func() {
+		beforeEach()
+		mockLock.EXPECT().Get().Return(true).Once()
+		mockDriver.EXPECT().Push(withOwner, "default").Return(nil).Once()
+		mockDriver.EXPECT().Driver().Return(contractsqueue.DriverDatabase).Once()
+
+		s.NoError(s.pendingJob.Dispatch())
+	})
+
+	s.Run("releases the lock when the sync driver processed the job", func() {
+		beforeEach()
+		mockLock.EXPECT().Get().Return(true).Once()
+		mockDriver.EXPECT().Push(withOwner, "default").Return(nil).Once()
+		mockDriver.EXPECT().Driver().Return(contractsqueue.DriverSync).Once()
+		mockLock.EXPECT().Release().Return(true).Once()
+
+		s.NoError(s.pendingJob.Dispatch())
+	})
+
+	s.Run("releases the lock when failed to push", func() {
+		beforeEach()
+		mockLock.EXPECT().Get().Return(true).Once()
+		mockDriver.EXPECT().Push(withOwner, "default").Return(assert.AnError).Once()
+		mockLock.EXPECT().Release().Return(true).Once()
+
+		s.Equal(assert.AnError, s.pendingJob.Dispatch())
+	})
+
+	s.Run("skips the job when another one is queued", func() {
+		beforeEach()
+		mockLock.EXPECT().Get().Return(false).Once()
+
+		s.NoError(s.pendingJob.Dispatch())
+	})
+}
+
 func (s *PendingJobTestSuite) TestDispatchSync() {
 	s.Run("happy path", func() {
 		err := s.pendingJob.DispatchSync()
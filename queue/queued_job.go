@@ -0,0 +1,81 @@
+package queue
+
+import (
+	"time"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+var _ contractsqueue.QueuedJob = &QueuedJob{}
+
+// QueuedJob is the job passed through the job middleware.
+type QueuedJob struct {
+	job         contractsqueue.Job
+	reservedJob contractsqueue.ReservedJob
+	args        []any
+	attempt     int
+	maxTries    int
+	delay       time.Duration
+	released    bool
+}
+
+func NewQueuedJob(job contractsqueue.Job, args []any, reservedJob contractsqueue.ReservedJob, attempt, maxTries int) *QueuedJob {
+	return &QueuedJob{
+		job:         job,
+		reservedJob: reservedJob,
+		args:        args,
+		attempt:     attempt,
+		maxTries:    maxTries,
+	}
+}
+
+func (r *QueuedJob) Args() []any {
+	return r.args
+}
+
+// Attempts returns the persisted attempt count when the job is reserved from
+// a queue, otherwise the in-memory attempt count.
+func (r *QueuedJob) Attempts() int {
+	if r.reservedJob != nil {
+		return r.reservedJob.Attempts()
+	}
+
+	return r.attempt
+}
+
+func (r *QueuedJob) Job() contractsqueue.Job {
+	return r.job
+}
+
+func (r *QueuedJob) MaxTries() int {
+	return r.maxTries
+}
+
+func (r *QueuedJob) Release(delay time.Duration) {
+	r.released = true
+	r.delay = delay
+}
+
+// Handle passes the job through its middleware, call runs the job itself at
+// the end of the pipeline.
+func (r *QueuedJob) Handle(call func() error) error {
+	jobWithMiddleware, ok := r.job.(contractsqueue.JobWithMiddleware)
+	if !ok {
+		return call()
+	}
+
+	middleware := jobWithMiddleware.Middleware(r.args...)
+
+	var next func(index int) error
+	next = func(index int) error {
+		if index >= len(middleware) {
+			return call()
+		}
+
+		return middleware[index].Handle(r, func() error {
+			return next(index + 1)
+		})
+	}
+
+	return next(0)
+}
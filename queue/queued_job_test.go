@@ -0,0 +1,105 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	mocksqueue "github.com/goravel/framework/mocks/queue"
+)
+
+type TestJobWithMiddleware struct {
+	middleware []contractsqueue.JobMiddleware
+}
+
+func (r *TestJobWithMiddleware) Signature() string {
+	return "test_job_with_middleware"
+}
+
+func (r *TestJobWithMiddleware) Handle(_ ...any) error {
+	return nil
+}
+
+func (r *TestJobWithMiddleware) Middleware(_ ...any) []contractsqueue.JobMiddleware {
+	return r.middleware
+}
+
+type TestJobMiddleware struct {
+	name    string
+	calls   *[]string
+	release bool
+}
+
+func (r *TestJobMiddleware) Handle(job contractsqueue.QueuedJob, next func() error) error {
+	*r.calls = append(*r.calls, r.name)
+	if r.release {
+		job.Release(time.Second)
+		return nil
+	}
+
+	return next()
+}
+
+func TestQueuedJobHandle(t *testing.T) {
+	t.Run("job without middleware", func(t *testing.T) {
+		called := false
+		err := NewQueuedJob(&TestJobOne{}, nil, nil, 1, 3).Handle(func() error {
+			called = true
+			return assert.AnError
+		})
+
+		assert.Equal(t, assert.AnError, err)
+		assert.True(t, called)
+	})
+
+	t.Run("middleware run in order", func(t *testing.T) {
+		var calls []string
+		job := &TestJobWithMiddleware{middleware: []contractsqueue.JobMiddleware{
+			&TestJobMiddleware{name: "first", calls: &calls},
+			&TestJobMiddleware{name: "second", calls: &calls},
+		}}
+
+		queuedJob := NewQueuedJob(job, []any{"a"}, nil, 1, 3)
+		err := queuedJob.Handle(func() error {
+			calls = append(calls, "job")
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first", "second", "job"}, calls)
+		assert.False(t, queuedJob.released)
+	})
+
+	t.Run("middleware releases the job", func(t *testing.T) {
+		var calls []string
+		job := &TestJobWithMiddleware{middleware: []contractsqueue.JobMiddleware{
+			&TestJobMiddleware{name: "first", calls: &calls, release: true},
+			&TestJobMiddleware{name: "second", calls: &calls},
+		}}
+
+		queuedJob := NewQueuedJob(job, nil, nil, 1, 3)
+		err := queuedJob.Handle(func() error {
+			calls = append(calls, "job")
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first"}, calls)
+		assert.True(t, queuedJob.released)
+		assert.Equal(t, time.Second, queuedJob.delay)
+	})
+}
+
+func TestQueuedJobAttempts(t *testing.T) {
+	assert.Equal(t, 2, NewQueuedJob(&TestJobOne{}, nil, nil, 2, 3).Attempts())
+
+	mockReservedJob := mocksqueue.NewReservedJob(t)
+	mockReservedJob.EXPECT().Attempts().Return(3).Once()
+	assert.Equal(t, 3, NewQueuedJob(&TestJobOne{}, nil, mockReservedJob, 1, 3).Attempts())
+}
+
+func TestQueuedJobMaxTries(t *testing.T) {
+	assert.Equal(t, 3, NewQueuedJob(&TestJobOne{}, nil, nil, 1, 3).MaxTries())
+}
@@ -45,6 +45,12 @@ func (r *ServiceProvider) Boot(app foundation.Application) {
 		&queueconsole.JobMakeCommand{},
 		queueconsole.NewQueueRetryCommand(app.MakeQueue(), app.GetJson()),
 		queueconsole.NewQueueFailedCommand(app.MakeQueue()),
+		queueconsole.NewQueueWorkCommand(app.MakeQueue()),
+		queueconsole.NewQueueMonitorCommand(app.MakeConfig(), app.MakeQueue()),
+		queueconsole.NewQueueClearCommand(app.MakeConfig(), app.MakeQueue()),
+		queueconsole.NewQueueFlushCommand(app.MakeQueue()),
+		queueconsole.NewQueueBatchesTableCommand(app.MakeConfig()),
+		queueconsole.NewQueueForgetCommand(app.MakeQueue()),
 	})
 }
 
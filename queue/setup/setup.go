@@ -26,6 +26,9 @@ func main() {
 	jobMigrationFileName, jobMigrationStruct, jobMigrationContent := stubs.JobMigration(migrationPkg, facadesImport, facadesPackage)
 	jobMigrationFilePath := path.Migration(jobMigrationFileName)
 	jobMigrationStructWithPkg := fmt.Sprintf("&%s.%s", migrationPkg, jobMigrationStruct)
+	jobBatchesMigrationFileName, jobBatchesMigrationStruct, jobBatchesMigrationContent := stubs.JobBatchesMigration(migrationPkg, facadesImport, facadesPackage)
+	jobBatchesMigrationFilePath := path.Migration(jobBatchesMigrationFileName)
+	jobBatchesMigrationStructWithPkg := fmt.Sprintf("&%s.%s", migrationPkg, jobBatchesMigrationStruct)
 
 	setup.Install(
 		// Avoid duplicate installation when installing drivers
@@ -44,12 +47,24 @@ func main() {
 
 			// Register the job migration
 			modify.RegisterMigration(migrationPkgPath, jobMigrationStructWithPkg),
+
+			// Add the job batches migration file
+			modify.File(jobBatchesMigrationFilePath).Overwrite(jobBatchesMigrationContent),
+
+			// Register the job batches migration
+			modify.RegisterMigration(migrationPkgPath, jobBatchesMigrationStructWithPkg),
 		),
 
 		// Add the database driver
 		modify.WhenDriver(databaseDriver, modify.GoFile(queueConfigPath).Find(match.Config("queue")).Modify(modify.AddConfig("default", `"database"`))),
 	).Uninstall(
 		modify.WhenFacade(facades.Queue,
+			// Unregister the job batches migration
+			modify.UnregisterMigration(migrationPkgPath, jobBatchesMigrationStructWithPkg),
+
+			// Remove the job batches migration file
+			modify.File(jobBatchesMigrationFilePath).Remove(),
+
 			// Unregister the job migration
 			modify.UnregisterMigration(migrationPkgPath, jobMigrationStructWithPkg),
 
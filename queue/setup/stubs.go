@@ -43,6 +43,15 @@ func init() {
 			"database": config.Env("DB_CONNECTION"),
 			"table":    "failed_jobs",
 		},
+
+		// Job Batching
+		//
+		// The following options configure the database and table that store job
+		// batching information, the table contains the progress of each batch.
+		"batching": map[string]any{
+			"database": config.Env("DB_CONNECTION"),
+			"table":    "job_batches",
+		},
 	})
 }
 `
@@ -126,6 +135,57 @@ func (r *M20210101000001CreateJobsTable) Down() error {
 	return "20210101000001_create_jobs_table.go", "M20210101000001CreateJobsTable{}", content
 }
 
+func (s Stubs) JobBatchesMigration(pkg, facadesImport, facadesPackage string) (fileName, structName, content string) {
+	content = `package DummyPackage
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"DummyFacadesImport"
+)
+
+type M20210101000002CreateJobBatchesTable struct{}
+
+// Signature The unique signature for the migration.
+func (r *M20210101000002CreateJobBatchesTable) Signature() string {
+	return "20210101000002_create_job_batches_table"
+}
+
+// Up Run the migrations.
+func (r *M20210101000002CreateJobBatchesTable) Up() error {
+	if !DummyFacadesPackage.Schema().HasTable("job_batches") {
+		return DummyFacadesPackage.Schema().Create("job_batches", func(table schema.Blueprint) {
+			table.String("id")
+			table.String("name")
+			table.Integer("total_jobs")
+			table.Integer("pending_jobs")
+			table.Integer("failed_jobs")
+			table.Integer("skipped_jobs").Default(0)
+			table.LongText("failed_job_ids")
+			table.MediumText("options").Nullable()
+			table.DateTimeTz("cancelled_at").Nullable()
+			table.DateTimeTz("created_at").UseCurrent()
+			table.DateTimeTz("finished_at").Nullable()
+			table.Primary("id")
+		})
+	}
+
+	return nil
+}
+
+// Down Reverse the migrations.
+func (r *M20210101000002CreateJobBatchesTable) Down() error {
+	return DummyFacadesPackage.Schema().DropIfExists("job_batches")
+}
+`
+
+	content = strings.ReplaceAll(content, "DummyPackage", pkg)
+	content = strings.ReplaceAll(content, "DummyFacadesImport", facadesImport)
+	content = strings.ReplaceAll(content, "DummyFacadesPackage", facadesPackage)
+
+	return "20210101000002_create_job_batches_table.go", "M20210101000002CreateJobBatchesTable{}", content
+}
+
 func (s Stubs) QueueFacade(pkg string) string {
 	content := `package DummyPackage
 
@@ -0,0 +1,47 @@
+package queue
+
+import (
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+// uniqueLock returns the lock that keeps a unique job from being queued twice,
+// nil is returned if the job isn't unique or the cache is unavailable.
+func uniqueLock(cache contractscache.Cache, job contractsqueue.Job, args []any) contractscache.Lock {
+	key, ok := uniqueKey(cache, job, args)
+	if !ok {
+		return nil
+	}
+
+	if jobWithUniqueFor, ok := job.(contractsqueue.JobWithUniqueFor); ok && jobWithUniqueFor.UniqueFor() > 0 {
+		return cache.Lock(key, jobWithUniqueFor.UniqueFor())
+	}
+
+	return cache.Lock(key)
+}
+
+// restoreUniqueLock returns the unique lock of the given owner, false is returned with
+// the plain lock when the store can't restore locks, which has to be force released then.
+func restoreUniqueLock(cache contractscache.Cache, job contractsqueue.Job, args []any, owner string) (contractscache.Lock, bool) {
+	key, ok := uniqueKey(cache, job, args)
+	if !ok {
+		return nil, false
+	}
+
+	if driver, ok := cache.(contractscache.DriverWithRestoreLock); ok {
+		if lock := driver.RestoreLock(key, owner); lock != nil {
+			return lock, true
+		}
+	}
+
+	return cache.Lock(key), false
+}
+
+func uniqueKey(cache contractscache.Cache, job contractsqueue.Job, args []any) (string, bool) {
+	jobWithUniqueID, ok := job.(contractsqueue.JobWithUniqueID)
+	if !ok || cache == nil {
+		return "", false
+	}
+
+	return "goravel:queue-unique:" + job.Signature() + ":" + jobWithUniqueID.UniqueID(args...), true
+}
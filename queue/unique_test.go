@@ -0,0 +1,132 @@
+package queue
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	mockscache "github.com/goravel/framework/mocks/cache"
+)
+
+type TestUniqueJob struct {
+	uniqueFor time.Duration
+}
+
+func (r *TestUniqueJob) Signature() string {
+	return "test_unique_job"
+}
+
+func (r *TestUniqueJob) Handle(_ ...any) error {
+	return nil
+}
+
+func (r *TestUniqueJob) UniqueID(args ...any) string {
+	return fmt.Sprint(args...)
+}
+
+func (r *TestUniqueJob) UniqueFor() time.Duration {
+	return r.uniqueFor
+}
+
+func TestUniqueLock(t *testing.T) {
+	t.Run("job isn't unique", func(t *testing.T) {
+		assert.Nil(t, uniqueLock(mockscache.NewCache(t), &TestJobOne{}, nil))
+	})
+
+	t.Run("cache is unavailable", func(t *testing.T) {
+		assert.Nil(t, uniqueLock(nil, &TestUniqueJob{}, nil))
+	})
+
+	t.Run("lock without expiration", func(t *testing.T) {
+		mockCache := mockscache.NewCache(t)
+		mockLock := mockscache.NewLock(t)
+		mockCache.EXPECT().Lock("goravel:queue-unique:test_unique_job:1").Return(mockLock).Once()
+
+		assert.Equal(t, mockLock, uniqueLock(mockCache, &TestUniqueJob{}, []any{1}))
+	})
+
+	t.Run("lock with expiration", func(t *testing.T) {
+		mockCache := mockscache.NewCache(t)
+		mockLock := mockscache.NewLock(t)
+		mockCache.EXPECT().Lock("goravel:queue-unique:test_unique_job:1", time.Minute).Return(mockLock).Once()
+
+		assert.Equal(t, mockLock, uniqueLock(mockCache, &TestUniqueJob{uniqueFor: time.Minute}, []any{1}))
+	})
+}
+
+type restorableCache struct {
+	*mockscache.Cache
+	*mockscache.DriverWithRestoreLock
+}
+
+func TestRestoreUniqueLock(t *testing.T) {
+	t.Run("job isn't unique", func(t *testing.T) {
+		lock, restored := restoreUniqueLock(mockscache.NewCache(t), &TestJobOne{}, nil, "owner")
+		assert.Nil(t, lock)
+		assert.False(t, restored)
+	})
+
+	t.Run("restores the lock of the owner", func(t *testing.T) {
+		mockCache := &restorableCache{Cache: mockscache.NewCache(t), DriverWithRestoreLock: mockscache.NewDriverWithRestoreLock(t)}
+		mockLock := mockscache.NewLock(t)
+		mockCache.DriverWithRestoreLock.EXPECT().RestoreLock("goravel:queue-unique:test_unique_job:1", "owner").Return(mockLock).Once()
+
+		lock, restored := restoreUniqueLock(mockCache, &TestUniqueJob{}, []any{1}, "owner")
+		assert.Equal(t, mockLock, lock)
+		assert.True(t, restored)
+	})
+
+	t.Run("store can't restore locks", func(t *testing.T) {
+		mockCache := mockscache.NewCache(t)
+		mockLock := mockscache.NewLock(t)
+		mockCache.EXPECT().Lock("goravel:queue-unique:test_unique_job:1").Return(mockLock).Once()
+
+		lock, restored := restoreUniqueLock(mockCache, &TestUniqueJob{}, []any{1}, "owner")
+		assert.Equal(t, mockLock, lock)
+		assert.False(t, restored)
+	})
+}
+
+func TestReleaseUniqueLock(t *testing.T) {
+	task := contractsqueue.Task{
+		ChainJob: contractsqueue.ChainJob{
+			Job:  &TestUniqueJob{},
+			Args: []contractsqueue.Arg{{Type: "int", Value: 1}},
+		},
+	}
+
+	t.Run("task doesn't carry the owner of the lock", func(t *testing.T) {
+		worker := &Worker{cache: mockscache.NewCache(t)}
+
+		worker.releaseUniqueLock(task)
+	})
+
+	t.Run("releases the lock of the owner", func(t *testing.T) {
+		mockCache := &restorableCache{Cache: mockscache.NewCache(t), DriverWithRestoreLock: mockscache.NewDriverWithRestoreLock(t)}
+		mockLock := mockscache.NewLock(t)
+		mockCache.DriverWithRestoreLock.EXPECT().RestoreLock("goravel:queue-unique:test_unique_job:1", "owner").Return(mockLock).Once()
+		mockLock.EXPECT().Release().Return(true).Once()
+
+		task := task
+		task.UniqueOwner = "owner"
+		worker := &Worker{cache: mockCache}
+
+		worker.releaseUniqueLock(task)
+	})
+
+	t.Run("force releases the lock of a store that can't restore locks", func(t *testing.T) {
+		mockCache := mockscache.NewCache(t)
+		mockLock := mockscache.NewLock(t)
+		mockCache.EXPECT().Lock("goravel:queue-unique:test_unique_job:1").Return(mockLock).Once()
+		mockLock.EXPECT().ForceRelease().Return(true).Once()
+
+		task := task
+		task.UniqueOwner = "owner"
+		worker := &Worker{cache: mockCache}
+
+		worker.releaseUniqueLock(task)
+	})
+}
@@ -12,14 +12,18 @@ import (
 
 type Task struct {
 	Job
-	UUID  string `json:"uuid"`
-	Chain []Job  `json:"chain"`
+	UUID    string `json:"uuid"`
+	BatchID string `json:"batch_id,omitempty"`
+	Chain   []Job  `json:"chain"`
+	// UniqueOwner is the owner token of the unique lock acquired when the task was dispatched.
+	UniqueOwner string `json:"unique_owner,omitempty"`
 }
 
 type Job struct {
-	Delay     *time.Time           `json:"delay"`
-	Signature string               `json:"signature"`
-	Args      []contractsqueue.Arg `json:"args"`
+	Delay      *time.Time           `json:"delay"`
+	RetryUntil *time.Time           `json:"retry_until,omitempty"`
+	Signature  string               `json:"signature"`
+	Args       []contractsqueue.Arg `json:"args"`
 }
 
 func TaskToJson(task contractsqueue.Task, json foundation.Json) (string, error) {
@@ -41,6 +45,10 @@ func TaskToJson(task contractsqueue.Task, json foundation.Json) (string, error)
 			job.Delay = &taskData.Delay
 		}
 
+		if !taskData.RetryUntil.IsZero() {
+			job.RetryUntil = &taskData.RetryUntil
+		}
+
 		chain = append(chain, job)
 	}
 
@@ -61,10 +69,16 @@ func TaskToJson(task contractsqueue.Task, json foundation.Json) (string, error)
 		job.Delay = &task.Delay
 	}
 
+	if !task.RetryUntil.IsZero() {
+		job.RetryUntil = &task.RetryUntil
+	}
+
 	t := Task{
-		UUID:  task.UUID,
-		Job:   job,
-		Chain: chain,
+		UUID:        task.UUID,
+		BatchID:     task.BatchID,
+		Job:         job,
+		Chain:       chain,
+		UniqueOwner: task.UniqueOwner,
 	}
 
 	payload, err := json.MarshalString(t)
@@ -97,6 +111,10 @@ func JsonToTask(payload string, jobStorer contractsqueue.JobStorer, json foundat
 			jobs.Delay = *item.Delay
 		}
 
+		if item.RetryUntil != nil {
+			jobs.RetryUntil = *item.RetryUntil
+		}
+
 		chain = append(chain, jobs)
 	}
 
@@ -114,10 +132,16 @@ func JsonToTask(payload string, jobStorer contractsqueue.JobStorer, json foundat
 		jobs.Delay = *task.Delay
 	}
 
+	if task.RetryUntil != nil {
+		jobs.RetryUntil = *task.RetryUntil
+	}
+
 	return contractsqueue.Task{
-		UUID:     task.UUID,
-		ChainJob: jobs,
-		Chain:    chain,
+		UUID:        task.UUID,
+		BatchID:     task.BatchID,
+		ChainJob:    jobs,
+		Chain:       chain,
+		UniqueOwner: task.UniqueOwner,
 	}, nil
 }
 
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goravel/framework/errors"
+)
+
+// Priority decides the order in which a worker consumes its queues. The queues
+// are separated by commas, e.g. "high,default,low", and are consumed in strict
+// priority: a queue is only consumed when the queues before it are empty. A
+// weight can be appended to each queue, e.g. "high:5,default:3,low:1", then the
+// queues are consumed in smooth weighted round-robin, so that the queues with a
+// low weight are not starved. A queue without a weight has a weight of 1.
+type Priority struct {
+	names    []string
+	weights  []int
+	current  []int
+	total    int
+	weighted bool
+	mu       sync.Mutex
+}
+
+func NewPriority(queues string) (*Priority, error) {
+	priority := &Priority{}
+
+	for _, item := range strings.Split(queues, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, weight, found := strings.Cut(item, ":")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, errors.QueueInvalidPriority.Args(queues)
+		}
+
+		parsedWeight := 1
+		if found {
+			var err error
+			parsedWeight, err = strconv.Atoi(strings.TrimSpace(weight))
+			if err != nil || parsedWeight <= 0 {
+				return nil, errors.QueueInvalidPriority.Args(queues)
+			}
+
+			priority.weighted = true
+		}
+
+		priority.names = append(priority.names, name)
+		priority.weights = append(priority.weights, parsedWeight)
+		priority.total += parsedWeight
+	}
+
+	if len(priority.names) == 0 {
+		return nil, errors.QueueInvalidPriority.Args(queues)
+	}
+
+	priority.current = make([]int, len(priority.names))
+
+	return priority, nil
+}
+
+// Names returns the queues in the configured order.
+func (r *Priority) Names() []string {
+	return r.names
+}
+
+// Next returns the queues in the order they should be consumed this time. In
+// weighted mode, the selected queue comes first, the other queues follow in
+// descending weight order so that the worker doesn't idle when the selected
+// queue is empty.
+func (r *Priority) Next() []string {
+	if !r.weighted || len(r.names) == 1 {
+		return r.names
+	}
+
+	r.mu.Lock()
+	selected := 0
+	for i := range r.names {
+		r.current[i] += r.weights[i]
+		if r.current[i] > r.current[selected] {
+			selected = i
+		}
+	}
+	r.current[selected] -= r.total
+	r.mu.Unlock()
+
+	indexes := make([]int, 0, len(r.names))
+	for i := range r.names {
+		if i != selected {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return r.weights[b] - r.weights[a]
+	})
+
+	names := make([]string, 0, len(r.names))
+	names = append(names, r.names[selected])
+	for _, i := range indexes {
+		names = append(names, r.names[i])
+	}
+
+	return names
+}
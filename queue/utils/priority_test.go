@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/errors"
+)
+
+func TestNewPriority(t *testing.T) {
+	priority, err := NewPriority(" high, default ,low ")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"high", "default", "low"}, priority.Names())
+	assert.False(t, priority.weighted)
+
+	priority, err = NewPriority("high:3,low")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, priority.weights)
+	assert.True(t, priority.weighted)
+
+	for _, queues := range []string{"", ",", ":1", "high:0", "high:a"} {
+		_, err = NewPriority(queues)
+		assert.ErrorIs(t, err, errors.QueueInvalidPriority, queues)
+	}
+}
+
+func TestPriorityNext(t *testing.T) {
+	priority, err := NewPriority("high,default,low")
+	assert.NoError(t, err)
+
+	for range 3 {
+		assert.Equal(t, []string{"high", "default", "low"}, priority.Next())
+	}
+
+	priority, err = NewPriority("high:3,default:2,low:1")
+	assert.NoError(t, err)
+
+	var first []string
+	for range 6 {
+		names := priority.Next()
+		assert.Len(t, names, 3)
+		first = append(first, names[0])
+	}
+
+	// Smooth weighted round-robin spreads the selections of a cycle.
+	assert.Equal(t, []string{"high", "default", "high", "low", "default", "high"}, first)
+
+	priority, err = NewPriority("high:1,default:3,low:2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"default", "low", "high"}, priority.Next())
+	assert.Equal(t, []string{"low", "default", "high"}, priority.Next())
+}
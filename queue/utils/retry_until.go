@@ -0,0 +1,29 @@
+package utils
+
+import (
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+// RefreshRetryUntil sets the RetryUntil of the jobs of the task that implement
+// JobWithRetryUntil, it's called when the task is pushed onto a queue.
+func RefreshRetryUntil(task contractsqueue.Task) contractsqueue.Task {
+	task.ChainJob = refreshRetryUntil(task.ChainJob)
+
+	if len(task.Chain) > 0 {
+		chain := make([]contractsqueue.ChainJob, len(task.Chain))
+		for i, job := range task.Chain {
+			chain[i] = refreshRetryUntil(job)
+		}
+		task.Chain = chain
+	}
+
+	return task
+}
+
+func refreshRetryUntil(job contractsqueue.ChainJob) contractsqueue.ChainJob {
+	if jobWithRetryUntil, ok := job.Job.(contractsqueue.JobWithRetryUntil); ok {
+		job.RetryUntil = jobWithRetryUntil.RetryUntil()
+	}
+
+	return job
+}
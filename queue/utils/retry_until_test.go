@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type TestJobWithRetryUntil struct {
+	TestJobOne
+}
+
+func (r *TestJobWithRetryUntil) RetryUntil() time.Time {
+	return carbon.Now().AddHour().StdTime()
+}
+
+func TestRefreshRetryUntil(t *testing.T) {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	task := RefreshRetryUntil(contractsqueue.Task{
+		ChainJob: contractsqueue.ChainJob{
+			Job:        &TestJobWithRetryUntil{},
+			RetryUntil: carbon.Now().SubHour().StdTime(),
+		},
+		Chain: []contractsqueue.ChainJob{
+			{Job: &TestJobTwo{}},
+			{Job: &TestJobWithRetryUntil{}},
+		},
+	})
+
+	assert.Equal(t, carbon.Now().AddHour().StdTime(), task.RetryUntil)
+	assert.True(t, task.Chain[0].RetryUntil.IsZero())
+	assert.Equal(t, carbon.Now().AddHour().StdTime(), task.Chain[1].RetryUntil)
+}
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"cmp"
 	"context"
 	"sync"
 	"sync/atomic"
@@ -19,7 +20,13 @@ import (
 	"github.com/goravel/framework/support/console"
 )
 
+// minChainReleaseDelay prevents a chain job released without a delay from
+// being retried in a busy loop.
+const minChainReleaseDelay = time.Second
+
 type Worker struct {
+	batch  *BatchRepository
+	cache  cache.Cache
 	config queue.Config
 	db     db.DB
 	driver queue.Driver
@@ -27,31 +34,48 @@ type Worker struct {
 	json   foundation.Json
 	log    log.Log
 
+	priority      *utils.Priority
 	failedJobChan chan models.FailedJob
 
 	connection     string
 	queue          string
 	jobWg          sync.WaitGroup
 	failedJobWg    sync.WaitGroup
+	closeOnce      sync.Once
 	concurrent     int
 	tries          int
+	maxJobs        int
+	maxTime        time.Duration
+	sleep          time.Duration
 	shutdownCtx    context.Context
 	shutdownCancel context.CancelFunc
 
-	isShutdown atomic.Bool
-	debug      bool
+	processedJobs atomic.Int64
+	isShutdown    atomic.Bool
+	debug         bool
+	stopWhenEmpty bool
 }
 
-func NewWorker(config queue.Config, cache cache.Cache, db db.DB, job queue.JobStorer, json foundation.Json, log log.Log, connection, queue string, concurrent, tries int) (*Worker, error) {
+func NewWorker(config queue.Config, cache cache.Cache, db db.DB, job queue.JobStorer, json foundation.Json, log log.Log, args queue.Args) (*Worker, error) {
 	driverCreator := NewDriverCreator(config, cache, db, job, json, log)
-	driver, err := driverCreator.Create(connection)
+	driver, err := driverCreator.Create(args.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := utils.NewPriority(args.Queue)
 	if err != nil {
 		return nil, err
 	}
 
+	// Batching is optional, batch jobs report the missing configuration when they are processed.
+	batch, _ := NewBatchRepository(config, db, job, json)
+
 	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
 
 	return &Worker{
+		batch:  batch,
+		cache:  cache,
 		config: config,
 		db:     db,
 		driver: driver,
@@ -59,12 +83,17 @@ func NewWorker(config queue.Config, cache cache.Cache, db db.DB, job queue.JobSt
 		json:   json,
 		log:    log,
 
-		failedJobChan: make(chan models.FailedJob, concurrent),
-
-		connection:     connection,
-		queue:          queue,
-		concurrent:     concurrent,
-		tries:          tries,
+		priority:      priority,
+		failedJobChan: make(chan models.FailedJob, args.Concurrent),
+
+		connection:     args.Connection,
+		queue:          args.Queue,
+		concurrent:     args.Concurrent,
+		tries:          args.Tries,
+		maxJobs:        args.MaxJobs,
+		maxTime:        args.MaxTime,
+		sleep:          args.Sleep,
+		stopWhenEmpty:  args.StopWhenEmpty,
 		debug:          config.Debug(),
 		shutdownCtx:    shutdownCtx,
 		shutdownCancel: shutdownCancel,
@@ -78,20 +107,23 @@ func (r *Worker) Run() error {
 	}
 
 	r.isShutdown.Store(false)
+	r.processedJobs.Store(0)
 	r.shutdownCtx, r.shutdownCancel = context.WithCancel(context.Background())
 
 	return r.run()
 }
 
 func (r *Worker) Shutdown() error {
-	r.isShutdown.Store(true)
-	r.shutdownCancel()
+	r.stop()
 
 	// Wait for all worker goroutines to finish processing current tasks
 	r.jobWg.Wait()
 
-	// Close the failed job channel to allow the failed job processor goroutine to exit
-	close(r.failedJobChan)
+	// Close the failed job channel to allow the failed job processor goroutine to exit,
+	// Shutdown may be called again after the worker stopped by itself.
+	r.closeOnce.Do(func() {
+		close(r.failedJobChan)
+	})
 
 	// Wait for the failed job processor goroutine to finish
 	r.failedJobWg.Wait()
@@ -99,7 +131,7 @@ func (r *Worker) Shutdown() error {
 	return nil
 }
 
-func (r *Worker) call(task queue.Task, reservedJob queue.ReservedJob) (released bool, err error) {
+func (r *Worker) call(queueName string, task queue.Task, reservedJob queue.ReservedJob) (released bool, err error) {
 	attempt := 1
 	r.printRunningLog(task)
 
@@ -109,11 +141,39 @@ func (r *Worker) call(task queue.Task, reservedJob queue.ReservedJob) (released
 		}
 
 		now := carbon.Now()
-		callErr := r.job.Call(task.Job.Signature(), utils.ConvertArgs(task.Args))
+		args := utils.ConvertArgs(task.Args)
+		queuedJob := NewQueuedJob(task.Job, args, reservedJob, attempt, r.maxTries(task))
+		callErr := queuedJob.Handle(func() error {
+			return r.handle(task, args)
+		})
 		duration := now.DiffAbsInDuration().String()
 
+		// The job was released by a middleware, it's neither a success nor a
+		// failure, but the release counts as an attempt.
+		if queuedJob.released {
+			if r.attemptsExhausted(task, queuedJob.Attempts()) {
+				return false, r.fail(queueName, task, errors.QueueJobAttemptedTooManyTimes.Args(task.Job.Signature()), duration)
+			}
+
+			if reservedJob == nil {
+				// Chain jobs have no queue entry to release; retry in-memory.
+				if !r.wait(max(queuedJob.delay, minChainReleaseDelay)) {
+					return false, r.fail(queueName, task, errors.QueueJobReleasedOnShutdown.Args(task.Job.Signature()), duration)
+				}
+				attempt++
+				continue
+			}
+
+			if relErr := reservedJob.Release(queuedJob.delay); relErr != nil {
+				r.log.Error(errors.QueueFailedToReleaseReservedJob.Args(reservedJob, relErr))
+			}
+
+			return true, nil
+		}
+
 		if callErr == nil {
 			r.printSuccessLog(task, duration)
+			r.recordBatch(task, nil)
 			return false, nil
 		}
 
@@ -123,23 +183,17 @@ func (r *Worker) call(task queue.Task, reservedJob queue.ReservedJob) (released
 			attempt = reservedJob.Attempts()
 		}
 
-		shouldRetry := false
-		var delay time.Duration = 0
-
-		if jobWithShouldRetry, ok := task.Job.(queue.JobWithShouldRetry); ok {
-			shouldRetry, delay = jobWithShouldRetry.ShouldRetry(callErr, attempt, r.tries)
-		} else {
-			shouldRetry = attempt < r.tries /* || r.tries == 0 */ // Currently, we do not support unlimited retries, see https://github.com/goravel/framework/pull/1123#discussion_r2194272829
-		}
-
+		shouldRetry, delay := r.shouldRetry(task, callErr, attempt)
 		if shouldRetry {
 			if reservedJob == nil {
-				// Chain jobs have no queue entry to release; retry in-memory.
-				if delay > 0 {
-					time.Sleep(delay)
+				// Chain jobs have no queue entry to release; retry in-memory
+				// unless the worker shuts down meanwhile.
+				if r.wait(delay) {
+					attempt++
+					continue
 				}
-				attempt++
-				continue
+
+				return false, r.fail(queueName, task, callErr, duration)
 			}
 
 			if relErr := reservedJob.Release(delay); relErr != nil {
@@ -151,24 +205,138 @@ func (r *Worker) call(task queue.Task, reservedJob queue.ReservedJob) (released
 			return true, nil
 		}
 
-		payload, jsonErr := utils.TaskToJson(task, r.json)
-		if jsonErr != nil {
-			return false, errors.QueueFailedToConvertTaskToJson.Args(jsonErr, task)
+		return false, r.fail(queueName, task, callErr, duration)
+	}
+}
+
+// fail records the failed job and returns QueueFailedToCallJob, which tells
+// the caller that the failure has already been reported.
+func (r *Worker) fail(queueName string, task queue.Task, callErr error, duration string) error {
+	payload, jsonErr := utils.TaskToJson(task, r.json)
+	if jsonErr != nil {
+		return errors.QueueFailedToConvertTaskToJson.Args(jsonErr, task)
+	}
+
+	r.failedJobChan <- models.FailedJob{
+		UUID:       task.UUID,
+		Connection: r.connection,
+		Queue:      queueName,
+		Payload:    payload,
+		Exception:  callErr.Error(),
+		FailedAt:   carbon.NewDateTime(carbon.Now()),
+	}
+
+	r.printFailedLog(task, duration)
+	r.recordBatch(task, callErr)
+
+	return errors.QueueFailedToCallJob
+}
+
+// wait sleeps for the delay, it returns false if the worker shuts down meanwhile.
+func (r *Worker) wait(delay time.Duration) bool {
+	if delay <= 0 {
+		return r.shutdownCtx.Err() == nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-r.shutdownCtx.Done():
+		return false
+	}
+}
+
+// handle calls the job, the context passed to a job implementing both
+// JobWithTimeout and JobWithContext is cancelled once its timeout is reached,
+// and the attempt is marked as failed. The worker waits for the job to return
+// before moving on, so an attempt is never retried while it's still running.
+// A job without JobWithContext can't be cancelled, its attempt is marked as
+// failed once the timeout is reached and the job is left running in the background.
+func (r *Worker) handle(task queue.Task, args []any) error {
+	var timeout time.Duration
+	if jobWithTimeout, ok := task.Job.(queue.JobWithTimeout); ok {
+		timeout = jobWithTimeout.Timeout()
+	}
+
+	jobWithContext, ok := task.Job.(queue.JobWithContext)
+	if !ok {
+		if timeout <= 0 {
+			return r.job.Call(task.Job.Signature(), args)
 		}
 
-		r.failedJobChan <- models.FailedJob{
-			UUID:       task.UUID,
-			Connection: r.connection,
-			Queue:      r.queue,
-			Payload:    payload,
-			Exception:  callErr.Error(),
-			FailedAt:   carbon.NewDateTime(carbon.Now()),
+		result := make(chan error, 1)
+		go func() {
+			result <- r.job.Call(task.Job.Signature(), args)
+		}()
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case err := <-result:
+			return err
+		case <-timer.C:
+			return errors.QueueJobTimedOut.Args(task.Job.Signature(), timeout)
 		}
+	}
 
-		r.printFailedLog(task, duration)
+	if timeout <= 0 {
+		return jobWithContext.HandleWithContext(context.Background(), args...)
+	}
 
-		return false, errors.QueueFailedToCallJob
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := jobWithContext.HandleWithContext(ctx, args...)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errors.QueueJobTimedOut.Args(task.Job.Signature(), timeout)
 	}
+
+	return err
+}
+
+// shouldRetry decides if a failed attempt should be retried, and the delay
+// before the next attempt. JobWithShouldRetry takes precedence, then the
+// RetryUntil evaluated on dispatch, then JobWithTries and the worker's Tries.
+func (r *Worker) shouldRetry(task queue.Task, err error, attempt int) (bool, time.Duration) {
+	if jobWithShouldRetry, ok := task.Job.(queue.JobWithShouldRetry); ok {
+		return jobWithShouldRetry.ShouldRetry(err, attempt, r.tries)
+	}
+
+	if r.attemptsExhausted(task, attempt) {
+		return false, 0
+	}
+
+	var delay time.Duration
+	if jobWithBackoff, ok := task.Job.(queue.JobWithBackoff); ok {
+		if backoff := jobWithBackoff.Backoff(); len(backoff) > 0 {
+			delay = backoff[min(max(attempt, 1), len(backoff))-1]
+		}
+	}
+
+	return true, delay
+}
+
+// attemptsExhausted reports whether the job may not be attempted again, the
+// RetryUntil evaluated on dispatch takes precedence over the tries.
+func (r *Worker) attemptsExhausted(task queue.Task, attempt int) bool {
+	if !task.RetryUntil.IsZero() {
+		return !carbon.Now().Lt(carbon.FromStdTime(task.RetryUntil))
+	}
+
+	return attempt >= r.maxTries(task) /* && tries != 0 */ // Currently, we do not support unlimited retries, see https://github.com/goravel/framework/pull/1123#discussion_r2194272829
+}
+
+// maxTries returns the tries of the job, falling back to the worker's tries.
+func (r *Worker) maxTries(task queue.Task) int {
+	if jobWithTries, ok := task.Job.(queue.JobWithTries); ok && jobWithTries.Tries() > 0 {
+		return jobWithTries.Tries()
+	}
+
+	return r.tries
 }
 
 func (r *Worker) logFailedJob(job models.FailedJob) {
@@ -187,6 +355,22 @@ func (r *Worker) logFailedJob(job models.FailedJob) {
 	}
 }
 
+// recordBatch records the final result of a batch job, retried attempts are not recorded.
+func (r *Worker) recordBatch(task queue.Task, err error) {
+	if task.BatchID == "" {
+		return
+	}
+
+	if r.batch == nil {
+		r.log.Error(errors.QueueBatchingNotConfigured)
+		return
+	}
+
+	if err := r.batch.Record(task.BatchID, task.UUID, err); err != nil {
+		r.log.Error(err)
+	}
+}
+
 func (r *Worker) printRunningLog(task queue.Task) {
 	if !r.debug {
 		return
@@ -241,6 +425,16 @@ func (r *Worker) run() error {
 		}
 	}()
 
+	if r.maxTime > 0 {
+		go func() {
+			select {
+			case <-time.After(r.maxTime):
+				r.stop()
+			case <-r.shutdownCtx.Done():
+			}
+		}()
+	}
+
 	if receiver, ok := r.driver.(queue.DriverWithReceive); ok {
 		return r.runWithReceive(receiver)
 	}
@@ -254,20 +448,23 @@ func (r *Worker) runWithPop() error {
 		go func() {
 			defer r.jobWg.Done()
 
-			// TODO make the initial delay and max delay configurable
-			currentDelay := 1 * time.Second
-			maxDelay := 32 * time.Second
+			// TODO make the max delay configurable
+			initialDelay := cmp.Or(r.sleep, 1*time.Second)
+			currentDelay := initialDelay
+			maxDelay := max(32*time.Second, initialDelay)
 
 			for {
 				if r.isShutdown.Load() {
 					return
 				}
 
-				reservedJob, err := r.driver.Pop(r.queue)
+				queueName, reservedJob, err := r.pop()
 				if err != nil {
-					if !errors.Is(err, errors.QueueDriverNoJobFound) {
-						r.log.Error(errors.QueueDriverFailedToPop.Args(r.queue, err))
+					if r.stopWhenEmpty && errors.Is(err, errors.QueueDriverNoJobFound) {
+						return
+					}
 
+					if !errors.Is(err, errors.QueueDriverNoJobFound) {
 						currentDelay *= 2
 						if currentDelay > maxDelay {
 							currentDelay = maxDelay
@@ -283,8 +480,9 @@ func (r *Worker) runWithPop() error {
 					continue
 				}
 
-				currentDelay = 1 * time.Second
-				r.processReservedJob(reservedJob)
+				currentDelay = initialDelay
+				r.processReservedJob(queueName, reservedJob)
+				r.countProcessedJobs(1)
 			}
 		}()
 	}
@@ -298,26 +496,22 @@ func (r *Worker) runWithReceive(receiver queue.DriverWithReceive) error {
 	r.jobWg.Add(1)
 	defer r.jobWg.Done()
 
-	// TODO make the initial delay and max delay configurable
-	currentDelay := 100 * time.Millisecond
-	maxDelay := 3200 * time.Millisecond
+	// TODO make the max delay configurable
+	initialDelay := cmp.Or(r.sleep, 100*time.Millisecond)
+	currentDelay := initialDelay
+	maxDelay := max(3200*time.Millisecond, initialDelay)
 
 	for {
 		if r.isShutdown.Load() {
 			return nil
 		}
 
-		ctx, cancel := context.WithTimeout(r.shutdownCtx, 5*time.Second) // TODO make the timeout configurable
-		jobs, err := receiver.Receive(ctx, r.queue, r.concurrent)
-		cancel()
-
+		jobs, err := r.receive(receiver)
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
 				continue
 			}
 			if !errors.Is(err, context.DeadlineExceeded) {
-				r.log.Error(errors.QueueDriverFailedToReceive.Args(r.queue, err))
-
 				currentDelay *= 2
 				if currentDelay > maxDelay {
 					currentDelay = maxDelay
@@ -333,6 +527,10 @@ func (r *Worker) runWithReceive(receiver queue.DriverWithReceive) error {
 		}
 
 		if len(jobs) == 0 {
+			if r.stopWhenEmpty {
+				return nil
+			}
+
 			select {
 			case <-time.After(currentDelay):
 			case <-r.shutdownCtx.Done():
@@ -341,24 +539,162 @@ func (r *Worker) runWithReceive(receiver queue.DriverWithReceive) error {
 			continue
 		}
 
-		currentDelay = 100 * time.Millisecond
+		currentDelay = initialDelay
 
+		// More than concurrent jobs may be received when several queues have
+		// jobs, they are processed in order without exceeding the concurrency.
 		var wg sync.WaitGroup
-		for _, reservedJob := range jobs {
+		semaphore := make(chan struct{}, r.concurrent)
+		for _, job := range jobs {
+			semaphore <- struct{}{}
 			wg.Add(1)
 			go func() {
-				defer wg.Done()
-				r.processReservedJob(reservedJob)
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				r.processReservedJob(job.queue, job.reservedJob)
 			}()
 		}
 		wg.Wait()
+		r.countProcessedJobs(len(jobs))
+	}
+}
+
+// pop pops the next job from the queues in the order decided by the priority.
+// QueueDriverNoJobFound is returned when all of the queues are empty.
+func (r *Worker) pop() (string, queue.ReservedJob, error) {
+	if popper, ok := r.driver.(queue.DriverWithPopFrom); ok {
+		queueName, reservedJob, err := popper.PopFrom(r.priority.Next())
+		if err != nil {
+			if !errors.Is(err, errors.QueueDriverNoJobFound) {
+				r.log.Error(errors.QueueDriverFailedToPop.Args(r.queue, err))
+			}
+
+			return "", nil, err
+		}
+		if reservedJob == nil {
+			return "", nil, errors.QueueDriverNoJobFound.Args(r.queue)
+		}
+
+		return queueName, reservedJob, nil
+	}
+
+	var lastErr error
+
+	for _, queueName := range r.priority.Next() {
+		reservedJob, err := r.driver.Pop(queueName)
+		if err == nil && reservedJob != nil {
+			return queueName, reservedJob, nil
+		}
+
+		if err != nil && !errors.Is(err, errors.QueueDriverNoJobFound) {
+			r.log.Error(errors.QueueDriverFailedToPop.Args(queueName, err))
+			lastErr = err
+		}
 	}
+
+	if lastErr != nil {
+		return "", nil, lastErr
+	}
+
+	return "", nil, errors.QueueDriverNoJobFound.Args(r.queue)
+}
+
+type receivedJob struct {
+	queue       string
+	reservedJob queue.ReservedJob
 }
 
-func (r *Worker) processReservedJob(reservedJob queue.ReservedJob) {
+// receive receives up to concurrent jobs from each of the queues, the queues
+// are received from at the same time so that the latency doesn't grow with the
+// number of queues, and the other receives are cancelled once a queue has jobs.
+// The jobs are returned in the order decided by the priority.
+func (r *Worker) receive(receiver queue.DriverWithReceive) ([]receivedJob, error) {
+	ctx, cancel := context.WithTimeout(r.shutdownCtx, 5*time.Second) // TODO make the timeout configurable
+	defer cancel()
+
+	names := r.priority.Next()
+	results := make([][]queue.ReservedJob, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	for i, queueName := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = receiver.Receive(ctx, queueName, r.concurrent)
+			if len(results[i]) > 0 {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	var (
+		jobs    []receivedJob
+		lastErr error
+	)
+	for i, queueName := range names {
+		for _, reservedJob := range results[i] {
+			jobs = append(jobs, receivedJob{queue: queueName, reservedJob: reservedJob})
+		}
+
+		err := errs[i]
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, context.Canceled) && r.shutdownCtx.Err() != nil {
+			lastErr = err
+			continue
+		}
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			r.log.Error(errors.QueueDriverFailedToReceive.Args(queueName, err))
+			lastErr = err
+		}
+	}
+
+	if len(jobs) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return jobs, nil
+}
+
+// countProcessedJobs stops the worker once it has processed the maximum number of jobs.
+func (r *Worker) countProcessedJobs(count int) {
+	if processed := r.processedJobs.Add(int64(count)); r.maxJobs > 0 && processed >= int64(r.maxJobs) {
+		r.stop()
+	}
+}
+
+// stop stops fetching new jobs, the jobs being processed are not interrupted.
+func (r *Worker) stop() {
+	r.isShutdown.Store(true)
+	r.shutdownCancel()
+}
+
+func (r *Worker) processReservedJob(queueName string, reservedJob queue.ReservedJob) {
 	task := reservedJob.Task()
 
-	released, err := r.call(task, reservedJob)
+	// Jobs of a cancelled batch are skipped, they are recorded as skipped
+	// rather than processed so that the Finally callbacks of the batch are called.
+	if task.BatchID != "" && r.batch != nil && r.batch.Cancelled(task.BatchID) {
+		if err := r.batch.RecordSkipped(task.BatchID); err != nil {
+			r.log.Error(err)
+		}
+
+		if err := reservedJob.Delete(); err != nil {
+			r.log.Error(errors.QueueFailedToDeleteReservedJob.Args(reservedJob, err))
+		}
+
+		r.releaseUniqueLock(task)
+
+		return
+	}
+
+	released, err := r.call(queueName, task, reservedJob)
 	if released {
 		// The job is back in the queue for a later retry (or was left
 		// reserved for retry_after expiry recovery); its row must remain
@@ -375,6 +711,8 @@ func (r *Worker) processReservedJob(reservedJob queue.ReservedJob) {
 			r.log.Error(errors.QueueFailedToDeleteReservedJob.Args(reservedJob, err))
 		}
 
+		r.releaseUniqueLock(task)
+
 		return
 	}
 
@@ -391,7 +729,7 @@ func (r *Worker) processReservedJob(reservedJob queue.ReservedJob) {
 			// invariant, stop the chain rather than silently continuing to
 			// the next job — hence the explicit released check (which also
 			// prevents the final Delete below from orphaning chain jobs).
-			released, err = r.call(chainTask, nil)
+			released, err = r.call(queueName, chainTask, nil)
 			if released {
 				break
 			}
@@ -414,4 +752,26 @@ func (r *Worker) processReservedJob(reservedJob queue.ReservedJob) {
 	if err := reservedJob.Delete(); err != nil {
 		r.log.Error(errors.QueueFailedToDeleteReservedJob.Args(reservedJob, err))
 	}
+
+	r.releaseUniqueLock(task)
+}
+
+// releaseUniqueLock releases the lock acquired when the unique job was dispatched, only
+// the task carrying the owner token of the lock releases it, so that batch jobs and
+// retried failed jobs don't release the lock of another dispatch.
+func (r *Worker) releaseUniqueLock(task queue.Task) {
+	if task.UniqueOwner == "" {
+		return
+	}
+
+	lock, restored := restoreUniqueLock(r.cache, task.Job, utils.ConvertArgs(task.Args), task.UniqueOwner)
+	if lock == nil {
+		return
+	}
+
+	if restored {
+		lock.Release()
+	} else {
+		lock.ForceRelease()
+	}
 }
@@ -11,6 +11,7 @@ import (
 
 	contractsqueue "github.com/goravel/framework/contracts/queue"
 	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/foundation/json"
 	mocksdb "github.com/goravel/framework/mocks/database/db"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
 	mockslog "github.com/goravel/framework/mocks/log"
@@ -45,6 +46,9 @@ func (s *WorkerTestSuite) SetupTest() {
 
 	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
 
+	priority, err := utils.NewPriority("default")
+	s.Require().NoError(err)
+
 	s.worker = &Worker{
 		config: s.mockConfig,
 		db:     s.mockDB,
@@ -53,6 +57,7 @@ func (s *WorkerTestSuite) SetupTest() {
 		json:   s.mockJson,
 		log:    s.mockLog,
 
+		priority:      priority,
 		failedJobChan: make(chan models.FailedJob, 1),
 
 		connection:     "sync",
@@ -68,8 +73,9 @@ func (s *WorkerTestSuite) SetupTest() {
 func (s *WorkerTestSuite) TestNewWorker() {
 	s.Run("happy path", func() {
 		s.mockConfig.EXPECT().Driver("sync").Return(contractsqueue.DriverSync).Once()
+		s.mockConfig.EXPECT().BatchingDatabase().Return("").Once()
 		s.mockConfig.EXPECT().Debug().Return(true).Once()
-		worker, err := NewWorker(s.mockConfig, nil, s.mockDB, s.mockJob, s.mockJson, s.mockLog, "sync", "default", 2, 1)
+		worker, err := NewWorker(s.mockConfig, nil, s.mockDB, s.mockJob, s.mockJson, s.mockLog, contractsqueue.Args{Connection: "sync", Queue: "default", Concurrent: 2, Tries: 1})
 
 		s.NotNil(worker)
 		s.NoError(err)
@@ -77,7 +83,7 @@ func (s *WorkerTestSuite) TestNewWorker() {
 
 	s.Run("failed to create driver", func() {
 		s.mockConfig.EXPECT().Driver("sync").Return("unknown").Once()
-		worker, err := NewWorker(s.mockConfig, nil, s.mockDB, s.mockJob, s.mockJson, s.mockLog, "sync", "default", 2, 1)
+		worker, err := NewWorker(s.mockConfig, nil, s.mockDB, s.mockJob, s.mockJson, s.mockLog, contractsqueue.Args{Connection: "sync", Queue: "default", Concurrent: 2, Tries: 1})
 		s.Nil(worker)
 		s.Equal(errors.QueueDriverNotSupported.Args("unknown"), err)
 	})
@@ -119,7 +125,7 @@ func (s *WorkerTestSuite) Test_call() {
 
 		s.mockJob.EXPECT().Call(task.Job.Signature(), utils.ConvertArgs(task.Args)).Return(nil).Once()
 
-		released, err := s.worker.call(task, nil)
+		released, err := s.worker.call("default", task, nil)
 		s.False(released)
 		s.NoError(err)
 	})
@@ -144,7 +150,7 @@ func (s *WorkerTestSuite) Test_call() {
 			},
 		}).Return("{\"signature\":\"test_job_one\",\"args\":[{\"type\":\"string\",\"value\":\"test\"}],\"delay\":null,\"uuid\":\"test\",\"chain\":[{\"signature\":\"test_job_two\",\"args\":[{\"type\":\"int\",\"value\":1}],\"delay\":null,\"uuid\":\"test\",\"chain\":[]}]}", nil).Once()
 
-		released, err := s.worker.call(task, nil)
+		released, err := s.worker.call("default", task, nil)
 		s.False(released)
 		s.Equal(errors.QueueFailedToCallJob, err)
 	})
@@ -165,7 +171,7 @@ func (s *WorkerTestSuite) Test_call() {
 		mockReservedJob.EXPECT().Attempts().Return(1).Once()
 		mockReservedJob.EXPECT().Release(time.Duration(0)).Return(nil).Once()
 
-		released, err := s.worker.call(errorTask, mockReservedJob)
+		released, err := s.worker.call("default", errorTask, mockReservedJob)
 		s.True(released)
 		s.NoError(err)
 	})
@@ -187,7 +193,7 @@ func (s *WorkerTestSuite) Test_call() {
 		mockReservedJob.EXPECT().Release(time.Duration(0)).Return(assert.AnError).Once()
 		s.mockLog.EXPECT().Error(errors.QueueFailedToReleaseReservedJob.Args(mockReservedJob, assert.AnError)).Once()
 
-		released, err := s.worker.call(errorTask, mockReservedJob)
+		released, err := s.worker.call("default", errorTask, mockReservedJob)
 		s.True(released)
 		s.NoError(err)
 	})
@@ -207,7 +213,7 @@ func (s *WorkerTestSuite) Test_call() {
 		mockReservedJob.EXPECT().Attempts().Return(3).Once()
 		mockReservedJob.EXPECT().Release(time.Duration(0)).Return(nil).Once()
 
-		released, err := s.worker.call(retryTask, mockReservedJob)
+		released, err := s.worker.call("default", retryTask, mockReservedJob)
 		s.True(released)
 		s.NoError(err)
 		s.Equal(3, retryTask.Job.(*TestJobRetry).attempt)
@@ -230,13 +236,158 @@ func (s *WorkerTestSuite) Test_call() {
 		mockReservedJob.EXPECT().Attempts().Return(1).Once()
 		mockReservedJob.EXPECT().Release(time.Duration(0)).Return(nil).Once()
 
-		released, err := s.worker.call(retryTask, mockReservedJob)
+		released, err := s.worker.call("default", retryTask, mockReservedJob)
 		s.True(released)
 		s.NoError(err)
 		s.Equal(3, retryTask.Job.(*TestJobRetry).maxTries) // worker's tries handed to ShouldRetry
 	})
 }
 
+func (s *WorkerTestSuite) Test_callWithJobPolicies() {
+	carbon.SetTestNow(carbon.Now())
+	defer carbon.ClearTestNow()
+
+	s.Run("tries and backoff of the job", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		task := contractsqueue.Task{
+			ChainJob: contractsqueue.ChainJob{
+				Job: &TestJobWithPolicies{tries: 3, backoff: []time.Duration{time.Second, 2 * time.Second}},
+			},
+		}
+
+		for _, attempt := range []int{1, 2} {
+			mockReservedJob := mocksqueue.NewReservedJob(s.T())
+			s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).Return(assert.AnError).Once()
+			mockReservedJob.EXPECT().Attempts().Return(attempt).Once()
+			mockReservedJob.EXPECT().Release(time.Duration(attempt) * time.Second).Return(nil).Once()
+
+			released, err := s.worker.call("default", task, mockReservedJob)
+			s.True(released)
+			s.NoError(err)
+		}
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).Return(assert.AnError).Once()
+		mockReservedJob.EXPECT().Attempts().Return(3).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("default", task, mockReservedJob)
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+	})
+
+	s.Run("retry until takes precedence over the tries", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		task := contractsqueue.Task{
+			ChainJob: contractsqueue.ChainJob{
+				Job:        &TestJobWithPolicies{tries: 1},
+				RetryUntil: carbon.Now().AddMinute().StdTime(),
+			},
+		}
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).Return(assert.AnError).Once()
+		mockReservedJob.EXPECT().Attempts().Return(5).Once()
+		mockReservedJob.EXPECT().Release(time.Duration(0)).Return(nil).Once()
+
+		released, err := s.worker.call("default", task, mockReservedJob)
+		s.True(released)
+		s.NoError(err)
+
+		task.RetryUntil = carbon.Now().SubMinute().StdTime()
+		mockReservedJob = mocksqueue.NewReservedJob(s.T())
+		s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).Return(assert.AnError).Once()
+		mockReservedJob.EXPECT().Attempts().Return(1).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err = s.worker.call("default", task, mockReservedJob)
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+	})
+
+	s.Run("timeout cancels the context of the job", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		job := &TestJobWithTimeout{timeout: 50 * time.Millisecond, cancelled: make(chan struct{})}
+		task := contractsqueue.Task{
+			ChainJob: contractsqueue.ChainJob{
+				Job: job,
+			},
+		}
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Attempts().Return(1).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("default", task, mockReservedJob)
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+
+		failedJob := <-s.worker.failedJobChan
+		s.Equal(errors.QueueJobTimedOut.Args("test_job_with_timeout", 50*time.Millisecond).Error(), failedJob.Exception)
+
+		// The attempt is failed only once the job has returned.
+		select {
+		case <-job.cancelled:
+		default:
+			s.Fail("the job should have returned")
+		}
+	})
+
+	s.Run("job without JobWithContext returns before the timeout", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		task := contractsqueue.Task{
+			ChainJob: contractsqueue.ChainJob{
+				Job: &TestJobWithPolicies{timeout: time.Second},
+			},
+		}
+
+		s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).Return(nil).Once()
+
+		released, err := s.worker.call("default", task, nil)
+		s.False(released)
+		s.NoError(err)
+	})
+
+	s.Run("timeout fails the attempt of a job without JobWithContext", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		task := contractsqueue.Task{
+			ChainJob: contractsqueue.ChainJob{
+				Job: &TestJobWithPolicies{timeout: 50 * time.Millisecond, tries: 1},
+			},
+		}
+
+		returned := make(chan struct{})
+		s.mockJob.EXPECT().Call("test_job_with_policies", make([]any, 0)).RunAndReturn(func(string, []any) error {
+			defer close(returned)
+			time.Sleep(200 * time.Millisecond)
+
+			return nil
+		}).Once()
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Attempts().Return(1).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("default", task, mockReservedJob)
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+
+		failedJob := <-s.worker.failedJobChan
+		s.Equal(errors.QueueJobTimedOut.Args("test_job_with_policies", 50*time.Millisecond).Error(), failedJob.Exception)
+
+		<-returned
+	})
+}
+
 func (s *WorkerTestSuite) Test_logFailedJob() {
 
 	failedJob := models.FailedJob{
@@ -731,6 +882,27 @@ func (r *receiveDriver) Receive(ctx context.Context, queue string, count int) ([
 	return r.receiver.Receive(ctx, queue, count)
 }
 
+type popFromDriver struct {
+	driver *mocksqueue.Driver
+	popper *mocksqueue.DriverWithPopFrom
+}
+
+func (r *popFromDriver) Driver() string {
+	return contractsqueue.DriverDatabase
+}
+
+func (r *popFromDriver) Pop(queue string) (contractsqueue.ReservedJob, error) {
+	return r.driver.Pop(queue)
+}
+
+func (r *popFromDriver) Push(task contractsqueue.Task, queue string) error {
+	return r.driver.Push(task, queue)
+}
+
+func (r *popFromDriver) PopFrom(queues []string) (string, contractsqueue.ReservedJob, error) {
+	return r.popper.PopFrom(queues)
+}
+
 func (s *WorkerTestSuite) Test_runWithReceive() {
 	carbon.SetTestNow(carbon.FromStdTime(time.Now()))
 	defer carbon.ClearTestNow()
@@ -976,6 +1148,299 @@ func (s *WorkerTestSuite) Test_runWithReceive() {
 	})
 }
 
+func (s *WorkerTestSuite) Test_runWithLimits() {
+	task := contractsqueue.Task{
+		UUID: "test",
+		ChainJob: contractsqueue.ChainJob{
+			Job: &MockJob{},
+		},
+	}
+
+	s.Run("stops when the queue is empty", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.stopWhenEmpty = true
+		s.mockDriver.EXPECT().Pop("default").Return(nil, errors.QueueDriverNoJobFound).Once()
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+	})
+
+	s.Run("stops after processing the max jobs", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.maxJobs = 2
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		s.mockDriver.EXPECT().Pop("default").Return(mockReservedJob, nil).Twice()
+		mockReservedJob.EXPECT().Task().Return(task).Twice()
+		mockReservedJob.EXPECT().Delete().Return(nil).Twice()
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(nil).Twice()
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+		s.Equal(int64(2), s.worker.processedJobs.Load())
+	})
+
+	s.Run("stops after the max time", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.maxTime = 100 * time.Millisecond
+		s.worker.sleep = 10 * time.Millisecond
+		s.mockDriver.EXPECT().Pop("default").Return(nil, errors.QueueDriverNoJobFound)
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+	})
+}
+
+func (s *WorkerTestSuite) Test_runWithPriority() {
+	task := contractsqueue.Task{
+		UUID: "test",
+		ChainJob: contractsqueue.ChainJob{
+			Job: &MockJob{},
+		},
+	}
+
+	s.Run("pops the queues in strict priority", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.stopWhenEmpty = true
+
+		priority, err := utils.NewPriority("high,low")
+		s.Require().NoError(err)
+		s.worker.priority = priority
+
+		highJob := mocksqueue.NewReservedJob(s.T())
+		lowJob := mocksqueue.NewReservedJob(s.T())
+		s.mockDriver.EXPECT().Pop("high").Return(highJob, nil).Once()
+		s.mockDriver.EXPECT().Pop("high").Return(nil, errors.QueueDriverNoJobFound).Twice()
+		s.mockDriver.EXPECT().Pop("low").Return(lowJob, nil).Once()
+		s.mockDriver.EXPECT().Pop("low").Return(nil, errors.QueueDriverNoJobFound).Once()
+
+		var calls []string
+		for name, reservedJob := range map[string]*mocksqueue.ReservedJob{"high": highJob, "low": lowJob} {
+			reservedJob.EXPECT().Task().Return(task).Once()
+			reservedJob.EXPECT().Delete().RunAndReturn(func() error {
+				calls = append(calls, name)
+				return nil
+			}).Once()
+		}
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(nil).Twice()
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+		s.Equal([]string{"high", "low"}, calls)
+	})
+
+	s.Run("records the queue of the failed job", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Attempts().Return(1).Once()
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(assert.AnError).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("low", task, mockReservedJob)
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+		s.Equal("low", (<-s.worker.failedJobChan).Queue)
+	})
+
+	s.Run("receives the queues at the same time and processes the jobs in priority", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.concurrent = 1
+		s.worker.stopWhenEmpty = true
+
+		priority, err := utils.NewPriority("high,low")
+		s.Require().NoError(err)
+		s.worker.priority = priority
+
+		mockDriverWithReceive := mocksqueue.NewDriverWithReceive(s.T())
+		s.worker.driver = &receiveDriver{
+			driver:   s.mockDriver,
+			receiver: mockDriverWithReceive,
+		}
+
+		var calls []string
+		reservedJobs := make(map[string]contractsqueue.ReservedJob)
+		for _, name := range []string{"high", "low"} {
+			reservedJob := mocksqueue.NewReservedJob(s.T())
+			reservedJob.EXPECT().Task().Return(task).Once()
+			reservedJob.EXPECT().Delete().RunAndReturn(func() error {
+				calls = append(calls, name)
+				return nil
+			}).Once()
+			reservedJobs[name] = reservedJob
+		}
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(nil).Twice()
+
+		mockDriverWithReceive.EXPECT().Receive(mock.Anything, "high", 1).Return([]contractsqueue.ReservedJob{reservedJobs["high"]}, nil).Once()
+		mockDriverWithReceive.EXPECT().Receive(mock.Anything, "low", 1).Return([]contractsqueue.ReservedJob{reservedJobs["low"]}, nil).Once()
+		mockDriverWithReceive.EXPECT().Receive(mock.Anything, "high", 1).Return(nil, nil).Once()
+		mockDriverWithReceive.EXPECT().Receive(mock.Anything, "low", 1).Return(nil, nil).Once()
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+		s.Equal([]string{"high", "low"}, calls)
+	})
+
+	s.Run("pops the queues at once when the driver supports it", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.stopWhenEmpty = true
+
+		priority, err := utils.NewPriority("high,low")
+		s.Require().NoError(err)
+		s.worker.priority = priority
+
+		mockDriverWithPopFrom := mocksqueue.NewDriverWithPopFrom(s.T())
+		s.worker.driver = &popFromDriver{
+			driver: s.mockDriver,
+			popper: mockDriverWithPopFrom,
+		}
+
+		reservedJob := mocksqueue.NewReservedJob(s.T())
+		reservedJob.EXPECT().Task().Return(task).Once()
+		reservedJob.EXPECT().Delete().Return(nil).Once()
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(nil).Once()
+
+		mockDriverWithPopFrom.EXPECT().PopFrom([]string{"high", "low"}).Return("low", reservedJob, nil).Once()
+		mockDriverWithPopFrom.EXPECT().PopFrom([]string{"high", "low"}).Return("", nil, errors.QueueDriverNoJobFound.Args("high,low")).Once()
+
+		s.NoError(s.worker.run())
+		s.NoError(s.worker.Shutdown())
+	})
+}
+
+func (s *WorkerTestSuite) Test_processReservedJobWithBatch() {
+	task := contractsqueue.Task{
+		UUID:    "job",
+		BatchID: "batch",
+		ChainJob: contractsqueue.ChainJob{
+			Job: &MockJob{},
+		},
+	}
+
+	s.Run("batch is not configured", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Task().Return(task).Once()
+		mockReservedJob.EXPECT().Delete().Return(nil).Once()
+		s.mockJob.EXPECT().Call(task.Job.Signature(), make([]any, 0)).Return(nil).Once()
+		s.mockLog.EXPECT().Error(errors.QueueBatchingNotConfigured).Once()
+
+		s.worker.processReservedJob("default", mockReservedJob)
+	})
+
+	s.Run("skips the job of a cancelled batch", func() {
+		s.SetupTest()
+		s.worker.debug = false
+
+		mockQuery := mocksdb.NewQuery(s.T())
+		s.worker.batch = &BatchRepository{db: s.mockDB, jobStorer: s.mockJob, json: json.New(), table: "job_batches"}
+
+		s.mockDB.EXPECT().Table("job_batches").Return(mockQuery).Once()
+		mockQuery.EXPECT().Where("id", "batch").Return(mockQuery).Once()
+		mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*models.JobBatch) = models.JobBatch{ID: "batch", CancelledAt: carbon.NewDateTime(carbon.Now())}
+		}).Return(nil).Once()
+		s.mockDB.EXPECT().Transaction(mock.Anything).Return(assert.AnError).Once()
+		s.mockLog.EXPECT().Error(errors.QueueFailedToUpdateBatch.Args("batch", assert.AnError)).Once()
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Task().Return(task).Once()
+		mockReservedJob.EXPECT().Delete().Return(nil).Once()
+
+		s.worker.processReservedJob("default", mockReservedJob)
+	})
+}
+
+func (s *WorkerTestSuite) Test_processReservedJobReleasedByMiddleware() {
+	var calls []string
+	task := contractsqueue.Task{
+		UUID: "job",
+		ChainJob: contractsqueue.ChainJob{
+			Job: &TestJobWithMiddleware{middleware: []contractsqueue.JobMiddleware{
+				&TestJobMiddleware{name: "release", calls: &calls, release: true},
+			}},
+		},
+	}
+
+	s.Run("releases the job", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.tries = 3
+		calls = nil
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Task().Return(task).Once()
+		mockReservedJob.EXPECT().Attempts().Return(1).Once()
+		mockReservedJob.EXPECT().Release(time.Second).Return(nil).Once()
+
+		s.worker.processReservedJob("default", mockReservedJob)
+
+		s.Equal([]string{"release"}, calls)
+	})
+
+	s.Run("fails the job once the tries are exhausted", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.tries = 3
+		calls = nil
+
+		mockReservedJob := mocksqueue.NewReservedJob(s.T())
+		mockReservedJob.EXPECT().Task().Return(task).Once()
+		mockReservedJob.EXPECT().Attempts().Return(3).Once()
+		mockReservedJob.EXPECT().Delete().Return(nil).Once()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		s.worker.processReservedJob("default", mockReservedJob)
+
+		s.Equal([]string{"release"}, calls)
+		s.Equal(errors.QueueJobAttemptedTooManyTimes.Args(task.Job.Signature()).Error(), (<-s.worker.failedJobChan).Exception)
+	})
+
+	s.Run("retries a released chain job in-memory until the tries are exhausted", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.tries = 2
+		calls = nil
+
+		start := time.Now()
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("default", task, nil)
+
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+		s.Equal([]string{"release", "release"}, calls)
+		s.GreaterOrEqual(time.Since(start), minChainReleaseDelay)
+		s.Equal(errors.QueueJobAttemptedTooManyTimes.Args(task.Job.Signature()).Error(), (<-s.worker.failedJobChan).Exception)
+	})
+
+	s.Run("fails a released chain job when the worker shuts down", func() {
+		s.SetupTest()
+		s.worker.debug = false
+		s.worker.tries = 3
+		s.worker.shutdownCancel()
+		calls = nil
+
+		s.mockJson.EXPECT().MarshalString(mock.Anything).Return("{}", nil).Once()
+
+		released, err := s.worker.call("default", task, nil)
+
+		s.False(released)
+		s.Equal(errors.QueueFailedToCallJob, err)
+		s.Equal([]string{"release"}, calls)
+		s.Equal(errors.QueueJobReleasedOnShutdown.Args(task.Job.Signature()).Error(), (<-s.worker.failedJobChan).Exception)
+	})
+}
+
 func (s *WorkerTestSuite) TestRunWithSyncDriver() {
 	s.mockDriver.EXPECT().Driver().Return(contractsqueue.DriverSync).Once()
 
@@ -1223,3 +1688,53 @@ func (r *TestJobRetry) ShouldRetry(err error, attempt, maxTries int) (bool, time
 	r.maxTries = maxTries
 	return true, 0
 }
+
+type TestJobWithPolicies struct {
+	backoff []time.Duration
+	timeout time.Duration
+	tries   int
+}
+
+func (r *TestJobWithPolicies) Signature() string {
+	return "test_job_with_policies"
+}
+
+func (r *TestJobWithPolicies) Handle(_ ...any) error {
+	return nil
+}
+
+func (r *TestJobWithPolicies) Backoff() []time.Duration {
+	return r.backoff
+}
+
+func (r *TestJobWithPolicies) Timeout() time.Duration {
+	return r.timeout
+}
+
+func (r *TestJobWithPolicies) Tries() int {
+	return r.tries
+}
+
+type TestJobWithTimeout struct {
+	cancelled chan struct{}
+	timeout   time.Duration
+}
+
+func (r *TestJobWithTimeout) Signature() string {
+	return "test_job_with_timeout"
+}
+
+func (r *TestJobWithTimeout) Handle(_ ...any) error {
+	return nil
+}
+
+func (r *TestJobWithTimeout) HandleWithContext(ctx context.Context, _ ...any) error {
+	<-ctx.Done()
+	close(r.cancelled)
+
+	return ctx.Err()
+}
+
+func (r *TestJobWithTimeout) Timeout() time.Duration {
+	return r.timeout
+}
@@ -4,19 +4,24 @@ import (
 	"github.com/goravel/framework/contracts/binding"
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/route"
 	"github.com/goravel/framework/errors"
 	routeconsole "github.com/goravel/framework/route/console"
+	supportbinding "github.com/goravel/framework/support/binding"
 )
 
 type ServiceProvider struct {
 }
 
 func (r *ServiceProvider) Relationship() binding.Relationship {
+	bindings := []string{
+		binding.Route,
+		binding.URL,
+	}
+
 	return binding.Relationship{
-		Bindings: []string{
-			binding.Route,
-		},
-		Dependencies: binding.Bindings[binding.Route].Dependencies,
+		Bindings:     bindings,
+		Dependencies: supportbinding.Dependencies(bindings...),
 		ProvideFor:   []string{},
 	}
 }
@@ -30,6 +35,16 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 
 		return NewRoute(config)
 	})
+	app.Singleton(binding.URL, func(app foundation.Application) (any, error) {
+		config := app.MakeConfig()
+		if config == nil {
+			return nil, errors.ConfigFacadeNotSet.SetModule(errors.ModuleRoute)
+		}
+
+		return NewURL(config, func() route.Route {
+			return app.MakeRoute()
+		}), nil
+	})
 }
 
 func (r *ServiceProvider) Boot(app foundation.Application) {
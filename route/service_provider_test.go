@@ -21,8 +21,8 @@ func TestServiceProviderRelationship(t *testing.T) {
 
 	relationship := provider.Relationship()
 
-	assert.Equal(t, []string{binding.Route}, relationship.Bindings)
-	assert.Equal(t, binding.Bindings[binding.Route].Dependencies, relationship.Dependencies)
+	assert.Equal(t, []string{binding.Route, binding.URL}, relationship.Bindings)
+	assert.ElementsMatch(t, binding.Bindings[binding.Route].Dependencies, relationship.Dependencies)
 	assert.Empty(t, relationship.ProvideFor)
 }
 
@@ -41,6 +41,15 @@ func TestServiceProviderRegister(t *testing.T) {
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), errors.ConfigFacadeNotSet.Error())
 		}).Once()
+		app.EXPECT().Singleton(binding.URL, mock.AnythingOfType("func(foundation.Application) (interface {}, error)")).Run(func(_ any, callback func(contractsfoundation.Application) (any, error)) {
+			callbackApp := mocksfoundation.NewApplication(t)
+			callbackApp.EXPECT().MakeConfig().Return(nil).Once()
+
+			instance, err := callback(callbackApp)
+
+			assert.Nil(t, instance)
+			assert.ErrorIs(t, err, errors.ConfigFacadeNotSet)
+		}).Once()
 
 		provider.Register(app)
 	})
@@ -60,6 +69,16 @@ func TestServiceProviderRegister(t *testing.T) {
 			assert.True(t, ok)
 			assert.Equal(t, config, routeInstance.config)
 		}).Once()
+		app.EXPECT().Singleton(binding.URL, mock.AnythingOfType("func(foundation.Application) (interface {}, error)")).Run(func(_ any, callback func(contractsfoundation.Application) (any, error)) {
+			callbackApp := mocksfoundation.NewApplication(t)
+			config := mocksconfig.NewConfig(t)
+			callbackApp.EXPECT().MakeConfig().Return(config).Once()
+
+			instance, err := callback(callbackApp)
+
+			assert.NoError(t, err)
+			assert.IsType(t, &URL{}, instance)
+		}).Once()
 
 		provider.Register(app)
 	})
@@ -15,6 +15,7 @@ func main() {
 	setup := packages.Setup(os.Args)
 	stubs := Stubs{}
 	routeFacadePath := path.Facade("route.go")
+	urlFacadePath := path.Facade("url.go")
 	routesImport := setup.Paths().Routes().Import()
 	webFunc := setup.Paths().Routes().Package() + ".Web()"
 	webRoutePath := path.Route("web.go")
@@ -57,15 +58,17 @@ JWT_SECRET=
 
 		// Add the Web function to WithRouting
 		modify.RegisterRoute(routesImport, webFunc),
-		// Register the Route facade
+		// Register the Route and URL facades
 		modify.File(routeFacadePath).Overwrite(stubs.RouteFacade(facadesPackage)),
+		modify.File(urlFacadePath).Overwrite(stubs.URLFacade(facadesPackage)),
 
 		// Add configurations to the .env and .env.example files
 		modify.WhenFileNotContains(envPath, "APP_URL", modify.File(envPath).Append(env)),
 		modify.WhenFileNotContains(envExamplePath, "APP_URL", modify.File(envExamplePath).Append(env)),
 	).Uninstall(
-		// Remove the Route facade
+		// Remove the Route and URL facades
 		modify.File(routeFacadePath).Remove(),
+		modify.File(urlFacadePath).Remove(),
 
 		// Remove the Web function from WithRouting
 		modify.UnregisterRoute(routesImport, webFunc),
@@ -145,6 +145,21 @@ func Route() route.Route {
 	return strings.ReplaceAll(content, "DummyPackage", pkg)
 }
 
+func (s Stubs) URLFacade(pkg string) string {
+	content := `package DummyPackage
+
+import (
+	"github.com/goravel/framework/contracts/route"
+)
+
+func URL() route.URL {
+	return App().MakeURL()
+}
+`
+
+	return strings.ReplaceAll(content, "DummyPackage", pkg)
+}
+
 func (s Stubs) Routes(pkg, appImport, facadesImport, facadesPackage string) string {
 	content := `package DummyPackage
 
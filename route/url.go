@@ -0,0 +1,100 @@
+package route
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/errors"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+// routeParameterRegex matches the parameters of the route path, eg: {id} in /users/{id},
+// or :id in /users/:id that may be returned by the route drivers.
+var routeParameterRegex = regexp.MustCompile(`\{[^/{}]+\}|:[A-Za-z0-9_]+`)
+
+type URL struct {
+	config config.Config
+	route  func() route.Route
+}
+
+func NewURL(config config.Config, route func() route.Route) *URL {
+	return &URL{
+		config: config,
+		route:  route,
+	}
+}
+
+func (r *URL) Route(name string, parameters ...map[string]any) (string, error) {
+	router := r.route()
+	if router == nil {
+		return "", errors.RouteFacadeNotSet.SetModule(errors.ModuleRoute)
+	}
+
+	info := router.Info(name)
+	if info.Path == "" {
+		return "", errors.RouteNotDefined.Args(name)
+	}
+
+	query := make(map[string]any)
+	if len(parameters) > 0 {
+		for key, value := range parameters[0] {
+			query[key] = value
+		}
+	}
+
+	var err error
+	path := routeParameterRegex.ReplaceAllStringFunc(info.Path, func(match string) string {
+		key := strings.TrimPrefix(strings.Trim(match, "{}"), ":")
+		value, exist := query[key]
+		if !exist {
+			if err == nil {
+				err = errors.RouteParameterMissing.Args(key, name)
+			}
+			return match
+		}
+
+		delete(query, key)
+
+		return url.PathEscape(cast.ToString(value))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return r.To(path, query), nil
+}
+
+func (r *URL) SignedRoute(name string, parameters ...map[string]any) (string, error) {
+	return r.TemporarySignedRoute(name, time.Time{}, parameters...)
+}
+
+func (r *URL) TemporarySignedRoute(name string, expiration time.Time, parameters ...map[string]any) (string, error) {
+	rawURL, err := r.Route(name, parameters...)
+	if err != nil {
+		return "", err
+	}
+
+	return supporthttp.SignURL(r.config.GetString("app.key"), rawURL, expiration)
+}
+
+func (r *URL) To(path string, query ...map[string]any) string {
+	to := strings.TrimSuffix(r.config.GetString("app.url"), "/") + "/" + strings.TrimPrefix(path, "/")
+
+	values := url.Values{}
+	if len(query) > 0 {
+		for key, value := range query[0] {
+			values.Set(key, cast.ToString(value))
+		}
+	}
+	if len(values) > 0 {
+		to += "?" + values.Encode()
+	}
+
+	return to
+}
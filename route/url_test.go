@@ -0,0 +1,123 @@
+package route
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/errors"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksroute "github.com/goravel/framework/mocks/route"
+	supporthttp "github.com/goravel/framework/support/http"
+)
+
+func TestURL(t *testing.T) {
+	var (
+		mockConfig *mocksconfig.Config
+		mockRoute  *mocksroute.Route
+		u          *URL
+	)
+
+	key := "12345678901234567890123456789012"
+
+	beforeEach := func() {
+		mockConfig = mocksconfig.NewConfig(t)
+		mockRoute = mocksroute.NewRoute(t)
+		u = NewURL(mockConfig, func() contractsroute.Route {
+			return mockRoute
+		})
+	}
+
+	t.Run("Route", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("users.posts.show").Return(contractshttp.Info{Name: "users.posts.show", Path: "/users/{user}/posts/{post}"}).Once()
+		mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev/").Once()
+
+		result, err := u.Route("users.posts.show", map[string]any{"user": 1, "post": "hello world", "tab": "comments", "page": 2})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://goravel.dev/users/1/posts/hello%20world?page=2&tab=comments", result)
+	})
+
+	t.Run("Route with the colon parameters", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("users.show").Return(contractshttp.Info{Name: "users.show", Path: "/users/:id"}).Once()
+		mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev").Once()
+
+		result, err := u.Route("users.show", map[string]any{"id": 1})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://goravel.dev/users/1", result)
+	})
+
+	t.Run("Route is not defined", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("users.show").Return(contractshttp.Info{}).Once()
+
+		result, err := u.Route("users.show")
+		assert.Empty(t, result)
+		assert.ErrorIs(t, err, errors.RouteNotDefined)
+	})
+
+	t.Run("Route parameter is missing", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("users.show").Return(contractshttp.Info{Name: "users.show", Path: "/users/{id}"}).Once()
+
+		result, err := u.Route("users.show", map[string]any{"name": "goravel"})
+		assert.Empty(t, result)
+		assert.EqualError(t, err, "[route] missing parameter id of route users.show")
+	})
+
+	t.Run("Route facade is not set", func(t *testing.T) {
+		u = NewURL(mocksconfig.NewConfig(t), func() contractsroute.Route {
+			return nil
+		})
+
+		result, err := u.Route("users.show")
+		assert.Empty(t, result)
+		assert.ErrorIs(t, err, errors.RouteFacadeNotSet)
+	})
+
+	t.Run("SignedRoute", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("unsubscribe").Return(contractshttp.Info{Name: "unsubscribe", Path: "/unsubscribe/{user}"}).Once()
+		mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev").Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		result, err := u.SignedRoute("unsubscribe", map[string]any{"user": 1})
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(result)
+		require.NoError(t, err)
+		assert.Equal(t, "/unsubscribe/1", parsed.Path)
+		assert.Empty(t, parsed.Query().Get(supporthttp.SignatureQueryExpires))
+		assert.NoError(t, supporthttp.VerifyURL(key, parsed, time.Now().Add(24*time.Hour)))
+	})
+
+	t.Run("TemporarySignedRoute", func(t *testing.T) {
+		beforeEach()
+		mockRoute.EXPECT().Info("verification.verify").Return(contractshttp.Info{Name: "verification.verify", Path: "/email/verify/{id}"}).Once()
+		mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev").Once()
+		mockConfig.EXPECT().GetString("app.key").Return(key).Once()
+
+		now := time.Now()
+		result, err := u.TemporarySignedRoute("verification.verify", now.Add(time.Hour), map[string]any{"id": 1})
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(result)
+		require.NoError(t, err)
+		assert.NoError(t, supporthttp.VerifyURL(key, parsed, now))
+		assert.ErrorIs(t, supporthttp.VerifyURL(key, parsed, now.Add(2*time.Hour)), errors.HttpSignatureExpired)
+	})
+
+	t.Run("To", func(t *testing.T) {
+		beforeEach()
+		mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev/").Twice()
+
+		assert.Equal(t, "https://goravel.dev/docs", u.To("/docs"))
+		assert.Equal(t, "https://goravel.dev/docs?version=1.16", u.To("docs", map[string]any{"version": "1.16"}))
+	})
+}
@@ -0,0 +1,128 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/packages/modify"
+	"github.com/goravel/framework/support/env"
+)
+
+// Usage:
+//
+//	./artisan session:table
+//	./artisan migrate
+type TableCommand struct{}
+
+func NewTableCommand() *TableCommand {
+	return &TableCommand{}
+}
+
+func (c *TableCommand) Signature() string {
+	return "session:table"
+}
+
+func (c *TableCommand) Description() string {
+	return "Create a migration for the session database table"
+}
+
+func (c *TableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "session",
+	}
+}
+
+func (c *TableCommand) Handle(ctx console.Context) error {
+	timestamp := time.Now().Format("20060102150405")
+	filename := timestamp + "_create_sessions_table.go"
+	dest := filepath.Join("database", "migrations", filename)
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dest); err == nil {
+		ctx.Warning("Migration already exists: " + dest)
+		return nil
+	}
+
+	if err := os.WriteFile(dest, []byte(migrationStub(timestamp)), 0o644); err != nil {
+		return err
+	}
+
+	ctx.Info("Migration created successfully: " + dest)
+
+	structName := "M" + timestamp + "CreateSessionsTable"
+	if err := c.registerMigration(structName); err != nil {
+		ctx.Warning("Could not auto-register migration: " + err.Error())
+		ctx.Warning("Add manually to your migrations registration:")
+		ctx.Info("  &migrations." + structName + "{},")
+	} else {
+		ctx.Info("Migration registered successfully")
+	}
+
+	ctx.Info("Run `./artisan migrate` to apply it.")
+	return nil
+}
+
+func (c *TableCommand) registerMigration(structName string) error {
+	if !env.IsBootstrapSetup() {
+		return errors.SessionTableRequiresBootstrapSetup
+	}
+
+	modulePath := "goravel"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		modulePath = info.Main.Path
+	}
+	pkgImportPath := modulePath + "/database/migrations"
+	entry := fmt.Sprintf("&migrations.%s{}", structName)
+
+	return modify.AddMigration(pkgImportPath, entry)
+}
+
+// Column shape here MUST stay in sync with DatabaseSession in
+// session/driver/database.go.
+func migrationStub(timestamp string) string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/app/facades"
+)
+
+type M` + timestamp + `CreateSessionsTable struct{}
+
+func (r *M` + timestamp + `CreateSessionsTable) Signature() string {
+	return "` + timestamp + `_create_sessions_table"
+}
+
+func (r *M` + timestamp + `CreateSessionsTable) Up() error {
+	if facades.Schema().HasTable("sessions") {
+		return nil
+	}
+
+	return facades.Schema().Create("sessions", func(table schema.Blueprint) {
+		table.String("id")
+		table.Primary("id")
+		table.String("user_id").Nullable()
+		table.String("ip_address", 45).Nullable()
+		table.Text("user_agent").Nullable()
+		table.LongText("payload")
+		table.BigInteger("last_activity")
+		table.Index("user_id")
+		table.Index("last_activity")
+	})
+}
+
+func (r *M` + timestamp + `CreateSessionsTable) Down() error {
+	return facades.Schema().DropIfExists("sessions")
+}
+`
+}
@@ -0,0 +1,42 @@
+package console
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+)
+
+func TestTableCommand(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	ctx := mocksconsole.NewContext(t)
+	ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "Migration created successfully:")
+	})).Once()
+	ctx.EXPECT().Warning(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "Could not auto-register migration:")
+	})).Once()
+	ctx.EXPECT().Warning("Add manually to your migrations registration:").Once()
+	ctx.EXPECT().Info(mock.MatchedBy(func(s string) bool {
+		return strings.HasPrefix(s, "  &migrations.")
+	})).Once()
+	ctx.EXPECT().Info("Run `./artisan migrate` to apply it.").Once()
+
+	cmd := NewTableCommand()
+	assert.Equal(t, "session:table", cmd.Signature())
+	assert.NoError(t, cmd.Handle(ctx))
+
+	entries, err := os.ReadDir("database/migrations")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries[0].Name(), "_create_sessions_table.go")
+
+	content, err := os.ReadFile("database/migrations/" + entries[0].Name())
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `Create("sessions", func`)
+}
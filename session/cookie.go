@@ -1,6 +1,9 @@
 package session
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/goravel/framework/contracts/http"
 	sessioncontract "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/support/carbon"
@@ -21,3 +24,45 @@ func WriteCookie(ctx http.Context, session sessioncontract.Session) {
 		SameSite: ConfigFacade.GetString("session.same_site"),
 	})
 }
+
+// PayloadCookieName returns the name of the cookie that carries the data of
+// the session when the cookie driver is used.
+func PayloadCookieName(session sessioncontract.Session) string {
+	return session.GetName() + "_payload"
+}
+
+// WritePayloadCookie sends the encrypted data of the session to the client, an
+// empty payload removes the cookie. The payload is sent every time the session
+// changes, the payload cookie added to the response before is replaced.
+func WritePayloadCookie(ctx http.Context, session sessioncontract.Session, payload string) {
+	forgetCookie(ctx, PayloadCookieName(session))
+
+	if payload == "" {
+		ctx.Response().WithoutCookie(PayloadCookieName(session))
+		return
+	}
+
+	ctx.Response().Cookie(http.Cookie{
+		Name:     PayloadCookieName(session),
+		Value:    payload,
+		Expires:  carbon.Now().AddMinutes(ConfigFacade.GetInt("session.lifetime", 120)).StdTime(),
+		Path:     ConfigFacade.GetString("session.path"),
+		Domain:   ConfigFacade.GetString("session.domain"),
+		Secure:   ConfigFacade.GetBool("session.secure"),
+		HttpOnly: ConfigFacade.GetBool("session.http_only"),
+		SameSite: ConfigFacade.GetString("session.same_site"),
+	})
+}
+
+// forgetCookie removes the cookie of the given name added to the response before.
+func forgetCookie(ctx http.Context, name string) {
+	header := ctx.Response().Writer().Header()
+	cookies := slices.DeleteFunc(slices.Clone(header.Values("Set-Cookie")), func(cookie string) bool {
+		return strings.HasPrefix(cookie, name+"=")
+	})
+
+	header.Del("Set-Cookie")
+	for _, cookie := range cookies {
+		header.Add("Set-Cookie", cookie)
+	}
+}
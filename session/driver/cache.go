@@ -0,0 +1,54 @@
+package driver
+
+import (
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+)
+
+// Cache stores the sessions in a cache store, the items expire by themselves,
+// so Gc has nothing to do. The keys are prefixed, so that a session ID never
+// collides with the other items of the store.
+type Cache struct {
+	store   contractscache.Driver
+	minutes int
+}
+
+func NewCache(store contractscache.Driver, minutes int) *Cache {
+	return &Cache{
+		store:   store,
+		minutes: minutes,
+	}
+}
+
+const cacheKeyPrefix = "session:"
+
+func (c *Cache) Close() error {
+	return nil
+}
+
+func (c *Cache) Destroy(id string) error {
+	c.store.Forget(c.key(id))
+
+	return nil
+}
+
+func (c *Cache) Gc(int) error {
+	return nil
+}
+
+func (c *Cache) Open(string, string) error {
+	return nil
+}
+
+func (c *Cache) Read(id string) (string, error) {
+	return c.store.GetString(c.key(id)), nil
+}
+
+func (c *Cache) Write(id string, data string) error {
+	return c.store.Put(c.key(id), data, time.Duration(c.minutes)*time.Minute)
+}
+
+func (c *Cache) key(id string) string {
+	return cacheKeyPrefix + id
+}
@@ -0,0 +1,29 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mockscache "github.com/goravel/framework/mocks/cache"
+)
+
+func TestCache(t *testing.T) {
+	mockStore := mockscache.NewDriver(t)
+	driver := NewCache(mockStore, 120)
+
+	mockStore.EXPECT().Put("session:foo", "bar", 120*time.Minute).Return(nil).Once()
+	assert.Nil(t, driver.Write("foo", "bar"))
+
+	mockStore.EXPECT().GetString("session:foo").Return("bar").Once()
+	value, err := driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", value)
+
+	mockStore.EXPECT().Forget("session:foo").Return(true).Once()
+	assert.Nil(t, driver.Destroy("foo"))
+
+	assert.Nil(t, driver.Gc(300))
+	assert.Nil(t, driver.Close())
+}
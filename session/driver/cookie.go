@@ -0,0 +1,109 @@
+package driver
+
+import (
+	"encoding/json"
+
+	contractscrypt "github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// Cookie stores the encrypted sessions in a cookie of the client. The driver
+// registered in the manager is shared by all requests, the StartSession
+// middleware scopes it to a request with WithPayload and sends Payload back to
+// the client after the session is saved. Keep in mind that the browsers limit
+// the size of a cookie to 4KB.
+type Cookie struct {
+	crypt   contractscrypt.Crypt
+	minutes int
+	payload string
+}
+
+type cookiePayload struct {
+	ID      string `json:"id"`
+	Data    string `json:"data"`
+	Expires int64  `json:"expires"`
+}
+
+func NewCookie(crypt contractscrypt.Crypt, minutes int) *Cookie {
+	return &Cookie{
+		crypt:   crypt,
+		minutes: minutes,
+	}
+}
+
+// WithPayload returns a copy of the driver holding the payload received from the client.
+func (c *Cookie) WithPayload(payload string) *Cookie {
+	return &Cookie{
+		crypt:   c.crypt,
+		minutes: c.minutes,
+		payload: payload,
+	}
+}
+
+// Payload returns the encrypted payload that should be sent to the client,
+// an empty payload means the cookie should be removed.
+func (c *Cookie) Payload() string {
+	return c.payload
+}
+
+func (c *Cookie) Close() error {
+	return nil
+}
+
+func (c *Cookie) Destroy(string) error {
+	c.payload = ""
+
+	return nil
+}
+
+func (c *Cookie) Gc(int) error {
+	return nil
+}
+
+func (c *Cookie) Open(string, string) error {
+	return nil
+}
+
+// Read returns the data of the payload, a payload that is invalid, expired or
+// belongs to another session is ignored.
+func (c *Cookie) Read(id string) (string, error) {
+	if c.payload == "" {
+		return "", nil
+	}
+
+	decrypted, err := c.crypt.DecryptString(c.payload)
+	if err != nil {
+		return "", nil
+	}
+
+	var payload cookiePayload
+	if err := json.Unmarshal([]byte(decrypted), &payload); err != nil {
+		return "", nil
+	}
+
+	if payload.ID != id || payload.Expires <= carbon.Now().Timestamp() {
+		return "", nil
+	}
+
+	return payload.Data, nil
+}
+
+func (c *Cookie) Write(id string, data string) error {
+	encoded, err := json.Marshal(cookiePayload{
+		ID:      id,
+		Data:    data,
+		Expires: carbon.Now().AddMinutes(c.minutes).Timestamp(),
+	})
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := c.crypt.EncryptString(string(encoded))
+	if err != nil {
+		return err
+	}
+
+	c.payload = encrypted
+
+	return nil
+}
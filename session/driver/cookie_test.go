@@ -0,0 +1,68 @@
+package driver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/errors"
+	mockscrypt "github.com/goravel/framework/mocks/crypt"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestCookie(t *testing.T) {
+	mockCrypt := mockscrypt.NewCrypt(t)
+	mockCrypt.EXPECT().EncryptString(mock.Anything).RunAndReturn(func(value string) (string, error) {
+		return "encrypted:" + value, nil
+	}).Maybe()
+	mockCrypt.EXPECT().DecryptString(mock.Anything).RunAndReturn(func(payload string) (string, error) {
+		if !strings.HasPrefix(payload, "encrypted:") {
+			return "", errors.New("invalid payload")
+		}
+
+		return strings.TrimPrefix(payload, "encrypted:"), nil
+	}).Maybe()
+
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.ClearTestNow()
+
+	driver := NewCookie(mockCrypt, 120)
+
+	// The shared driver doesn't hold any payload.
+	value, err := driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	scoped := driver.WithPayload("")
+	assert.Nil(t, scoped.Write("foo", "bar"))
+	assert.NotEmpty(t, scoped.Payload())
+	assert.Empty(t, driver.Payload())
+
+	next := driver.WithPayload(scoped.Payload())
+	value, err = next.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", value)
+
+	// The payload of another session is ignored.
+	value, err = next.Read("baz")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	// The invalid payload is ignored.
+	value, err = driver.WithPayload("invalid").Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	// The expired payload is ignored.
+	carbon.SetTestNow(now.AddMinutes(121))
+	value, err = next.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	assert.Nil(t, next.Destroy("foo"))
+	assert.Empty(t, next.Payload())
+	assert.Nil(t, next.Gc(300))
+}
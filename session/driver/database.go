@@ -0,0 +1,137 @@
+package driver
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cast"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	contractssession "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/support/carbon"
+)
+
+var _ contractssession.UserDriver = (*Database)(nil)
+
+// DatabaseSession is a row of the sessions table.
+type DatabaseSession struct {
+	ID           string  `db:"id"`
+	UserID       *string `db:"user_id"`
+	IPAddress    *string `db:"ip_address"`
+	UserAgent    *string `db:"user_agent"`
+	Payload      string  `db:"payload"`
+	LastActivity int64   `db:"last_activity"`
+}
+
+type Database struct {
+	db      contractsdb.DB
+	table   string
+	minutes int
+}
+
+func NewDatabase(db contractsdb.DB, table string, minutes int) *Database {
+	return &Database{
+		db:      db,
+		table:   table,
+		minutes: minutes,
+	}
+}
+
+func (d *Database) Close() error {
+	return nil
+}
+
+func (d *Database) Destroy(id string) error {
+	_, err := d.db.Table(d.table).Where("id", id).Delete()
+
+	return err
+}
+
+func (d *Database) DestroyOthers(userID string, exceptID string) error {
+	_, err := d.db.Table(d.table).Where("user_id", userID).Where("id <> ?", exceptID).Delete()
+
+	return err
+}
+
+func (d *Database) Gc(maxLifetime int) error {
+	_, err := d.db.Table(d.table).Where("last_activity <= ?", carbon.Now().SubSeconds(maxLifetime).Timestamp()).Delete()
+
+	return err
+}
+
+func (d *Database) Open(string, string) error {
+	return nil
+}
+
+func (d *Database) Read(id string) (string, error) {
+	var session DatabaseSession
+	if err := d.db.Table(d.table).Where("id", id).First(&session); err != nil {
+		return "", err
+	}
+
+	if session.ID == "" || session.LastActivity <= d.expiredAt() {
+		return "", nil
+	}
+
+	return session.Payload, nil
+}
+
+func (d *Database) Sessions(userID string) ([]contractssession.Activity, error) {
+	var sessions []DatabaseSession
+	if err := d.db.Table(d.table).Where("user_id", userID).Where("last_activity > ?", d.expiredAt()).OrderByDesc("last_activity").Get(&sessions); err != nil {
+		return nil, err
+	}
+
+	activities := make([]contractssession.Activity, len(sessions))
+	for i, session := range sessions {
+		activities[i] = contractssession.Activity{
+			ID:           session.ID,
+			UserID:       userID,
+			IPAddress:    stringValue(session.IPAddress),
+			UserAgent:    stringValue(session.UserAgent),
+			LastActivity: time.Unix(session.LastActivity, 0),
+		}
+	}
+
+	return activities, nil
+}
+
+// Write stores the payload and the information of the user read from the reserved attributes.
+func (d *Database) Write(id string, data string) error {
+	values := map[string]any{
+		"payload":       data,
+		"last_activity": carbon.Now().Timestamp(),
+		"user_id":       nil,
+		"ip_address":    nil,
+		"user_agent":    nil,
+	}
+
+	var attributes map[string]any
+	if err := json.Unmarshal([]byte(data), &attributes); err == nil {
+		for column, attribute := range map[string]string{
+			"user_id":    contractssession.AttributeUserID,
+			"ip_address": contractssession.AttributeIPAddress,
+			"user_agent": contractssession.AttributeUserAgent,
+		} {
+			if value, exist := attributes[attribute]; exist && value != nil {
+				values[column] = cast.ToString(value)
+			}
+		}
+	}
+
+	_, err := d.db.Table(d.table).UpdateOrInsert(map[string]any{"id": id}, values)
+
+	return err
+}
+
+func (d *Database) expiredAt() int64 {
+	return carbon.Now().SubMinutes(d.minutes).Timestamp()
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
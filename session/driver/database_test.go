@@ -0,0 +1,169 @@
+package driver
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	contractssession "github.com/goravel/framework/contracts/session"
+	databasedb "github.com/goravel/framework/database/db"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
+	mocksdriver "github.com/goravel/framework/mocks/database/driver"
+	mockslogger "github.com/goravel/framework/mocks/database/logger"
+	"github.com/goravel/framework/support/carbon"
+)
+
+type DatabaseTestSuite struct {
+	suite.Suite
+	mockDB    *mocksdb.DB
+	mockQuery *mocksdb.Query
+	driver    *Database
+	now       *carbon.Carbon
+}
+
+func TestDatabaseTestSuite(t *testing.T) {
+	suite.Run(t, &DatabaseTestSuite{})
+}
+
+func (s *DatabaseTestSuite) SetupTest() {
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockQuery = mocksdb.NewQuery(s.T())
+	s.driver = NewDatabase(s.mockDB, "sessions", 120)
+	s.now = carbon.Now()
+	carbon.SetTestNow(s.now)
+}
+
+func (s *DatabaseTestSuite) TearDownTest() {
+	carbon.ClearTestNow()
+}
+
+func (s *DatabaseTestSuite) TestRead() {
+	s.Run("active session", func() {
+		s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("id", "foo").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*DatabaseSession) = DatabaseSession{ID: "foo", Payload: "bar", LastActivity: s.now.Timestamp()}
+		}).Return(nil).Once()
+
+		value, err := s.driver.Read("foo")
+		s.Nil(err)
+		s.Equal("bar", value)
+	})
+
+	s.Run("expired session", func() {
+		s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("id", "foo").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+			*dest.(*DatabaseSession) = DatabaseSession{ID: "foo", Payload: "bar", LastActivity: carbon.Now().SubMinutes(121).Timestamp()}
+		}).Return(nil).Once()
+
+		value, err := s.driver.Read("foo")
+		s.Nil(err)
+		s.Equal("", value)
+	})
+
+	s.Run("missing session", func() {
+		s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Where("id", "foo").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().First(mock.Anything).Return(nil).Once()
+
+		value, err := s.driver.Read("foo")
+		s.Nil(err)
+		s.Equal("", value)
+	})
+}
+
+func (s *DatabaseTestSuite) TestWrite() {
+	s.Run("without user", func() {
+		s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().UpdateOrInsert(map[string]any{"id": "foo"}, map[string]any{
+			"payload":       "bar",
+			"last_activity": s.now.Timestamp(),
+			"user_id":       nil,
+			"ip_address":    nil,
+			"user_agent":    nil,
+		}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+		s.Nil(s.driver.Write("foo", "bar"))
+	})
+
+	s.Run("with user", func() {
+		data := `{"_user_id":"1","_ip_address":"127.0.0.1","_user_agent":"Goravel","name":"goravel"}`
+		s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().UpdateOrInsert(map[string]any{"id": "foo"}, map[string]any{
+			"payload":       data,
+			"last_activity": s.now.Timestamp(),
+			"user_id":       "1",
+			"ip_address":    "127.0.0.1",
+			"user_agent":    "Goravel",
+		}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+		s.Nil(s.driver.Write("foo", data))
+	})
+}
+
+func (s *DatabaseTestSuite) TestSessions() {
+	ip := "127.0.0.1"
+	s.expectSelect("SELECT * FROM sessions WHERE (user_id = ? AND last_activity > ?) ORDER BY last_activity DESC", []DatabaseSession{
+		{ID: "foo", IPAddress: &ip, LastActivity: s.now.Timestamp()},
+	}, "1", carbon.Now().SubMinutes(120).Timestamp())
+
+	sessions, err := s.driver.Sessions("1")
+	s.Nil(err)
+	s.Equal([]contractssession.Activity{
+		{ID: "foo", UserID: "1", IPAddress: ip, LastActivity: time.Unix(s.now.Timestamp(), 0)},
+	}, sessions)
+}
+
+func (s *DatabaseTestSuite) TestDestroyOthers() {
+	s.expectExec("DELETE FROM sessions WHERE (user_id = ? AND id <> ?)", "1", "foo")
+
+	s.Nil(s.driver.DestroyOthers("1", "foo"))
+}
+
+func (s *DatabaseTestSuite) TestDestroy() {
+	s.mockDB.EXPECT().Table("sessions").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where("id", "foo").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+
+	s.Nil(s.driver.Destroy("foo"))
+}
+
+func (s *DatabaseTestSuite) TestGc() {
+	s.expectExec("DELETE FROM sessions WHERE last_activity <= ?", carbon.Now().SubSeconds(300).Timestamp())
+
+	s.Nil(s.driver.Gc(300))
+}
+
+// expectSelect expects the statement built by the query builder of the table to be selected into the sessions.
+func (s *DatabaseTestSuite) expectSelect(sql string, sessions []DatabaseSession, args ...any) {
+	mockBuilder := mocksdb.NewBuilder(s.T())
+	mockGrammar := mocksdriver.NewGrammar(s.T())
+	mockLogger := mockslogger.NewLogger(s.T())
+
+	s.mockDB.EXPECT().Table("sessions").Return(databasedb.NewQuery(context.Background(), mockBuilder, mockBuilder, mockGrammar, mockLogger, "sessions", nil)).Once()
+	mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	mockBuilder.EXPECT().SelectContext(mock.Anything, mock.Anything, sql, args...).Run(func(ctx context.Context, dest any, query string, args ...any) {
+		*dest.(*[]DatabaseSession) = sessions
+	}).Return(nil).Once()
+	mockBuilder.EXPECT().Explain(sql, args...).Return(sql).Once()
+	mockLogger.EXPECT().Trace(mock.Anything, mock.Anything, sql, int64(len(sessions)), nil).Return().Once()
+}
+
+// expectExec expects the statement built by the query builder of the table to be executed.
+func (s *DatabaseTestSuite) expectExec(sql string, args ...any) {
+	mockBuilder := mocksdb.NewBuilder(s.T())
+	mockGrammar := mocksdriver.NewGrammar(s.T())
+	mockLogger := mockslogger.NewLogger(s.T())
+
+	s.mockDB.EXPECT().Table("sessions").Return(databasedb.NewQuery(context.Background(), mockBuilder, mockBuilder, mockGrammar, mockLogger, "sessions", nil)).Once()
+	mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	mockBuilder.EXPECT().ExecContext(mock.Anything, sql, args...).Return(sqldriver.RowsAffected(1), nil).Once()
+	mockBuilder.EXPECT().Explain(sql, args...).Return(sql).Once()
+	mockLogger.EXPECT().Trace(mock.Anything, mock.Anything, sql, int64(1), nil).Return().Once()
+}
@@ -5,21 +5,33 @@ import (
 	"sync"
 	"time"
 
+	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/foundation"
 	contractssession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/errors"
-	"github.com/goravel/framework/session/driver"
+	sessiondriver "github.com/goravel/framework/session/driver"
 	"github.com/goravel/framework/support/color"
 )
 
 // Ensure interface implementation
 var _ contractssession.Manager = (*Manager)(nil)
 
+// Resolvers resolve the facades required by some drivers, they are called only
+// when the drivers are registered, so the session doesn't depend on them by default.
+type Resolvers struct {
+	Cache func() cache.Cache
+	Crypt func() crypt.Crypt
+	DB    func() db.DB
+}
+
 type Manager struct {
 	sessionPool sync.Pool
 	config      config.Config
 	json        foundation.Json
+	resolvers   Resolvers
 
 	drivers map[string]contractssession.Driver
 
@@ -32,7 +44,7 @@ type Manager struct {
 	mu sync.RWMutex
 }
 
-func NewManager(config config.Config, json foundation.Json) *Manager {
+func NewManager(config config.Config, json foundation.Json, resolvers Resolvers) *Manager {
 	cookie := config.GetString("session.cookie")
 	defaultDriver := config.GetString("session.default", "file")
 	files := config.GetString("session.files")
@@ -40,8 +52,9 @@ func NewManager(config config.Config, json foundation.Json) *Manager {
 	lifetime := config.GetInt("session.lifetime", 120)
 
 	manager := &Manager{
-		config: config,
-		json:   json,
+		config:    config,
+		json:      json,
+		resolvers: resolvers,
 
 		cookie:        cookie,
 		defaultDriver: defaultDriver,
@@ -114,6 +127,34 @@ func (m *Manager) acquireSession() contractssession.Session {
 	return session
 }
 
+func (m *Manager) cache(driver string) (contractssession.Driver, error) {
+	var store cache.Cache
+	if m.resolvers.Cache != nil {
+		store = m.resolvers.Cache()
+	}
+	if store == nil {
+		return nil, errors.SessionCacheNotSet.Args(driver)
+	}
+
+	if name := m.config.GetString(fmt.Sprintf("session.drivers.%s.store", driver)); name != "" {
+		return sessiondriver.NewCache(store.Store(name), m.lifetime), nil
+	}
+
+	return sessiondriver.NewCache(store, m.lifetime), nil
+}
+
+func (m *Manager) cookieDriver(driver string) (contractssession.Driver, error) {
+	var c crypt.Crypt
+	if m.resolvers.Crypt != nil {
+		c = m.resolvers.Crypt()
+	}
+	if c == nil {
+		return nil, errors.SessionCryptNotSet.Args(driver)
+	}
+
+	return sessiondriver.NewCookie(c, m.lifetime), nil
+}
+
 func (m *Manager) custom(driver string) (contractssession.Driver, error) {
 	via := m.config.Get(fmt.Sprintf("session.drivers.%s.via", driver))
 	if custom, ok := via.(contractssession.Driver); ok {
@@ -126,8 +167,26 @@ func (m *Manager) custom(driver string) (contractssession.Driver, error) {
 	return nil, errors.SessionDriverContractNotFulfilled.Args(driver)
 }
 
+func (m *Manager) database(driver string) (contractssession.Driver, error) {
+	var database db.DB
+	if m.resolvers.DB != nil {
+		database = m.resolvers.DB()
+	}
+	if database == nil {
+		return nil, errors.SessionDatabaseNotSet.Args(driver)
+	}
+
+	if connection := m.config.GetString(fmt.Sprintf("session.drivers.%s.connection", driver)); connection != "" {
+		database = database.Connection(connection)
+	}
+
+	table := m.config.GetString(fmt.Sprintf("session.drivers.%s.table", driver), "sessions")
+
+	return sessiondriver.NewDatabase(database, table, m.lifetime), nil
+}
+
 func (m *Manager) file() contractssession.Driver {
-	return driver.NewFile(m.files, m.lifetime)
+	return sessiondriver.NewFile(m.files, m.lifetime)
 }
 
 func (m *Manager) registerDriver(name string) error {
@@ -138,6 +197,25 @@ func (m *Manager) registerDriver(name string) error {
 		driverInstance := m.file()
 		m.drivers[name] = driverInstance
 		m.startGcTimer(driverInstance)
+	case "database":
+		driverInstance, err := m.database(name)
+		if err != nil {
+			return err
+		}
+		m.drivers[name] = driverInstance
+		m.startGcTimer(driverInstance)
+	case "cookie":
+		driverInstance, err := m.cookieDriver(name)
+		if err != nil {
+			return err
+		}
+		m.drivers[name] = driverInstance
+	case "cache":
+		driverInstance, err := m.cache(name)
+		if err != nil {
+			return err
+		}
+		m.drivers[name] = driverInstance
 	case "custom":
 		driverInstance, err := m.custom(name)
 		if err != nil {
@@ -7,11 +7,17 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractscrypt "github.com/goravel/framework/contracts/crypt"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
 	"github.com/goravel/framework/contracts/foundation"
 	contractssession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/errors"
 	"github.com/goravel/framework/foundation/json"
+	mockscache "github.com/goravel/framework/mocks/cache"
 	mockconfig "github.com/goravel/framework/mocks/config"
+	mockscrypt "github.com/goravel/framework/mocks/crypt"
+	mocksdb "github.com/goravel/framework/mocks/database/db"
 	mocksession "github.com/goravel/framework/mocks/session"
 	"github.com/goravel/framework/session/driver"
 	"github.com/goravel/framework/support/path"
@@ -62,7 +68,7 @@ func (s *ManagerTestSuite) SetupTest() {
 	s.mockConfig.EXPECT().GetString("session.files").Return(path.Storage("framework/sessions")).Once()
 	s.mockConfig.EXPECT().GetString("session.cookie").Return("goravel_session").Once()
 
-	s.manager = NewManager(s.mockConfig, s.json)
+	s.manager = NewManager(s.mockConfig, s.json, Resolvers{})
 	s.Require().NotNil(s.manager)
 }
 
@@ -99,6 +105,61 @@ func (s *ManagerTestSuite) TestDriver_ResolveCustomDriver() {
 	s.Equal(s.mockOtherDriver, driverInstance, "Expected mock driver for my_driver driver from config")
 }
 
+func (s *ManagerTestSuite) TestDriver_ResolveDatabaseDriver() {
+	s.mockConfig.EXPECT().GetString("session.drivers.database.driver").Return("database").Twice()
+
+	driverInstance, err := s.manager.Driver("database")
+	s.ErrorIs(err, errors.SessionDatabaseNotSet.Args("database"))
+	s.Nil(driverInstance)
+
+	mockDB := mocksdb.NewDB(s.T())
+	s.manager.resolvers.DB = func() contractsdb.DB {
+		return mockDB
+	}
+	s.mockConfig.EXPECT().GetString("session.drivers.database.connection").Return("mysql").Once()
+	s.mockConfig.EXPECT().GetString("session.drivers.database.table", "sessions").Return("sessions").Once()
+	mockDB.EXPECT().Connection("mysql").Return(mockDB).Once()
+
+	driverInstance, err = s.manager.Driver("database")
+	s.Nil(err)
+	s.IsType(&driver.Database{}, driverInstance)
+}
+
+func (s *ManagerTestSuite) TestDriver_ResolveCookieDriver() {
+	s.mockConfig.EXPECT().GetString("session.drivers.cookie.driver").Return("cookie").Twice()
+
+	driverInstance, err := s.manager.Driver("cookie")
+	s.ErrorIs(err, errors.SessionCryptNotSet.Args("cookie"))
+	s.Nil(driverInstance)
+
+	s.manager.resolvers.Crypt = func() contractscrypt.Crypt {
+		return mockscrypt.NewCrypt(s.T())
+	}
+
+	driverInstance, err = s.manager.Driver("cookie")
+	s.Nil(err)
+	s.IsType(&driver.Cookie{}, driverInstance)
+}
+
+func (s *ManagerTestSuite) TestDriver_ResolveCacheDriver() {
+	s.mockConfig.EXPECT().GetString("session.drivers.cache.driver").Return("cache").Twice()
+
+	driverInstance, err := s.manager.Driver("cache")
+	s.ErrorIs(err, errors.SessionCacheNotSet.Args("cache"))
+	s.Nil(driverInstance)
+
+	mockCache := mockscache.NewCache(s.T())
+	s.manager.resolvers.Cache = func() contractscache.Cache {
+		return mockCache
+	}
+	s.mockConfig.EXPECT().GetString("session.drivers.cache.store").Return("redis").Once()
+	mockCache.EXPECT().Store("redis").Return(mockscache.NewDriver(s.T())).Once()
+
+	driverInstance, err = s.manager.Driver("cache")
+	s.Nil(err)
+	s.IsType(&driver.Cache{}, driverInstance)
+}
+
 func (s *ManagerTestSuite) TestDriver_NotSupported() {
 	s.mockConfig.On("GetString", "session.drivers.not_supported.driver").Return("not_supported").Once()
 
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/http"
+	contractssession "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/support/color"
+)
+
+// sessionSavedKey marks the request whose session has been saved by BlockSession,
+// StartSession doesn't save it again once the lock is released.
+type sessionSavedKey struct{}
+
+type blockSessionMiddleware struct {
+	lock time.Duration
+	wait time.Duration
+}
+
+func (s *blockSessionMiddleware) Signature() string {
+	return "goravel:block_session"
+}
+
+func (s *blockSessionMiddleware) Handle(ctx http.Context) {
+	req := ctx.Request()
+
+	if !req.HasSession() {
+		req.Next()
+		return
+	}
+
+	store := s.store()
+	if store == nil {
+		color.Errorln(errors.SessionBlockCacheNotSet)
+		req.Next()
+		return
+	}
+
+	sess := req.Session()
+	lock := store.Lock("session:lock:"+sess.GetID(), s.lock)
+	if !lock.Block(s.wait) {
+		req.AbortWithStatus(http.StatusLocked)
+		return
+	}
+	defer lock.Release()
+
+	// The session was loaded before the lock was acquired, it's loaded again to
+	// get the changes of the request that held the lock.
+	client := sess.Only([]string{contractssession.AttributeIPAddress, contractssession.AttributeUserAgent})
+	sess.Flush()
+	sess.Start()
+	for key, value := range client {
+		sess.Put(key, value)
+	}
+
+	req.Next()
+
+	if err := sess.Save(); err != nil {
+		color.Errorf("Error saving session: %s\n", err)
+	}
+
+	ctx.WithValue(sessionSavedKey{}, true)
+}
+
+func (s *blockSessionMiddleware) store() contractscache.Driver {
+	if session.CacheResolver == nil {
+		return nil
+	}
+
+	cache := session.CacheResolver()
+	if cache == nil {
+		return nil
+	}
+
+	if name := session.ConfigFacade.GetString("session.block_store"); name != "" {
+		return cache.Store(name)
+	}
+
+	return cache
+}
+
+// BlockSession serialises the concurrent requests of the same session, so that
+// the changes of a request are not overwritten by another one. A request waits
+// for the lock at most wait, and the lock is released after lock in case the
+// request never finishes. It should be registered after StartSession.
+func BlockSession(lock, wait time.Duration) http.Middleware {
+	return &blockSessionMiddleware{
+		lock: lock,
+		wait: wait,
+	}
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsession "github.com/goravel/framework/contracts/session"
+	mockscache "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
+	mockshttp "github.com/goravel/framework/mocks/http"
+	mockssession "github.com/goravel/framework/mocks/session"
+	"github.com/goravel/framework/session"
+)
+
+func TestBlockSession(t *testing.T) {
+	var (
+		mockCache   *mockscache.Cache
+		mockConfig  *configmocks.Config
+		mockContext *mockshttp.Context
+		mockRequest *mockshttp.ContextRequest
+		mockSession *mockssession.Session
+		mockLock    *mockscache.Lock
+	)
+
+	originConfigFacade := session.ConfigFacade
+	originCacheResolver := session.CacheResolver
+	t.Cleanup(func() {
+		session.ConfigFacade = originConfigFacade
+		session.CacheResolver = originCacheResolver
+	})
+
+	beforeEach := func() {
+		mockCache = mockscache.NewCache(t)
+		mockConfig = configmocks.NewConfig(t)
+		mockContext = mockshttp.NewContext(t)
+		mockRequest = mockshttp.NewContextRequest(t)
+		mockSession = mockssession.NewSession(t)
+		mockLock = mockscache.NewLock(t)
+
+		session.ConfigFacade = mockConfig
+		session.CacheResolver = func() contractscache.Cache {
+			return mockCache
+		}
+		mockContext.EXPECT().Request().Return(mockRequest).Once()
+	}
+
+	t.Run("without session", func(t *testing.T) {
+		beforeEach()
+		mockRequest.EXPECT().HasSession().Return(false).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		BlockSession(10*time.Second, 5*time.Second).Handle(mockContext)
+	})
+
+	t.Run("lock timeout", func(t *testing.T) {
+		beforeEach()
+		mockRequest.EXPECT().HasSession().Return(true).Once()
+		mockRequest.EXPECT().Session().Return(mockSession).Once()
+		mockConfig.EXPECT().GetString("session.block_store").Return("redis").Once()
+		mockCache.EXPECT().Store("redis").Return(mockCache).Once()
+		mockSession.EXPECT().GetID().Return("id").Once()
+		mockCache.EXPECT().Lock("session:lock:id", 10*time.Second).Return(mockLock).Once()
+		mockLock.EXPECT().Block(5 * time.Second).Return(false).Once()
+		mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusLocked).Once()
+
+		BlockSession(10*time.Second, 5*time.Second).Handle(mockContext)
+	})
+
+	t.Run("reloads and saves the session while holding the lock", func(t *testing.T) {
+		beforeEach()
+		mockRequest.EXPECT().HasSession().Return(true).Once()
+		mockRequest.EXPECT().Session().Return(mockSession).Once()
+		mockConfig.EXPECT().GetString("session.block_store").Return("").Once()
+		mockSession.EXPECT().GetID().Return("id").Once()
+		mockCache.EXPECT().Lock("session:lock:id", 10*time.Second).Return(mockLock).Once()
+		mockLock.EXPECT().Block(5 * time.Second).Return(true).Once()
+
+		var steps []string
+		mockSession.EXPECT().Only([]string{contractsession.AttributeIPAddress, contractsession.AttributeUserAgent}).
+			Return(map[string]any{contractsession.AttributeIPAddress: "127.0.0.1"}).Once()
+		mockSession.EXPECT().Flush().Return(mockSession).Once()
+		mockSession.EXPECT().Start().RunAndReturn(func() bool {
+			steps = append(steps, "start")
+			return true
+		}).Once()
+		mockSession.EXPECT().Put(contractsession.AttributeIPAddress, "127.0.0.1").Return(mockSession).Once()
+		mockRequest.EXPECT().Next().Run(func() {
+			steps = append(steps, "next")
+		}).Once()
+		mockSession.EXPECT().Save().RunAndReturn(func() error {
+			steps = append(steps, "save")
+			return nil
+		}).Once()
+		mockContext.EXPECT().WithValue(sessionSavedKey{}, true).Once()
+		mockLock.EXPECT().Release().RunAndReturn(func() bool {
+			steps = append(steps, "release")
+			return true
+		}).Once()
+
+		BlockSession(10*time.Second, 5*time.Second).Handle(mockContext)
+
+		assert.Equal(t, []string{"start", "next", "save", "release"}, steps)
+	})
+}
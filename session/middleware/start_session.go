@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"github.com/goravel/framework/contracts/http"
+	contractsession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/session"
+	sessiondriver "github.com/goravel/framework/session/driver"
 	"github.com/goravel/framework/support/color"
 )
 
@@ -36,20 +38,67 @@ func (s *startSessionMiddleware) Handle(ctx http.Context) {
 
 	sess.SetID(req.Cookie(sess.GetName()))
 
+	// The cookie driver is shared by all requests, it's scoped to the payload of this request.
+	cookieDriver, isCookie := driver.(*sessiondriver.Cookie)
+	if isCookie {
+		cookieDriver = cookieDriver.WithPayload(req.Cookie(session.PayloadCookieName(sess)))
+		sess.SetDriver(cookieDriver)
+	}
+
 	sess.Start()
 	req.SetSession(sess)
 
+	// The drivers indexing the sessions by user record the client of the session.
+	if _, ok := driver.(contractsession.UserDriver); ok {
+		sess.Put(contractsession.AttributeIPAddress, req.Ip())
+		sess.Put(contractsession.AttributeUserAgent, req.Header("User-Agent"))
+	}
+
 	session.WriteCookie(ctx, sess)
 
+	// The response is usually written before Next returns, so the payload of the
+	// cookie driver is sent every time the session changes.
+	sessWithOnChange, hasOnChange := sess.(*session.Session)
+	if isCookie && hasOnChange {
+		sessWithOnChange.OnChange(func() {
+			writePayload(ctx, sessWithOnChange, cookieDriver)
+		})
+	}
+
 	req.Next()
 
-	if err = sess.Save(); err != nil {
+	if isCookie && hasOnChange {
+		sessWithOnChange.OnChange(nil)
+	}
+
+	if saved, _ := ctx.Value(sessionSavedKey{}).(bool); !saved {
+		err = sess.Save()
+	}
+
+	if err != nil {
 		color.Errorf("Error saving session: %s\n", err)
+	} else if isCookie {
+		session.WritePayloadCookie(ctx, sess, cookieDriver.Payload())
 	}
 
 	session.SessionFacade.ReleaseSession(sess)
 }
 
+// writePayload writes the session to the cookie driver and sends the payload to the client.
+func writePayload(ctx http.Context, sess *session.Session, driver *sessiondriver.Cookie) {
+	data, err := sess.Data()
+	if err == nil {
+		err = driver.Write(sess.GetID(), data)
+	}
+
+	if err != nil {
+		color.Errorf("Error saving session: %s\n", err)
+		return
+	}
+
+	session.WritePayloadCookie(ctx, sess, driver.Payload())
+}
+
 func StartSession() http.Middleware {
 	return &startSessionMiddleware{}
 }
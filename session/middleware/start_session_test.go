@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"encoding/base64"
 	nethttp "net/http"
 	"net/http/httptest"
 	"net/url"
@@ -9,12 +10,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/goravel/framework/contracts/crypt"
 	contractshttp "github.com/goravel/framework/contracts/http"
 	contractsession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/foundation/json"
 	configmocks "github.com/goravel/framework/mocks/config"
+	cryptmocks "github.com/goravel/framework/mocks/crypt"
 	"github.com/goravel/framework/session"
 	"github.com/goravel/framework/support/file"
 	"github.com/goravel/framework/support/path"
@@ -47,7 +51,7 @@ func TestStartSession(t *testing.T) {
 	mockConfig.EXPECT().GetString("session.files").Return(path.Storage("framework/sessions")).Once()
 	mockConfig.EXPECT().GetString("session.cookie").Return("goravel_session").Once()
 
-	session.SessionFacade = session.NewManager(mockConfig, json.New())
+	session.SessionFacade = session.NewManager(mockConfig, json.New(), session.Resolvers{})
 	server := httptest.NewServer(testHttpSessionMiddleware(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
 		switch r.URL.Path {
 		case "/add":
@@ -82,6 +86,73 @@ func TestStartSession(t *testing.T) {
 	assert.NoError(t, file.Remove("storage"))
 }
 
+func TestStartSessionWithCookieDriver(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	session.ConfigFacade = mockConfig
+	mockConfig.EXPECT().GetString("session.default", "file").Return("cookie").Once()
+	mockConfig.EXPECT().GetString("session.drivers.cookie.driver").Return("cookie").Once()
+	mockConfig.EXPECT().GetInt("session.gc_interval", 30).Return(30).Once()
+	mockConfig.EXPECT().GetString("session.files").Return("").Once()
+	mockConfig.EXPECT().GetString("session.cookie").Return("goravel_session").Once()
+	mockConfig.EXPECT().GetString("session.default").Return("cookie")
+	mockConfig.EXPECT().GetInt("session.lifetime", 120).Return(120)
+	mockConfig.EXPECT().GetString("session.path").Return("/")
+	mockConfig.EXPECT().GetString("session.domain").Return("")
+	mockConfig.EXPECT().GetBool("session.secure").Return(false)
+	mockConfig.EXPECT().GetBool("session.http_only").Return(true)
+	mockConfig.EXPECT().GetString("session.same_site").Return("")
+
+	mockCrypt := cryptmocks.NewCrypt(t)
+	mockCrypt.EXPECT().EncryptString(mock.Anything).RunAndReturn(func(value string) (string, error) {
+		return base64.StdEncoding.EncodeToString([]byte(value)), nil
+	})
+	mockCrypt.EXPECT().DecryptString(mock.Anything).RunAndReturn(func(payload string) (string, error) {
+		value, err := base64.StdEncoding.DecodeString(payload)
+		return string(value), err
+	})
+
+	session.SessionFacade = session.NewManager(mockConfig, json.New(), session.Resolvers{
+		Crypt: func() crypt.Crypt { return mockCrypt },
+	})
+	next := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		s := r.Context().Value("session").(contractsession.Session)
+		switch r.URL.Path {
+		case "/add":
+			s.Put("foo", "bar").Flash("baz", "qux")
+		case "/get":
+			assert.Equal(t, "bar", s.Get("foo"))
+			assert.Equal(t, "qux", s.Get("baz"))
+		}
+
+		// The response is written before the middleware saves the session.
+		_, err := w.Write([]byte("ok"))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		StartSession().Handle(NewTestContext(r.Context(), next, w, r))
+	}))
+	defer server.Close()
+
+	client := &nethttp.Client{}
+
+	resp, err := client.Get(server.URL + "/add")
+	require.NoError(t, err)
+	cookies := resp.Cookies()
+	require.Len(t, cookies, 2)
+	assert.Equal(t, "goravel_session", cookies[0].Name)
+	assert.Equal(t, "goravel_session_payload", cookies[1].Name)
+
+	req, err := nethttp.NewRequest("GET", server.URL+"/get", nil)
+	require.NoError(t, err)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, nethttp.StatusOK, resp.StatusCode)
+}
+
 type TestContext struct {
 	ctx     context.Context
 	next    nethttp.Handler
@@ -225,3 +296,11 @@ func (r *TestResponse) Cookie(cookie contractshttp.Cookie) contractshttp.Context
 func (r *TestResponse) Header(string, string) contractshttp.ContextResponse {
 	return r
 }
+
+func (r *TestResponse) WithoutCookie(name string) contractshttp.ContextResponse {
+	return r.Cookie(contractshttp.Cookie{Name: name, MaxAge: -1})
+}
+
+func (r *TestResponse) Writer() nethttp.ResponseWriter {
+	return r.ctx.writer
+}
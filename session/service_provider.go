@@ -2,15 +2,21 @@ package session
 
 import (
 	"github.com/goravel/framework/contracts/binding"
+	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	contractsconsole "github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/session/console"
 )
 
 var (
 	SessionFacade session.Manager
 	ConfigFacade  config.Config
+	// CacheResolver resolves the cache used to lock the sessions, it's called
+	// only when the BlockSession middleware is used.
+	CacheResolver func() cache.Cache
 )
 
 type ServiceProvider struct {
@@ -38,11 +44,24 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 			return nil, errors.JSONParserNotSet.SetModule(errors.ModuleSession)
 		}
 
-		return NewManager(c, j), nil
+		return NewManager(c, j, Resolvers{
+			Cache: app.MakeCache,
+			Crypt: app.MakeCrypt,
+			DB:    app.MakeDB,
+		}), nil
 	})
 }
 
 func (r *ServiceProvider) Boot(app foundation.Application) {
 	SessionFacade = app.MakeSession()
 	ConfigFacade = app.MakeConfig()
+	CacheResolver = app.MakeCache
+
+	r.registerCommands(app)
+}
+
+func (r *ServiceProvider) registerCommands(app foundation.Application) {
+	app.Commands([]contractsconsole.Command{
+		console.NewTableCommand(),
+	})
 }
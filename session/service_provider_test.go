@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/goravel/framework/contracts/binding"
+	contractsconsole "github.com/goravel/framework/contracts/console"
 	contractsfoundation "github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/errors"
 	"github.com/goravel/framework/foundation/json"
@@ -97,6 +98,9 @@ func TestSessionServiceProviderBoot(t *testing.T) {
 
 	app.EXPECT().MakeSession().Return(session).Once()
 	app.EXPECT().MakeConfig().Return(config).Once()
+	app.EXPECT().Commands(mock.MatchedBy(func(commands []contractsconsole.Command) bool {
+		return len(commands) == 1 && commands[0].Signature() == "session:table"
+	})).Once()
 
 	provider.Boot(app)
 
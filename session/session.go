@@ -20,6 +20,7 @@ type Session struct {
 	id         string
 	name       string
 	started    bool
+	onChange   func()
 }
 
 func NewSession(name string, driver sessioncontract.Driver, json foundation.Json, id ...string) *Session {
@@ -60,11 +61,14 @@ func (s *Session) Flash(key string, value any) sessioncontract.Session {
 
 func (s *Session) Flush() sessioncontract.Session {
 	s.attributes = make(map[string]any)
+	s.changed()
+
 	return s
 }
 
 func (s *Session) Forget(keys ...string) sessioncontract.Session {
 	supportmaps.Forget(s.attributes, keys...)
+	s.changed()
 
 	return s
 }
@@ -73,6 +77,10 @@ func (s *Session) Get(key string, defaultValue ...any) any {
 	return supportmaps.Get(s.attributes, key, defaultValue...)
 }
 
+func (s *Session) GetDriver() sessioncontract.Driver {
+	return s.driver
+}
+
 func (s *Session) GetID() string {
 	return s.id
 }
@@ -105,6 +113,15 @@ func (s *Session) Missing(key string) bool {
 	return !s.Exists(key)
 }
 
+// Data returns the data that Save writes to the driver, the flash data is aged
+// in a copy of the attributes, so that the session can still be changed.
+func (s *Session) Data() (string, error) {
+	attributes := maps.Clone(s.attributes)
+	ageFlashData(attributes)
+
+	return s.json.MarshalString(attributes)
+}
+
 func (s *Session) Now(key string, value any) sessioncontract.Session {
 	s.Put(key, value)
 
@@ -114,16 +131,27 @@ func (s *Session) Now(key string, value any) sessioncontract.Session {
 	return s
 }
 
+// OnChange sets the callback called after the attributes or the ID of the session
+// change, the cookie driver uses it to send the session before the response is written.
+func (s *Session) OnChange(callback func()) {
+	s.onChange = callback
+}
+
 func (s *Session) Only(keys []string) map[string]any {
 	return supportmaps.Only(s.attributes, keys...)
 }
 
 func (s *Session) Pull(key string, def ...any) any {
-	return supportmaps.Pull(s.attributes, key, def...)
+	value := supportmaps.Pull(s.attributes, key, def...)
+	s.changed()
+
+	return value
 }
 
 func (s *Session) Put(key string, value any) sessioncontract.Session {
 	s.attributes[key] = value
+	s.changed()
+
 	return s
 }
 
@@ -181,6 +209,8 @@ func (s *Session) SetID(id string) sessioncontract.Session {
 		s.id = s.generateSessionID()
 	}
 
+	s.changed()
+
 	return s
 }
 
@@ -205,6 +235,12 @@ func (s *Session) Token() string {
 	return s.Get("_token").(string)
 }
 
+func (s *Session) changed() {
+	if s.onChange != nil {
+		s.onChange()
+	}
+}
+
 func (s *Session) generateSessionID() string {
 	return str.Random(40)
 }
@@ -255,10 +291,7 @@ func (s *Session) readFromHandler() map[string]any {
 }
 
 func (s *Session) ageFlashData() {
-	old := toStringSlice(s.Get("_flash.old", []any{}).([]any))
-	s.Forget(old...)
-	s.Put("_flash.old", s.Get("_flash.new", []any{}))
-	s.Put("_flash.new", []any{})
+	ageFlashData(s.attributes)
 }
 
 func (s *Session) mergeNewFlashes(keys ...string) {
@@ -286,6 +319,15 @@ func (s *Session) removeFromOldFlashData(keys ...string) {
 	s.Put("_flash.old", old)
 }
 
+// ageFlashData removes the flash data of the previous request and keeps the
+// flash data of the current request for the next one.
+func ageFlashData(attributes map[string]any) {
+	old := toStringSlice(supportmaps.Get(attributes, "_flash.old", any([]any{})).([]any))
+	supportmaps.Forget(attributes, old...)
+	attributes["_flash.old"] = supportmaps.Get(attributes, "_flash.new", any([]any{}))
+	attributes["_flash.new"] = []any{}
+}
+
 // toStringSlice converts an interface slice to a string slice.
 func toStringSlice(anySlice []any) []string {
 	strSlice := make([]string, len(anySlice))
@@ -27,13 +27,36 @@ func init() {
 		"default": "file",
 
 		// Session drivers
-		// Available Drivers: "file", "custom"
+		// Available Drivers: "file", "database", "cookie", "cache", "custom"
+		//
+		// The database driver requires the sessions table, run "./artisan session:table" to create the migration.
+		// The cookie driver stores the encrypted sessions in the cookies of the clients.
+		// The cache driver stores the sessions in a cache store, the default cache store is used when the store is empty.
 		"drivers": map[string]any{
 			"file": map[string]any{
 				"driver": "file",
 			},
+			"database": map[string]any{
+				"driver":     "database",
+				"connection": config.Env("DB_CONNECTION"),
+				"table":      "sessions",
+			},
+			"cookie": map[string]any{
+				"driver": "cookie",
+			},
+			"cache": map[string]any{
+				"driver": "cache",
+				"store":  "",
+			},
 		},
 
+		// Session Block Store
+		//
+		// The BlockSession middleware locks a session with this cache store, so that the
+		// concurrent requests of the same session are handled one by one. The default
+		// cache store is used when it's empty.
+		"block_store": config.Env("SESSION_BLOCK_STORE", ""),
+
 		// Session Lifetime
 		//
 		// Here you may specify the number of minutes that you wish the session
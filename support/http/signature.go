@@ -0,0 +1,81 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/goravel/framework/errors"
+)
+
+const (
+	SignatureQueryExpires   = "expires"
+	SignatureQuerySignature = "signature"
+)
+
+// SignURL adds a HMAC-SHA256 signature of the path and query to the URL, the URL never expires if the expiration is zero.
+// The host isn't signed, so the URL keeps valid behind proxies.
+func SignURL(key, rawURL string, expiration time.Time) (string, error) {
+	if key == "" {
+		return "", errors.HttpSignatureKeyNotSet
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.HttpSignatureInvalidURL.Args(rawURL)
+	}
+
+	query := u.Query()
+	query.Del(SignatureQuerySignature)
+	query.Del(SignatureQueryExpires)
+	if !expiration.IsZero() {
+		query.Set(SignatureQueryExpires, strconv.FormatInt(expiration.Unix(), 10))
+	}
+
+	query.Set(SignatureQuerySignature, signature(key, u.EscapedPath(), query))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// VerifyURL checks the signature of the URL signed by SignURL, and whether it has expired at the given time.
+func VerifyURL(key string, u *url.URL, now time.Time) error {
+	if key == "" {
+		return errors.HttpSignatureKeyNotSet
+	}
+
+	query := u.Query()
+	if !hmac.Equal([]byte(query.Get(SignatureQuerySignature)), []byte(signature(key, u.EscapedPath(), query))) {
+		return errors.HttpSignatureInvalid
+	}
+
+	if expires := query.Get(SignatureQueryExpires); expires != "" {
+		timestamp, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return errors.HttpSignatureInvalid
+		}
+		if now.Unix() > timestamp {
+			return errors.HttpSignatureExpired
+		}
+	}
+
+	return nil
+}
+
+// signature signs the path and the query except the signature, the query is sorted by key via Encode.
+func signature(key, path string, query url.Values) string {
+	unsigned := url.Values{}
+	for k, v := range query {
+		if k != SignatureQuerySignature {
+			unsigned[k] = v
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(path + "?" + unsigned.Encode()))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
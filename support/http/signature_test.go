@@ -0,0 +1,87 @@
+package http
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goravel/framework/errors"
+)
+
+func TestSignURL(t *testing.T) {
+	key := "12345678901234567890123456789012"
+	now := time.Now()
+
+	t.Run("key is not set", func(t *testing.T) {
+		_, err := SignURL("", "https://goravel.dev/users/1", time.Time{})
+		assert.ErrorIs(t, err, errors.HttpSignatureKeyNotSet)
+	})
+
+	t.Run("never expires", func(t *testing.T) {
+		signed, err := SignURL(key, "https://goravel.dev/users/1?b=2&a=1", time.Time{})
+		require.NoError(t, err)
+
+		u, err := url.Parse(signed)
+		require.NoError(t, err)
+		assert.Empty(t, u.Query().Get(SignatureQueryExpires))
+		assert.Equal(t, "1", u.Query().Get("a"))
+		assert.NoError(t, VerifyURL(key, u, now.Add(24*time.Hour)))
+	})
+
+	t.Run("the host isn't signed", func(t *testing.T) {
+		signed, err := SignURL(key, "https://goravel.dev/users/1", time.Time{})
+		require.NoError(t, err)
+
+		u, err := url.Parse(signed)
+		require.NoError(t, err)
+		u.Host = "internal:3000"
+		assert.NoError(t, VerifyURL(key, u, now))
+	})
+
+	t.Run("expires", func(t *testing.T) {
+		signed, err := SignURL(key, "https://goravel.dev/users/1", now.Add(time.Minute))
+		require.NoError(t, err)
+
+		u, err := url.Parse(signed)
+		require.NoError(t, err)
+		assert.NoError(t, VerifyURL(key, u, now))
+		assert.ErrorIs(t, VerifyURL(key, u, now.Add(2*time.Minute)), errors.HttpSignatureExpired)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		signed, err := SignURL(key, "https://goravel.dev/users/1?a=1", now.Add(time.Minute))
+		require.NoError(t, err)
+
+		u, err := url.Parse(signed)
+		require.NoError(t, err)
+
+		tampered := *u
+		tampered.Path = "/users/2"
+		assert.ErrorIs(t, VerifyURL(key, &tampered, now), errors.HttpSignatureInvalid)
+
+		query := u.Query()
+		query.Set(SignatureQueryExpires, "9999999999")
+		tampered = *u
+		tampered.RawQuery = query.Encode()
+		assert.ErrorIs(t, VerifyURL(key, &tampered, now), errors.HttpSignatureInvalid)
+
+		assert.ErrorIs(t, VerifyURL("another-key", u, now), errors.HttpSignatureInvalid)
+	})
+
+	t.Run("resign replaces the signature", func(t *testing.T) {
+		signed, err := SignURL(key, "https://goravel.dev/users/1", now.Add(time.Minute))
+		require.NoError(t, err)
+
+		resigned, err := SignURL(key, signed, time.Time{})
+		require.NoError(t, err)
+
+		u, err := url.Parse(resigned)
+		require.NoError(t, err)
+		assert.Len(t, u.Query()[SignatureQuerySignature], 1)
+		assert.Empty(t, u.Query().Get(SignatureQueryExpires))
+		assert.NoError(t, VerifyURL(key, u, now.Add(time.Hour)))
+	})
+}
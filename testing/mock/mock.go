@@ -320,6 +320,13 @@ func (r *factory) Testing() *mockstesting.Testing {
 	return mockTesting
 }
 
+func (r *factory) URL() *mocksroute.URL {
+	mockURL := &mocksroute.URL{}
+	r.app.EXPECT().MakeURL().Return(mockURL)
+
+	return mockURL
+}
+
 func (r *factory) Validation() *mocksvalidate.Validation {
 	mockValidation := &mocksvalidate.Validation{}
 	r.app.EXPECT().MakeValidation().Return(mockValidation)